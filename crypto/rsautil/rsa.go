@@ -6,6 +6,7 @@ import (
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/sha1"
+	"crypto/sha256"
 	"crypto/sha512"
 	"crypto/x509"
 	"encoding/asn1"
@@ -273,3 +274,29 @@ func VerifySHA1(publickey []byte, data []byte, vStr []byte) (bool, error) {
 	}
 	return true, nil
 }
+
+//SignSHA256WithKey RSA签名 SHA256withRSA,使用已解析的私钥(如PKCS#12证书中的私钥)
+func SignSHA256WithKey(privateKey *rsa.PrivateKey, data []byte) ([]byte, error) {
+	if privateKey == nil {
+		return nil, errors.New("private key error")
+	}
+	hashed := sha256.Sum256(data)
+	ret, err := rsa.SignPKCS1v15(rand.Reader, privateKey, crypto.SHA256, hashed[:])
+	if err != nil {
+		return nil, errors.New("签名失败:" + err.Error())
+	}
+	return ret, nil
+}
+
+//VerifySHA256WithKey RSA验证签名 SHA256withRSA,使用已解析的公钥(如证书中的公钥)
+func VerifySHA256WithKey(publicKey *rsa.PublicKey, data []byte, vStr []byte) (bool, error) {
+	if publicKey == nil {
+		return false, errors.New("public key error")
+	}
+	hashed := sha256.Sum256(data)
+	err := rsa.VerifyPKCS1v15(publicKey, crypto.SHA256, hashed[:], vStr)
+	if err != nil {
+		return false, errors.New("签名验证失败:" + err.Error())
+	}
+	return true, nil
+}
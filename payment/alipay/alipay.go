@@ -2,16 +2,19 @@ package alipay
 
 import (
 	"io/ioutil"
-	"net/http"
 
 	"github.com/kinwyb/golang/payment"
+	"github.com/kinwyb/golang/payment/qrcode"
 
 	"github.com/kinwyb/golang/utils"
 
 	"fmt"
 
 	"encoding/json"
+	"errors"
 	"strconv"
+	"strings"
+	"time"
 )
 
 type alipay struct {
@@ -25,6 +28,9 @@ type alipay struct {
 
 //支付,返回支付代码
 func (a *alipay) Pay(req *payment.PayRequest) (string, error) {
+	if !payment.SupportCurrency(a, req.Currency) {
+		return "", fmt.Errorf("不支持的交易币种:%s", req.Currency)
+	}
 	service := "alipay.trade.page.pay"
 	sParams := map[string]string{
 		"subject":      req.Desc,
@@ -32,6 +38,12 @@ func (a *alipay) Pay(req *payment.PayRequest) (string, error) {
 		"out_trade_no": req.No,
 		"product_code": "FAST_INSTANT_TRADE_PAY",
 	}
+	if !payment.IsCNY(req.Currency) { //跨境交易,total_amount为标价币种金额
+		sParams["trans_currency"] = payment.Currency(req.Currency)
+		if a.config.SettleCurrency != "" {
+			sParams["settle_currency"] = a.config.SettleCurrency
+		}
+	}
 	requestbytes, err := json.Marshal(sParams)
 	if err != nil {
 		return "", fmt.Errorf("参数序列化错误")
@@ -39,13 +51,61 @@ func (a *alipay) Pay(req *payment.PayRequest) (string, error) {
 	if req.IsApp { //app支付
 		sParams["product_code"] = "QUICK_MSECURITY_PAY"
 		service = "alipay.trade.app.pay"
-		respdata, err := request(service, a.config, string(requestbytes), a.gateway)
+		respdata, err := request(&a.PayInfo, service, a.config, string(requestbytes), a.gateway)
 		if err != nil {
 			return "", err
 		}
 		return string(respdata), nil
 	}
-	return buildForm(service, a.config, string(requestbytes), a.gateway), nil
+	return buildForm(&a.PayInfo, service, a.config, string(requestbytes), a.gateway), nil
+}
+
+//QRCodePay 当面付扫码支付(预下单),返回二维码内容,opt不为空时同时返回生成的二维码图片
+func (a *alipay) QRCodePay(req *payment.PayRequest, opt *qrcode.Options) (*payment.QRCodeResult, error) {
+	if !payment.SupportCurrency(a, req.Currency) {
+		return nil, fmt.Errorf("不支持的交易币种:%s", req.Currency)
+	}
+	sParams := map[string]string{
+		"subject":      req.Desc,
+		"total_amount": fmt.Sprintf("%.2f", req.Money),
+		"out_trade_no": req.No,
+	}
+	if !payment.IsCNY(req.Currency) {
+		sParams["trans_currency"] = payment.Currency(req.Currency)
+		if a.config.SettleCurrency != "" {
+			sParams["settle_currency"] = a.config.SettleCurrency
+		}
+	}
+	requestbytes, err := json.Marshal(sParams)
+	if err != nil {
+		return nil, fmt.Errorf("参数序列化错误")
+	}
+	respdata, err := request(&a.PayInfo, "alipay.trade.precreate", a.config, string(requestbytes), a.gateway)
+	if err != nil {
+		return nil, err
+	}
+	a.Log(utils.LogLevelInfo, "支付宝预下单结果:%s", respdata)
+	vmap := &precreateAPIResp{}
+	err = json.Unmarshal(respdata, &vmap)
+	if err != nil || vmap.Method == nil {
+		return nil, fmt.Errorf("支付宝预下单结果解析失败")
+	}
+	response := string(respdata)
+	start, end := len(`{"alipay_trade_precreate_response":`), strings.LastIndex(response, `,"sign":`)
+	if vmap.Sign != "" && (end < start || !verify(&a.PayInfo, response[start:end], vmap.Sign, a.config.PublicKey)) {
+		return nil, fmt.Errorf("支付宝预下单结果签名验证失败")
+	} else if vmap.Method.Code != "10000" {
+		return nil, fmt.Errorf("支付宝预下单失败:[%s]%s", vmap.Method.SubCode, vmap.Method.SubMsg)
+	}
+	ret := &payment.QRCodeResult{Code: vmap.Method.QRCode}
+	if opt != nil {
+		ret.Image, err = qrcode.Render(ret.Code, opt)
+		if err != nil {
+			a.Log(utils.LogLevelError, "二维码创建失败:%s", err.Error())
+			return ret, fmt.Errorf("二维码创建失败")
+		}
+	}
+	return ret, nil
 }
 
 //异步结果通知处理,返回支付结果
@@ -68,6 +128,7 @@ func (a *alipay) Notify(params map[string]string) *payment.PayResult {
 	}
 	var err error
 	result.Money, err = strconv.ParseFloat(params["total_amount"], 64)
+	setSettlement(result, params)
 	if err != nil {
 		result.Succ = false
 		result.ErrMsg = "支付宝回调数据错误"
@@ -76,6 +137,10 @@ func (a *alipay) Notify(params map[string]string) *payment.PayResult {
 		if status == "TRADE_FINISHED" || status == "TRADE_SUCCESS" {
 			result.Succ = true
 			result.ThirdAccount = params["buyer_email"]
+			if err = a.verifyOrder(result, params); err != nil {
+				result.Succ = false
+				result.ErrMsg = "支付宝回调订单校验失败:" + err.Error()
+			}
 		} else {
 			result.Succ = false
 		}
@@ -83,7 +148,29 @@ func (a *alipay) Notify(params map[string]string) *payment.PayResult {
 		result.Succ = false
 		result.ErrMsg = "支付宝回调数据验证失败"
 	}
-	return result
+	return a.FillPayFee(result)
+}
+
+//订单业务校验,校验收款方是否为本商户并调用配置的OrderVerifier
+func (a *alipay) verifyOrder(result *payment.PayResult, params map[string]string) error {
+	order := &payment.NotifyOrder{
+		PayCode:      result.PayCode,
+		No:           result.No,
+		TradeNo:      result.TradeNo,
+		ThirdTradeNo: result.ThirdTradeNo,
+		Money:        result.Money,
+		AppID:        params["app_id"],
+		MerchantID:   params["seller_id"],
+		Navite:       params,
+	}
+	appID := ""
+	if order.AppID != "" { //老版本即时到账通知中没有app_id
+		appID = a.config.Partner
+	}
+	if err := order.VerifyMerchant(appID, a.config.SellerID); err != nil {
+		return err
+	}
+	return payment.VerifyNotifyOrder(a.config.OrderVerifier, order)
 }
 
 //同步结果跳转处理,返回支付结果
@@ -98,11 +185,12 @@ func (a *alipay) Result(params map[string]string) *payment.PayResult {
 		Succ:         true,
 	}
 	result.Money,_ = strconv.ParseFloat(params["total_amount"],64)
+	setSettlement(result, params)
 	if !a.verify(params) {
 		result.Succ = false
 		result.ErrMsg = "支付宝回调数据验证失败"
 	}
-	return result
+	return a.FillPayFee(result)
 }
 
 //NotifyResult 通知结果返回内容
@@ -113,6 +201,22 @@ func (a *alipay) NotifyResult(payResult *payment.PayResult) string {
 	return "fail"
 }
 
+//Currencies 支持的交易币种
+func (a *alipay) Currencies() []string {
+	return append([]string{payment.CNY}, a.config.Currencies...)
+}
+
+//跨境交易币种及结算信息
+func setSettlement(result *payment.PayResult, params map[string]string) {
+	result.Currency = payment.Currency(params["trans_currency"])
+	if params["settle_currency"] == "" {
+		return
+	}
+	result.SettleCurrency = params["settle_currency"]
+	result.SettleMoney, _ = strconv.ParseFloat(params["settle_amount"], 64)
+	result.ExchangeRate, _ = strconv.ParseFloat(params["settle_trans_rate"], 64)
+}
+
 //GetPayment 生成一个支付对象
 func (a *alipay) GetPayment(cfg interface{}) payment.Payment {
 	var c *PayConfig
@@ -132,6 +236,8 @@ func (a *alipay) GetPayment(cfg interface{}) payment.Payment {
 		config:       c,
 	}
 	obj.Init(obj.config.Code, obj.config.Name, obj.config.State)
+	obj.SetFee(obj.config.Fee)
+	obj.SetOptions(obj.config.Options, &lg)
 	return obj
 }
 
@@ -150,13 +256,13 @@ func (a *alipay) verify(params map[string]string) bool {
 	delete(params, "sign_type")
 	keys := paraFilter(params)
 	signStr := createLinkString(keys, params)
-	return verify(signStr, sign, a.config.PublicKey)
+	return verify(&a.PayInfo, signStr, sign, a.config.PublicKey)
 }
 
 //获取远程服务器ATN结果,验证返回URL
 func (a *alipay) verifyResponse(notifyID string) bool {
 	verifyURL := a.verifyURL + "partner=" + a.config.Partner + "&notify_id=" + notifyID
-	resp, err := http.Get(verifyURL)
+	resp, err := a.HTTPClient().Get(verifyURL)
 	if err != nil {
 		return false
 	}
@@ -168,7 +274,180 @@ func (a *alipay) verifyResponse(notifyID string) bool {
 	return string(body) == "true"
 }
 
+//Close 关闭未支付的交易(alipay.trade.close),用户未登录支付宝(交易未创建)时视为关闭成功
+func (a *alipay) Close(tradeno string) error {
+	requestbytes, _ := json.Marshal(map[string]string{"out_trade_no": tradeno})
+	respdata, err := request(&a.PayInfo, "alipay.trade.close", a.config, string(requestbytes), a.gateway)
+	if err != nil {
+		return err
+	}
+	a.Log(utils.LogLevelInfo, "支付宝关闭交易结果:%s", respdata)
+	vmap := &tradeCloseAPIResp{}
+	if err = json.Unmarshal(respdata, &vmap); err != nil || vmap.Method == nil {
+		return errors.New("支付宝关闭交易结果解析失败")
+	} else if vmap.Sign != "" && !verifyRaw(&a.PayInfo, respdata, vmap.Sign, "alipay_trade_close_response", a.config.PublicKey) {
+		return errors.New("支付宝关闭交易结果签名验证失败")
+	} else if vmap.Method.Code != "10000" && vmap.Method.SubCode != "ACQ.TRADE_NOT_EXIST" {
+		return errors.New("支付宝关闭交易失败:[" + vmap.Method.SubCode + "]" + vmap.Method.SubMsg)
+	}
+	return nil
+}
+
+//Query 查询交易(alipay.trade.query),交易不存在(用户未登录支付宝)时返回未支付
+//	请求失败、结果验证失败或支付宝系统错误时返回结果的Navite为空,表示支付状态未知
+func (a *alipay) Query(tradeno string, tradeDate ...time.Time) *payment.PayResult {
+	ret := &payment.PayResult{
+		PayCode: a.Code(),
+		TradeNo: tradeno,
+		No:      tradeno,
+	}
+	requestbytes, _ := json.Marshal(map[string]string{"out_trade_no": tradeno})
+	respdata, err := request(&a.PayInfo, "alipay.trade.query", a.config, string(requestbytes), a.gateway)
+	if err != nil {
+		ret.ErrMsg = err.Error()
+		return ret
+	}
+	a.Log(utils.LogLevelInfo, "支付宝查询交易结果:%s", respdata)
+	vmap := &tradeQueryAPIResp{}
+	if err = json.Unmarshal(respdata, &vmap); err != nil || vmap.Method == nil {
+		ret.ErrMsg = "支付宝查询交易结果解析失败"
+		return ret
+	} else if (vmap.Sign != "" || vmap.Method.Code == "10000") && !verifyRaw(&a.PayInfo, respdata, vmap.Sign, "alipay_trade_query_response", a.config.PublicKey) {
+		ret.ErrMsg = "支付宝查询交易结果签名验证失败"
+		return ret
+	}
+	resp := vmap.Method
+	if resp.Code != "10000" {
+		ret.ErrMsg = "支付宝查询交易失败:[" + resp.SubCode + "]" + resp.SubMsg
+		if resp.SubCode == "ACQ.TRADE_NOT_EXIST" {
+			ret.Navite = map[string]string{"code": resp.Code, "sub_code": resp.SubCode, "sub_msg": resp.SubMsg}
+		}
+		return ret
+	}
+	ret.Navite = map[string]string{
+		"trade_no":          resp.TradeNo,
+		"out_trade_no":      resp.OutTradeNo,
+		"buyer_logon_id":    resp.BuyerLogonID,
+		"trade_status":      resp.TradeStatus,
+		"total_amount":      resp.TotalAmount,
+		"trans_currency":    resp.TransCurrency,
+		"settle_currency":   resp.SettleCurrency,
+		"settle_amount":     resp.SettleAmount,
+		"settle_trans_rate": resp.SettleTransRate,
+		"send_pay_date":     resp.SendPayDate,
+	}
+	ret.ThirdTradeNo = resp.TradeNo
+	ret.ThirdAccount = resp.BuyerLogonID
+	ret.Money, _ = strconv.ParseFloat(resp.TotalAmount, 64)
+	setSettlement(ret, ret.Navite)
+	if resp.TradeStatus == "TRADE_SUCCESS" || resp.TradeStatus == "TRADE_FINISHED" {
+		ret.Succ = true
+	} else {
+		ret.ErrMsg = "支付宝交易未支付:" + resp.TradeStatus
+	}
+	return a.FillPayFee(ret)
+}
+
+//Refund 退款(alipay.trade.refund),RefundNo作为退款请求号,相同退款请求号重复请求只退款一次
+//	请求结果未知或支付宝系统错误时返回UNKNOW,可以使用相同退款请求号重试
+func (a *alipay) Refund(req *payment.RefundRequest) *payment.RefundResult {
+	ret := &payment.RefundResult{
+		Status:   payment.FAIL,
+		No:       req.No,
+		RefundNo: req.RefundNo,
+		Money:    req.Money,
+		PayCode:  a.Code(),
+	}
+	params := map[string]string{
+		"out_trade_no":   req.No,
+		"refund_amount":  fmt.Sprintf("%.2f", req.Money),
+		"out_request_no": req.RefundNo,
+	}
+	if req.ThirdTradeNo != "" {
+		params["trade_no"] = req.ThirdTradeNo
+	}
+	if req.Reason != "" {
+		params["refund_reason"] = req.Reason
+	}
+	requestbytes, _ := json.Marshal(params)
+	respdata, err := request(&a.PayInfo, "alipay.trade.refund", a.config, string(requestbytes), a.gateway)
+	if err != nil {
+		if payment.IsRequestFailed(err) {
+			ret.Status = payment.UNKNOW
+		}
+		ret.FailCode = "REQUEST_FAIL"
+		ret.FailMsg = err.Error()
+		return ret
+	}
+	a.Log(utils.LogLevelInfo, "支付宝退款结果:%s", respdata)
+	vmap := &tradeRefundAPIResp{}
+	if err = json.Unmarshal(respdata, &vmap); err != nil || vmap.Method == nil {
+		ret.Status = payment.UNKNOW
+		ret.FailMsg = "支付宝退款结果解析失败"
+		return ret
+	} else if vmap.Sign != "" && !verifyRaw(&a.PayInfo, respdata, vmap.Sign, "alipay_trade_refund_response", a.config.PublicKey) {
+		ret.Status = payment.UNKNOW
+		ret.FailMsg = "支付宝退款结果签名验证失败"
+		return ret
+	}
+	resp := vmap.Method
+	ret.Navite = map[string]string{
+		"code":           resp.Code,
+		"sub_code":       resp.SubCode,
+		"sub_msg":        resp.SubMsg,
+		"trade_no":       resp.TradeNo,
+		"out_trade_no":   resp.OutTradeNo,
+		"buyer_logon_id": resp.BuyerLogonID,
+		"fund_change":    resp.FundChange,
+		"refund_fee":     resp.RefundFee,
+		"gmt_refund_pay": resp.GmtRefundPay,
+	}
+	switch {
+	case resp.Code == "10000":
+		ret.Status = payment.SUCCESS
+		ret.ThirdTradeNo = resp.TradeNo
+	case resp.Code == "20000" || resp.SubCode == "ACQ.SYSTEM_ERROR": //系统繁忙,结果未知
+		ret.Status = payment.UNKNOW
+		ret.FailCode = resp.SubCode
+		ret.FailMsg = resp.SubMsg
+	default:
+		ret.FailCode = resp.SubCode
+		ret.FailMsg = resp.SubMsg
+	}
+	return ret
+}
+
+//Bill 查询交易对账单下载地址(alipay.data.dataservice.bill.downloadurl.query),下载地址30秒内有效
+func (a *alipay) Bill(date time.Time) (*payment.BillResult, error) {
+	day := date.Format("2006-01-02")
+	requestbytes, _ := json.Marshal(map[string]string{"bill_type": "trade", "bill_date": day})
+	respdata, err := request(&a.PayInfo, "alipay.data.dataservice.bill.downloadurl.query", a.config, string(requestbytes), a.gateway)
+	if err != nil {
+		return nil, err
+	}
+	a.Log(utils.LogLevelInfo, "支付宝对账单查询结果:%s", respdata)
+	vmap := &billAPIResp{}
+	if err = json.Unmarshal(respdata, &vmap); err != nil || vmap.Method == nil {
+		return nil, errors.New("支付宝对账单查询结果解析失败")
+	} else if vmap.Sign != "" && !verifyRaw(&a.PayInfo, respdata, vmap.Sign, "alipay_data_dataservice_bill_downloadurl_query_response", a.config.PublicKey) {
+		return nil, errors.New("支付宝对账单查询结果签名验证失败")
+	} else if vmap.Method.Code != "10000" {
+		return nil, errors.New("支付宝对账单查询失败:[" + vmap.Method.SubCode + "]" + vmap.Method.SubMsg)
+	}
+	return &payment.BillResult{PayCode: a.Code(), Date: day, URL: vmap.Method.BillDownloadURL}, nil
+}
+
 //支付宝支付无需确认支付
 func (a *alipay) PayConfirm(req *payment.PayConfirmRequest) *payment.PayResult {
 	return payment.NoPayConfirmResult
 }
+
+//Capabilities 支付能力,PayConfirm无需调用,IsApp为true时使用APP支付
+func (a *alipay) Capabilities() *payment.Capabilities {
+	return &payment.Capabilities{
+		Operations: []string{payment.OpPay, payment.OpNotify, payment.OpResult},
+		TradeTypes: []string{payment.TradeWeb, payment.TradeApp},
+		Required:   []string{"No", "Desc", "Money"},
+		MaxTradeNo: 64,
+	}
+}
@@ -3,52 +3,53 @@ package alipay
 import (
 	"bytes"
 	"crypto"
+	"crypto/md5"
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/sha256"
 	"crypto/x509"
 	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io/ioutil"
-	"net/http"
 
 	"sort"
 	"strings"
 
 	"encoding/pem"
 
-	"time"
-
 	"net/url"
 
+	"github.com/kinwyb/golang/payment"
 	"github.com/kinwyb/golang/utils"
 )
 
 //签名
-func sign(args map[string]string, privatekey string) {
+func sign(p *payment.PayInfo, args map[string]string, privatekey string) {
 	keys := paraFilter(args)
 	signStr := createLinkString(keys, args)
 	data, err := decodeRSAKey(privatekey)
 	if err != nil {
-		log(utils.LogLevelError, "支付宝私钥解析失败")
+		p.Log(utils.LogLevelError, "支付宝私钥解析失败")
 		return
 	}
 	priv, err := x509.ParsePKCS8PrivateKey(data)
 	if err != nil {
-		log(utils.LogLevelError, "支付宝签名RSA私钥初始化失败:"+err.Error())
+		p.Log(utils.LogLevelError, "支付宝签名RSA私钥初始化失败:"+err.Error())
 		return
 	}
-	log(utils.LogLevelDebug, "支付宝签名字符串:%s", signStr)
+	p.Log(utils.LogLevelDebug, "支付宝签名字符串:%s", signStr)
 	dt := sha256.Sum256([]byte(signStr))
 	data, err = rsa.SignPKCS1v15(rand.Reader, priv.(*rsa.PrivateKey), crypto.SHA256, dt[:])
 	if err != nil {
-		log(utils.LogLevelError, "支付宝签名失败:"+err.Error())
+		p.Log(utils.LogLevelError, "支付宝签名失败:"+err.Error())
 		return
 	}
 	args["sign"] = base64.StdEncoding.EncodeToString(data)
 	args["sign_type"] = "RSA2"
-	log(utils.LogLevelError, "签名结果:%s", args["sign"])
+	p.Log(utils.LogLevelError, "签名结果:%s", args["sign"])
 }
 
 //过滤
@@ -93,30 +94,34 @@ func decodeRSAKey(key string) ([]byte, error) {
 }
 
 //request请求
-func request(service string, config *PayConfig, bizContent string, getway string) ([]byte, error) {
-	args := buildParams(service, config, bizContent)
+func request(p *payment.PayInfo, service string, config *PayConfig, bizContent string, getway string) ([]byte, error) {
+	args := buildParams(p, service, config, bizContent)
 	params := url.Values{}
 	for k, v := range args {
 		params.Add(k, v)
 	}
-	log(utils.LogLevelDebug, "支付宝接口请求参数:%s", params.Encode())
-	resp, err := http.Post(getway,
+	p.Log(utils.LogLevelDebug, "支付宝接口请求参数:%s", params.Encode())
+	resp, err := p.HTTPClient().Post(getway,
 		"application/x-www-form-urlencoded;charset=utf-8", strings.NewReader(params.Encode()))
 	if err != nil {
-		log(utils.LogLevelError, "支付宝接口请求异常:%s", err.Error())
-		return nil, fmt.Errorf("请求失败")
+		p.Log(utils.LogLevelError, "支付宝接口请求异常:%s", err.Error())
+		return nil, payment.WrapRequestError(err, fmt.Errorf("请求失败"))
 	}
 	respdata, err := ioutil.ReadAll(resp.Body)
 	resp.Body.Close()
 	if err != nil {
-		log(utils.LogLevelError, "支付宝接口结果读取异常:%s", err.Error())
-		return nil, fmt.Errorf("结果读取失败")
+		p.Log(utils.LogLevelError, "支付宝接口结果读取异常:%s", err.Error())
+		return nil, &payment.RequestFailed{Err: fmt.Errorf("结果读取失败")}
 	}
 	return respdata, nil
 }
 
-func buildForm(service string, config *PayConfig, bizContent string, getway string) string {
-	sParams := buildParams(service, config, bizContent)
+func buildForm(p *payment.PayInfo, service string, config *PayConfig, bizContent string, getway string) string {
+	return renderForm(buildParams(p, service, config, bizContent), getway)
+}
+
+//生成自动提交的表单
+func renderForm(sParams map[string]string, getway string) string {
 	buf := bytes.NewBufferString("<form id=\"alipaysubmit\" name=\"alipaysubmit\" action=\"")
 	buf.WriteString(getway)
 	buf.WriteString("?charset=UTF-8\" method=\"POST\">\n")
@@ -133,14 +138,14 @@ func buildForm(service string, config *PayConfig, bizContent string, getway stri
 }
 
 //生成请求参数
-func buildParams(service string, config *PayConfig, bizContent string) map[string]string {
+func buildParams(p *payment.PayInfo, service string, config *PayConfig, bizContent string) map[string]string {
 	args := map[string]string{
 		"app_id":      config.Partner,
 		"method":      service,
 		"format":      "json",
 		"charset":     "UTF-8",
 		"sign_type":   "RSA2",
-		"timestamp":   time.Now().Format("2006-01-02 15:04:05"),
+		"timestamp":   p.Now().Format("2006-01-02 15:04:05"),
 		"version":     "1.0",
 		"biz_content": bizContent,
 	}
@@ -150,29 +155,88 @@ func buildParams(service string, config *PayConfig, bizContent string) map[strin
 	if config.ReturnURL != "" {
 		args["return_url"] = config.ReturnURL
 	}
-	sign(args, config.PrivateKey)
+	if config.AppCert != "" && config.AlipayRootCert != "" { //公钥证书模式
+		args["app_cert_sn"] = appCertSN(p, config.AppCert)
+		args["alipay_root_cert_sn"] = rootCertSN(p, config.AlipayRootCert)
+	}
+	sign(p, args, config.PrivateKey)
 	return args
 }
 
+//解析证书内容,内容中可以包含多个证书
+func parseCerts(data string) []*x509.Certificate {
+	var ret []*x509.Certificate
+	rest := []byte(data)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			return ret
+		}
+		if cert, err := x509.ParseCertificate(block.Bytes); err == nil {
+			ret = append(ret, cert)
+		}
+	}
+}
+
+//证书序列号,证书签发机构和证书序列号拼接后的MD5值
+func certSN(cert *x509.Certificate) string {
+	sum := md5.Sum([]byte(cert.Issuer.String() + cert.SerialNumber.String()))
+	return hex.EncodeToString(sum[:])
+}
+
+//应用公钥证书序列号
+func appCertSN(p *payment.PayInfo, data string) string {
+	certs := parseCerts(data)
+	if len(certs) < 1 {
+		p.Log(utils.LogLevelError, "支付宝应用公钥证书解析失败")
+		return ""
+	}
+	return certSN(certs[0])
+}
+
+//支付宝根证书序列号,根证书中RSA签名算法证书的序列号使用"_"拼接
+func rootCertSN(p *payment.PayInfo, data string) string {
+	var sn []string
+	for _, cert := range parseCerts(data) {
+		if cert.SignatureAlgorithm == x509.SHA1WithRSA || cert.SignatureAlgorithm == x509.SHA256WithRSA {
+			sn = append(sn, certSN(cert))
+		}
+	}
+	if len(sn) < 1 {
+		p.Log(utils.LogLevelError, "支付宝根证书解析失败")
+	}
+	return strings.Join(sn, "_")
+}
+
+//verifyRaw 接口返回结果校验,name为返回内容中结果对象的字段名称,使用结果对象的原始内容验签
+func verifyRaw(p *payment.PayInfo, response []byte, signString string, name string, publicKey string) bool {
+	raw := map[string]json.RawMessage{}
+	if err := json.Unmarshal(response, &raw); err != nil || raw[name] == nil {
+		return false
+	}
+	return verify(p, string(raw[name]), signString, publicKey)
+}
+
 //verify 支付结果校验
-func verify(response string, signString string, publicKey string) bool {
+func verify(p *payment.PayInfo, response string, signString string, publicKey string) bool {
 	sign, _ := base64.StdEncoding.DecodeString(signString)
 	data, err := decodeRSAKey(publicKey)
 	if err != nil {
-		log(utils.LogLevelError, "支付宝公钥解析失败")
+		p.Log(utils.LogLevelError, "支付宝公钥解析失败")
 		return false
 	}
 	pubi, err := x509.ParsePKIXPublicKey(data)
 	if err != nil {
-		log(utils.LogLevelError, "支付宝结果校验RSA公钥初始化错误:"+err.Error())
+		p.Log(utils.LogLevelError, "支付宝结果校验RSA公钥初始化错误:"+err.Error())
 		return false
 	}
 	dt := sha256.Sum256([]byte(response))
 	err = rsa.VerifyPKCS1v15(pubi.(*rsa.PublicKey), crypto.SHA256, dt[:], sign)
 	if err != nil {
-		log(utils.LogLevelError, "支付宝结果校验失败:"+err.Error())
-		log(utils.LogLevelError, "支付宝校验签名的字符串:%s", response)
-		log(utils.LogLevelError, "支付宝校验的签名:%s", signString)
+		p.Log(utils.LogLevelError, "支付宝结果校验失败:"+err.Error())
+		p.Log(utils.LogLevelError, "支付宝校验签名的字符串:%s", response)
+		p.Log(utils.LogLevelError, "支付宝校验的签名:%s", signString)
 		return false
 	}
 	return true
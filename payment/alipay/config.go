@@ -11,57 +11,252 @@ import (
 //PayConfig 支付配置信息
 type PayConfig struct {
 	payment.Config
-	Partner    string //商户号
-	PrivateKey string //交易私钥
-	PublicKey  string //交易公钥
-	ReturnURL  string //同步跳转地址
-	NotifyURL  string //异步跳转地址
+	Partner        string   //商户号
+	PrivateKey     string   //交易私钥
+	PublicKey      string   //交易公钥
+	ReturnURL      string   //同步跳转地址
+	NotifyURL      string   //异步跳转地址
+	SellerID       string   //收款支付宝用户号,不为空时校验异步通知中的seller_id
+	Currencies     []string //支持的交易币种[跨境交易],为空只支持CNY
+	SettleCurrency string   //结算币种[跨境交易],为空由支付宝按签约结算币种处理
+	AppCert        string   //应用公钥证书内容[公钥证书模式],与AlipayRootCert同时设置时请求携带证书序列号,转账接口必须使用公钥证书模式
+	AlipayRootCert string   //支付宝根证书内容[公钥证书模式]
+	SignNotifyURL  string   //代扣签约/解约结果通知地址,为空使用NotifyURL
+	SignScene      string   //代扣签约场景码,为空使用INDUSTRY|DIGITAL_MEDIA
+	//OrderVerifier 订单业务校验,异步通知签名验证通过后调用
+	OrderVerifier payment.OrderVerifier `json:"-"`
 }
 
-type withdrawAPIResp struct {
-	Method *withdrawAPIResponse `json:"alipay_fund_trans_toaccount_transfer_response"`
+type transferAPIResp struct {
+	Method *transferAPIResponse `json:"alipay_fund_trans_uni_transfer_response"`
 	Sign   string               `json:"sign"`
 }
 
-//withdrawAPIResponse 提现接口返回结果对象
-type withdrawAPIResponse struct {
-	Code     string `json:"code"`       //网关返回码
-	Msg      string `json:"msg"`        //网关返回码描述
-	SubCode  string `json:"sub_code"`   //业务返回码
-	SubMsg   string `json:"sub_msg"`    //业务返回码描述
-	Sign     string `json:"sign"`       //签名
-	OutBizNo string `json:"out_biz_no"` //商户转账唯一单号
-	OrderID  string `json:"order_id"`   //支付宝转账单据
-	PayDate  string `json:"pay_date"`   //支付时间
+//transferAPIResponse 单笔转账接口返回结果对象
+type transferAPIResponse struct {
+	Code           string `json:"code"`              //网关返回码
+	Msg            string `json:"msg"`               //网关返回码描述
+	SubCode        string `json:"sub_code"`          //业务返回码
+	SubMsg         string `json:"sub_msg"`           //业务返回码描述
+	OutBizNo       string `json:"out_biz_no"`        //商户转账唯一单号
+	OrderID        string `json:"order_id"`          //支付宝转账订单号
+	PayFundOrderID string `json:"pay_fund_order_id"` //支付宝支付资金流水号
+	Status         string `json:"status"`            //转账单据状态 SUCCESS/DEALING/FAIL
+	TransDate      string `json:"trans_date"`        //订单支付时间
 }
-type withdrawQueryAPIResp struct {
-	Method *withdrawQueryAPIResponse `json:"alipay_fund_trans_order_query_response"`
+
+type transferQueryAPIResp struct {
+	Method *transferQueryAPIResponse `json:"alipay_fund_trans_common_query_response"`
 	Sign   string                    `json:"sign"`
 }
 
-//withdrawQueryAPIResponse 提现查询接口返回结果对象
-type withdrawQueryAPIResponse struct {
-	Code       string `json:"code"`        //网关返回码
-	Msg        string `json:"msg"`         //网关返回码描述
-	SubCode    string `json:"sub_code"`    //业务返回码
-	SubMsg     string `json:"sub_msg"`     //业务返回码描述
-	Sign       string `json:"sign"`        //签名
-	OrderID    string `json:"order_id"`    //支付宝转账单据
-	PayDate    string `json:"pay_date"`    //支付时间
-	Status     string `json:"status"`      //转账单据状态
-	OutBizNo   string `json:"out_biz_no"`  //商户转账唯一单号
-	FailReason string `json:"fail_reason"` //失败原因
-	ErrorCode  string `json:"error_code"`  //错误代码
-}
-
-//withdrawAPIRequest 提现接口请求参数
-type withdrawAPIRequest struct {
-	OutBizNo string `json:"out_biz_no"`      //商户转账唯一订单号
-	Type     string `json:"payee_type"`      //收款方账户类型
-	Account  string `json:"payee_account"`   //收款方账户
-	Amount   string `json:"amount"`          //转账金额
-	RealName string `json:"payee_real_name"` //收款方真实姓名
-	Remark   string `json:"remark"`          //转账备注
+//transferQueryAPIResponse 转账业务单据查询接口返回结果对象
+type transferQueryAPIResponse struct {
+	Code           string `json:"code"`              //网关返回码
+	Msg            string `json:"msg"`               //网关返回码描述
+	SubCode        string `json:"sub_code"`          //业务返回码
+	SubMsg         string `json:"sub_msg"`           //业务返回码描述
+	OrderID        string `json:"order_id"`          //支付宝转账订单号
+	PayFundOrderID string `json:"pay_fund_order_id"` //支付宝支付资金流水号
+	OutBizNo       string `json:"out_biz_no"`        //商户转账唯一单号
+	TransAmount    string `json:"trans_amount"`      //转账金额
+	Status         string `json:"status"`            //转账单据状态
+	PayDate        string `json:"pay_date"`          //支付时间
+	ArrivalTimeEnd string `json:"arrival_time_end"`  //预计到账时间
+	OrderFee       string `json:"order_fee"`         //手续费
+	ErrorCode      string `json:"error_code"`        //错误代码
+	FailReason     string `json:"fail_reason"`       //失败原因
+}
+
+type accountQueryAPIResp struct {
+	Method *accountQueryAPIResponse `json:"alipay_fund_account_query_response"`
+	Sign   string                   `json:"sign"`
+}
+
+//accountQueryAPIResponse 资金账户余额查询接口返回结果对象
+type accountQueryAPIResponse struct {
+	Code            string `json:"code"`             //网关返回码
+	Msg             string `json:"msg"`              //网关返回码描述
+	SubCode         string `json:"sub_code"`         //业务返回码
+	SubMsg          string `json:"sub_msg"`          //业务返回码描述
+	AvailableAmount string `json:"available_amount"` //账户可用余额
+	FreezeAmount    string `json:"freeze_amount"`    //冻结金额
+}
+
+type agreementQueryAPIResp struct {
+	Method *agreementQueryAPIResponse `json:"alipay_user_agreement_query_response"`
+	Sign   string                     `json:"sign"`
+}
+
+//agreementQueryAPIResponse 代扣协议查询接口返回结果对象
+type agreementQueryAPIResponse struct {
+	Code                string `json:"code"`                  //网关返回码
+	Msg                 string `json:"msg"`                   //网关返回码描述
+	SubCode             string `json:"sub_code"`              //业务返回码
+	SubMsg              string `json:"sub_msg"`               //业务返回码描述
+	AgreementNo         string `json:"agreement_no"`          //支付宝协议号
+	ExternalAgreementNo string `json:"external_agreement_no"` //商户签约号
+	Status              string `json:"status"`                //协议状态 TEMP/NORMAL/STOP
+	SignTime            string `json:"sign_time"`             //签约时间
+	InvalidTime         string `json:"invalid_time"`          //协议失效时间
+	PrincipalID         string `json:"principal_id"`          //签约用户支付宝用户号
+}
+
+type agreementUnsignAPIResp struct {
+	Method *agreementUnsignAPIResponse `json:"alipay_user_agreement_unsign_response"`
+	Sign   string                      `json:"sign"`
+}
+
+//agreementUnsignAPIResponse 代扣协议解约接口返回结果对象
+type agreementUnsignAPIResponse struct {
+	Code    string `json:"code"`     //网关返回码
+	Msg     string `json:"msg"`      //网关返回码描述
+	SubCode string `json:"sub_code"` //业务返回码
+	SubMsg  string `json:"sub_msg"`  //业务返回码描述
+}
+
+type tradePayAPIResp struct {
+	Method *tradePayAPIResponse `json:"alipay_trade_pay_response"`
+	Sign   string               `json:"sign"`
+}
+
+//tradePayAPIResponse 统一收单交易支付接口返回结果对象
+type tradePayAPIResponse struct {
+	Code        string `json:"code"`          //网关返回码
+	Msg         string `json:"msg"`           //网关返回码描述
+	SubCode     string `json:"sub_code"`      //业务返回码
+	SubMsg      string `json:"sub_msg"`       //业务返回码描述
+	TradeNo     string `json:"trade_no"`      //支付宝交易号
+	OutTradeNo  string `json:"out_trade_no"`  //商户订单号
+	BuyerUserID string `json:"buyer_user_id"` //买家支付宝用户号
+	TotalAmount string `json:"total_amount"`  //交易金额
+	GmtPayment  string `json:"gmt_payment"`   //交易支付时间
+}
+
+type tradeCloseAPIResp struct {
+	Method *tradeCloseAPIResponse `json:"alipay_trade_close_response"`
+	Sign   string                 `json:"sign"`
+}
+
+//tradeCloseAPIResponse 统一收单交易关闭接口返回结果对象
+type tradeCloseAPIResponse struct {
+	Code       string `json:"code"`         //网关返回码
+	Msg        string `json:"msg"`          //网关返回码描述
+	SubCode    string `json:"sub_code"`     //业务返回码
+	SubMsg     string `json:"sub_msg"`      //业务返回码描述
+	TradeNo    string `json:"trade_no"`     //支付宝交易号
+	OutTradeNo string `json:"out_trade_no"` //商户订单号
+}
+
+type tradeQueryAPIResp struct {
+	Method *tradeQueryAPIResponse `json:"alipay_trade_query_response"`
+	Sign   string                 `json:"sign"`
+}
+
+//tradeQueryAPIResponse 统一收单交易查询接口返回结果对象
+type tradeQueryAPIResponse struct {
+	Code            string `json:"code"`              //网关返回码
+	Msg             string `json:"msg"`               //网关返回码描述
+	SubCode         string `json:"sub_code"`          //业务返回码
+	SubMsg          string `json:"sub_msg"`           //业务返回码描述
+	TradeNo         string `json:"trade_no"`          //支付宝交易号
+	OutTradeNo      string `json:"out_trade_no"`      //商户订单号
+	BuyerLogonID    string `json:"buyer_logon_id"`    //买家支付宝账号
+	TradeStatus     string `json:"trade_status"`      //交易状态 WAIT_BUYER_PAY/TRADE_CLOSED/TRADE_SUCCESS/TRADE_FINISHED
+	TotalAmount     string `json:"total_amount"`      //交易金额
+	TransCurrency   string `json:"trans_currency"`    //标价币种[跨境交易]
+	SettleCurrency  string `json:"settle_currency"`   //结算币种[跨境交易]
+	SettleAmount    string `json:"settle_amount"`     //结算币种金额[跨境交易]
+	SettleTransRate string `json:"settle_trans_rate"` //结算币种兑换标价币种汇率[跨境交易]
+	SendPayDate     string `json:"send_pay_date"`     //交易支付时间
+}
+
+type tradeRefundAPIResp struct {
+	Method *tradeRefundAPIResponse `json:"alipay_trade_refund_response"`
+	Sign   string                  `json:"sign"`
+}
+
+//tradeRefundAPIResponse 统一收单交易退款接口返回结果对象
+type tradeRefundAPIResponse struct {
+	Code         string `json:"code"`           //网关返回码
+	Msg          string `json:"msg"`            //网关返回码描述
+	SubCode      string `json:"sub_code"`       //业务返回码
+	SubMsg       string `json:"sub_msg"`        //业务返回码描述
+	TradeNo      string `json:"trade_no"`       //支付宝交易号
+	OutTradeNo   string `json:"out_trade_no"`   //商户订单号
+	BuyerLogonID string `json:"buyer_logon_id"` //买家支付宝账号
+	FundChange   string `json:"fund_change"`    //本次退款是否发生了资金变化 Y/N,重复请求时为N
+	RefundFee    string `json:"refund_fee"`     //退款总金额
+	GmtRefundPay string `json:"gmt_refund_pay"` //退款支付时间
+}
+
+type billAPIResp struct {
+	Method *billAPIResponse `json:"alipay_data_dataservice_bill_downloadurl_query_response"`
+	Sign   string           `json:"sign"`
+}
+
+//billAPIResponse 对账单下载地址查询接口返回结果对象
+type billAPIResponse struct {
+	Code            string `json:"code"`              //网关返回码
+	Msg             string `json:"msg"`               //网关返回码描述
+	SubCode         string `json:"sub_code"`          //业务返回码
+	SubMsg          string `json:"sub_msg"`           //业务返回码描述
+	BillDownloadURL string `json:"bill_download_url"` //对账单下载地址,30秒内有效
+}
+
+//withdrawNotifyContent 转账状态变更通知(alipay.fund.trans.order.changed)业务参数
+type withdrawNotifyContent struct {
+	OutBizNo    string `json:"out_biz_no"`   //商户转账唯一单号
+	OrderID     string `json:"order_id"`     //支付宝转账单据
+	Status      string `json:"status"`       //转账单据状态
+	TransAmount string `json:"trans_amount"` //转账金额
+	PayDate     string `json:"pay_date"`     //支付时间
+	ErrorCode   string `json:"error_code"`   //错误代码
+	FailReason  string `json:"fail_reason"`  //失败原因
+	ProductCode string `json:"product_code"` //产品码
+	BizScene    string `json:"biz_scene"`    //业务场景
+}
+
+//transferAPIRequest 单笔转账接口请求参数
+type transferAPIRequest struct {
+	OutBizNo    string         `json:"out_biz_no"`       //商户转账唯一订单号
+	TransAmount string         `json:"trans_amount"`     //转账金额
+	ProductCode string         `json:"product_code"`     //产品码 TRANS_ACCOUNT_NO_PWD/TRANS_BANKCARD_NO_PWD
+	BizScene    string         `json:"biz_scene"`        //业务场景 DIRECT_TRANSFER
+	OrderTitle  string         `json:"order_title"`      //转账业务标题
+	PayeeInfo   *transferPayee `json:"payee_info"`       //收款方信息
+	Remark      string         `json:"remark,omitempty"` //转账备注
+}
+
+//transferPayee 转账收款方信息
+type transferPayee struct {
+	Identity        string            `json:"identity"`                    //收款方唯一标识
+	IdentityType    string            `json:"identity_type"`               //收款方标识类型 ALIPAY_USER_ID/ALIPAY_LOGON_ID/BANKCARD_ACCOUNT
+	Name            string            `json:"name,omitempty"`              //收款方真实姓名
+	BankcardExtInfo *transferBankcard `json:"bankcard_ext_info,omitempty"` //银行卡收款方信息
+}
+
+//transferBankcard 银行卡收款方信息
+type transferBankcard struct {
+	AccountType  string `json:"account_type"`            //收款账户类型 1=对公 2=对私
+	InstName     string `json:"inst_name"`               //机构名称
+	InstProvince string `json:"inst_province,omitempty"` //收款机构所在省份
+	InstCity     string `json:"inst_city,omitempty"`     //收款机构所在城市
+}
+
+type precreateAPIResp struct {
+	Method *precreateAPIResponse `json:"alipay_trade_precreate_response"`
+	Sign   string                `json:"sign"`
+}
+
+//precreateAPIResponse 预下单接口返回结果对象
+type precreateAPIResponse struct {
+	Code       string `json:"code"`         //网关返回码
+	Msg        string `json:"msg"`          //网关返回码描述
+	SubCode    string `json:"sub_code"`     //业务返回码
+	SubMsg     string `json:"sub_msg"`      //业务返回码描述
+	OutTradeNo string `json:"out_trade_no"` //商户订单号
+	QRCode     string `json:"qr_code"`      //二维码码串
 }
 
 //app支付返回结果
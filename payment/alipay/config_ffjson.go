@@ -6,6 +6,7 @@ package alipay
 import (
 	"bytes"
 	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"reflect"
@@ -47,6 +48,31 @@ func (j *PayConfig) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 	fflib.WriteJsonString(buf, string(j.ReturnURL))
 	buf.WriteString(`,"NotifyURL":`)
 	fflib.WriteJsonString(buf, string(j.NotifyURL))
+	buf.WriteString(`,"SellerID":`)
+	fflib.WriteJsonString(buf, string(j.SellerID))
+	buf.WriteString(`,"Currencies":`)
+	if j.Currencies != nil {
+		buf.WriteString(`[`)
+		for i, v := range j.Currencies {
+			if i != 0 {
+				buf.WriteString(`,`)
+			}
+			fflib.WriteJsonString(buf, string(v))
+		}
+		buf.WriteString(`]`)
+	} else {
+		buf.WriteString(`null`)
+	}
+	buf.WriteString(`,"SettleCurrency":`)
+	fflib.WriteJsonString(buf, string(j.SettleCurrency))
+	buf.WriteString(`,"AppCert":`)
+	fflib.WriteJsonString(buf, string(j.AppCert))
+	buf.WriteString(`,"AlipayRootCert":`)
+	fflib.WriteJsonString(buf, string(j.AlipayRootCert))
+	buf.WriteString(`,"SignNotifyURL":`)
+	fflib.WriteJsonString(buf, string(j.SignNotifyURL))
+	buf.WriteString(`,"SignScene":`)
+	fflib.WriteJsonString(buf, string(j.SignScene))
 	buf.WriteString(`,"Code":`)
 	fflib.WriteJsonString(buf, string(j.Code))
 	buf.WriteString(`,"Name":`)
@@ -56,6 +82,16 @@ func (j *PayConfig) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 	} else {
 		buf.WriteString(`,"State":false`)
 	}
+	if j.Fee != nil {
+		/* Struct fall back. type=payment.FeeSchedule kind=struct */
+		buf.WriteString(`,"Fee":`)
+		err = buf.Encode(j.Fee)
+		if err != nil {
+			return err
+		}
+	} else {
+		buf.WriteString(`,"Fee":null`)
+	}
 	buf.WriteByte('}')
 	return nil
 }
@@ -74,11 +110,27 @@ const (
 
 	ffjtPayConfigNotifyURL
 
+	ffjtPayConfigSellerID
+
+	ffjtPayConfigCurrencies
+
+	ffjtPayConfigSettleCurrency
+
+	ffjtPayConfigAppCert
+
+	ffjtPayConfigAlipayRootCert
+
+	ffjtPayConfigSignNotifyURL
+
+	ffjtPayConfigSignScene
+
 	ffjtPayConfigCode
 
 	ffjtPayConfigName
 
 	ffjtPayConfigState
+
+	ffjtPayConfigFee
 )
 
 var ffjKeyPayConfigPartner = []byte("Partner")
@@ -91,12 +143,28 @@ var ffjKeyPayConfigReturnURL = []byte("ReturnURL")
 
 var ffjKeyPayConfigNotifyURL = []byte("NotifyURL")
 
+var ffjKeyPayConfigSellerID = []byte("SellerID")
+
+var ffjKeyPayConfigCurrencies = []byte("Currencies")
+
+var ffjKeyPayConfigSettleCurrency = []byte("SettleCurrency")
+
+var ffjKeyPayConfigAppCert = []byte("AppCert")
+
+var ffjKeyPayConfigAlipayRootCert = []byte("AlipayRootCert")
+
+var ffjKeyPayConfigSignNotifyURL = []byte("SignNotifyURL")
+
+var ffjKeyPayConfigSignScene = []byte("SignScene")
+
 var ffjKeyPayConfigCode = []byte("Code")
 
 var ffjKeyPayConfigName = []byte("Name")
 
 var ffjKeyPayConfigState = []byte("State")
 
+var ffjKeyPayConfigFee = []byte("Fee")
+
 // UnmarshalJSON umarshall json - template of ffjson
 func (j *PayConfig) UnmarshalJSON(input []byte) error {
 	fs := fflib.NewFFLexer(input)
@@ -158,14 +226,40 @@ mainparse:
 			} else {
 				switch kn[0] {
 
+				case 'A':
+
+					if bytes.Equal(ffjKeyPayConfigAppCert, kn) {
+						currentKey = ffjtPayConfigAppCert
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeyPayConfigAlipayRootCert, kn) {
+						currentKey = ffjtPayConfigAlipayRootCert
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
 				case 'C':
 
-					if bytes.Equal(ffjKeyPayConfigCode, kn) {
+					if bytes.Equal(ffjKeyPayConfigCurrencies, kn) {
+						currentKey = ffjtPayConfigCurrencies
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeyPayConfigCode, kn) {
 						currentKey = ffjtPayConfigCode
 						state = fflib.FFParse_want_colon
 						goto mainparse
 					}
 
+				case 'F':
+
+					if bytes.Equal(ffjKeyPayConfigFee, kn) {
+						currentKey = ffjtPayConfigFee
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
 				case 'N':
 
 					if bytes.Equal(ffjKeyPayConfigNotifyURL, kn) {
@@ -207,7 +301,27 @@ mainparse:
 
 				case 'S':
 
-					if bytes.Equal(ffjKeyPayConfigState, kn) {
+					if bytes.Equal(ffjKeyPayConfigSellerID, kn) {
+						currentKey = ffjtPayConfigSellerID
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeyPayConfigSettleCurrency, kn) {
+						currentKey = ffjtPayConfigSettleCurrency
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeyPayConfigSignNotifyURL, kn) {
+						currentKey = ffjtPayConfigSignNotifyURL
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeyPayConfigSignScene, kn) {
+						currentKey = ffjtPayConfigSignScene
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeyPayConfigState, kn) {
 						currentKey = ffjtPayConfigState
 						state = fflib.FFParse_want_colon
 						goto mainparse
@@ -215,6 +329,12 @@ mainparse:
 
 				}
 
+				if fflib.SimpleLetterEqualFold(ffjKeyPayConfigFee, kn) {
+					currentKey = ffjtPayConfigFee
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
 				if fflib.EqualFoldRight(ffjKeyPayConfigState, kn) {
 					currentKey = ffjtPayConfigState
 					state = fflib.FFParse_want_colon
@@ -233,6 +353,48 @@ mainparse:
 					goto mainparse
 				}
 
+				if fflib.EqualFoldRight(ffjKeyPayConfigSignScene, kn) {
+					currentKey = ffjtPayConfigSignScene
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeyPayConfigSignNotifyURL, kn) {
+					currentKey = ffjtPayConfigSignNotifyURL
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeyPayConfigAlipayRootCert, kn) {
+					currentKey = ffjtPayConfigAlipayRootCert
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeyPayConfigAppCert, kn) {
+					currentKey = ffjtPayConfigAppCert
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeyPayConfigSettleCurrency, kn) {
+					currentKey = ffjtPayConfigSettleCurrency
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeyPayConfigCurrencies, kn) {
+					currentKey = ffjtPayConfigCurrencies
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeyPayConfigSellerID, kn) {
+					currentKey = ffjtPayConfigSellerID
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
 				if fflib.SimpleLetterEqualFold(ffjKeyPayConfigNotifyURL, kn) {
 					currentKey = ffjtPayConfigNotifyURL
 					state = fflib.FFParse_want_colon
@@ -295,6 +457,27 @@ mainparse:
 				case ffjtPayConfigNotifyURL:
 					goto handle_NotifyURL
 
+				case ffjtPayConfigSellerID:
+					goto handle_SellerID
+
+				case ffjtPayConfigCurrencies:
+					goto handle_Currencies
+
+				case ffjtPayConfigSettleCurrency:
+					goto handle_SettleCurrency
+
+				case ffjtPayConfigAppCert:
+					goto handle_AppCert
+
+				case ffjtPayConfigAlipayRootCert:
+					goto handle_AlipayRootCert
+
+				case ffjtPayConfigSignNotifyURL:
+					goto handle_SignNotifyURL
+
+				case ffjtPayConfigSignScene:
+					goto handle_SignScene
+
 				case ffjtPayConfigCode:
 					goto handle_Code
 
@@ -304,6 +487,9 @@ mainparse:
 				case ffjtPayConfigState:
 					goto handle_State
 
+				case ffjtPayConfigFee:
+					goto handle_Fee
+
 				case ffjtPayConfignosuchkey:
 					err = fs.SkipField(tok)
 					if err != nil {
@@ -448,9 +634,9 @@ handle_NotifyURL:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_Code:
+handle_SellerID:
 
-	/* handler: j.Code type=string kind=string quoted=false*/
+	/* handler: j.SellerID type=string kind=string quoted=false*/
 
 	{
 
@@ -466,7 +652,7 @@ handle_Code:
 
 			outBuf := fs.Output.Bytes()
 
-			j.Code = string(string(outBuf))
+			j.SellerID = string(string(outBuf))
 
 		}
 	}
@@ -474,60 +660,99 @@ handle_Code:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_Name:
+handle_Currencies:
 
-	/* handler: j.Name type=string kind=string quoted=false*/
+	/* handler: j.Currencies type=[]string kind=slice quoted=false*/
 
 	{
 
 		{
-			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
-				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
 			}
 		}
 
 		if tok == fflib.FFTok_null {
-
+			j.Currencies = nil
 		} else {
 
-			outBuf := fs.Output.Bytes()
+			j.Currencies = []string{}
 
-			j.Name = string(string(outBuf))
+			wantVal := true
+
+			for {
+
+				var tmpJCurrencies string
+
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_brace {
+					break
+				}
+
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
+
+				/* handler: tmpJCurrencies type=string kind=string quoted=false*/
+
+				{
+
+					{
+						if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+							return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+						}
+					}
+
+					if tok == fflib.FFTok_null {
+
+					} else {
+
+						outBuf := fs.Output.Bytes()
+
+						tmpJCurrencies = string(string(outBuf))
+
+					}
+				}
+
+				j.Currencies = append(j.Currencies, tmpJCurrencies)
 
+				wantVal = false
+			}
 		}
 	}
 
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_State:
+handle_SettleCurrency:
 
-	/* handler: j.State type=bool kind=bool quoted=false*/
+	/* handler: j.SettleCurrency type=string kind=string quoted=false*/
 
 	{
-		if tok != fflib.FFTok_bool && tok != fflib.FFTok_null {
-			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for bool", tok))
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
 		}
-	}
 
-	{
 		if tok == fflib.FFTok_null {
 
 		} else {
-			tmpb := fs.Output.Bytes()
-
-			if bytes.Compare([]byte{'t', 'r', 'u', 'e'}, tmpb) == 0 {
-
-				j.State = true
-
-			} else if bytes.Compare([]byte{'f', 'a', 'l', 's', 'e'}, tmpb) == 0 {
 
-				j.State = false
+			outBuf := fs.Output.Bytes()
 
-			} else {
-				err = errors.New("unexpected bytes for true/false value")
-				return fs.WrapErr(err)
-			}
+			j.SettleCurrency = string(string(outBuf))
 
 		}
 	}
@@ -535,121 +760,9244 @@ handle_State:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-wantedvalue:
-	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
-wrongtokenerror:
-	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
-tokerror:
-	if fs.BigError != nil {
-		return fs.WrapErr(fs.BigError)
-	}
-	err = fs.Error.ToError()
-	if err != nil {
-		return fs.WrapErr(err)
-	}
-	panic("ffjson-generated: unreachable, please report bug.")
-done:
+handle_AppCert:
 
-	return nil
-}
+	/* handler: j.AppCert type=string kind=string quoted=false*/
 
-// MarshalJSON marshal bytes to json - template
-func (j *appPayResponse) MarshalJSON() ([]byte, error) {
-	var buf fflib.Buffer
-	if j == nil {
-		buf.WriteString("null")
-		return buf.Bytes(), nil
-	}
-	err := j.MarshalJSONBuf(&buf)
-	if err != nil {
-		return nil, err
-	}
-	return buf.Bytes(), nil
-}
+	{
 
-// MarshalJSONBuf marshal buff to json - template
-func (j *appPayResponse) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
-	if j == nil {
-		buf.WriteString("null")
-		return nil
-	}
-	var err error
-	var obj []byte
-	_ = obj
-	_ = err
-	buf.WriteString(`{"code":`)
-	fflib.WriteJsonString(buf, string(j.Code))
-	buf.WriteString(`,"msg":`)
-	fflib.WriteJsonString(buf, string(j.Msg))
-	buf.WriteString(`,"app_id":`)
-	fflib.WriteJsonString(buf, string(j.AppID))
-	buf.WriteString(`,"out_trade_no":`)
-	fflib.WriteJsonString(buf, string(j.OutTradeNo))
-	buf.WriteString(`,"trade_no":`)
-	fflib.WriteJsonString(buf, string(j.TradeNo))
-	buf.WriteString(`,"total_amount":`)
-	fflib.AppendFloat(buf, float64(j.TotalAmount), 'g', -1, 64)
-	buf.WriteString(`,"seller_id":`)
-	fflib.WriteJsonString(buf, string(j.SellerID))
-	buf.WriteString(`,"charset":`)
-	fflib.WriteJsonString(buf, string(j.Charset))
-	buf.WriteString(`,"timestamp":`)
-	fflib.WriteJsonString(buf, string(j.Timestamp))
-	buf.WriteByte('}')
-	return nil
-}
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
 
-const (
-	ffjtappPayResponsebase = iota
-	ffjtappPayResponsenosuchkey
+		if tok == fflib.FFTok_null {
 
-	ffjtappPayResponseCode
+		} else {
 
-	ffjtappPayResponseMsg
+			outBuf := fs.Output.Bytes()
 
-	ffjtappPayResponseAppID
+			j.AppCert = string(string(outBuf))
 
-	ffjtappPayResponseOutTradeNo
+		}
+	}
 
-	ffjtappPayResponseTradeNo
+	state = fflib.FFParse_after_value
+	goto mainparse
 
-	ffjtappPayResponseTotalAmount
+handle_AlipayRootCert:
 
-	ffjtappPayResponseSellerID
+	/* handler: j.AlipayRootCert type=string kind=string quoted=false*/
 
-	ffjtappPayResponseCharset
+	{
 
-	ffjtappPayResponseTimestamp
-)
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
 
-var ffjKeyappPayResponseCode = []byte("code")
+		if tok == fflib.FFTok_null {
 
-var ffjKeyappPayResponseMsg = []byte("msg")
+		} else {
 
-var ffjKeyappPayResponseAppID = []byte("app_id")
+			outBuf := fs.Output.Bytes()
 
-var ffjKeyappPayResponseOutTradeNo = []byte("out_trade_no")
+			j.AlipayRootCert = string(string(outBuf))
 
-var ffjKeyappPayResponseTradeNo = []byte("trade_no")
+		}
+	}
 
-var ffjKeyappPayResponseTotalAmount = []byte("total_amount")
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_SignNotifyURL:
+
+	/* handler: j.SignNotifyURL type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.SignNotifyURL = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_SignScene:
+
+	/* handler: j.SignScene type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.SignScene = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Code:
+
+	/* handler: j.Code type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.Code = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Name:
+
+	/* handler: j.Name type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.Name = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_State:
+
+	/* handler: j.State type=bool kind=bool quoted=false*/
+
+	{
+		if tok != fflib.FFTok_bool && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for bool", tok))
+		}
+	}
+
+	{
+		if tok == fflib.FFTok_null {
+
+		} else {
+			tmpb := fs.Output.Bytes()
+
+			if bytes.Compare([]byte{'t', 'r', 'u', 'e'}, tmpb) == 0 {
+
+				j.State = true
+
+			} else if bytes.Compare([]byte{'f', 'a', 'l', 's', 'e'}, tmpb) == 0 {
+
+				j.State = false
+
+			} else {
+				err = errors.New("unexpected bytes for true/false value")
+				return fs.WrapErr(err)
+			}
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Fee:
+
+	/* handler: j.Fee type=payment.FeeSchedule kind=struct quoted=false*/
+
+	{
+		/* Falling back. type=payment.FeeSchedule kind=struct */
+		tbuf, err := fs.CaptureField(tok)
+		if err != nil {
+			return fs.WrapErr(err)
+		}
+
+		err = json.Unmarshal(tbuf, &j.Fee)
+		if err != nil {
+			return fs.WrapErr(err)
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+wantedvalue:
+	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+wrongtokenerror:
+	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
+tokerror:
+	if fs.BigError != nil {
+		return fs.WrapErr(fs.BigError)
+	}
+	err = fs.Error.ToError()
+	if err != nil {
+		return fs.WrapErr(err)
+	}
+	panic("ffjson-generated: unreachable, please report bug.")
+done:
+
+	return nil
+}
+
+// MarshalJSON marshal bytes to json - template
+func (j *accountQueryAPIResp) MarshalJSON() ([]byte, error) {
+	var buf fflib.Buffer
+	if j == nil {
+		buf.WriteString("null")
+		return buf.Bytes(), nil
+	}
+	err := j.MarshalJSONBuf(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// MarshalJSONBuf marshal buff to json - template
+func (j *accountQueryAPIResp) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+	if j == nil {
+		buf.WriteString("null")
+		return nil
+	}
+	var err error
+	var obj []byte
+	_ = obj
+	_ = err
+	if j.Method != nil {
+		buf.WriteString(`{"alipay_fund_account_query_response":`)
+
+		{
+
+			err = j.Method.MarshalJSONBuf(buf)
+			if err != nil {
+				return err
+			}
+
+		}
+	} else {
+		buf.WriteString(`{"alipay_fund_account_query_response":null`)
+	}
+	buf.WriteString(`,"sign":`)
+	fflib.WriteJsonString(buf, string(j.Sign))
+	buf.WriteByte('}')
+	return nil
+}
+
+const (
+	ffjtaccountQueryAPIRespbase = iota
+	ffjtaccountQueryAPIRespnosuchkey
+
+	ffjtaccountQueryAPIRespMethod
+
+	ffjtaccountQueryAPIRespSign
+)
+
+var ffjKeyaccountQueryAPIRespMethod = []byte("alipay_fund_account_query_response")
+
+var ffjKeyaccountQueryAPIRespSign = []byte("sign")
+
+// UnmarshalJSON umarshall json - template of ffjson
+func (j *accountQueryAPIResp) UnmarshalJSON(input []byte) error {
+	fs := fflib.NewFFLexer(input)
+	return j.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
+}
+
+// UnmarshalJSONFFLexer fast json unmarshall - template ffjson
+func (j *accountQueryAPIResp) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+	var err error
+	currentKey := ffjtaccountQueryAPIRespbase
+	_ = currentKey
+	tok := fflib.FFTok_init
+	wantedTok := fflib.FFTok_init
+
+mainparse:
+	for {
+		tok = fs.Scan()
+		//	println(fmt.Sprintf("debug: tok: %v  state: %v", tok, state))
+		if tok == fflib.FFTok_error {
+			goto tokerror
+		}
+
+		switch state {
+
+		case fflib.FFParse_map_start:
+			if tok != fflib.FFTok_left_bracket {
+				wantedTok = fflib.FFTok_left_bracket
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_key
+			continue
+
+		case fflib.FFParse_after_value:
+			if tok == fflib.FFTok_comma {
+				state = fflib.FFParse_want_key
+			} else if tok == fflib.FFTok_right_bracket {
+				goto done
+			} else {
+				wantedTok = fflib.FFTok_comma
+				goto wrongtokenerror
+			}
+
+		case fflib.FFParse_want_key:
+			// json {} ended. goto exit. woo.
+			if tok == fflib.FFTok_right_bracket {
+				goto done
+			}
+			if tok != fflib.FFTok_string {
+				wantedTok = fflib.FFTok_string
+				goto wrongtokenerror
+			}
+
+			kn := fs.Output.Bytes()
+			if len(kn) <= 0 {
+				// "" case. hrm.
+				currentKey = ffjtaccountQueryAPIRespnosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			} else {
+				switch kn[0] {
+
+				case 'a':
+
+					if bytes.Equal(ffjKeyaccountQueryAPIRespMethod, kn) {
+						currentKey = ffjtaccountQueryAPIRespMethod
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 's':
+
+					if bytes.Equal(ffjKeyaccountQueryAPIRespSign, kn) {
+						currentKey = ffjtaccountQueryAPIRespSign
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				}
+
+				if fflib.EqualFoldRight(ffjKeyaccountQueryAPIRespSign, kn) {
+					currentKey = ffjtaccountQueryAPIRespSign
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeyaccountQueryAPIRespMethod, kn) {
+					currentKey = ffjtaccountQueryAPIRespMethod
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				currentKey = ffjtaccountQueryAPIRespnosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			}
+
+		case fflib.FFParse_want_colon:
+			if tok != fflib.FFTok_colon {
+				wantedTok = fflib.FFTok_colon
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_value
+			continue
+		case fflib.FFParse_want_value:
+
+			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
+				switch currentKey {
+
+				case ffjtaccountQueryAPIRespMethod:
+					goto handle_Method
+
+				case ffjtaccountQueryAPIRespSign:
+					goto handle_Sign
+
+				case ffjtaccountQueryAPIRespnosuchkey:
+					err = fs.SkipField(tok)
+					if err != nil {
+						return fs.WrapErr(err)
+					}
+					state = fflib.FFParse_after_value
+					goto mainparse
+				}
+			} else {
+				goto wantedvalue
+			}
+		}
+	}
+
+handle_Method:
+
+	/* handler: j.Method type=alipay.accountQueryAPIResponse kind=struct quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+			j.Method = nil
+
+		} else {
+
+			if j.Method == nil {
+				j.Method = new(accountQueryAPIResponse)
+			}
+
+			err = j.Method.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+			if err != nil {
+				return err
+			}
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Sign:
+
+	/* handler: j.Sign type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.Sign = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+wantedvalue:
+	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+wrongtokenerror:
+	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
+tokerror:
+	if fs.BigError != nil {
+		return fs.WrapErr(fs.BigError)
+	}
+	err = fs.Error.ToError()
+	if err != nil {
+		return fs.WrapErr(err)
+	}
+	panic("ffjson-generated: unreachable, please report bug.")
+done:
+
+	return nil
+}
+
+// MarshalJSON marshal bytes to json - template
+func (j *accountQueryAPIResponse) MarshalJSON() ([]byte, error) {
+	var buf fflib.Buffer
+	if j == nil {
+		buf.WriteString("null")
+		return buf.Bytes(), nil
+	}
+	err := j.MarshalJSONBuf(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// MarshalJSONBuf marshal buff to json - template
+func (j *accountQueryAPIResponse) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+	if j == nil {
+		buf.WriteString("null")
+		return nil
+	}
+	var err error
+	var obj []byte
+	_ = obj
+	_ = err
+	buf.WriteString(`{"code":`)
+	fflib.WriteJsonString(buf, string(j.Code))
+	buf.WriteString(`,"msg":`)
+	fflib.WriteJsonString(buf, string(j.Msg))
+	buf.WriteString(`,"sub_code":`)
+	fflib.WriteJsonString(buf, string(j.SubCode))
+	buf.WriteString(`,"sub_msg":`)
+	fflib.WriteJsonString(buf, string(j.SubMsg))
+	buf.WriteString(`,"available_amount":`)
+	fflib.WriteJsonString(buf, string(j.AvailableAmount))
+	buf.WriteString(`,"freeze_amount":`)
+	fflib.WriteJsonString(buf, string(j.FreezeAmount))
+	buf.WriteByte('}')
+	return nil
+}
+
+const (
+	ffjtaccountQueryAPIResponsebase = iota
+	ffjtaccountQueryAPIResponsenosuchkey
+
+	ffjtaccountQueryAPIResponseCode
+
+	ffjtaccountQueryAPIResponseMsg
+
+	ffjtaccountQueryAPIResponseSubCode
+
+	ffjtaccountQueryAPIResponseSubMsg
+
+	ffjtaccountQueryAPIResponseAvailableAmount
+
+	ffjtaccountQueryAPIResponseFreezeAmount
+)
+
+var ffjKeyaccountQueryAPIResponseCode = []byte("code")
+
+var ffjKeyaccountQueryAPIResponseMsg = []byte("msg")
+
+var ffjKeyaccountQueryAPIResponseSubCode = []byte("sub_code")
+
+var ffjKeyaccountQueryAPIResponseSubMsg = []byte("sub_msg")
+
+var ffjKeyaccountQueryAPIResponseAvailableAmount = []byte("available_amount")
+
+var ffjKeyaccountQueryAPIResponseFreezeAmount = []byte("freeze_amount")
+
+// UnmarshalJSON umarshall json - template of ffjson
+func (j *accountQueryAPIResponse) UnmarshalJSON(input []byte) error {
+	fs := fflib.NewFFLexer(input)
+	return j.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
+}
+
+// UnmarshalJSONFFLexer fast json unmarshall - template ffjson
+func (j *accountQueryAPIResponse) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+	var err error
+	currentKey := ffjtaccountQueryAPIResponsebase
+	_ = currentKey
+	tok := fflib.FFTok_init
+	wantedTok := fflib.FFTok_init
+
+mainparse:
+	for {
+		tok = fs.Scan()
+		//	println(fmt.Sprintf("debug: tok: %v  state: %v", tok, state))
+		if tok == fflib.FFTok_error {
+			goto tokerror
+		}
+
+		switch state {
+
+		case fflib.FFParse_map_start:
+			if tok != fflib.FFTok_left_bracket {
+				wantedTok = fflib.FFTok_left_bracket
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_key
+			continue
+
+		case fflib.FFParse_after_value:
+			if tok == fflib.FFTok_comma {
+				state = fflib.FFParse_want_key
+			} else if tok == fflib.FFTok_right_bracket {
+				goto done
+			} else {
+				wantedTok = fflib.FFTok_comma
+				goto wrongtokenerror
+			}
+
+		case fflib.FFParse_want_key:
+			// json {} ended. goto exit. woo.
+			if tok == fflib.FFTok_right_bracket {
+				goto done
+			}
+			if tok != fflib.FFTok_string {
+				wantedTok = fflib.FFTok_string
+				goto wrongtokenerror
+			}
+
+			kn := fs.Output.Bytes()
+			if len(kn) <= 0 {
+				// "" case. hrm.
+				currentKey = ffjtaccountQueryAPIResponsenosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			} else {
+				switch kn[0] {
+
+				case 'a':
+
+					if bytes.Equal(ffjKeyaccountQueryAPIResponseAvailableAmount, kn) {
+						currentKey = ffjtaccountQueryAPIResponseAvailableAmount
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'c':
+
+					if bytes.Equal(ffjKeyaccountQueryAPIResponseCode, kn) {
+						currentKey = ffjtaccountQueryAPIResponseCode
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'f':
+
+					if bytes.Equal(ffjKeyaccountQueryAPIResponseFreezeAmount, kn) {
+						currentKey = ffjtaccountQueryAPIResponseFreezeAmount
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'm':
+
+					if bytes.Equal(ffjKeyaccountQueryAPIResponseMsg, kn) {
+						currentKey = ffjtaccountQueryAPIResponseMsg
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 's':
+
+					if bytes.Equal(ffjKeyaccountQueryAPIResponseSubCode, kn) {
+						currentKey = ffjtaccountQueryAPIResponseSubCode
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeyaccountQueryAPIResponseSubMsg, kn) {
+						currentKey = ffjtaccountQueryAPIResponseSubMsg
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				}
+
+				if fflib.AsciiEqualFold(ffjKeyaccountQueryAPIResponseFreezeAmount, kn) {
+					currentKey = ffjtaccountQueryAPIResponseFreezeAmount
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.AsciiEqualFold(ffjKeyaccountQueryAPIResponseAvailableAmount, kn) {
+					currentKey = ffjtaccountQueryAPIResponseAvailableAmount
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeyaccountQueryAPIResponseSubMsg, kn) {
+					currentKey = ffjtaccountQueryAPIResponseSubMsg
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeyaccountQueryAPIResponseSubCode, kn) {
+					currentKey = ffjtaccountQueryAPIResponseSubCode
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeyaccountQueryAPIResponseMsg, kn) {
+					currentKey = ffjtaccountQueryAPIResponseMsg
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeyaccountQueryAPIResponseCode, kn) {
+					currentKey = ffjtaccountQueryAPIResponseCode
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				currentKey = ffjtaccountQueryAPIResponsenosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			}
+
+		case fflib.FFParse_want_colon:
+			if tok != fflib.FFTok_colon {
+				wantedTok = fflib.FFTok_colon
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_value
+			continue
+		case fflib.FFParse_want_value:
+
+			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
+				switch currentKey {
+
+				case ffjtaccountQueryAPIResponseCode:
+					goto handle_Code
+
+				case ffjtaccountQueryAPIResponseMsg:
+					goto handle_Msg
+
+				case ffjtaccountQueryAPIResponseSubCode:
+					goto handle_SubCode
+
+				case ffjtaccountQueryAPIResponseSubMsg:
+					goto handle_SubMsg
+
+				case ffjtaccountQueryAPIResponseAvailableAmount:
+					goto handle_AvailableAmount
+
+				case ffjtaccountQueryAPIResponseFreezeAmount:
+					goto handle_FreezeAmount
+
+				case ffjtaccountQueryAPIResponsenosuchkey:
+					err = fs.SkipField(tok)
+					if err != nil {
+						return fs.WrapErr(err)
+					}
+					state = fflib.FFParse_after_value
+					goto mainparse
+				}
+			} else {
+				goto wantedvalue
+			}
+		}
+	}
+
+handle_Code:
+
+	/* handler: j.Code type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.Code = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Msg:
+
+	/* handler: j.Msg type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.Msg = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_SubCode:
+
+	/* handler: j.SubCode type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.SubCode = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_SubMsg:
+
+	/* handler: j.SubMsg type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.SubMsg = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_AvailableAmount:
+
+	/* handler: j.AvailableAmount type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.AvailableAmount = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_FreezeAmount:
+
+	/* handler: j.FreezeAmount type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.FreezeAmount = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+wantedvalue:
+	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+wrongtokenerror:
+	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
+tokerror:
+	if fs.BigError != nil {
+		return fs.WrapErr(fs.BigError)
+	}
+	err = fs.Error.ToError()
+	if err != nil {
+		return fs.WrapErr(err)
+	}
+	panic("ffjson-generated: unreachable, please report bug.")
+done:
+
+	return nil
+}
+
+// MarshalJSON marshal bytes to json - template
+func (j *agreementQueryAPIResp) MarshalJSON() ([]byte, error) {
+	var buf fflib.Buffer
+	if j == nil {
+		buf.WriteString("null")
+		return buf.Bytes(), nil
+	}
+	err := j.MarshalJSONBuf(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// MarshalJSONBuf marshal buff to json - template
+func (j *agreementQueryAPIResp) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+	if j == nil {
+		buf.WriteString("null")
+		return nil
+	}
+	var err error
+	var obj []byte
+	_ = obj
+	_ = err
+	if j.Method != nil {
+		buf.WriteString(`{"alipay_user_agreement_query_response":`)
+
+		{
+
+			err = j.Method.MarshalJSONBuf(buf)
+			if err != nil {
+				return err
+			}
+
+		}
+	} else {
+		buf.WriteString(`{"alipay_user_agreement_query_response":null`)
+	}
+	buf.WriteString(`,"sign":`)
+	fflib.WriteJsonString(buf, string(j.Sign))
+	buf.WriteByte('}')
+	return nil
+}
+
+const (
+	ffjtagreementQueryAPIRespbase = iota
+	ffjtagreementQueryAPIRespnosuchkey
+
+	ffjtagreementQueryAPIRespMethod
+
+	ffjtagreementQueryAPIRespSign
+)
+
+var ffjKeyagreementQueryAPIRespMethod = []byte("alipay_user_agreement_query_response")
+
+var ffjKeyagreementQueryAPIRespSign = []byte("sign")
+
+// UnmarshalJSON umarshall json - template of ffjson
+func (j *agreementQueryAPIResp) UnmarshalJSON(input []byte) error {
+	fs := fflib.NewFFLexer(input)
+	return j.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
+}
+
+// UnmarshalJSONFFLexer fast json unmarshall - template ffjson
+func (j *agreementQueryAPIResp) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+	var err error
+	currentKey := ffjtagreementQueryAPIRespbase
+	_ = currentKey
+	tok := fflib.FFTok_init
+	wantedTok := fflib.FFTok_init
+
+mainparse:
+	for {
+		tok = fs.Scan()
+		//	println(fmt.Sprintf("debug: tok: %v  state: %v", tok, state))
+		if tok == fflib.FFTok_error {
+			goto tokerror
+		}
+
+		switch state {
+
+		case fflib.FFParse_map_start:
+			if tok != fflib.FFTok_left_bracket {
+				wantedTok = fflib.FFTok_left_bracket
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_key
+			continue
+
+		case fflib.FFParse_after_value:
+			if tok == fflib.FFTok_comma {
+				state = fflib.FFParse_want_key
+			} else if tok == fflib.FFTok_right_bracket {
+				goto done
+			} else {
+				wantedTok = fflib.FFTok_comma
+				goto wrongtokenerror
+			}
+
+		case fflib.FFParse_want_key:
+			// json {} ended. goto exit. woo.
+			if tok == fflib.FFTok_right_bracket {
+				goto done
+			}
+			if tok != fflib.FFTok_string {
+				wantedTok = fflib.FFTok_string
+				goto wrongtokenerror
+			}
+
+			kn := fs.Output.Bytes()
+			if len(kn) <= 0 {
+				// "" case. hrm.
+				currentKey = ffjtagreementQueryAPIRespnosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			} else {
+				switch kn[0] {
+
+				case 'a':
+
+					if bytes.Equal(ffjKeyagreementQueryAPIRespMethod, kn) {
+						currentKey = ffjtagreementQueryAPIRespMethod
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 's':
+
+					if bytes.Equal(ffjKeyagreementQueryAPIRespSign, kn) {
+						currentKey = ffjtagreementQueryAPIRespSign
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				}
+
+				if fflib.EqualFoldRight(ffjKeyagreementQueryAPIRespSign, kn) {
+					currentKey = ffjtagreementQueryAPIRespSign
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeyagreementQueryAPIRespMethod, kn) {
+					currentKey = ffjtagreementQueryAPIRespMethod
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				currentKey = ffjtagreementQueryAPIRespnosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			}
+
+		case fflib.FFParse_want_colon:
+			if tok != fflib.FFTok_colon {
+				wantedTok = fflib.FFTok_colon
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_value
+			continue
+		case fflib.FFParse_want_value:
+
+			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
+				switch currentKey {
+
+				case ffjtagreementQueryAPIRespMethod:
+					goto handle_Method
+
+				case ffjtagreementQueryAPIRespSign:
+					goto handle_Sign
+
+				case ffjtagreementQueryAPIRespnosuchkey:
+					err = fs.SkipField(tok)
+					if err != nil {
+						return fs.WrapErr(err)
+					}
+					state = fflib.FFParse_after_value
+					goto mainparse
+				}
+			} else {
+				goto wantedvalue
+			}
+		}
+	}
+
+handle_Method:
+
+	/* handler: j.Method type=alipay.agreementQueryAPIResponse kind=struct quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+			j.Method = nil
+
+		} else {
+
+			if j.Method == nil {
+				j.Method = new(agreementQueryAPIResponse)
+			}
+
+			err = j.Method.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+			if err != nil {
+				return err
+			}
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Sign:
+
+	/* handler: j.Sign type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.Sign = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+wantedvalue:
+	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+wrongtokenerror:
+	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
+tokerror:
+	if fs.BigError != nil {
+		return fs.WrapErr(fs.BigError)
+	}
+	err = fs.Error.ToError()
+	if err != nil {
+		return fs.WrapErr(err)
+	}
+	panic("ffjson-generated: unreachable, please report bug.")
+done:
+
+	return nil
+}
+
+// MarshalJSON marshal bytes to json - template
+func (j *agreementQueryAPIResponse) MarshalJSON() ([]byte, error) {
+	var buf fflib.Buffer
+	if j == nil {
+		buf.WriteString("null")
+		return buf.Bytes(), nil
+	}
+	err := j.MarshalJSONBuf(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// MarshalJSONBuf marshal buff to json - template
+func (j *agreementQueryAPIResponse) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+	if j == nil {
+		buf.WriteString("null")
+		return nil
+	}
+	var err error
+	var obj []byte
+	_ = obj
+	_ = err
+	buf.WriteString(`{"code":`)
+	fflib.WriteJsonString(buf, string(j.Code))
+	buf.WriteString(`,"msg":`)
+	fflib.WriteJsonString(buf, string(j.Msg))
+	buf.WriteString(`,"sub_code":`)
+	fflib.WriteJsonString(buf, string(j.SubCode))
+	buf.WriteString(`,"sub_msg":`)
+	fflib.WriteJsonString(buf, string(j.SubMsg))
+	buf.WriteString(`,"agreement_no":`)
+	fflib.WriteJsonString(buf, string(j.AgreementNo))
+	buf.WriteString(`,"external_agreement_no":`)
+	fflib.WriteJsonString(buf, string(j.ExternalAgreementNo))
+	buf.WriteString(`,"status":`)
+	fflib.WriteJsonString(buf, string(j.Status))
+	buf.WriteString(`,"sign_time":`)
+	fflib.WriteJsonString(buf, string(j.SignTime))
+	buf.WriteString(`,"invalid_time":`)
+	fflib.WriteJsonString(buf, string(j.InvalidTime))
+	buf.WriteString(`,"principal_id":`)
+	fflib.WriteJsonString(buf, string(j.PrincipalID))
+	buf.WriteByte('}')
+	return nil
+}
+
+const (
+	ffjtagreementQueryAPIResponsebase = iota
+	ffjtagreementQueryAPIResponsenosuchkey
+
+	ffjtagreementQueryAPIResponseCode
+
+	ffjtagreementQueryAPIResponseMsg
+
+	ffjtagreementQueryAPIResponseSubCode
+
+	ffjtagreementQueryAPIResponseSubMsg
+
+	ffjtagreementQueryAPIResponseAgreementNo
+
+	ffjtagreementQueryAPIResponseExternalAgreementNo
+
+	ffjtagreementQueryAPIResponseStatus
+
+	ffjtagreementQueryAPIResponseSignTime
+
+	ffjtagreementQueryAPIResponseInvalidTime
+
+	ffjtagreementQueryAPIResponsePrincipalID
+)
+
+var ffjKeyagreementQueryAPIResponseCode = []byte("code")
+
+var ffjKeyagreementQueryAPIResponseMsg = []byte("msg")
+
+var ffjKeyagreementQueryAPIResponseSubCode = []byte("sub_code")
+
+var ffjKeyagreementQueryAPIResponseSubMsg = []byte("sub_msg")
+
+var ffjKeyagreementQueryAPIResponseAgreementNo = []byte("agreement_no")
+
+var ffjKeyagreementQueryAPIResponseExternalAgreementNo = []byte("external_agreement_no")
+
+var ffjKeyagreementQueryAPIResponseStatus = []byte("status")
+
+var ffjKeyagreementQueryAPIResponseSignTime = []byte("sign_time")
+
+var ffjKeyagreementQueryAPIResponseInvalidTime = []byte("invalid_time")
+
+var ffjKeyagreementQueryAPIResponsePrincipalID = []byte("principal_id")
+
+// UnmarshalJSON umarshall json - template of ffjson
+func (j *agreementQueryAPIResponse) UnmarshalJSON(input []byte) error {
+	fs := fflib.NewFFLexer(input)
+	return j.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
+}
+
+// UnmarshalJSONFFLexer fast json unmarshall - template ffjson
+func (j *agreementQueryAPIResponse) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+	var err error
+	currentKey := ffjtagreementQueryAPIResponsebase
+	_ = currentKey
+	tok := fflib.FFTok_init
+	wantedTok := fflib.FFTok_init
+
+mainparse:
+	for {
+		tok = fs.Scan()
+		//	println(fmt.Sprintf("debug: tok: %v  state: %v", tok, state))
+		if tok == fflib.FFTok_error {
+			goto tokerror
+		}
+
+		switch state {
+
+		case fflib.FFParse_map_start:
+			if tok != fflib.FFTok_left_bracket {
+				wantedTok = fflib.FFTok_left_bracket
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_key
+			continue
+
+		case fflib.FFParse_after_value:
+			if tok == fflib.FFTok_comma {
+				state = fflib.FFParse_want_key
+			} else if tok == fflib.FFTok_right_bracket {
+				goto done
+			} else {
+				wantedTok = fflib.FFTok_comma
+				goto wrongtokenerror
+			}
+
+		case fflib.FFParse_want_key:
+			// json {} ended. goto exit. woo.
+			if tok == fflib.FFTok_right_bracket {
+				goto done
+			}
+			if tok != fflib.FFTok_string {
+				wantedTok = fflib.FFTok_string
+				goto wrongtokenerror
+			}
+
+			kn := fs.Output.Bytes()
+			if len(kn) <= 0 {
+				// "" case. hrm.
+				currentKey = ffjtagreementQueryAPIResponsenosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			} else {
+				switch kn[0] {
+
+				case 'a':
+
+					if bytes.Equal(ffjKeyagreementQueryAPIResponseAgreementNo, kn) {
+						currentKey = ffjtagreementQueryAPIResponseAgreementNo
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'c':
+
+					if bytes.Equal(ffjKeyagreementQueryAPIResponseCode, kn) {
+						currentKey = ffjtagreementQueryAPIResponseCode
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'e':
+
+					if bytes.Equal(ffjKeyagreementQueryAPIResponseExternalAgreementNo, kn) {
+						currentKey = ffjtagreementQueryAPIResponseExternalAgreementNo
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'i':
+
+					if bytes.Equal(ffjKeyagreementQueryAPIResponseInvalidTime, kn) {
+						currentKey = ffjtagreementQueryAPIResponseInvalidTime
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'm':
+
+					if bytes.Equal(ffjKeyagreementQueryAPIResponseMsg, kn) {
+						currentKey = ffjtagreementQueryAPIResponseMsg
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'p':
+
+					if bytes.Equal(ffjKeyagreementQueryAPIResponsePrincipalID, kn) {
+						currentKey = ffjtagreementQueryAPIResponsePrincipalID
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 's':
+
+					if bytes.Equal(ffjKeyagreementQueryAPIResponseSubCode, kn) {
+						currentKey = ffjtagreementQueryAPIResponseSubCode
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeyagreementQueryAPIResponseSubMsg, kn) {
+						currentKey = ffjtagreementQueryAPIResponseSubMsg
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeyagreementQueryAPIResponseStatus, kn) {
+						currentKey = ffjtagreementQueryAPIResponseStatus
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeyagreementQueryAPIResponseSignTime, kn) {
+						currentKey = ffjtagreementQueryAPIResponseSignTime
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				}
+
+				if fflib.AsciiEqualFold(ffjKeyagreementQueryAPIResponsePrincipalID, kn) {
+					currentKey = ffjtagreementQueryAPIResponsePrincipalID
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.AsciiEqualFold(ffjKeyagreementQueryAPIResponseInvalidTime, kn) {
+					currentKey = ffjtagreementQueryAPIResponseInvalidTime
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeyagreementQueryAPIResponseSignTime, kn) {
+					currentKey = ffjtagreementQueryAPIResponseSignTime
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeyagreementQueryAPIResponseStatus, kn) {
+					currentKey = ffjtagreementQueryAPIResponseStatus
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.AsciiEqualFold(ffjKeyagreementQueryAPIResponseExternalAgreementNo, kn) {
+					currentKey = ffjtagreementQueryAPIResponseExternalAgreementNo
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.AsciiEqualFold(ffjKeyagreementQueryAPIResponseAgreementNo, kn) {
+					currentKey = ffjtagreementQueryAPIResponseAgreementNo
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeyagreementQueryAPIResponseSubMsg, kn) {
+					currentKey = ffjtagreementQueryAPIResponseSubMsg
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeyagreementQueryAPIResponseSubCode, kn) {
+					currentKey = ffjtagreementQueryAPIResponseSubCode
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeyagreementQueryAPIResponseMsg, kn) {
+					currentKey = ffjtagreementQueryAPIResponseMsg
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeyagreementQueryAPIResponseCode, kn) {
+					currentKey = ffjtagreementQueryAPIResponseCode
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				currentKey = ffjtagreementQueryAPIResponsenosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			}
+
+		case fflib.FFParse_want_colon:
+			if tok != fflib.FFTok_colon {
+				wantedTok = fflib.FFTok_colon
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_value
+			continue
+		case fflib.FFParse_want_value:
+
+			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
+				switch currentKey {
+
+				case ffjtagreementQueryAPIResponseCode:
+					goto handle_Code
+
+				case ffjtagreementQueryAPIResponseMsg:
+					goto handle_Msg
+
+				case ffjtagreementQueryAPIResponseSubCode:
+					goto handle_SubCode
+
+				case ffjtagreementQueryAPIResponseSubMsg:
+					goto handle_SubMsg
+
+				case ffjtagreementQueryAPIResponseAgreementNo:
+					goto handle_AgreementNo
+
+				case ffjtagreementQueryAPIResponseExternalAgreementNo:
+					goto handle_ExternalAgreementNo
+
+				case ffjtagreementQueryAPIResponseStatus:
+					goto handle_Status
+
+				case ffjtagreementQueryAPIResponseSignTime:
+					goto handle_SignTime
+
+				case ffjtagreementQueryAPIResponseInvalidTime:
+					goto handle_InvalidTime
+
+				case ffjtagreementQueryAPIResponsePrincipalID:
+					goto handle_PrincipalID
+
+				case ffjtagreementQueryAPIResponsenosuchkey:
+					err = fs.SkipField(tok)
+					if err != nil {
+						return fs.WrapErr(err)
+					}
+					state = fflib.FFParse_after_value
+					goto mainparse
+				}
+			} else {
+				goto wantedvalue
+			}
+		}
+	}
+
+handle_Code:
+
+	/* handler: j.Code type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.Code = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Msg:
+
+	/* handler: j.Msg type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.Msg = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_SubCode:
+
+	/* handler: j.SubCode type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.SubCode = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_SubMsg:
+
+	/* handler: j.SubMsg type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.SubMsg = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_AgreementNo:
+
+	/* handler: j.AgreementNo type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.AgreementNo = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_ExternalAgreementNo:
+
+	/* handler: j.ExternalAgreementNo type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.ExternalAgreementNo = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Status:
+
+	/* handler: j.Status type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.Status = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_SignTime:
+
+	/* handler: j.SignTime type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.SignTime = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_InvalidTime:
+
+	/* handler: j.InvalidTime type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.InvalidTime = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_PrincipalID:
+
+	/* handler: j.PrincipalID type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.PrincipalID = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+wantedvalue:
+	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+wrongtokenerror:
+	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
+tokerror:
+	if fs.BigError != nil {
+		return fs.WrapErr(fs.BigError)
+	}
+	err = fs.Error.ToError()
+	if err != nil {
+		return fs.WrapErr(err)
+	}
+	panic("ffjson-generated: unreachable, please report bug.")
+done:
+
+	return nil
+}
+
+// MarshalJSON marshal bytes to json - template
+func (j *agreementUnsignAPIResp) MarshalJSON() ([]byte, error) {
+	var buf fflib.Buffer
+	if j == nil {
+		buf.WriteString("null")
+		return buf.Bytes(), nil
+	}
+	err := j.MarshalJSONBuf(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// MarshalJSONBuf marshal buff to json - template
+func (j *agreementUnsignAPIResp) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+	if j == nil {
+		buf.WriteString("null")
+		return nil
+	}
+	var err error
+	var obj []byte
+	_ = obj
+	_ = err
+	if j.Method != nil {
+		buf.WriteString(`{"alipay_user_agreement_unsign_response":`)
+
+		{
+
+			err = j.Method.MarshalJSONBuf(buf)
+			if err != nil {
+				return err
+			}
+
+		}
+	} else {
+		buf.WriteString(`{"alipay_user_agreement_unsign_response":null`)
+	}
+	buf.WriteString(`,"sign":`)
+	fflib.WriteJsonString(buf, string(j.Sign))
+	buf.WriteByte('}')
+	return nil
+}
+
+const (
+	ffjtagreementUnsignAPIRespbase = iota
+	ffjtagreementUnsignAPIRespnosuchkey
+
+	ffjtagreementUnsignAPIRespMethod
+
+	ffjtagreementUnsignAPIRespSign
+)
+
+var ffjKeyagreementUnsignAPIRespMethod = []byte("alipay_user_agreement_unsign_response")
+
+var ffjKeyagreementUnsignAPIRespSign = []byte("sign")
+
+// UnmarshalJSON umarshall json - template of ffjson
+func (j *agreementUnsignAPIResp) UnmarshalJSON(input []byte) error {
+	fs := fflib.NewFFLexer(input)
+	return j.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
+}
+
+// UnmarshalJSONFFLexer fast json unmarshall - template ffjson
+func (j *agreementUnsignAPIResp) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+	var err error
+	currentKey := ffjtagreementUnsignAPIRespbase
+	_ = currentKey
+	tok := fflib.FFTok_init
+	wantedTok := fflib.FFTok_init
+
+mainparse:
+	for {
+		tok = fs.Scan()
+		//	println(fmt.Sprintf("debug: tok: %v  state: %v", tok, state))
+		if tok == fflib.FFTok_error {
+			goto tokerror
+		}
+
+		switch state {
+
+		case fflib.FFParse_map_start:
+			if tok != fflib.FFTok_left_bracket {
+				wantedTok = fflib.FFTok_left_bracket
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_key
+			continue
+
+		case fflib.FFParse_after_value:
+			if tok == fflib.FFTok_comma {
+				state = fflib.FFParse_want_key
+			} else if tok == fflib.FFTok_right_bracket {
+				goto done
+			} else {
+				wantedTok = fflib.FFTok_comma
+				goto wrongtokenerror
+			}
+
+		case fflib.FFParse_want_key:
+			// json {} ended. goto exit. woo.
+			if tok == fflib.FFTok_right_bracket {
+				goto done
+			}
+			if tok != fflib.FFTok_string {
+				wantedTok = fflib.FFTok_string
+				goto wrongtokenerror
+			}
+
+			kn := fs.Output.Bytes()
+			if len(kn) <= 0 {
+				// "" case. hrm.
+				currentKey = ffjtagreementUnsignAPIRespnosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			} else {
+				switch kn[0] {
+
+				case 'a':
+
+					if bytes.Equal(ffjKeyagreementUnsignAPIRespMethod, kn) {
+						currentKey = ffjtagreementUnsignAPIRespMethod
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 's':
+
+					if bytes.Equal(ffjKeyagreementUnsignAPIRespSign, kn) {
+						currentKey = ffjtagreementUnsignAPIRespSign
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				}
+
+				if fflib.EqualFoldRight(ffjKeyagreementUnsignAPIRespSign, kn) {
+					currentKey = ffjtagreementUnsignAPIRespSign
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeyagreementUnsignAPIRespMethod, kn) {
+					currentKey = ffjtagreementUnsignAPIRespMethod
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				currentKey = ffjtagreementUnsignAPIRespnosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			}
+
+		case fflib.FFParse_want_colon:
+			if tok != fflib.FFTok_colon {
+				wantedTok = fflib.FFTok_colon
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_value
+			continue
+		case fflib.FFParse_want_value:
+
+			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
+				switch currentKey {
+
+				case ffjtagreementUnsignAPIRespMethod:
+					goto handle_Method
+
+				case ffjtagreementUnsignAPIRespSign:
+					goto handle_Sign
+
+				case ffjtagreementUnsignAPIRespnosuchkey:
+					err = fs.SkipField(tok)
+					if err != nil {
+						return fs.WrapErr(err)
+					}
+					state = fflib.FFParse_after_value
+					goto mainparse
+				}
+			} else {
+				goto wantedvalue
+			}
+		}
+	}
+
+handle_Method:
+
+	/* handler: j.Method type=alipay.agreementUnsignAPIResponse kind=struct quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+			j.Method = nil
+
+		} else {
+
+			if j.Method == nil {
+				j.Method = new(agreementUnsignAPIResponse)
+			}
+
+			err = j.Method.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+			if err != nil {
+				return err
+			}
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Sign:
+
+	/* handler: j.Sign type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.Sign = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+wantedvalue:
+	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+wrongtokenerror:
+	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
+tokerror:
+	if fs.BigError != nil {
+		return fs.WrapErr(fs.BigError)
+	}
+	err = fs.Error.ToError()
+	if err != nil {
+		return fs.WrapErr(err)
+	}
+	panic("ffjson-generated: unreachable, please report bug.")
+done:
+
+	return nil
+}
+
+// MarshalJSON marshal bytes to json - template
+func (j *agreementUnsignAPIResponse) MarshalJSON() ([]byte, error) {
+	var buf fflib.Buffer
+	if j == nil {
+		buf.WriteString("null")
+		return buf.Bytes(), nil
+	}
+	err := j.MarshalJSONBuf(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// MarshalJSONBuf marshal buff to json - template
+func (j *agreementUnsignAPIResponse) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+	if j == nil {
+		buf.WriteString("null")
+		return nil
+	}
+	var err error
+	var obj []byte
+	_ = obj
+	_ = err
+	buf.WriteString(`{"code":`)
+	fflib.WriteJsonString(buf, string(j.Code))
+	buf.WriteString(`,"msg":`)
+	fflib.WriteJsonString(buf, string(j.Msg))
+	buf.WriteString(`,"sub_code":`)
+	fflib.WriteJsonString(buf, string(j.SubCode))
+	buf.WriteString(`,"sub_msg":`)
+	fflib.WriteJsonString(buf, string(j.SubMsg))
+	buf.WriteByte('}')
+	return nil
+}
+
+const (
+	ffjtagreementUnsignAPIResponsebase = iota
+	ffjtagreementUnsignAPIResponsenosuchkey
+
+	ffjtagreementUnsignAPIResponseCode
+
+	ffjtagreementUnsignAPIResponseMsg
+
+	ffjtagreementUnsignAPIResponseSubCode
+
+	ffjtagreementUnsignAPIResponseSubMsg
+)
+
+var ffjKeyagreementUnsignAPIResponseCode = []byte("code")
+
+var ffjKeyagreementUnsignAPIResponseMsg = []byte("msg")
+
+var ffjKeyagreementUnsignAPIResponseSubCode = []byte("sub_code")
+
+var ffjKeyagreementUnsignAPIResponseSubMsg = []byte("sub_msg")
+
+// UnmarshalJSON umarshall json - template of ffjson
+func (j *agreementUnsignAPIResponse) UnmarshalJSON(input []byte) error {
+	fs := fflib.NewFFLexer(input)
+	return j.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
+}
+
+// UnmarshalJSONFFLexer fast json unmarshall - template ffjson
+func (j *agreementUnsignAPIResponse) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+	var err error
+	currentKey := ffjtagreementUnsignAPIResponsebase
+	_ = currentKey
+	tok := fflib.FFTok_init
+	wantedTok := fflib.FFTok_init
+
+mainparse:
+	for {
+		tok = fs.Scan()
+		//	println(fmt.Sprintf("debug: tok: %v  state: %v", tok, state))
+		if tok == fflib.FFTok_error {
+			goto tokerror
+		}
+
+		switch state {
+
+		case fflib.FFParse_map_start:
+			if tok != fflib.FFTok_left_bracket {
+				wantedTok = fflib.FFTok_left_bracket
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_key
+			continue
+
+		case fflib.FFParse_after_value:
+			if tok == fflib.FFTok_comma {
+				state = fflib.FFParse_want_key
+			} else if tok == fflib.FFTok_right_bracket {
+				goto done
+			} else {
+				wantedTok = fflib.FFTok_comma
+				goto wrongtokenerror
+			}
+
+		case fflib.FFParse_want_key:
+			// json {} ended. goto exit. woo.
+			if tok == fflib.FFTok_right_bracket {
+				goto done
+			}
+			if tok != fflib.FFTok_string {
+				wantedTok = fflib.FFTok_string
+				goto wrongtokenerror
+			}
+
+			kn := fs.Output.Bytes()
+			if len(kn) <= 0 {
+				// "" case. hrm.
+				currentKey = ffjtagreementUnsignAPIResponsenosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			} else {
+				switch kn[0] {
+
+				case 'c':
+
+					if bytes.Equal(ffjKeyagreementUnsignAPIResponseCode, kn) {
+						currentKey = ffjtagreementUnsignAPIResponseCode
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'm':
+
+					if bytes.Equal(ffjKeyagreementUnsignAPIResponseMsg, kn) {
+						currentKey = ffjtagreementUnsignAPIResponseMsg
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 's':
+
+					if bytes.Equal(ffjKeyagreementUnsignAPIResponseSubCode, kn) {
+						currentKey = ffjtagreementUnsignAPIResponseSubCode
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeyagreementUnsignAPIResponseSubMsg, kn) {
+						currentKey = ffjtagreementUnsignAPIResponseSubMsg
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				}
+
+				if fflib.EqualFoldRight(ffjKeyagreementUnsignAPIResponseSubMsg, kn) {
+					currentKey = ffjtagreementUnsignAPIResponseSubMsg
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeyagreementUnsignAPIResponseSubCode, kn) {
+					currentKey = ffjtagreementUnsignAPIResponseSubCode
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeyagreementUnsignAPIResponseMsg, kn) {
+					currentKey = ffjtagreementUnsignAPIResponseMsg
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeyagreementUnsignAPIResponseCode, kn) {
+					currentKey = ffjtagreementUnsignAPIResponseCode
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				currentKey = ffjtagreementUnsignAPIResponsenosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			}
+
+		case fflib.FFParse_want_colon:
+			if tok != fflib.FFTok_colon {
+				wantedTok = fflib.FFTok_colon
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_value
+			continue
+		case fflib.FFParse_want_value:
+
+			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
+				switch currentKey {
+
+				case ffjtagreementUnsignAPIResponseCode:
+					goto handle_Code
+
+				case ffjtagreementUnsignAPIResponseMsg:
+					goto handle_Msg
+
+				case ffjtagreementUnsignAPIResponseSubCode:
+					goto handle_SubCode
+
+				case ffjtagreementUnsignAPIResponseSubMsg:
+					goto handle_SubMsg
+
+				case ffjtagreementUnsignAPIResponsenosuchkey:
+					err = fs.SkipField(tok)
+					if err != nil {
+						return fs.WrapErr(err)
+					}
+					state = fflib.FFParse_after_value
+					goto mainparse
+				}
+			} else {
+				goto wantedvalue
+			}
+		}
+	}
+
+handle_Code:
+
+	/* handler: j.Code type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.Code = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Msg:
+
+	/* handler: j.Msg type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.Msg = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_SubCode:
+
+	/* handler: j.SubCode type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.SubCode = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_SubMsg:
+
+	/* handler: j.SubMsg type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.SubMsg = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+wantedvalue:
+	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+wrongtokenerror:
+	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
+tokerror:
+	if fs.BigError != nil {
+		return fs.WrapErr(fs.BigError)
+	}
+	err = fs.Error.ToError()
+	if err != nil {
+		return fs.WrapErr(err)
+	}
+	panic("ffjson-generated: unreachable, please report bug.")
+done:
+
+	return nil
+}
+
+// MarshalJSON marshal bytes to json - template
+func (j *appPayResponse) MarshalJSON() ([]byte, error) {
+	var buf fflib.Buffer
+	if j == nil {
+		buf.WriteString("null")
+		return buf.Bytes(), nil
+	}
+	err := j.MarshalJSONBuf(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// MarshalJSONBuf marshal buff to json - template
+func (j *appPayResponse) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+	if j == nil {
+		buf.WriteString("null")
+		return nil
+	}
+	var err error
+	var obj []byte
+	_ = obj
+	_ = err
+	buf.WriteString(`{"code":`)
+	fflib.WriteJsonString(buf, string(j.Code))
+	buf.WriteString(`,"msg":`)
+	fflib.WriteJsonString(buf, string(j.Msg))
+	buf.WriteString(`,"app_id":`)
+	fflib.WriteJsonString(buf, string(j.AppID))
+	buf.WriteString(`,"out_trade_no":`)
+	fflib.WriteJsonString(buf, string(j.OutTradeNo))
+	buf.WriteString(`,"trade_no":`)
+	fflib.WriteJsonString(buf, string(j.TradeNo))
+	buf.WriteString(`,"total_amount":`)
+	fflib.AppendFloat(buf, float64(j.TotalAmount), 'g', -1, 64)
+	buf.WriteString(`,"seller_id":`)
+	fflib.WriteJsonString(buf, string(j.SellerID))
+	buf.WriteString(`,"charset":`)
+	fflib.WriteJsonString(buf, string(j.Charset))
+	buf.WriteString(`,"timestamp":`)
+	fflib.WriteJsonString(buf, string(j.Timestamp))
+	buf.WriteByte('}')
+	return nil
+}
+
+const (
+	ffjtappPayResponsebase = iota
+	ffjtappPayResponsenosuchkey
+
+	ffjtappPayResponseCode
+
+	ffjtappPayResponseMsg
+
+	ffjtappPayResponseAppID
+
+	ffjtappPayResponseOutTradeNo
+
+	ffjtappPayResponseTradeNo
+
+	ffjtappPayResponseTotalAmount
+
+	ffjtappPayResponseSellerID
+
+	ffjtappPayResponseCharset
+
+	ffjtappPayResponseTimestamp
+)
+
+var ffjKeyappPayResponseCode = []byte("code")
+
+var ffjKeyappPayResponseMsg = []byte("msg")
+
+var ffjKeyappPayResponseAppID = []byte("app_id")
+
+var ffjKeyappPayResponseOutTradeNo = []byte("out_trade_no")
+
+var ffjKeyappPayResponseTradeNo = []byte("trade_no")
+
+var ffjKeyappPayResponseTotalAmount = []byte("total_amount")
+
+var ffjKeyappPayResponseSellerID = []byte("seller_id")
+
+var ffjKeyappPayResponseCharset = []byte("charset")
+
+var ffjKeyappPayResponseTimestamp = []byte("timestamp")
+
+// UnmarshalJSON umarshall json - template of ffjson
+func (j *appPayResponse) UnmarshalJSON(input []byte) error {
+	fs := fflib.NewFFLexer(input)
+	return j.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
+}
+
+// UnmarshalJSONFFLexer fast json unmarshall - template ffjson
+func (j *appPayResponse) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+	var err error
+	currentKey := ffjtappPayResponsebase
+	_ = currentKey
+	tok := fflib.FFTok_init
+	wantedTok := fflib.FFTok_init
+
+mainparse:
+	for {
+		tok = fs.Scan()
+		//	println(fmt.Sprintf("debug: tok: %v  state: %v", tok, state))
+		if tok == fflib.FFTok_error {
+			goto tokerror
+		}
+
+		switch state {
+
+		case fflib.FFParse_map_start:
+			if tok != fflib.FFTok_left_bracket {
+				wantedTok = fflib.FFTok_left_bracket
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_key
+			continue
+
+		case fflib.FFParse_after_value:
+			if tok == fflib.FFTok_comma {
+				state = fflib.FFParse_want_key
+			} else if tok == fflib.FFTok_right_bracket {
+				goto done
+			} else {
+				wantedTok = fflib.FFTok_comma
+				goto wrongtokenerror
+			}
+
+		case fflib.FFParse_want_key:
+			// json {} ended. goto exit. woo.
+			if tok == fflib.FFTok_right_bracket {
+				goto done
+			}
+			if tok != fflib.FFTok_string {
+				wantedTok = fflib.FFTok_string
+				goto wrongtokenerror
+			}
+
+			kn := fs.Output.Bytes()
+			if len(kn) <= 0 {
+				// "" case. hrm.
+				currentKey = ffjtappPayResponsenosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			} else {
+				switch kn[0] {
+
+				case 'a':
+
+					if bytes.Equal(ffjKeyappPayResponseAppID, kn) {
+						currentKey = ffjtappPayResponseAppID
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'c':
+
+					if bytes.Equal(ffjKeyappPayResponseCode, kn) {
+						currentKey = ffjtappPayResponseCode
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeyappPayResponseCharset, kn) {
+						currentKey = ffjtappPayResponseCharset
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'm':
+
+					if bytes.Equal(ffjKeyappPayResponseMsg, kn) {
+						currentKey = ffjtappPayResponseMsg
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'o':
+
+					if bytes.Equal(ffjKeyappPayResponseOutTradeNo, kn) {
+						currentKey = ffjtappPayResponseOutTradeNo
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 's':
+
+					if bytes.Equal(ffjKeyappPayResponseSellerID, kn) {
+						currentKey = ffjtappPayResponseSellerID
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 't':
+
+					if bytes.Equal(ffjKeyappPayResponseTradeNo, kn) {
+						currentKey = ffjtappPayResponseTradeNo
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeyappPayResponseTotalAmount, kn) {
+						currentKey = ffjtappPayResponseTotalAmount
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeyappPayResponseTimestamp, kn) {
+						currentKey = ffjtappPayResponseTimestamp
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				}
+
+				if fflib.EqualFoldRight(ffjKeyappPayResponseTimestamp, kn) {
+					currentKey = ffjtappPayResponseTimestamp
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeyappPayResponseCharset, kn) {
+					currentKey = ffjtappPayResponseCharset
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeyappPayResponseSellerID, kn) {
+					currentKey = ffjtappPayResponseSellerID
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.AsciiEqualFold(ffjKeyappPayResponseTotalAmount, kn) {
+					currentKey = ffjtappPayResponseTotalAmount
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.AsciiEqualFold(ffjKeyappPayResponseTradeNo, kn) {
+					currentKey = ffjtappPayResponseTradeNo
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.AsciiEqualFold(ffjKeyappPayResponseOutTradeNo, kn) {
+					currentKey = ffjtappPayResponseOutTradeNo
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.AsciiEqualFold(ffjKeyappPayResponseAppID, kn) {
+					currentKey = ffjtappPayResponseAppID
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeyappPayResponseMsg, kn) {
+					currentKey = ffjtappPayResponseMsg
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeyappPayResponseCode, kn) {
+					currentKey = ffjtappPayResponseCode
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				currentKey = ffjtappPayResponsenosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			}
+
+		case fflib.FFParse_want_colon:
+			if tok != fflib.FFTok_colon {
+				wantedTok = fflib.FFTok_colon
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_value
+			continue
+		case fflib.FFParse_want_value:
+
+			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
+				switch currentKey {
+
+				case ffjtappPayResponseCode:
+					goto handle_Code
+
+				case ffjtappPayResponseMsg:
+					goto handle_Msg
+
+				case ffjtappPayResponseAppID:
+					goto handle_AppID
+
+				case ffjtappPayResponseOutTradeNo:
+					goto handle_OutTradeNo
+
+				case ffjtappPayResponseTradeNo:
+					goto handle_TradeNo
+
+				case ffjtappPayResponseTotalAmount:
+					goto handle_TotalAmount
+
+				case ffjtappPayResponseSellerID:
+					goto handle_SellerID
+
+				case ffjtappPayResponseCharset:
+					goto handle_Charset
+
+				case ffjtappPayResponseTimestamp:
+					goto handle_Timestamp
+
+				case ffjtappPayResponsenosuchkey:
+					err = fs.SkipField(tok)
+					if err != nil {
+						return fs.WrapErr(err)
+					}
+					state = fflib.FFParse_after_value
+					goto mainparse
+				}
+			} else {
+				goto wantedvalue
+			}
+		}
+	}
+
+handle_Code:
+
+	/* handler: j.Code type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.Code = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Msg:
+
+	/* handler: j.Msg type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.Msg = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_AppID:
+
+	/* handler: j.AppID type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.AppID = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_OutTradeNo:
+
+	/* handler: j.OutTradeNo type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.OutTradeNo = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_TradeNo:
+
+	/* handler: j.TradeNo type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.TradeNo = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_TotalAmount:
+
+	/* handler: j.TotalAmount type=float64 kind=float64 quoted=false*/
+
+	{
+		if tok != fflib.FFTok_double && tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for float64", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseFloat(fs.Output.Bytes(), 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			j.TotalAmount = float64(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_SellerID:
+
+	/* handler: j.SellerID type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.SellerID = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Charset:
+
+	/* handler: j.Charset type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.Charset = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Timestamp:
+
+	/* handler: j.Timestamp type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.Timestamp = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+wantedvalue:
+	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+wrongtokenerror:
+	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
+tokerror:
+	if fs.BigError != nil {
+		return fs.WrapErr(fs.BigError)
+	}
+	err = fs.Error.ToError()
+	if err != nil {
+		return fs.WrapErr(err)
+	}
+	panic("ffjson-generated: unreachable, please report bug.")
+done:
+
+	return nil
+}
+
+// MarshalJSON marshal bytes to json - template
+func (j *appPayResult) MarshalJSON() ([]byte, error) {
+	var buf fflib.Buffer
+	if j == nil {
+		buf.WriteString("null")
+		return buf.Bytes(), nil
+	}
+	err := j.MarshalJSONBuf(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// MarshalJSONBuf marshal buff to json - template
+func (j *appPayResult) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+	if j == nil {
+		buf.WriteString("null")
+		return nil
+	}
+	var err error
+	var obj []byte
+	_ = obj
+	_ = err
+	if j.Response != nil {
+		buf.WriteString(`{"alipay_trade_app_pay_response":`)
+
+		{
+
+			err = j.Response.MarshalJSONBuf(buf)
+			if err != nil {
+				return err
+			}
+
+		}
+	} else {
+		buf.WriteString(`{"alipay_trade_app_pay_response":null`)
+	}
+	buf.WriteString(`,"sign":`)
+	fflib.WriteJsonString(buf, string(j.Sign))
+	buf.WriteString(`,"sign_type":`)
+	fflib.WriteJsonString(buf, string(j.SignType))
+	buf.WriteByte('}')
+	return nil
+}
+
+const (
+	ffjtappPayResultbase = iota
+	ffjtappPayResultnosuchkey
+
+	ffjtappPayResultResponse
+
+	ffjtappPayResultSign
+
+	ffjtappPayResultSignType
+)
+
+var ffjKeyappPayResultResponse = []byte("alipay_trade_app_pay_response")
+
+var ffjKeyappPayResultSign = []byte("sign")
+
+var ffjKeyappPayResultSignType = []byte("sign_type")
+
+// UnmarshalJSON umarshall json - template of ffjson
+func (j *appPayResult) UnmarshalJSON(input []byte) error {
+	fs := fflib.NewFFLexer(input)
+	return j.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
+}
+
+// UnmarshalJSONFFLexer fast json unmarshall - template ffjson
+func (j *appPayResult) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+	var err error
+	currentKey := ffjtappPayResultbase
+	_ = currentKey
+	tok := fflib.FFTok_init
+	wantedTok := fflib.FFTok_init
+
+mainparse:
+	for {
+		tok = fs.Scan()
+		//	println(fmt.Sprintf("debug: tok: %v  state: %v", tok, state))
+		if tok == fflib.FFTok_error {
+			goto tokerror
+		}
+
+		switch state {
+
+		case fflib.FFParse_map_start:
+			if tok != fflib.FFTok_left_bracket {
+				wantedTok = fflib.FFTok_left_bracket
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_key
+			continue
+
+		case fflib.FFParse_after_value:
+			if tok == fflib.FFTok_comma {
+				state = fflib.FFParse_want_key
+			} else if tok == fflib.FFTok_right_bracket {
+				goto done
+			} else {
+				wantedTok = fflib.FFTok_comma
+				goto wrongtokenerror
+			}
+
+		case fflib.FFParse_want_key:
+			// json {} ended. goto exit. woo.
+			if tok == fflib.FFTok_right_bracket {
+				goto done
+			}
+			if tok != fflib.FFTok_string {
+				wantedTok = fflib.FFTok_string
+				goto wrongtokenerror
+			}
+
+			kn := fs.Output.Bytes()
+			if len(kn) <= 0 {
+				// "" case. hrm.
+				currentKey = ffjtappPayResultnosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			} else {
+				switch kn[0] {
+
+				case 'a':
+
+					if bytes.Equal(ffjKeyappPayResultResponse, kn) {
+						currentKey = ffjtappPayResultResponse
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 's':
+
+					if bytes.Equal(ffjKeyappPayResultSign, kn) {
+						currentKey = ffjtappPayResultSign
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeyappPayResultSignType, kn) {
+						currentKey = ffjtappPayResultSignType
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				}
+
+				if fflib.EqualFoldRight(ffjKeyappPayResultSignType, kn) {
+					currentKey = ffjtappPayResultSignType
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeyappPayResultSign, kn) {
+					currentKey = ffjtappPayResultSign
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeyappPayResultResponse, kn) {
+					currentKey = ffjtappPayResultResponse
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				currentKey = ffjtappPayResultnosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			}
+
+		case fflib.FFParse_want_colon:
+			if tok != fflib.FFTok_colon {
+				wantedTok = fflib.FFTok_colon
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_value
+			continue
+		case fflib.FFParse_want_value:
+
+			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
+				switch currentKey {
+
+				case ffjtappPayResultResponse:
+					goto handle_Response
+
+				case ffjtappPayResultSign:
+					goto handle_Sign
+
+				case ffjtappPayResultSignType:
+					goto handle_SignType
+
+				case ffjtappPayResultnosuchkey:
+					err = fs.SkipField(tok)
+					if err != nil {
+						return fs.WrapErr(err)
+					}
+					state = fflib.FFParse_after_value
+					goto mainparse
+				}
+			} else {
+				goto wantedvalue
+			}
+		}
+	}
+
+handle_Response:
+
+	/* handler: j.Response type=alipay.appPayResponse kind=struct quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+			j.Response = nil
+
+		} else {
+
+			if j.Response == nil {
+				j.Response = new(appPayResponse)
+			}
+
+			err = j.Response.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+			if err != nil {
+				return err
+			}
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Sign:
+
+	/* handler: j.Sign type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.Sign = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_SignType:
+
+	/* handler: j.SignType type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.SignType = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+wantedvalue:
+	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+wrongtokenerror:
+	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
+tokerror:
+	if fs.BigError != nil {
+		return fs.WrapErr(fs.BigError)
+	}
+	err = fs.Error.ToError()
+	if err != nil {
+		return fs.WrapErr(err)
+	}
+	panic("ffjson-generated: unreachable, please report bug.")
+done:
+
+	return nil
+}
+
+// MarshalJSON marshal bytes to json - template
+func (j *appPayReturn) MarshalJSON() ([]byte, error) {
+	var buf fflib.Buffer
+	if j == nil {
+		buf.WriteString("null")
+		return buf.Bytes(), nil
+	}
+	err := j.MarshalJSONBuf(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// MarshalJSONBuf marshal buff to json - template
+func (j *appPayReturn) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+	if j == nil {
+		buf.WriteString("null")
+		return nil
+	}
+	var err error
+	var obj []byte
+	_ = obj
+	_ = err
+	buf.WriteString(`{"result":`)
+	if j.Result != nil {
+		buf.WriteString(`"`)
+		{
+			enc := base64.NewEncoder(base64.StdEncoding, buf)
+			enc.Write(reflect.Indirect(reflect.ValueOf(j.Result)).Bytes())
+			enc.Close()
+		}
+		buf.WriteString(`"`)
+	} else {
+		buf.WriteString(`null`)
+	}
+	buf.WriteString(`,"resultStatus":`)
+	fflib.WriteJsonString(buf, string(j.Status))
+	buf.WriteString(`,"memo":`)
+	fflib.WriteJsonString(buf, string(j.Memo))
+	buf.WriteByte('}')
+	return nil
+}
+
+const (
+	ffjtappPayReturnbase = iota
+	ffjtappPayReturnnosuchkey
+
+	ffjtappPayReturnResult
+
+	ffjtappPayReturnStatus
+
+	ffjtappPayReturnMemo
+)
+
+var ffjKeyappPayReturnResult = []byte("result")
+
+var ffjKeyappPayReturnStatus = []byte("resultStatus")
+
+var ffjKeyappPayReturnMemo = []byte("memo")
+
+// UnmarshalJSON umarshall json - template of ffjson
+func (j *appPayReturn) UnmarshalJSON(input []byte) error {
+	fs := fflib.NewFFLexer(input)
+	return j.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
+}
+
+// UnmarshalJSONFFLexer fast json unmarshall - template ffjson
+func (j *appPayReturn) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+	var err error
+	currentKey := ffjtappPayReturnbase
+	_ = currentKey
+	tok := fflib.FFTok_init
+	wantedTok := fflib.FFTok_init
+
+mainparse:
+	for {
+		tok = fs.Scan()
+		//	println(fmt.Sprintf("debug: tok: %v  state: %v", tok, state))
+		if tok == fflib.FFTok_error {
+			goto tokerror
+		}
+
+		switch state {
+
+		case fflib.FFParse_map_start:
+			if tok != fflib.FFTok_left_bracket {
+				wantedTok = fflib.FFTok_left_bracket
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_key
+			continue
+
+		case fflib.FFParse_after_value:
+			if tok == fflib.FFTok_comma {
+				state = fflib.FFParse_want_key
+			} else if tok == fflib.FFTok_right_bracket {
+				goto done
+			} else {
+				wantedTok = fflib.FFTok_comma
+				goto wrongtokenerror
+			}
+
+		case fflib.FFParse_want_key:
+			// json {} ended. goto exit. woo.
+			if tok == fflib.FFTok_right_bracket {
+				goto done
+			}
+			if tok != fflib.FFTok_string {
+				wantedTok = fflib.FFTok_string
+				goto wrongtokenerror
+			}
+
+			kn := fs.Output.Bytes()
+			if len(kn) <= 0 {
+				// "" case. hrm.
+				currentKey = ffjtappPayReturnnosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			} else {
+				switch kn[0] {
+
+				case 'm':
+
+					if bytes.Equal(ffjKeyappPayReturnMemo, kn) {
+						currentKey = ffjtappPayReturnMemo
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'r':
+
+					if bytes.Equal(ffjKeyappPayReturnResult, kn) {
+						currentKey = ffjtappPayReturnResult
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeyappPayReturnStatus, kn) {
+						currentKey = ffjtappPayReturnStatus
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeyappPayReturnMemo, kn) {
+					currentKey = ffjtappPayReturnMemo
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeyappPayReturnStatus, kn) {
+					currentKey = ffjtappPayReturnStatus
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeyappPayReturnResult, kn) {
+					currentKey = ffjtappPayReturnResult
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				currentKey = ffjtappPayReturnnosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			}
+
+		case fflib.FFParse_want_colon:
+			if tok != fflib.FFTok_colon {
+				wantedTok = fflib.FFTok_colon
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_value
+			continue
+		case fflib.FFParse_want_value:
+
+			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
+				switch currentKey {
+
+				case ffjtappPayReturnResult:
+					goto handle_Result
+
+				case ffjtappPayReturnStatus:
+					goto handle_Status
+
+				case ffjtappPayReturnMemo:
+					goto handle_Memo
+
+				case ffjtappPayReturnnosuchkey:
+					err = fs.SkipField(tok)
+					if err != nil {
+						return fs.WrapErr(err)
+					}
+					state = fflib.FFParse_after_value
+					goto mainparse
+				}
+			} else {
+				goto wantedvalue
+			}
+		}
+	}
+
+handle_Result:
+
+	/* handler: j.Result type=[]uint8 kind=slice quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+			j.Result = nil
+		} else {
+			b := make([]byte, base64.StdEncoding.DecodedLen(fs.Output.Len()))
+			n, err := base64.StdEncoding.Decode(b, fs.Output.Bytes())
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			v := reflect.ValueOf(&j.Result).Elem()
+			v.SetBytes(b[0:n])
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Status:
+
+	/* handler: j.Status type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.Status = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Memo:
+
+	/* handler: j.Memo type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.Memo = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+wantedvalue:
+	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+wrongtokenerror:
+	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
+tokerror:
+	if fs.BigError != nil {
+		return fs.WrapErr(fs.BigError)
+	}
+	err = fs.Error.ToError()
+	if err != nil {
+		return fs.WrapErr(err)
+	}
+	panic("ffjson-generated: unreachable, please report bug.")
+done:
+
+	return nil
+}
+
+// MarshalJSON marshal bytes to json - template
+func (j *billAPIResp) MarshalJSON() ([]byte, error) {
+	var buf fflib.Buffer
+	if j == nil {
+		buf.WriteString("null")
+		return buf.Bytes(), nil
+	}
+	err := j.MarshalJSONBuf(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// MarshalJSONBuf marshal buff to json - template
+func (j *billAPIResp) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+	if j == nil {
+		buf.WriteString("null")
+		return nil
+	}
+	var err error
+	var obj []byte
+	_ = obj
+	_ = err
+	if j.Method != nil {
+		buf.WriteString(`{"alipay_data_dataservice_bill_downloadurl_query_response":`)
+
+		{
+
+			err = j.Method.MarshalJSONBuf(buf)
+			if err != nil {
+				return err
+			}
+
+		}
+	} else {
+		buf.WriteString(`{"alipay_data_dataservice_bill_downloadurl_query_response":null`)
+	}
+	buf.WriteString(`,"sign":`)
+	fflib.WriteJsonString(buf, string(j.Sign))
+	buf.WriteByte('}')
+	return nil
+}
+
+const (
+	ffjtbillAPIRespbase = iota
+	ffjtbillAPIRespnosuchkey
+
+	ffjtbillAPIRespMethod
+
+	ffjtbillAPIRespSign
+)
+
+var ffjKeybillAPIRespMethod = []byte("alipay_data_dataservice_bill_downloadurl_query_response")
+
+var ffjKeybillAPIRespSign = []byte("sign")
+
+// UnmarshalJSON umarshall json - template of ffjson
+func (j *billAPIResp) UnmarshalJSON(input []byte) error {
+	fs := fflib.NewFFLexer(input)
+	return j.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
+}
+
+// UnmarshalJSONFFLexer fast json unmarshall - template ffjson
+func (j *billAPIResp) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+	var err error
+	currentKey := ffjtbillAPIRespbase
+	_ = currentKey
+	tok := fflib.FFTok_init
+	wantedTok := fflib.FFTok_init
+
+mainparse:
+	for {
+		tok = fs.Scan()
+		//	println(fmt.Sprintf("debug: tok: %v  state: %v", tok, state))
+		if tok == fflib.FFTok_error {
+			goto tokerror
+		}
+
+		switch state {
+
+		case fflib.FFParse_map_start:
+			if tok != fflib.FFTok_left_bracket {
+				wantedTok = fflib.FFTok_left_bracket
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_key
+			continue
+
+		case fflib.FFParse_after_value:
+			if tok == fflib.FFTok_comma {
+				state = fflib.FFParse_want_key
+			} else if tok == fflib.FFTok_right_bracket {
+				goto done
+			} else {
+				wantedTok = fflib.FFTok_comma
+				goto wrongtokenerror
+			}
+
+		case fflib.FFParse_want_key:
+			// json {} ended. goto exit. woo.
+			if tok == fflib.FFTok_right_bracket {
+				goto done
+			}
+			if tok != fflib.FFTok_string {
+				wantedTok = fflib.FFTok_string
+				goto wrongtokenerror
+			}
+
+			kn := fs.Output.Bytes()
+			if len(kn) <= 0 {
+				// "" case. hrm.
+				currentKey = ffjtbillAPIRespnosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			} else {
+				switch kn[0] {
+
+				case 'a':
+
+					if bytes.Equal(ffjKeybillAPIRespMethod, kn) {
+						currentKey = ffjtbillAPIRespMethod
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 's':
+
+					if bytes.Equal(ffjKeybillAPIRespSign, kn) {
+						currentKey = ffjtbillAPIRespSign
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				}
+
+				if fflib.EqualFoldRight(ffjKeybillAPIRespSign, kn) {
+					currentKey = ffjtbillAPIRespSign
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeybillAPIRespMethod, kn) {
+					currentKey = ffjtbillAPIRespMethod
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				currentKey = ffjtbillAPIRespnosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			}
+
+		case fflib.FFParse_want_colon:
+			if tok != fflib.FFTok_colon {
+				wantedTok = fflib.FFTok_colon
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_value
+			continue
+		case fflib.FFParse_want_value:
+
+			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
+				switch currentKey {
+
+				case ffjtbillAPIRespMethod:
+					goto handle_Method
+
+				case ffjtbillAPIRespSign:
+					goto handle_Sign
+
+				case ffjtbillAPIRespnosuchkey:
+					err = fs.SkipField(tok)
+					if err != nil {
+						return fs.WrapErr(err)
+					}
+					state = fflib.FFParse_after_value
+					goto mainparse
+				}
+			} else {
+				goto wantedvalue
+			}
+		}
+	}
+
+handle_Method:
+
+	/* handler: j.Method type=alipay.billAPIResponse kind=struct quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+			j.Method = nil
+
+		} else {
+
+			if j.Method == nil {
+				j.Method = new(billAPIResponse)
+			}
+
+			err = j.Method.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+			if err != nil {
+				return err
+			}
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Sign:
+
+	/* handler: j.Sign type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.Sign = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+wantedvalue:
+	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+wrongtokenerror:
+	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
+tokerror:
+	if fs.BigError != nil {
+		return fs.WrapErr(fs.BigError)
+	}
+	err = fs.Error.ToError()
+	if err != nil {
+		return fs.WrapErr(err)
+	}
+	panic("ffjson-generated: unreachable, please report bug.")
+done:
+
+	return nil
+}
+
+// MarshalJSON marshal bytes to json - template
+func (j *billAPIResponse) MarshalJSON() ([]byte, error) {
+	var buf fflib.Buffer
+	if j == nil {
+		buf.WriteString("null")
+		return buf.Bytes(), nil
+	}
+	err := j.MarshalJSONBuf(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// MarshalJSONBuf marshal buff to json - template
+func (j *billAPIResponse) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+	if j == nil {
+		buf.WriteString("null")
+		return nil
+	}
+	var err error
+	var obj []byte
+	_ = obj
+	_ = err
+	buf.WriteString(`{"code":`)
+	fflib.WriteJsonString(buf, string(j.Code))
+	buf.WriteString(`,"msg":`)
+	fflib.WriteJsonString(buf, string(j.Msg))
+	buf.WriteString(`,"sub_code":`)
+	fflib.WriteJsonString(buf, string(j.SubCode))
+	buf.WriteString(`,"sub_msg":`)
+	fflib.WriteJsonString(buf, string(j.SubMsg))
+	buf.WriteString(`,"bill_download_url":`)
+	fflib.WriteJsonString(buf, string(j.BillDownloadURL))
+	buf.WriteByte('}')
+	return nil
+}
+
+const (
+	ffjtbillAPIResponsebase = iota
+	ffjtbillAPIResponsenosuchkey
+
+	ffjtbillAPIResponseCode
+
+	ffjtbillAPIResponseMsg
+
+	ffjtbillAPIResponseSubCode
+
+	ffjtbillAPIResponseSubMsg
+
+	ffjtbillAPIResponseBillDownloadURL
+)
+
+var ffjKeybillAPIResponseCode = []byte("code")
+
+var ffjKeybillAPIResponseMsg = []byte("msg")
+
+var ffjKeybillAPIResponseSubCode = []byte("sub_code")
+
+var ffjKeybillAPIResponseSubMsg = []byte("sub_msg")
+
+var ffjKeybillAPIResponseBillDownloadURL = []byte("bill_download_url")
+
+// UnmarshalJSON umarshall json - template of ffjson
+func (j *billAPIResponse) UnmarshalJSON(input []byte) error {
+	fs := fflib.NewFFLexer(input)
+	return j.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
+}
+
+// UnmarshalJSONFFLexer fast json unmarshall - template ffjson
+func (j *billAPIResponse) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+	var err error
+	currentKey := ffjtbillAPIResponsebase
+	_ = currentKey
+	tok := fflib.FFTok_init
+	wantedTok := fflib.FFTok_init
+
+mainparse:
+	for {
+		tok = fs.Scan()
+		//	println(fmt.Sprintf("debug: tok: %v  state: %v", tok, state))
+		if tok == fflib.FFTok_error {
+			goto tokerror
+		}
+
+		switch state {
+
+		case fflib.FFParse_map_start:
+			if tok != fflib.FFTok_left_bracket {
+				wantedTok = fflib.FFTok_left_bracket
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_key
+			continue
+
+		case fflib.FFParse_after_value:
+			if tok == fflib.FFTok_comma {
+				state = fflib.FFParse_want_key
+			} else if tok == fflib.FFTok_right_bracket {
+				goto done
+			} else {
+				wantedTok = fflib.FFTok_comma
+				goto wrongtokenerror
+			}
+
+		case fflib.FFParse_want_key:
+			// json {} ended. goto exit. woo.
+			if tok == fflib.FFTok_right_bracket {
+				goto done
+			}
+			if tok != fflib.FFTok_string {
+				wantedTok = fflib.FFTok_string
+				goto wrongtokenerror
+			}
+
+			kn := fs.Output.Bytes()
+			if len(kn) <= 0 {
+				// "" case. hrm.
+				currentKey = ffjtbillAPIResponsenosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			} else {
+				switch kn[0] {
+
+				case 'b':
+
+					if bytes.Equal(ffjKeybillAPIResponseBillDownloadURL, kn) {
+						currentKey = ffjtbillAPIResponseBillDownloadURL
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'c':
+
+					if bytes.Equal(ffjKeybillAPIResponseCode, kn) {
+						currentKey = ffjtbillAPIResponseCode
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'm':
+
+					if bytes.Equal(ffjKeybillAPIResponseMsg, kn) {
+						currentKey = ffjtbillAPIResponseMsg
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 's':
+
+					if bytes.Equal(ffjKeybillAPIResponseSubCode, kn) {
+						currentKey = ffjtbillAPIResponseSubCode
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeybillAPIResponseSubMsg, kn) {
+						currentKey = ffjtbillAPIResponseSubMsg
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				}
+
+				if fflib.AsciiEqualFold(ffjKeybillAPIResponseBillDownloadURL, kn) {
+					currentKey = ffjtbillAPIResponseBillDownloadURL
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeybillAPIResponseSubMsg, kn) {
+					currentKey = ffjtbillAPIResponseSubMsg
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeybillAPIResponseSubCode, kn) {
+					currentKey = ffjtbillAPIResponseSubCode
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeybillAPIResponseMsg, kn) {
+					currentKey = ffjtbillAPIResponseMsg
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeybillAPIResponseCode, kn) {
+					currentKey = ffjtbillAPIResponseCode
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				currentKey = ffjtbillAPIResponsenosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			}
+
+		case fflib.FFParse_want_colon:
+			if tok != fflib.FFTok_colon {
+				wantedTok = fflib.FFTok_colon
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_value
+			continue
+		case fflib.FFParse_want_value:
+
+			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
+				switch currentKey {
+
+				case ffjtbillAPIResponseCode:
+					goto handle_Code
+
+				case ffjtbillAPIResponseMsg:
+					goto handle_Msg
+
+				case ffjtbillAPIResponseSubCode:
+					goto handle_SubCode
+
+				case ffjtbillAPIResponseSubMsg:
+					goto handle_SubMsg
+
+				case ffjtbillAPIResponseBillDownloadURL:
+					goto handle_BillDownloadURL
+
+				case ffjtbillAPIResponsenosuchkey:
+					err = fs.SkipField(tok)
+					if err != nil {
+						return fs.WrapErr(err)
+					}
+					state = fflib.FFParse_after_value
+					goto mainparse
+				}
+			} else {
+				goto wantedvalue
+			}
+		}
+	}
+
+handle_Code:
+
+	/* handler: j.Code type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.Code = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Msg:
+
+	/* handler: j.Msg type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.Msg = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_SubCode:
+
+	/* handler: j.SubCode type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.SubCode = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_SubMsg:
+
+	/* handler: j.SubMsg type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.SubMsg = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_BillDownloadURL:
+
+	/* handler: j.BillDownloadURL type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.BillDownloadURL = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+wantedvalue:
+	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+wrongtokenerror:
+	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
+tokerror:
+	if fs.BigError != nil {
+		return fs.WrapErr(fs.BigError)
+	}
+	err = fs.Error.ToError()
+	if err != nil {
+		return fs.WrapErr(err)
+	}
+	panic("ffjson-generated: unreachable, please report bug.")
+done:
+
+	return nil
+}
+
+// MarshalJSON marshal bytes to json - template
+func (j *precreateAPIResp) MarshalJSON() ([]byte, error) {
+	var buf fflib.Buffer
+	if j == nil {
+		buf.WriteString("null")
+		return buf.Bytes(), nil
+	}
+	err := j.MarshalJSONBuf(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// MarshalJSONBuf marshal buff to json - template
+func (j *precreateAPIResp) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+	if j == nil {
+		buf.WriteString("null")
+		return nil
+	}
+	var err error
+	var obj []byte
+	_ = obj
+	_ = err
+	if j.Method != nil {
+		buf.WriteString(`{"alipay_trade_precreate_response":`)
+
+		{
+
+			err = j.Method.MarshalJSONBuf(buf)
+			if err != nil {
+				return err
+			}
+
+		}
+	} else {
+		buf.WriteString(`{"alipay_trade_precreate_response":null`)
+	}
+	buf.WriteString(`,"sign":`)
+	fflib.WriteJsonString(buf, string(j.Sign))
+	buf.WriteByte('}')
+	return nil
+}
+
+const (
+	ffjtprecreateAPIRespbase = iota
+	ffjtprecreateAPIRespnosuchkey
+
+	ffjtprecreateAPIRespMethod
+
+	ffjtprecreateAPIRespSign
+)
+
+var ffjKeyprecreateAPIRespMethod = []byte("alipay_trade_precreate_response")
+
+var ffjKeyprecreateAPIRespSign = []byte("sign")
+
+// UnmarshalJSON umarshall json - template of ffjson
+func (j *precreateAPIResp) UnmarshalJSON(input []byte) error {
+	fs := fflib.NewFFLexer(input)
+	return j.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
+}
+
+// UnmarshalJSONFFLexer fast json unmarshall - template ffjson
+func (j *precreateAPIResp) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+	var err error
+	currentKey := ffjtprecreateAPIRespbase
+	_ = currentKey
+	tok := fflib.FFTok_init
+	wantedTok := fflib.FFTok_init
+
+mainparse:
+	for {
+		tok = fs.Scan()
+		//	println(fmt.Sprintf("debug: tok: %v  state: %v", tok, state))
+		if tok == fflib.FFTok_error {
+			goto tokerror
+		}
+
+		switch state {
+
+		case fflib.FFParse_map_start:
+			if tok != fflib.FFTok_left_bracket {
+				wantedTok = fflib.FFTok_left_bracket
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_key
+			continue
+
+		case fflib.FFParse_after_value:
+			if tok == fflib.FFTok_comma {
+				state = fflib.FFParse_want_key
+			} else if tok == fflib.FFTok_right_bracket {
+				goto done
+			} else {
+				wantedTok = fflib.FFTok_comma
+				goto wrongtokenerror
+			}
+
+		case fflib.FFParse_want_key:
+			// json {} ended. goto exit. woo.
+			if tok == fflib.FFTok_right_bracket {
+				goto done
+			}
+			if tok != fflib.FFTok_string {
+				wantedTok = fflib.FFTok_string
+				goto wrongtokenerror
+			}
+
+			kn := fs.Output.Bytes()
+			if len(kn) <= 0 {
+				// "" case. hrm.
+				currentKey = ffjtprecreateAPIRespnosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			} else {
+				switch kn[0] {
+
+				case 'a':
+
+					if bytes.Equal(ffjKeyprecreateAPIRespMethod, kn) {
+						currentKey = ffjtprecreateAPIRespMethod
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 's':
+
+					if bytes.Equal(ffjKeyprecreateAPIRespSign, kn) {
+						currentKey = ffjtprecreateAPIRespSign
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				}
+
+				if fflib.EqualFoldRight(ffjKeyprecreateAPIRespSign, kn) {
+					currentKey = ffjtprecreateAPIRespSign
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeyprecreateAPIRespMethod, kn) {
+					currentKey = ffjtprecreateAPIRespMethod
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				currentKey = ffjtprecreateAPIRespnosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			}
+
+		case fflib.FFParse_want_colon:
+			if tok != fflib.FFTok_colon {
+				wantedTok = fflib.FFTok_colon
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_value
+			continue
+		case fflib.FFParse_want_value:
+
+			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
+				switch currentKey {
+
+				case ffjtprecreateAPIRespMethod:
+					goto handle_Method
+
+				case ffjtprecreateAPIRespSign:
+					goto handle_Sign
+
+				case ffjtprecreateAPIRespnosuchkey:
+					err = fs.SkipField(tok)
+					if err != nil {
+						return fs.WrapErr(err)
+					}
+					state = fflib.FFParse_after_value
+					goto mainparse
+				}
+			} else {
+				goto wantedvalue
+			}
+		}
+	}
+
+handle_Method:
+
+	/* handler: j.Method type=alipay.precreateAPIResponse kind=struct quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+			j.Method = nil
+
+		} else {
+
+			if j.Method == nil {
+				j.Method = new(precreateAPIResponse)
+			}
+
+			err = j.Method.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+			if err != nil {
+				return err
+			}
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Sign:
+
+	/* handler: j.Sign type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.Sign = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+wantedvalue:
+	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+wrongtokenerror:
+	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
+tokerror:
+	if fs.BigError != nil {
+		return fs.WrapErr(fs.BigError)
+	}
+	err = fs.Error.ToError()
+	if err != nil {
+		return fs.WrapErr(err)
+	}
+	panic("ffjson-generated: unreachable, please report bug.")
+done:
+
+	return nil
+}
+
+// MarshalJSON marshal bytes to json - template
+func (j *precreateAPIResponse) MarshalJSON() ([]byte, error) {
+	var buf fflib.Buffer
+	if j == nil {
+		buf.WriteString("null")
+		return buf.Bytes(), nil
+	}
+	err := j.MarshalJSONBuf(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// MarshalJSONBuf marshal buff to json - template
+func (j *precreateAPIResponse) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+	if j == nil {
+		buf.WriteString("null")
+		return nil
+	}
+	var err error
+	var obj []byte
+	_ = obj
+	_ = err
+	buf.WriteString(`{"code":`)
+	fflib.WriteJsonString(buf, string(j.Code))
+	buf.WriteString(`,"msg":`)
+	fflib.WriteJsonString(buf, string(j.Msg))
+	buf.WriteString(`,"sub_code":`)
+	fflib.WriteJsonString(buf, string(j.SubCode))
+	buf.WriteString(`,"sub_msg":`)
+	fflib.WriteJsonString(buf, string(j.SubMsg))
+	buf.WriteString(`,"out_trade_no":`)
+	fflib.WriteJsonString(buf, string(j.OutTradeNo))
+	buf.WriteString(`,"qr_code":`)
+	fflib.WriteJsonString(buf, string(j.QRCode))
+	buf.WriteByte('}')
+	return nil
+}
+
+const (
+	ffjtprecreateAPIResponsebase = iota
+	ffjtprecreateAPIResponsenosuchkey
+
+	ffjtprecreateAPIResponseCode
+
+	ffjtprecreateAPIResponseMsg
+
+	ffjtprecreateAPIResponseSubCode
+
+	ffjtprecreateAPIResponseSubMsg
+
+	ffjtprecreateAPIResponseOutTradeNo
+
+	ffjtprecreateAPIResponseQRCode
+)
+
+var ffjKeyprecreateAPIResponseCode = []byte("code")
+
+var ffjKeyprecreateAPIResponseMsg = []byte("msg")
+
+var ffjKeyprecreateAPIResponseSubCode = []byte("sub_code")
+
+var ffjKeyprecreateAPIResponseSubMsg = []byte("sub_msg")
+
+var ffjKeyprecreateAPIResponseOutTradeNo = []byte("out_trade_no")
+
+var ffjKeyprecreateAPIResponseQRCode = []byte("qr_code")
+
+// UnmarshalJSON umarshall json - template of ffjson
+func (j *precreateAPIResponse) UnmarshalJSON(input []byte) error {
+	fs := fflib.NewFFLexer(input)
+	return j.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
+}
+
+// UnmarshalJSONFFLexer fast json unmarshall - template ffjson
+func (j *precreateAPIResponse) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+	var err error
+	currentKey := ffjtprecreateAPIResponsebase
+	_ = currentKey
+	tok := fflib.FFTok_init
+	wantedTok := fflib.FFTok_init
+
+mainparse:
+	for {
+		tok = fs.Scan()
+		//	println(fmt.Sprintf("debug: tok: %v  state: %v", tok, state))
+		if tok == fflib.FFTok_error {
+			goto tokerror
+		}
+
+		switch state {
+
+		case fflib.FFParse_map_start:
+			if tok != fflib.FFTok_left_bracket {
+				wantedTok = fflib.FFTok_left_bracket
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_key
+			continue
+
+		case fflib.FFParse_after_value:
+			if tok == fflib.FFTok_comma {
+				state = fflib.FFParse_want_key
+			} else if tok == fflib.FFTok_right_bracket {
+				goto done
+			} else {
+				wantedTok = fflib.FFTok_comma
+				goto wrongtokenerror
+			}
+
+		case fflib.FFParse_want_key:
+			// json {} ended. goto exit. woo.
+			if tok == fflib.FFTok_right_bracket {
+				goto done
+			}
+			if tok != fflib.FFTok_string {
+				wantedTok = fflib.FFTok_string
+				goto wrongtokenerror
+			}
+
+			kn := fs.Output.Bytes()
+			if len(kn) <= 0 {
+				// "" case. hrm.
+				currentKey = ffjtprecreateAPIResponsenosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			} else {
+				switch kn[0] {
+
+				case 'c':
+
+					if bytes.Equal(ffjKeyprecreateAPIResponseCode, kn) {
+						currentKey = ffjtprecreateAPIResponseCode
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'm':
+
+					if bytes.Equal(ffjKeyprecreateAPIResponseMsg, kn) {
+						currentKey = ffjtprecreateAPIResponseMsg
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'o':
+
+					if bytes.Equal(ffjKeyprecreateAPIResponseOutTradeNo, kn) {
+						currentKey = ffjtprecreateAPIResponseOutTradeNo
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'q':
+
+					if bytes.Equal(ffjKeyprecreateAPIResponseQRCode, kn) {
+						currentKey = ffjtprecreateAPIResponseQRCode
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 's':
+
+					if bytes.Equal(ffjKeyprecreateAPIResponseSubCode, kn) {
+						currentKey = ffjtprecreateAPIResponseSubCode
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeyprecreateAPIResponseSubMsg, kn) {
+						currentKey = ffjtprecreateAPIResponseSubMsg
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				}
+
+				if fflib.AsciiEqualFold(ffjKeyprecreateAPIResponseQRCode, kn) {
+					currentKey = ffjtprecreateAPIResponseQRCode
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.AsciiEqualFold(ffjKeyprecreateAPIResponseOutTradeNo, kn) {
+					currentKey = ffjtprecreateAPIResponseOutTradeNo
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeyprecreateAPIResponseSubMsg, kn) {
+					currentKey = ffjtprecreateAPIResponseSubMsg
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeyprecreateAPIResponseSubCode, kn) {
+					currentKey = ffjtprecreateAPIResponseSubCode
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeyprecreateAPIResponseMsg, kn) {
+					currentKey = ffjtprecreateAPIResponseMsg
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeyprecreateAPIResponseCode, kn) {
+					currentKey = ffjtprecreateAPIResponseCode
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				currentKey = ffjtprecreateAPIResponsenosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			}
+
+		case fflib.FFParse_want_colon:
+			if tok != fflib.FFTok_colon {
+				wantedTok = fflib.FFTok_colon
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_value
+			continue
+		case fflib.FFParse_want_value:
+
+			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
+				switch currentKey {
+
+				case ffjtprecreateAPIResponseCode:
+					goto handle_Code
+
+				case ffjtprecreateAPIResponseMsg:
+					goto handle_Msg
+
+				case ffjtprecreateAPIResponseSubCode:
+					goto handle_SubCode
+
+				case ffjtprecreateAPIResponseSubMsg:
+					goto handle_SubMsg
+
+				case ffjtprecreateAPIResponseOutTradeNo:
+					goto handle_OutTradeNo
+
+				case ffjtprecreateAPIResponseQRCode:
+					goto handle_QRCode
+
+				case ffjtprecreateAPIResponsenosuchkey:
+					err = fs.SkipField(tok)
+					if err != nil {
+						return fs.WrapErr(err)
+					}
+					state = fflib.FFParse_after_value
+					goto mainparse
+				}
+			} else {
+				goto wantedvalue
+			}
+		}
+	}
+
+handle_Code:
+
+	/* handler: j.Code type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.Code = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Msg:
+
+	/* handler: j.Msg type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.Msg = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_SubCode:
+
+	/* handler: j.SubCode type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.SubCode = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_SubMsg:
+
+	/* handler: j.SubMsg type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.SubMsg = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_OutTradeNo:
+
+	/* handler: j.OutTradeNo type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.OutTradeNo = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_QRCode:
+
+	/* handler: j.QRCode type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.QRCode = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+wantedvalue:
+	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+wrongtokenerror:
+	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
+tokerror:
+	if fs.BigError != nil {
+		return fs.WrapErr(fs.BigError)
+	}
+	err = fs.Error.ToError()
+	if err != nil {
+		return fs.WrapErr(err)
+	}
+	panic("ffjson-generated: unreachable, please report bug.")
+done:
+
+	return nil
+}
+
+// MarshalJSON marshal bytes to json - template
+func (j *tradeCloseAPIResp) MarshalJSON() ([]byte, error) {
+	var buf fflib.Buffer
+	if j == nil {
+		buf.WriteString("null")
+		return buf.Bytes(), nil
+	}
+	err := j.MarshalJSONBuf(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// MarshalJSONBuf marshal buff to json - template
+func (j *tradeCloseAPIResp) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+	if j == nil {
+		buf.WriteString("null")
+		return nil
+	}
+	var err error
+	var obj []byte
+	_ = obj
+	_ = err
+	if j.Method != nil {
+		buf.WriteString(`{"alipay_trade_close_response":`)
+
+		{
+
+			err = j.Method.MarshalJSONBuf(buf)
+			if err != nil {
+				return err
+			}
+
+		}
+	} else {
+		buf.WriteString(`{"alipay_trade_close_response":null`)
+	}
+	buf.WriteString(`,"sign":`)
+	fflib.WriteJsonString(buf, string(j.Sign))
+	buf.WriteByte('}')
+	return nil
+}
+
+const (
+	ffjttradeCloseAPIRespbase = iota
+	ffjttradeCloseAPIRespnosuchkey
+
+	ffjttradeCloseAPIRespMethod
+
+	ffjttradeCloseAPIRespSign
+)
+
+var ffjKeytradeCloseAPIRespMethod = []byte("alipay_trade_close_response")
+
+var ffjKeytradeCloseAPIRespSign = []byte("sign")
+
+// UnmarshalJSON umarshall json - template of ffjson
+func (j *tradeCloseAPIResp) UnmarshalJSON(input []byte) error {
+	fs := fflib.NewFFLexer(input)
+	return j.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
+}
+
+// UnmarshalJSONFFLexer fast json unmarshall - template ffjson
+func (j *tradeCloseAPIResp) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+	var err error
+	currentKey := ffjttradeCloseAPIRespbase
+	_ = currentKey
+	tok := fflib.FFTok_init
+	wantedTok := fflib.FFTok_init
+
+mainparse:
+	for {
+		tok = fs.Scan()
+		//	println(fmt.Sprintf("debug: tok: %v  state: %v", tok, state))
+		if tok == fflib.FFTok_error {
+			goto tokerror
+		}
+
+		switch state {
+
+		case fflib.FFParse_map_start:
+			if tok != fflib.FFTok_left_bracket {
+				wantedTok = fflib.FFTok_left_bracket
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_key
+			continue
+
+		case fflib.FFParse_after_value:
+			if tok == fflib.FFTok_comma {
+				state = fflib.FFParse_want_key
+			} else if tok == fflib.FFTok_right_bracket {
+				goto done
+			} else {
+				wantedTok = fflib.FFTok_comma
+				goto wrongtokenerror
+			}
+
+		case fflib.FFParse_want_key:
+			// json {} ended. goto exit. woo.
+			if tok == fflib.FFTok_right_bracket {
+				goto done
+			}
+			if tok != fflib.FFTok_string {
+				wantedTok = fflib.FFTok_string
+				goto wrongtokenerror
+			}
+
+			kn := fs.Output.Bytes()
+			if len(kn) <= 0 {
+				// "" case. hrm.
+				currentKey = ffjttradeCloseAPIRespnosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			} else {
+				switch kn[0] {
+
+				case 'a':
+
+					if bytes.Equal(ffjKeytradeCloseAPIRespMethod, kn) {
+						currentKey = ffjttradeCloseAPIRespMethod
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 's':
+
+					if bytes.Equal(ffjKeytradeCloseAPIRespSign, kn) {
+						currentKey = ffjttradeCloseAPIRespSign
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				}
+
+				if fflib.EqualFoldRight(ffjKeytradeCloseAPIRespSign, kn) {
+					currentKey = ffjttradeCloseAPIRespSign
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeytradeCloseAPIRespMethod, kn) {
+					currentKey = ffjttradeCloseAPIRespMethod
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				currentKey = ffjttradeCloseAPIRespnosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			}
+
+		case fflib.FFParse_want_colon:
+			if tok != fflib.FFTok_colon {
+				wantedTok = fflib.FFTok_colon
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_value
+			continue
+		case fflib.FFParse_want_value:
+
+			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
+				switch currentKey {
+
+				case ffjttradeCloseAPIRespMethod:
+					goto handle_Method
+
+				case ffjttradeCloseAPIRespSign:
+					goto handle_Sign
+
+				case ffjttradeCloseAPIRespnosuchkey:
+					err = fs.SkipField(tok)
+					if err != nil {
+						return fs.WrapErr(err)
+					}
+					state = fflib.FFParse_after_value
+					goto mainparse
+				}
+			} else {
+				goto wantedvalue
+			}
+		}
+	}
+
+handle_Method:
+
+	/* handler: j.Method type=alipay.tradeCloseAPIResponse kind=struct quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+			j.Method = nil
+
+		} else {
+
+			if j.Method == nil {
+				j.Method = new(tradeCloseAPIResponse)
+			}
+
+			err = j.Method.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+			if err != nil {
+				return err
+			}
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Sign:
+
+	/* handler: j.Sign type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.Sign = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+wantedvalue:
+	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+wrongtokenerror:
+	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
+tokerror:
+	if fs.BigError != nil {
+		return fs.WrapErr(fs.BigError)
+	}
+	err = fs.Error.ToError()
+	if err != nil {
+		return fs.WrapErr(err)
+	}
+	panic("ffjson-generated: unreachable, please report bug.")
+done:
+
+	return nil
+}
+
+// MarshalJSON marshal bytes to json - template
+func (j *tradeCloseAPIResponse) MarshalJSON() ([]byte, error) {
+	var buf fflib.Buffer
+	if j == nil {
+		buf.WriteString("null")
+		return buf.Bytes(), nil
+	}
+	err := j.MarshalJSONBuf(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// MarshalJSONBuf marshal buff to json - template
+func (j *tradeCloseAPIResponse) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+	if j == nil {
+		buf.WriteString("null")
+		return nil
+	}
+	var err error
+	var obj []byte
+	_ = obj
+	_ = err
+	buf.WriteString(`{"code":`)
+	fflib.WriteJsonString(buf, string(j.Code))
+	buf.WriteString(`,"msg":`)
+	fflib.WriteJsonString(buf, string(j.Msg))
+	buf.WriteString(`,"sub_code":`)
+	fflib.WriteJsonString(buf, string(j.SubCode))
+	buf.WriteString(`,"sub_msg":`)
+	fflib.WriteJsonString(buf, string(j.SubMsg))
+	buf.WriteString(`,"trade_no":`)
+	fflib.WriteJsonString(buf, string(j.TradeNo))
+	buf.WriteString(`,"out_trade_no":`)
+	fflib.WriteJsonString(buf, string(j.OutTradeNo))
+	buf.WriteByte('}')
+	return nil
+}
+
+const (
+	ffjttradeCloseAPIResponsebase = iota
+	ffjttradeCloseAPIResponsenosuchkey
+
+	ffjttradeCloseAPIResponseCode
+
+	ffjttradeCloseAPIResponseMsg
+
+	ffjttradeCloseAPIResponseSubCode
+
+	ffjttradeCloseAPIResponseSubMsg
+
+	ffjttradeCloseAPIResponseTradeNo
+
+	ffjttradeCloseAPIResponseOutTradeNo
+)
+
+var ffjKeytradeCloseAPIResponseCode = []byte("code")
+
+var ffjKeytradeCloseAPIResponseMsg = []byte("msg")
+
+var ffjKeytradeCloseAPIResponseSubCode = []byte("sub_code")
+
+var ffjKeytradeCloseAPIResponseSubMsg = []byte("sub_msg")
+
+var ffjKeytradeCloseAPIResponseTradeNo = []byte("trade_no")
+
+var ffjKeytradeCloseAPIResponseOutTradeNo = []byte("out_trade_no")
+
+// UnmarshalJSON umarshall json - template of ffjson
+func (j *tradeCloseAPIResponse) UnmarshalJSON(input []byte) error {
+	fs := fflib.NewFFLexer(input)
+	return j.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
+}
+
+// UnmarshalJSONFFLexer fast json unmarshall - template ffjson
+func (j *tradeCloseAPIResponse) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+	var err error
+	currentKey := ffjttradeCloseAPIResponsebase
+	_ = currentKey
+	tok := fflib.FFTok_init
+	wantedTok := fflib.FFTok_init
+
+mainparse:
+	for {
+		tok = fs.Scan()
+		//	println(fmt.Sprintf("debug: tok: %v  state: %v", tok, state))
+		if tok == fflib.FFTok_error {
+			goto tokerror
+		}
+
+		switch state {
+
+		case fflib.FFParse_map_start:
+			if tok != fflib.FFTok_left_bracket {
+				wantedTok = fflib.FFTok_left_bracket
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_key
+			continue
+
+		case fflib.FFParse_after_value:
+			if tok == fflib.FFTok_comma {
+				state = fflib.FFParse_want_key
+			} else if tok == fflib.FFTok_right_bracket {
+				goto done
+			} else {
+				wantedTok = fflib.FFTok_comma
+				goto wrongtokenerror
+			}
+
+		case fflib.FFParse_want_key:
+			// json {} ended. goto exit. woo.
+			if tok == fflib.FFTok_right_bracket {
+				goto done
+			}
+			if tok != fflib.FFTok_string {
+				wantedTok = fflib.FFTok_string
+				goto wrongtokenerror
+			}
+
+			kn := fs.Output.Bytes()
+			if len(kn) <= 0 {
+				// "" case. hrm.
+				currentKey = ffjttradeCloseAPIResponsenosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			} else {
+				switch kn[0] {
+
+				case 'c':
+
+					if bytes.Equal(ffjKeytradeCloseAPIResponseCode, kn) {
+						currentKey = ffjttradeCloseAPIResponseCode
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'm':
+
+					if bytes.Equal(ffjKeytradeCloseAPIResponseMsg, kn) {
+						currentKey = ffjttradeCloseAPIResponseMsg
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'o':
+
+					if bytes.Equal(ffjKeytradeCloseAPIResponseOutTradeNo, kn) {
+						currentKey = ffjttradeCloseAPIResponseOutTradeNo
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 's':
+
+					if bytes.Equal(ffjKeytradeCloseAPIResponseSubCode, kn) {
+						currentKey = ffjttradeCloseAPIResponseSubCode
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeytradeCloseAPIResponseSubMsg, kn) {
+						currentKey = ffjttradeCloseAPIResponseSubMsg
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 't':
+
+					if bytes.Equal(ffjKeytradeCloseAPIResponseTradeNo, kn) {
+						currentKey = ffjttradeCloseAPIResponseTradeNo
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				}
+
+				if fflib.AsciiEqualFold(ffjKeytradeCloseAPIResponseOutTradeNo, kn) {
+					currentKey = ffjttradeCloseAPIResponseOutTradeNo
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.AsciiEqualFold(ffjKeytradeCloseAPIResponseTradeNo, kn) {
+					currentKey = ffjttradeCloseAPIResponseTradeNo
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeytradeCloseAPIResponseSubMsg, kn) {
+					currentKey = ffjttradeCloseAPIResponseSubMsg
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeytradeCloseAPIResponseSubCode, kn) {
+					currentKey = ffjttradeCloseAPIResponseSubCode
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeytradeCloseAPIResponseMsg, kn) {
+					currentKey = ffjttradeCloseAPIResponseMsg
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeytradeCloseAPIResponseCode, kn) {
+					currentKey = ffjttradeCloseAPIResponseCode
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				currentKey = ffjttradeCloseAPIResponsenosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			}
+
+		case fflib.FFParse_want_colon:
+			if tok != fflib.FFTok_colon {
+				wantedTok = fflib.FFTok_colon
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_value
+			continue
+		case fflib.FFParse_want_value:
+
+			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
+				switch currentKey {
+
+				case ffjttradeCloseAPIResponseCode:
+					goto handle_Code
+
+				case ffjttradeCloseAPIResponseMsg:
+					goto handle_Msg
+
+				case ffjttradeCloseAPIResponseSubCode:
+					goto handle_SubCode
+
+				case ffjttradeCloseAPIResponseSubMsg:
+					goto handle_SubMsg
+
+				case ffjttradeCloseAPIResponseTradeNo:
+					goto handle_TradeNo
+
+				case ffjttradeCloseAPIResponseOutTradeNo:
+					goto handle_OutTradeNo
+
+				case ffjttradeCloseAPIResponsenosuchkey:
+					err = fs.SkipField(tok)
+					if err != nil {
+						return fs.WrapErr(err)
+					}
+					state = fflib.FFParse_after_value
+					goto mainparse
+				}
+			} else {
+				goto wantedvalue
+			}
+		}
+	}
+
+handle_Code:
+
+	/* handler: j.Code type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.Code = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Msg:
+
+	/* handler: j.Msg type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.Msg = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_SubCode:
+
+	/* handler: j.SubCode type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.SubCode = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_SubMsg:
+
+	/* handler: j.SubMsg type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.SubMsg = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_TradeNo:
+
+	/* handler: j.TradeNo type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.TradeNo = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_OutTradeNo:
+
+	/* handler: j.OutTradeNo type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.OutTradeNo = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+wantedvalue:
+	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+wrongtokenerror:
+	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
+tokerror:
+	if fs.BigError != nil {
+		return fs.WrapErr(fs.BigError)
+	}
+	err = fs.Error.ToError()
+	if err != nil {
+		return fs.WrapErr(err)
+	}
+	panic("ffjson-generated: unreachable, please report bug.")
+done:
+
+	return nil
+}
+
+// MarshalJSON marshal bytes to json - template
+func (j *tradePayAPIResp) MarshalJSON() ([]byte, error) {
+	var buf fflib.Buffer
+	if j == nil {
+		buf.WriteString("null")
+		return buf.Bytes(), nil
+	}
+	err := j.MarshalJSONBuf(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// MarshalJSONBuf marshal buff to json - template
+func (j *tradePayAPIResp) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+	if j == nil {
+		buf.WriteString("null")
+		return nil
+	}
+	var err error
+	var obj []byte
+	_ = obj
+	_ = err
+	if j.Method != nil {
+		buf.WriteString(`{"alipay_trade_pay_response":`)
+
+		{
+
+			err = j.Method.MarshalJSONBuf(buf)
+			if err != nil {
+				return err
+			}
+
+		}
+	} else {
+		buf.WriteString(`{"alipay_trade_pay_response":null`)
+	}
+	buf.WriteString(`,"sign":`)
+	fflib.WriteJsonString(buf, string(j.Sign))
+	buf.WriteByte('}')
+	return nil
+}
+
+const (
+	ffjttradePayAPIRespbase = iota
+	ffjttradePayAPIRespnosuchkey
+
+	ffjttradePayAPIRespMethod
+
+	ffjttradePayAPIRespSign
+)
+
+var ffjKeytradePayAPIRespMethod = []byte("alipay_trade_pay_response")
+
+var ffjKeytradePayAPIRespSign = []byte("sign")
+
+// UnmarshalJSON umarshall json - template of ffjson
+func (j *tradePayAPIResp) UnmarshalJSON(input []byte) error {
+	fs := fflib.NewFFLexer(input)
+	return j.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
+}
+
+// UnmarshalJSONFFLexer fast json unmarshall - template ffjson
+func (j *tradePayAPIResp) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+	var err error
+	currentKey := ffjttradePayAPIRespbase
+	_ = currentKey
+	tok := fflib.FFTok_init
+	wantedTok := fflib.FFTok_init
+
+mainparse:
+	for {
+		tok = fs.Scan()
+		//	println(fmt.Sprintf("debug: tok: %v  state: %v", tok, state))
+		if tok == fflib.FFTok_error {
+			goto tokerror
+		}
+
+		switch state {
+
+		case fflib.FFParse_map_start:
+			if tok != fflib.FFTok_left_bracket {
+				wantedTok = fflib.FFTok_left_bracket
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_key
+			continue
+
+		case fflib.FFParse_after_value:
+			if tok == fflib.FFTok_comma {
+				state = fflib.FFParse_want_key
+			} else if tok == fflib.FFTok_right_bracket {
+				goto done
+			} else {
+				wantedTok = fflib.FFTok_comma
+				goto wrongtokenerror
+			}
+
+		case fflib.FFParse_want_key:
+			// json {} ended. goto exit. woo.
+			if tok == fflib.FFTok_right_bracket {
+				goto done
+			}
+			if tok != fflib.FFTok_string {
+				wantedTok = fflib.FFTok_string
+				goto wrongtokenerror
+			}
+
+			kn := fs.Output.Bytes()
+			if len(kn) <= 0 {
+				// "" case. hrm.
+				currentKey = ffjttradePayAPIRespnosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			} else {
+				switch kn[0] {
+
+				case 'a':
+
+					if bytes.Equal(ffjKeytradePayAPIRespMethod, kn) {
+						currentKey = ffjttradePayAPIRespMethod
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 's':
+
+					if bytes.Equal(ffjKeytradePayAPIRespSign, kn) {
+						currentKey = ffjttradePayAPIRespSign
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				}
+
+				if fflib.EqualFoldRight(ffjKeytradePayAPIRespSign, kn) {
+					currentKey = ffjttradePayAPIRespSign
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeytradePayAPIRespMethod, kn) {
+					currentKey = ffjttradePayAPIRespMethod
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				currentKey = ffjttradePayAPIRespnosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			}
+
+		case fflib.FFParse_want_colon:
+			if tok != fflib.FFTok_colon {
+				wantedTok = fflib.FFTok_colon
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_value
+			continue
+		case fflib.FFParse_want_value:
+
+			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
+				switch currentKey {
+
+				case ffjttradePayAPIRespMethod:
+					goto handle_Method
+
+				case ffjttradePayAPIRespSign:
+					goto handle_Sign
+
+				case ffjttradePayAPIRespnosuchkey:
+					err = fs.SkipField(tok)
+					if err != nil {
+						return fs.WrapErr(err)
+					}
+					state = fflib.FFParse_after_value
+					goto mainparse
+				}
+			} else {
+				goto wantedvalue
+			}
+		}
+	}
+
+handle_Method:
+
+	/* handler: j.Method type=alipay.tradePayAPIResponse kind=struct quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+			j.Method = nil
+
+		} else {
+
+			if j.Method == nil {
+				j.Method = new(tradePayAPIResponse)
+			}
+
+			err = j.Method.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+			if err != nil {
+				return err
+			}
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Sign:
+
+	/* handler: j.Sign type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.Sign = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+wantedvalue:
+	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+wrongtokenerror:
+	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
+tokerror:
+	if fs.BigError != nil {
+		return fs.WrapErr(fs.BigError)
+	}
+	err = fs.Error.ToError()
+	if err != nil {
+		return fs.WrapErr(err)
+	}
+	panic("ffjson-generated: unreachable, please report bug.")
+done:
+
+	return nil
+}
+
+// MarshalJSON marshal bytes to json - template
+func (j *tradePayAPIResponse) MarshalJSON() ([]byte, error) {
+	var buf fflib.Buffer
+	if j == nil {
+		buf.WriteString("null")
+		return buf.Bytes(), nil
+	}
+	err := j.MarshalJSONBuf(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// MarshalJSONBuf marshal buff to json - template
+func (j *tradePayAPIResponse) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+	if j == nil {
+		buf.WriteString("null")
+		return nil
+	}
+	var err error
+	var obj []byte
+	_ = obj
+	_ = err
+	buf.WriteString(`{"code":`)
+	fflib.WriteJsonString(buf, string(j.Code))
+	buf.WriteString(`,"msg":`)
+	fflib.WriteJsonString(buf, string(j.Msg))
+	buf.WriteString(`,"sub_code":`)
+	fflib.WriteJsonString(buf, string(j.SubCode))
+	buf.WriteString(`,"sub_msg":`)
+	fflib.WriteJsonString(buf, string(j.SubMsg))
+	buf.WriteString(`,"trade_no":`)
+	fflib.WriteJsonString(buf, string(j.TradeNo))
+	buf.WriteString(`,"out_trade_no":`)
+	fflib.WriteJsonString(buf, string(j.OutTradeNo))
+	buf.WriteString(`,"buyer_user_id":`)
+	fflib.WriteJsonString(buf, string(j.BuyerUserID))
+	buf.WriteString(`,"total_amount":`)
+	fflib.WriteJsonString(buf, string(j.TotalAmount))
+	buf.WriteString(`,"gmt_payment":`)
+	fflib.WriteJsonString(buf, string(j.GmtPayment))
+	buf.WriteByte('}')
+	return nil
+}
+
+const (
+	ffjttradePayAPIResponsebase = iota
+	ffjttradePayAPIResponsenosuchkey
+
+	ffjttradePayAPIResponseCode
+
+	ffjttradePayAPIResponseMsg
+
+	ffjttradePayAPIResponseSubCode
+
+	ffjttradePayAPIResponseSubMsg
+
+	ffjttradePayAPIResponseTradeNo
+
+	ffjttradePayAPIResponseOutTradeNo
+
+	ffjttradePayAPIResponseBuyerUserID
+
+	ffjttradePayAPIResponseTotalAmount
+
+	ffjttradePayAPIResponseGmtPayment
+)
+
+var ffjKeytradePayAPIResponseCode = []byte("code")
+
+var ffjKeytradePayAPIResponseMsg = []byte("msg")
+
+var ffjKeytradePayAPIResponseSubCode = []byte("sub_code")
+
+var ffjKeytradePayAPIResponseSubMsg = []byte("sub_msg")
+
+var ffjKeytradePayAPIResponseTradeNo = []byte("trade_no")
+
+var ffjKeytradePayAPIResponseOutTradeNo = []byte("out_trade_no")
+
+var ffjKeytradePayAPIResponseBuyerUserID = []byte("buyer_user_id")
+
+var ffjKeytradePayAPIResponseTotalAmount = []byte("total_amount")
+
+var ffjKeytradePayAPIResponseGmtPayment = []byte("gmt_payment")
+
+// UnmarshalJSON umarshall json - template of ffjson
+func (j *tradePayAPIResponse) UnmarshalJSON(input []byte) error {
+	fs := fflib.NewFFLexer(input)
+	return j.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
+}
+
+// UnmarshalJSONFFLexer fast json unmarshall - template ffjson
+func (j *tradePayAPIResponse) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+	var err error
+	currentKey := ffjttradePayAPIResponsebase
+	_ = currentKey
+	tok := fflib.FFTok_init
+	wantedTok := fflib.FFTok_init
+
+mainparse:
+	for {
+		tok = fs.Scan()
+		//	println(fmt.Sprintf("debug: tok: %v  state: %v", tok, state))
+		if tok == fflib.FFTok_error {
+			goto tokerror
+		}
+
+		switch state {
+
+		case fflib.FFParse_map_start:
+			if tok != fflib.FFTok_left_bracket {
+				wantedTok = fflib.FFTok_left_bracket
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_key
+			continue
+
+		case fflib.FFParse_after_value:
+			if tok == fflib.FFTok_comma {
+				state = fflib.FFParse_want_key
+			} else if tok == fflib.FFTok_right_bracket {
+				goto done
+			} else {
+				wantedTok = fflib.FFTok_comma
+				goto wrongtokenerror
+			}
+
+		case fflib.FFParse_want_key:
+			// json {} ended. goto exit. woo.
+			if tok == fflib.FFTok_right_bracket {
+				goto done
+			}
+			if tok != fflib.FFTok_string {
+				wantedTok = fflib.FFTok_string
+				goto wrongtokenerror
+			}
+
+			kn := fs.Output.Bytes()
+			if len(kn) <= 0 {
+				// "" case. hrm.
+				currentKey = ffjttradePayAPIResponsenosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			} else {
+				switch kn[0] {
+
+				case 'b':
+
+					if bytes.Equal(ffjKeytradePayAPIResponseBuyerUserID, kn) {
+						currentKey = ffjttradePayAPIResponseBuyerUserID
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'c':
+
+					if bytes.Equal(ffjKeytradePayAPIResponseCode, kn) {
+						currentKey = ffjttradePayAPIResponseCode
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'g':
+
+					if bytes.Equal(ffjKeytradePayAPIResponseGmtPayment, kn) {
+						currentKey = ffjttradePayAPIResponseGmtPayment
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'm':
+
+					if bytes.Equal(ffjKeytradePayAPIResponseMsg, kn) {
+						currentKey = ffjttradePayAPIResponseMsg
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'o':
+
+					if bytes.Equal(ffjKeytradePayAPIResponseOutTradeNo, kn) {
+						currentKey = ffjttradePayAPIResponseOutTradeNo
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 's':
+
+					if bytes.Equal(ffjKeytradePayAPIResponseSubCode, kn) {
+						currentKey = ffjttradePayAPIResponseSubCode
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeytradePayAPIResponseSubMsg, kn) {
+						currentKey = ffjttradePayAPIResponseSubMsg
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 't':
+
+					if bytes.Equal(ffjKeytradePayAPIResponseTradeNo, kn) {
+						currentKey = ffjttradePayAPIResponseTradeNo
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeytradePayAPIResponseTotalAmount, kn) {
+						currentKey = ffjttradePayAPIResponseTotalAmount
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				}
+
+				if fflib.AsciiEqualFold(ffjKeytradePayAPIResponseGmtPayment, kn) {
+					currentKey = ffjttradePayAPIResponseGmtPayment
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.AsciiEqualFold(ffjKeytradePayAPIResponseTotalAmount, kn) {
+					currentKey = ffjttradePayAPIResponseTotalAmount
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeytradePayAPIResponseBuyerUserID, kn) {
+					currentKey = ffjttradePayAPIResponseBuyerUserID
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.AsciiEqualFold(ffjKeytradePayAPIResponseOutTradeNo, kn) {
+					currentKey = ffjttradePayAPIResponseOutTradeNo
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.AsciiEqualFold(ffjKeytradePayAPIResponseTradeNo, kn) {
+					currentKey = ffjttradePayAPIResponseTradeNo
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeytradePayAPIResponseSubMsg, kn) {
+					currentKey = ffjttradePayAPIResponseSubMsg
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeytradePayAPIResponseSubCode, kn) {
+					currentKey = ffjttradePayAPIResponseSubCode
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeytradePayAPIResponseMsg, kn) {
+					currentKey = ffjttradePayAPIResponseMsg
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeytradePayAPIResponseCode, kn) {
+					currentKey = ffjttradePayAPIResponseCode
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				currentKey = ffjttradePayAPIResponsenosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			}
+
+		case fflib.FFParse_want_colon:
+			if tok != fflib.FFTok_colon {
+				wantedTok = fflib.FFTok_colon
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_value
+			continue
+		case fflib.FFParse_want_value:
+
+			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
+				switch currentKey {
+
+				case ffjttradePayAPIResponseCode:
+					goto handle_Code
+
+				case ffjttradePayAPIResponseMsg:
+					goto handle_Msg
+
+				case ffjttradePayAPIResponseSubCode:
+					goto handle_SubCode
+
+				case ffjttradePayAPIResponseSubMsg:
+					goto handle_SubMsg
+
+				case ffjttradePayAPIResponseTradeNo:
+					goto handle_TradeNo
+
+				case ffjttradePayAPIResponseOutTradeNo:
+					goto handle_OutTradeNo
+
+				case ffjttradePayAPIResponseBuyerUserID:
+					goto handle_BuyerUserID
+
+				case ffjttradePayAPIResponseTotalAmount:
+					goto handle_TotalAmount
+
+				case ffjttradePayAPIResponseGmtPayment:
+					goto handle_GmtPayment
+
+				case ffjttradePayAPIResponsenosuchkey:
+					err = fs.SkipField(tok)
+					if err != nil {
+						return fs.WrapErr(err)
+					}
+					state = fflib.FFParse_after_value
+					goto mainparse
+				}
+			} else {
+				goto wantedvalue
+			}
+		}
+	}
+
+handle_Code:
+
+	/* handler: j.Code type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.Code = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Msg:
+
+	/* handler: j.Msg type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.Msg = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_SubCode:
+
+	/* handler: j.SubCode type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.SubCode = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_SubMsg:
+
+	/* handler: j.SubMsg type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.SubMsg = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_TradeNo:
+
+	/* handler: j.TradeNo type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.TradeNo = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_OutTradeNo:
+
+	/* handler: j.OutTradeNo type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.OutTradeNo = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_BuyerUserID:
+
+	/* handler: j.BuyerUserID type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.BuyerUserID = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_TotalAmount:
+
+	/* handler: j.TotalAmount type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.TotalAmount = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_GmtPayment:
+
+	/* handler: j.GmtPayment type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.GmtPayment = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+wantedvalue:
+	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+wrongtokenerror:
+	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
+tokerror:
+	if fs.BigError != nil {
+		return fs.WrapErr(fs.BigError)
+	}
+	err = fs.Error.ToError()
+	if err != nil {
+		return fs.WrapErr(err)
+	}
+	panic("ffjson-generated: unreachable, please report bug.")
+done:
+
+	return nil
+}
+
+// MarshalJSON marshal bytes to json - template
+func (j *tradeQueryAPIResp) MarshalJSON() ([]byte, error) {
+	var buf fflib.Buffer
+	if j == nil {
+		buf.WriteString("null")
+		return buf.Bytes(), nil
+	}
+	err := j.MarshalJSONBuf(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// MarshalJSONBuf marshal buff to json - template
+func (j *tradeQueryAPIResp) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+	if j == nil {
+		buf.WriteString("null")
+		return nil
+	}
+	var err error
+	var obj []byte
+	_ = obj
+	_ = err
+	if j.Method != nil {
+		buf.WriteString(`{"alipay_trade_query_response":`)
+
+		{
+
+			err = j.Method.MarshalJSONBuf(buf)
+			if err != nil {
+				return err
+			}
+
+		}
+	} else {
+		buf.WriteString(`{"alipay_trade_query_response":null`)
+	}
+	buf.WriteString(`,"sign":`)
+	fflib.WriteJsonString(buf, string(j.Sign))
+	buf.WriteByte('}')
+	return nil
+}
+
+const (
+	ffjttradeQueryAPIRespbase = iota
+	ffjttradeQueryAPIRespnosuchkey
+
+	ffjttradeQueryAPIRespMethod
+
+	ffjttradeQueryAPIRespSign
+)
+
+var ffjKeytradeQueryAPIRespMethod = []byte("alipay_trade_query_response")
+
+var ffjKeytradeQueryAPIRespSign = []byte("sign")
+
+// UnmarshalJSON umarshall json - template of ffjson
+func (j *tradeQueryAPIResp) UnmarshalJSON(input []byte) error {
+	fs := fflib.NewFFLexer(input)
+	return j.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
+}
+
+// UnmarshalJSONFFLexer fast json unmarshall - template ffjson
+func (j *tradeQueryAPIResp) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+	var err error
+	currentKey := ffjttradeQueryAPIRespbase
+	_ = currentKey
+	tok := fflib.FFTok_init
+	wantedTok := fflib.FFTok_init
+
+mainparse:
+	for {
+		tok = fs.Scan()
+		//	println(fmt.Sprintf("debug: tok: %v  state: %v", tok, state))
+		if tok == fflib.FFTok_error {
+			goto tokerror
+		}
+
+		switch state {
+
+		case fflib.FFParse_map_start:
+			if tok != fflib.FFTok_left_bracket {
+				wantedTok = fflib.FFTok_left_bracket
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_key
+			continue
+
+		case fflib.FFParse_after_value:
+			if tok == fflib.FFTok_comma {
+				state = fflib.FFParse_want_key
+			} else if tok == fflib.FFTok_right_bracket {
+				goto done
+			} else {
+				wantedTok = fflib.FFTok_comma
+				goto wrongtokenerror
+			}
+
+		case fflib.FFParse_want_key:
+			// json {} ended. goto exit. woo.
+			if tok == fflib.FFTok_right_bracket {
+				goto done
+			}
+			if tok != fflib.FFTok_string {
+				wantedTok = fflib.FFTok_string
+				goto wrongtokenerror
+			}
+
+			kn := fs.Output.Bytes()
+			if len(kn) <= 0 {
+				// "" case. hrm.
+				currentKey = ffjttradeQueryAPIRespnosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			} else {
+				switch kn[0] {
+
+				case 'a':
+
+					if bytes.Equal(ffjKeytradeQueryAPIRespMethod, kn) {
+						currentKey = ffjttradeQueryAPIRespMethod
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 's':
+
+					if bytes.Equal(ffjKeytradeQueryAPIRespSign, kn) {
+						currentKey = ffjttradeQueryAPIRespSign
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				}
+
+				if fflib.EqualFoldRight(ffjKeytradeQueryAPIRespSign, kn) {
+					currentKey = ffjttradeQueryAPIRespSign
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeytradeQueryAPIRespMethod, kn) {
+					currentKey = ffjttradeQueryAPIRespMethod
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				currentKey = ffjttradeQueryAPIRespnosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			}
+
+		case fflib.FFParse_want_colon:
+			if tok != fflib.FFTok_colon {
+				wantedTok = fflib.FFTok_colon
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_value
+			continue
+		case fflib.FFParse_want_value:
+
+			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
+				switch currentKey {
+
+				case ffjttradeQueryAPIRespMethod:
+					goto handle_Method
+
+				case ffjttradeQueryAPIRespSign:
+					goto handle_Sign
+
+				case ffjttradeQueryAPIRespnosuchkey:
+					err = fs.SkipField(tok)
+					if err != nil {
+						return fs.WrapErr(err)
+					}
+					state = fflib.FFParse_after_value
+					goto mainparse
+				}
+			} else {
+				goto wantedvalue
+			}
+		}
+	}
+
+handle_Method:
+
+	/* handler: j.Method type=alipay.tradeQueryAPIResponse kind=struct quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+			j.Method = nil
+
+		} else {
+
+			if j.Method == nil {
+				j.Method = new(tradeQueryAPIResponse)
+			}
+
+			err = j.Method.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+			if err != nil {
+				return err
+			}
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Sign:
+
+	/* handler: j.Sign type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.Sign = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+wantedvalue:
+	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+wrongtokenerror:
+	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
+tokerror:
+	if fs.BigError != nil {
+		return fs.WrapErr(fs.BigError)
+	}
+	err = fs.Error.ToError()
+	if err != nil {
+		return fs.WrapErr(err)
+	}
+	panic("ffjson-generated: unreachable, please report bug.")
+done:
+
+	return nil
+}
+
+// MarshalJSON marshal bytes to json - template
+func (j *tradeQueryAPIResponse) MarshalJSON() ([]byte, error) {
+	var buf fflib.Buffer
+	if j == nil {
+		buf.WriteString("null")
+		return buf.Bytes(), nil
+	}
+	err := j.MarshalJSONBuf(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// MarshalJSONBuf marshal buff to json - template
+func (j *tradeQueryAPIResponse) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+	if j == nil {
+		buf.WriteString("null")
+		return nil
+	}
+	var err error
+	var obj []byte
+	_ = obj
+	_ = err
+	buf.WriteString(`{"code":`)
+	fflib.WriteJsonString(buf, string(j.Code))
+	buf.WriteString(`,"msg":`)
+	fflib.WriteJsonString(buf, string(j.Msg))
+	buf.WriteString(`,"sub_code":`)
+	fflib.WriteJsonString(buf, string(j.SubCode))
+	buf.WriteString(`,"sub_msg":`)
+	fflib.WriteJsonString(buf, string(j.SubMsg))
+	buf.WriteString(`,"trade_no":`)
+	fflib.WriteJsonString(buf, string(j.TradeNo))
+	buf.WriteString(`,"out_trade_no":`)
+	fflib.WriteJsonString(buf, string(j.OutTradeNo))
+	buf.WriteString(`,"buyer_logon_id":`)
+	fflib.WriteJsonString(buf, string(j.BuyerLogonID))
+	buf.WriteString(`,"trade_status":`)
+	fflib.WriteJsonString(buf, string(j.TradeStatus))
+	buf.WriteString(`,"total_amount":`)
+	fflib.WriteJsonString(buf, string(j.TotalAmount))
+	buf.WriteString(`,"trans_currency":`)
+	fflib.WriteJsonString(buf, string(j.TransCurrency))
+	buf.WriteString(`,"settle_currency":`)
+	fflib.WriteJsonString(buf, string(j.SettleCurrency))
+	buf.WriteString(`,"settle_amount":`)
+	fflib.WriteJsonString(buf, string(j.SettleAmount))
+	buf.WriteString(`,"settle_trans_rate":`)
+	fflib.WriteJsonString(buf, string(j.SettleTransRate))
+	buf.WriteString(`,"send_pay_date":`)
+	fflib.WriteJsonString(buf, string(j.SendPayDate))
+	buf.WriteByte('}')
+	return nil
+}
+
+const (
+	ffjttradeQueryAPIResponsebase = iota
+	ffjttradeQueryAPIResponsenosuchkey
+
+	ffjttradeQueryAPIResponseCode
+
+	ffjttradeQueryAPIResponseMsg
+
+	ffjttradeQueryAPIResponseSubCode
+
+	ffjttradeQueryAPIResponseSubMsg
+
+	ffjttradeQueryAPIResponseTradeNo
+
+	ffjttradeQueryAPIResponseOutTradeNo
+
+	ffjttradeQueryAPIResponseBuyerLogonID
+
+	ffjttradeQueryAPIResponseTradeStatus
+
+	ffjttradeQueryAPIResponseTotalAmount
+
+	ffjttradeQueryAPIResponseTransCurrency
+
+	ffjttradeQueryAPIResponseSettleCurrency
+
+	ffjttradeQueryAPIResponseSettleAmount
+
+	ffjttradeQueryAPIResponseSettleTransRate
+
+	ffjttradeQueryAPIResponseSendPayDate
+)
+
+var ffjKeytradeQueryAPIResponseCode = []byte("code")
+
+var ffjKeytradeQueryAPIResponseMsg = []byte("msg")
+
+var ffjKeytradeQueryAPIResponseSubCode = []byte("sub_code")
+
+var ffjKeytradeQueryAPIResponseSubMsg = []byte("sub_msg")
+
+var ffjKeytradeQueryAPIResponseTradeNo = []byte("trade_no")
+
+var ffjKeytradeQueryAPIResponseOutTradeNo = []byte("out_trade_no")
+
+var ffjKeytradeQueryAPIResponseBuyerLogonID = []byte("buyer_logon_id")
+
+var ffjKeytradeQueryAPIResponseTradeStatus = []byte("trade_status")
+
+var ffjKeytradeQueryAPIResponseTotalAmount = []byte("total_amount")
+
+var ffjKeytradeQueryAPIResponseTransCurrency = []byte("trans_currency")
+
+var ffjKeytradeQueryAPIResponseSettleCurrency = []byte("settle_currency")
+
+var ffjKeytradeQueryAPIResponseSettleAmount = []byte("settle_amount")
+
+var ffjKeytradeQueryAPIResponseSettleTransRate = []byte("settle_trans_rate")
+
+var ffjKeytradeQueryAPIResponseSendPayDate = []byte("send_pay_date")
+
+// UnmarshalJSON umarshall json - template of ffjson
+func (j *tradeQueryAPIResponse) UnmarshalJSON(input []byte) error {
+	fs := fflib.NewFFLexer(input)
+	return j.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
+}
+
+// UnmarshalJSONFFLexer fast json unmarshall - template ffjson
+func (j *tradeQueryAPIResponse) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+	var err error
+	currentKey := ffjttradeQueryAPIResponsebase
+	_ = currentKey
+	tok := fflib.FFTok_init
+	wantedTok := fflib.FFTok_init
+
+mainparse:
+	for {
+		tok = fs.Scan()
+		//	println(fmt.Sprintf("debug: tok: %v  state: %v", tok, state))
+		if tok == fflib.FFTok_error {
+			goto tokerror
+		}
+
+		switch state {
+
+		case fflib.FFParse_map_start:
+			if tok != fflib.FFTok_left_bracket {
+				wantedTok = fflib.FFTok_left_bracket
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_key
+			continue
+
+		case fflib.FFParse_after_value:
+			if tok == fflib.FFTok_comma {
+				state = fflib.FFParse_want_key
+			} else if tok == fflib.FFTok_right_bracket {
+				goto done
+			} else {
+				wantedTok = fflib.FFTok_comma
+				goto wrongtokenerror
+			}
+
+		case fflib.FFParse_want_key:
+			// json {} ended. goto exit. woo.
+			if tok == fflib.FFTok_right_bracket {
+				goto done
+			}
+			if tok != fflib.FFTok_string {
+				wantedTok = fflib.FFTok_string
+				goto wrongtokenerror
+			}
+
+			kn := fs.Output.Bytes()
+			if len(kn) <= 0 {
+				// "" case. hrm.
+				currentKey = ffjttradeQueryAPIResponsenosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			} else {
+				switch kn[0] {
+
+				case 'b':
+
+					if bytes.Equal(ffjKeytradeQueryAPIResponseBuyerLogonID, kn) {
+						currentKey = ffjttradeQueryAPIResponseBuyerLogonID
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'c':
+
+					if bytes.Equal(ffjKeytradeQueryAPIResponseCode, kn) {
+						currentKey = ffjttradeQueryAPIResponseCode
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'm':
+
+					if bytes.Equal(ffjKeytradeQueryAPIResponseMsg, kn) {
+						currentKey = ffjttradeQueryAPIResponseMsg
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'o':
+
+					if bytes.Equal(ffjKeytradeQueryAPIResponseOutTradeNo, kn) {
+						currentKey = ffjttradeQueryAPIResponseOutTradeNo
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 's':
+
+					if bytes.Equal(ffjKeytradeQueryAPIResponseSubCode, kn) {
+						currentKey = ffjttradeQueryAPIResponseSubCode
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeytradeQueryAPIResponseSubMsg, kn) {
+						currentKey = ffjttradeQueryAPIResponseSubMsg
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeytradeQueryAPIResponseSettleCurrency, kn) {
+						currentKey = ffjttradeQueryAPIResponseSettleCurrency
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeytradeQueryAPIResponseSettleAmount, kn) {
+						currentKey = ffjttradeQueryAPIResponseSettleAmount
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeytradeQueryAPIResponseSettleTransRate, kn) {
+						currentKey = ffjttradeQueryAPIResponseSettleTransRate
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeytradeQueryAPIResponseSendPayDate, kn) {
+						currentKey = ffjttradeQueryAPIResponseSendPayDate
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 't':
+
+					if bytes.Equal(ffjKeytradeQueryAPIResponseTradeNo, kn) {
+						currentKey = ffjttradeQueryAPIResponseTradeNo
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeytradeQueryAPIResponseTradeStatus, kn) {
+						currentKey = ffjttradeQueryAPIResponseTradeStatus
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeytradeQueryAPIResponseTotalAmount, kn) {
+						currentKey = ffjttradeQueryAPIResponseTotalAmount
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeytradeQueryAPIResponseTransCurrency, kn) {
+						currentKey = ffjttradeQueryAPIResponseTransCurrency
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				}
+
+				if fflib.EqualFoldRight(ffjKeytradeQueryAPIResponseSendPayDate, kn) {
+					currentKey = ffjttradeQueryAPIResponseSendPayDate
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeytradeQueryAPIResponseSettleTransRate, kn) {
+					currentKey = ffjttradeQueryAPIResponseSettleTransRate
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeytradeQueryAPIResponseSettleAmount, kn) {
+					currentKey = ffjttradeQueryAPIResponseSettleAmount
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeytradeQueryAPIResponseSettleCurrency, kn) {
+					currentKey = ffjttradeQueryAPIResponseSettleCurrency
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeytradeQueryAPIResponseTransCurrency, kn) {
+					currentKey = ffjttradeQueryAPIResponseTransCurrency
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.AsciiEqualFold(ffjKeytradeQueryAPIResponseTotalAmount, kn) {
+					currentKey = ffjttradeQueryAPIResponseTotalAmount
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeytradeQueryAPIResponseTradeStatus, kn) {
+					currentKey = ffjttradeQueryAPIResponseTradeStatus
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.AsciiEqualFold(ffjKeytradeQueryAPIResponseBuyerLogonID, kn) {
+					currentKey = ffjttradeQueryAPIResponseBuyerLogonID
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.AsciiEqualFold(ffjKeytradeQueryAPIResponseOutTradeNo, kn) {
+					currentKey = ffjttradeQueryAPIResponseOutTradeNo
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.AsciiEqualFold(ffjKeytradeQueryAPIResponseTradeNo, kn) {
+					currentKey = ffjttradeQueryAPIResponseTradeNo
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeytradeQueryAPIResponseSubMsg, kn) {
+					currentKey = ffjttradeQueryAPIResponseSubMsg
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeytradeQueryAPIResponseSubCode, kn) {
+					currentKey = ffjttradeQueryAPIResponseSubCode
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeytradeQueryAPIResponseMsg, kn) {
+					currentKey = ffjttradeQueryAPIResponseMsg
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeytradeQueryAPIResponseCode, kn) {
+					currentKey = ffjttradeQueryAPIResponseCode
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				currentKey = ffjttradeQueryAPIResponsenosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			}
+
+		case fflib.FFParse_want_colon:
+			if tok != fflib.FFTok_colon {
+				wantedTok = fflib.FFTok_colon
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_value
+			continue
+		case fflib.FFParse_want_value:
+
+			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
+				switch currentKey {
+
+				case ffjttradeQueryAPIResponseCode:
+					goto handle_Code
+
+				case ffjttradeQueryAPIResponseMsg:
+					goto handle_Msg
+
+				case ffjttradeQueryAPIResponseSubCode:
+					goto handle_SubCode
+
+				case ffjttradeQueryAPIResponseSubMsg:
+					goto handle_SubMsg
+
+				case ffjttradeQueryAPIResponseTradeNo:
+					goto handle_TradeNo
+
+				case ffjttradeQueryAPIResponseOutTradeNo:
+					goto handle_OutTradeNo
+
+				case ffjttradeQueryAPIResponseBuyerLogonID:
+					goto handle_BuyerLogonID
+
+				case ffjttradeQueryAPIResponseTradeStatus:
+					goto handle_TradeStatus
+
+				case ffjttradeQueryAPIResponseTotalAmount:
+					goto handle_TotalAmount
+
+				case ffjttradeQueryAPIResponseTransCurrency:
+					goto handle_TransCurrency
+
+				case ffjttradeQueryAPIResponseSettleCurrency:
+					goto handle_SettleCurrency
+
+				case ffjttradeQueryAPIResponseSettleAmount:
+					goto handle_SettleAmount
+
+				case ffjttradeQueryAPIResponseSettleTransRate:
+					goto handle_SettleTransRate
+
+				case ffjttradeQueryAPIResponseSendPayDate:
+					goto handle_SendPayDate
+
+				case ffjttradeQueryAPIResponsenosuchkey:
+					err = fs.SkipField(tok)
+					if err != nil {
+						return fs.WrapErr(err)
+					}
+					state = fflib.FFParse_after_value
+					goto mainparse
+				}
+			} else {
+				goto wantedvalue
+			}
+		}
+	}
+
+handle_Code:
+
+	/* handler: j.Code type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.Code = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Msg:
+
+	/* handler: j.Msg type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.Msg = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_SubCode:
+
+	/* handler: j.SubCode type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.SubCode = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_SubMsg:
+
+	/* handler: j.SubMsg type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.SubMsg = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_TradeNo:
+
+	/* handler: j.TradeNo type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.TradeNo = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_OutTradeNo:
+
+	/* handler: j.OutTradeNo type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.OutTradeNo = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_BuyerLogonID:
+
+	/* handler: j.BuyerLogonID type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.BuyerLogonID = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_TradeStatus:
+
+	/* handler: j.TradeStatus type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.TradeStatus = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_TotalAmount:
+
+	/* handler: j.TotalAmount type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.TotalAmount = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_TransCurrency:
+
+	/* handler: j.TransCurrency type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.TransCurrency = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_SettleCurrency:
+
+	/* handler: j.SettleCurrency type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.SettleCurrency = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_SettleAmount:
+
+	/* handler: j.SettleAmount type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.SettleAmount = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_SettleTransRate:
+
+	/* handler: j.SettleTransRate type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.SettleTransRate = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_SendPayDate:
+
+	/* handler: j.SendPayDate type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.SendPayDate = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+wantedvalue:
+	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+wrongtokenerror:
+	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
+tokerror:
+	if fs.BigError != nil {
+		return fs.WrapErr(fs.BigError)
+	}
+	err = fs.Error.ToError()
+	if err != nil {
+		return fs.WrapErr(err)
+	}
+	panic("ffjson-generated: unreachable, please report bug.")
+done:
+
+	return nil
+}
+
+// MarshalJSON marshal bytes to json - template
+func (j *tradeRefundAPIResp) MarshalJSON() ([]byte, error) {
+	var buf fflib.Buffer
+	if j == nil {
+		buf.WriteString("null")
+		return buf.Bytes(), nil
+	}
+	err := j.MarshalJSONBuf(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// MarshalJSONBuf marshal buff to json - template
+func (j *tradeRefundAPIResp) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+	if j == nil {
+		buf.WriteString("null")
+		return nil
+	}
+	var err error
+	var obj []byte
+	_ = obj
+	_ = err
+	if j.Method != nil {
+		buf.WriteString(`{"alipay_trade_refund_response":`)
+
+		{
+
+			err = j.Method.MarshalJSONBuf(buf)
+			if err != nil {
+				return err
+			}
+
+		}
+	} else {
+		buf.WriteString(`{"alipay_trade_refund_response":null`)
+	}
+	buf.WriteString(`,"sign":`)
+	fflib.WriteJsonString(buf, string(j.Sign))
+	buf.WriteByte('}')
+	return nil
+}
+
+const (
+	ffjttradeRefundAPIRespbase = iota
+	ffjttradeRefundAPIRespnosuchkey
+
+	ffjttradeRefundAPIRespMethod
+
+	ffjttradeRefundAPIRespSign
+)
+
+var ffjKeytradeRefundAPIRespMethod = []byte("alipay_trade_refund_response")
+
+var ffjKeytradeRefundAPIRespSign = []byte("sign")
+
+// UnmarshalJSON umarshall json - template of ffjson
+func (j *tradeRefundAPIResp) UnmarshalJSON(input []byte) error {
+	fs := fflib.NewFFLexer(input)
+	return j.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
+}
+
+// UnmarshalJSONFFLexer fast json unmarshall - template ffjson
+func (j *tradeRefundAPIResp) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+	var err error
+	currentKey := ffjttradeRefundAPIRespbase
+	_ = currentKey
+	tok := fflib.FFTok_init
+	wantedTok := fflib.FFTok_init
+
+mainparse:
+	for {
+		tok = fs.Scan()
+		//	println(fmt.Sprintf("debug: tok: %v  state: %v", tok, state))
+		if tok == fflib.FFTok_error {
+			goto tokerror
+		}
+
+		switch state {
+
+		case fflib.FFParse_map_start:
+			if tok != fflib.FFTok_left_bracket {
+				wantedTok = fflib.FFTok_left_bracket
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_key
+			continue
+
+		case fflib.FFParse_after_value:
+			if tok == fflib.FFTok_comma {
+				state = fflib.FFParse_want_key
+			} else if tok == fflib.FFTok_right_bracket {
+				goto done
+			} else {
+				wantedTok = fflib.FFTok_comma
+				goto wrongtokenerror
+			}
+
+		case fflib.FFParse_want_key:
+			// json {} ended. goto exit. woo.
+			if tok == fflib.FFTok_right_bracket {
+				goto done
+			}
+			if tok != fflib.FFTok_string {
+				wantedTok = fflib.FFTok_string
+				goto wrongtokenerror
+			}
+
+			kn := fs.Output.Bytes()
+			if len(kn) <= 0 {
+				// "" case. hrm.
+				currentKey = ffjttradeRefundAPIRespnosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			} else {
+				switch kn[0] {
+
+				case 'a':
+
+					if bytes.Equal(ffjKeytradeRefundAPIRespMethod, kn) {
+						currentKey = ffjttradeRefundAPIRespMethod
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 's':
+
+					if bytes.Equal(ffjKeytradeRefundAPIRespSign, kn) {
+						currentKey = ffjttradeRefundAPIRespSign
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				}
+
+				if fflib.EqualFoldRight(ffjKeytradeRefundAPIRespSign, kn) {
+					currentKey = ffjttradeRefundAPIRespSign
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeytradeRefundAPIRespMethod, kn) {
+					currentKey = ffjttradeRefundAPIRespMethod
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				currentKey = ffjttradeRefundAPIRespnosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			}
+
+		case fflib.FFParse_want_colon:
+			if tok != fflib.FFTok_colon {
+				wantedTok = fflib.FFTok_colon
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_value
+			continue
+		case fflib.FFParse_want_value:
+
+			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
+				switch currentKey {
+
+				case ffjttradeRefundAPIRespMethod:
+					goto handle_Method
+
+				case ffjttradeRefundAPIRespSign:
+					goto handle_Sign
+
+				case ffjttradeRefundAPIRespnosuchkey:
+					err = fs.SkipField(tok)
+					if err != nil {
+						return fs.WrapErr(err)
+					}
+					state = fflib.FFParse_after_value
+					goto mainparse
+				}
+			} else {
+				goto wantedvalue
+			}
+		}
+	}
+
+handle_Method:
+
+	/* handler: j.Method type=alipay.tradeRefundAPIResponse kind=struct quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+			j.Method = nil
+
+		} else {
+
+			if j.Method == nil {
+				j.Method = new(tradeRefundAPIResponse)
+			}
+
+			err = j.Method.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+			if err != nil {
+				return err
+			}
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Sign:
+
+	/* handler: j.Sign type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.Sign = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+wantedvalue:
+	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+wrongtokenerror:
+	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
+tokerror:
+	if fs.BigError != nil {
+		return fs.WrapErr(fs.BigError)
+	}
+	err = fs.Error.ToError()
+	if err != nil {
+		return fs.WrapErr(err)
+	}
+	panic("ffjson-generated: unreachable, please report bug.")
+done:
+
+	return nil
+}
+
+// MarshalJSON marshal bytes to json - template
+func (j *tradeRefundAPIResponse) MarshalJSON() ([]byte, error) {
+	var buf fflib.Buffer
+	if j == nil {
+		buf.WriteString("null")
+		return buf.Bytes(), nil
+	}
+	err := j.MarshalJSONBuf(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// MarshalJSONBuf marshal buff to json - template
+func (j *tradeRefundAPIResponse) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+	if j == nil {
+		buf.WriteString("null")
+		return nil
+	}
+	var err error
+	var obj []byte
+	_ = obj
+	_ = err
+	buf.WriteString(`{"code":`)
+	fflib.WriteJsonString(buf, string(j.Code))
+	buf.WriteString(`,"msg":`)
+	fflib.WriteJsonString(buf, string(j.Msg))
+	buf.WriteString(`,"sub_code":`)
+	fflib.WriteJsonString(buf, string(j.SubCode))
+	buf.WriteString(`,"sub_msg":`)
+	fflib.WriteJsonString(buf, string(j.SubMsg))
+	buf.WriteString(`,"trade_no":`)
+	fflib.WriteJsonString(buf, string(j.TradeNo))
+	buf.WriteString(`,"out_trade_no":`)
+	fflib.WriteJsonString(buf, string(j.OutTradeNo))
+	buf.WriteString(`,"buyer_logon_id":`)
+	fflib.WriteJsonString(buf, string(j.BuyerLogonID))
+	buf.WriteString(`,"fund_change":`)
+	fflib.WriteJsonString(buf, string(j.FundChange))
+	buf.WriteString(`,"refund_fee":`)
+	fflib.WriteJsonString(buf, string(j.RefundFee))
+	buf.WriteString(`,"gmt_refund_pay":`)
+	fflib.WriteJsonString(buf, string(j.GmtRefundPay))
+	buf.WriteByte('}')
+	return nil
+}
+
+const (
+	ffjttradeRefundAPIResponsebase = iota
+	ffjttradeRefundAPIResponsenosuchkey
+
+	ffjttradeRefundAPIResponseCode
+
+	ffjttradeRefundAPIResponseMsg
+
+	ffjttradeRefundAPIResponseSubCode
+
+	ffjttradeRefundAPIResponseSubMsg
+
+	ffjttradeRefundAPIResponseTradeNo
+
+	ffjttradeRefundAPIResponseOutTradeNo
+
+	ffjttradeRefundAPIResponseBuyerLogonID
+
+	ffjttradeRefundAPIResponseFundChange
+
+	ffjttradeRefundAPIResponseRefundFee
+
+	ffjttradeRefundAPIResponseGmtRefundPay
+)
+
+var ffjKeytradeRefundAPIResponseCode = []byte("code")
+
+var ffjKeytradeRefundAPIResponseMsg = []byte("msg")
+
+var ffjKeytradeRefundAPIResponseSubCode = []byte("sub_code")
+
+var ffjKeytradeRefundAPIResponseSubMsg = []byte("sub_msg")
+
+var ffjKeytradeRefundAPIResponseTradeNo = []byte("trade_no")
+
+var ffjKeytradeRefundAPIResponseOutTradeNo = []byte("out_trade_no")
+
+var ffjKeytradeRefundAPIResponseBuyerLogonID = []byte("buyer_logon_id")
+
+var ffjKeytradeRefundAPIResponseFundChange = []byte("fund_change")
+
+var ffjKeytradeRefundAPIResponseRefundFee = []byte("refund_fee")
+
+var ffjKeytradeRefundAPIResponseGmtRefundPay = []byte("gmt_refund_pay")
+
+// UnmarshalJSON umarshall json - template of ffjson
+func (j *tradeRefundAPIResponse) UnmarshalJSON(input []byte) error {
+	fs := fflib.NewFFLexer(input)
+	return j.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
+}
+
+// UnmarshalJSONFFLexer fast json unmarshall - template ffjson
+func (j *tradeRefundAPIResponse) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+	var err error
+	currentKey := ffjttradeRefundAPIResponsebase
+	_ = currentKey
+	tok := fflib.FFTok_init
+	wantedTok := fflib.FFTok_init
+
+mainparse:
+	for {
+		tok = fs.Scan()
+		//	println(fmt.Sprintf("debug: tok: %v  state: %v", tok, state))
+		if tok == fflib.FFTok_error {
+			goto tokerror
+		}
+
+		switch state {
+
+		case fflib.FFParse_map_start:
+			if tok != fflib.FFTok_left_bracket {
+				wantedTok = fflib.FFTok_left_bracket
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_key
+			continue
+
+		case fflib.FFParse_after_value:
+			if tok == fflib.FFTok_comma {
+				state = fflib.FFParse_want_key
+			} else if tok == fflib.FFTok_right_bracket {
+				goto done
+			} else {
+				wantedTok = fflib.FFTok_comma
+				goto wrongtokenerror
+			}
+
+		case fflib.FFParse_want_key:
+			// json {} ended. goto exit. woo.
+			if tok == fflib.FFTok_right_bracket {
+				goto done
+			}
+			if tok != fflib.FFTok_string {
+				wantedTok = fflib.FFTok_string
+				goto wrongtokenerror
+			}
+
+			kn := fs.Output.Bytes()
+			if len(kn) <= 0 {
+				// "" case. hrm.
+				currentKey = ffjttradeRefundAPIResponsenosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			} else {
+				switch kn[0] {
+
+				case 'b':
+
+					if bytes.Equal(ffjKeytradeRefundAPIResponseBuyerLogonID, kn) {
+						currentKey = ffjttradeRefundAPIResponseBuyerLogonID
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'c':
+
+					if bytes.Equal(ffjKeytradeRefundAPIResponseCode, kn) {
+						currentKey = ffjttradeRefundAPIResponseCode
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'f':
+
+					if bytes.Equal(ffjKeytradeRefundAPIResponseFundChange, kn) {
+						currentKey = ffjttradeRefundAPIResponseFundChange
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'g':
+
+					if bytes.Equal(ffjKeytradeRefundAPIResponseGmtRefundPay, kn) {
+						currentKey = ffjttradeRefundAPIResponseGmtRefundPay
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'm':
+
+					if bytes.Equal(ffjKeytradeRefundAPIResponseMsg, kn) {
+						currentKey = ffjttradeRefundAPIResponseMsg
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'o':
+
+					if bytes.Equal(ffjKeytradeRefundAPIResponseOutTradeNo, kn) {
+						currentKey = ffjttradeRefundAPIResponseOutTradeNo
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'r':
+
+					if bytes.Equal(ffjKeytradeRefundAPIResponseRefundFee, kn) {
+						currentKey = ffjttradeRefundAPIResponseRefundFee
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 's':
+
+					if bytes.Equal(ffjKeytradeRefundAPIResponseSubCode, kn) {
+						currentKey = ffjttradeRefundAPIResponseSubCode
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeytradeRefundAPIResponseSubMsg, kn) {
+						currentKey = ffjttradeRefundAPIResponseSubMsg
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 't':
+
+					if bytes.Equal(ffjKeytradeRefundAPIResponseTradeNo, kn) {
+						currentKey = ffjttradeRefundAPIResponseTradeNo
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				}
+
+				if fflib.AsciiEqualFold(ffjKeytradeRefundAPIResponseGmtRefundPay, kn) {
+					currentKey = ffjttradeRefundAPIResponseGmtRefundPay
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.AsciiEqualFold(ffjKeytradeRefundAPIResponseRefundFee, kn) {
+					currentKey = ffjttradeRefundAPIResponseRefundFee
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.AsciiEqualFold(ffjKeytradeRefundAPIResponseFundChange, kn) {
+					currentKey = ffjttradeRefundAPIResponseFundChange
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.AsciiEqualFold(ffjKeytradeRefundAPIResponseBuyerLogonID, kn) {
+					currentKey = ffjttradeRefundAPIResponseBuyerLogonID
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.AsciiEqualFold(ffjKeytradeRefundAPIResponseOutTradeNo, kn) {
+					currentKey = ffjttradeRefundAPIResponseOutTradeNo
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.AsciiEqualFold(ffjKeytradeRefundAPIResponseTradeNo, kn) {
+					currentKey = ffjttradeRefundAPIResponseTradeNo
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeytradeRefundAPIResponseSubMsg, kn) {
+					currentKey = ffjttradeRefundAPIResponseSubMsg
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeytradeRefundAPIResponseSubCode, kn) {
+					currentKey = ffjttradeRefundAPIResponseSubCode
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeytradeRefundAPIResponseMsg, kn) {
+					currentKey = ffjttradeRefundAPIResponseMsg
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeytradeRefundAPIResponseCode, kn) {
+					currentKey = ffjttradeRefundAPIResponseCode
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				currentKey = ffjttradeRefundAPIResponsenosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			}
+
+		case fflib.FFParse_want_colon:
+			if tok != fflib.FFTok_colon {
+				wantedTok = fflib.FFTok_colon
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_value
+			continue
+		case fflib.FFParse_want_value:
+
+			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
+				switch currentKey {
+
+				case ffjttradeRefundAPIResponseCode:
+					goto handle_Code
+
+				case ffjttradeRefundAPIResponseMsg:
+					goto handle_Msg
+
+				case ffjttradeRefundAPIResponseSubCode:
+					goto handle_SubCode
+
+				case ffjttradeRefundAPIResponseSubMsg:
+					goto handle_SubMsg
+
+				case ffjttradeRefundAPIResponseTradeNo:
+					goto handle_TradeNo
+
+				case ffjttradeRefundAPIResponseOutTradeNo:
+					goto handle_OutTradeNo
+
+				case ffjttradeRefundAPIResponseBuyerLogonID:
+					goto handle_BuyerLogonID
+
+				case ffjttradeRefundAPIResponseFundChange:
+					goto handle_FundChange
+
+				case ffjttradeRefundAPIResponseRefundFee:
+					goto handle_RefundFee
+
+				case ffjttradeRefundAPIResponseGmtRefundPay:
+					goto handle_GmtRefundPay
+
+				case ffjttradeRefundAPIResponsenosuchkey:
+					err = fs.SkipField(tok)
+					if err != nil {
+						return fs.WrapErr(err)
+					}
+					state = fflib.FFParse_after_value
+					goto mainparse
+				}
+			} else {
+				goto wantedvalue
+			}
+		}
+	}
+
+handle_Code:
+
+	/* handler: j.Code type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.Code = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Msg:
+
+	/* handler: j.Msg type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.Msg = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_SubCode:
+
+	/* handler: j.SubCode type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.SubCode = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_SubMsg:
+
+	/* handler: j.SubMsg type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.SubMsg = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_TradeNo:
+
+	/* handler: j.TradeNo type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.TradeNo = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_OutTradeNo:
+
+	/* handler: j.OutTradeNo type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.OutTradeNo = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_BuyerLogonID:
+
+	/* handler: j.BuyerLogonID type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.BuyerLogonID = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_FundChange:
+
+	/* handler: j.FundChange type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.FundChange = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_RefundFee:
+
+	/* handler: j.RefundFee type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.RefundFee = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_GmtRefundPay:
+
+	/* handler: j.GmtRefundPay type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.GmtRefundPay = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+wantedvalue:
+	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+wrongtokenerror:
+	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
+tokerror:
+	if fs.BigError != nil {
+		return fs.WrapErr(fs.BigError)
+	}
+	err = fs.Error.ToError()
+	if err != nil {
+		return fs.WrapErr(err)
+	}
+	panic("ffjson-generated: unreachable, please report bug.")
+done:
+
+	return nil
+}
+
+// MarshalJSON marshal bytes to json - template
+func (j *transferAPIRequest) MarshalJSON() ([]byte, error) {
+	var buf fflib.Buffer
+	if j == nil {
+		buf.WriteString("null")
+		return buf.Bytes(), nil
+	}
+	err := j.MarshalJSONBuf(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// MarshalJSONBuf marshal buff to json - template
+func (j *transferAPIRequest) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+	if j == nil {
+		buf.WriteString("null")
+		return nil
+	}
+	var err error
+	var obj []byte
+	_ = obj
+	_ = err
+	buf.WriteString(`{ "out_biz_no":`)
+	fflib.WriteJsonString(buf, string(j.OutBizNo))
+	buf.WriteString(`,"trans_amount":`)
+	fflib.WriteJsonString(buf, string(j.TransAmount))
+	buf.WriteString(`,"product_code":`)
+	fflib.WriteJsonString(buf, string(j.ProductCode))
+	buf.WriteString(`,"biz_scene":`)
+	fflib.WriteJsonString(buf, string(j.BizScene))
+	buf.WriteString(`,"order_title":`)
+	fflib.WriteJsonString(buf, string(j.OrderTitle))
+	if j.PayeeInfo != nil {
+		buf.WriteString(`,"payee_info":`)
+
+		{
+
+			err = j.PayeeInfo.MarshalJSONBuf(buf)
+			if err != nil {
+				return err
+			}
+
+		}
+	} else {
+		buf.WriteString(`,"payee_info":null`)
+	}
+	buf.WriteByte(',')
+	if len(j.Remark) != 0 {
+		buf.WriteString(`"remark":`)
+		fflib.WriteJsonString(buf, string(j.Remark))
+		buf.WriteByte(',')
+	}
+	buf.Rewind(1)
+	buf.WriteByte('}')
+	return nil
+}
+
+const (
+	ffjttransferAPIRequestbase = iota
+	ffjttransferAPIRequestnosuchkey
+
+	ffjttransferAPIRequestOutBizNo
+
+	ffjttransferAPIRequestTransAmount
+
+	ffjttransferAPIRequestProductCode
+
+	ffjttransferAPIRequestBizScene
+
+	ffjttransferAPIRequestOrderTitle
+
+	ffjttransferAPIRequestPayeeInfo
+
+	ffjttransferAPIRequestRemark
+)
+
+var ffjKeytransferAPIRequestOutBizNo = []byte("out_biz_no")
+
+var ffjKeytransferAPIRequestTransAmount = []byte("trans_amount")
+
+var ffjKeytransferAPIRequestProductCode = []byte("product_code")
+
+var ffjKeytransferAPIRequestBizScene = []byte("biz_scene")
+
+var ffjKeytransferAPIRequestOrderTitle = []byte("order_title")
+
+var ffjKeytransferAPIRequestPayeeInfo = []byte("payee_info")
+
+var ffjKeytransferAPIRequestRemark = []byte("remark")
+
+// UnmarshalJSON umarshall json - template of ffjson
+func (j *transferAPIRequest) UnmarshalJSON(input []byte) error {
+	fs := fflib.NewFFLexer(input)
+	return j.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
+}
+
+// UnmarshalJSONFFLexer fast json unmarshall - template ffjson
+func (j *transferAPIRequest) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+	var err error
+	currentKey := ffjttransferAPIRequestbase
+	_ = currentKey
+	tok := fflib.FFTok_init
+	wantedTok := fflib.FFTok_init
+
+mainparse:
+	for {
+		tok = fs.Scan()
+		//	println(fmt.Sprintf("debug: tok: %v  state: %v", tok, state))
+		if tok == fflib.FFTok_error {
+			goto tokerror
+		}
+
+		switch state {
+
+		case fflib.FFParse_map_start:
+			if tok != fflib.FFTok_left_bracket {
+				wantedTok = fflib.FFTok_left_bracket
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_key
+			continue
+
+		case fflib.FFParse_after_value:
+			if tok == fflib.FFTok_comma {
+				state = fflib.FFParse_want_key
+			} else if tok == fflib.FFTok_right_bracket {
+				goto done
+			} else {
+				wantedTok = fflib.FFTok_comma
+				goto wrongtokenerror
+			}
+
+		case fflib.FFParse_want_key:
+			// json {} ended. goto exit. woo.
+			if tok == fflib.FFTok_right_bracket {
+				goto done
+			}
+			if tok != fflib.FFTok_string {
+				wantedTok = fflib.FFTok_string
+				goto wrongtokenerror
+			}
+
+			kn := fs.Output.Bytes()
+			if len(kn) <= 0 {
+				// "" case. hrm.
+				currentKey = ffjttransferAPIRequestnosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			} else {
+				switch kn[0] {
+
+				case 'b':
+
+					if bytes.Equal(ffjKeytransferAPIRequestBizScene, kn) {
+						currentKey = ffjttransferAPIRequestBizScene
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'o':
+
+					if bytes.Equal(ffjKeytransferAPIRequestOutBizNo, kn) {
+						currentKey = ffjttransferAPIRequestOutBizNo
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeytransferAPIRequestOrderTitle, kn) {
+						currentKey = ffjttransferAPIRequestOrderTitle
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'p':
+
+					if bytes.Equal(ffjKeytransferAPIRequestProductCode, kn) {
+						currentKey = ffjttransferAPIRequestProductCode
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeytransferAPIRequestPayeeInfo, kn) {
+						currentKey = ffjttransferAPIRequestPayeeInfo
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'r':
+
+					if bytes.Equal(ffjKeytransferAPIRequestRemark, kn) {
+						currentKey = ffjttransferAPIRequestRemark
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 't':
+
+					if bytes.Equal(ffjKeytransferAPIRequestTransAmount, kn) {
+						currentKey = ffjttransferAPIRequestTransAmount
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				}
+
+				if fflib.EqualFoldRight(ffjKeytransferAPIRequestRemark, kn) {
+					currentKey = ffjttransferAPIRequestRemark
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.AsciiEqualFold(ffjKeytransferAPIRequestPayeeInfo, kn) {
+					currentKey = ffjttransferAPIRequestPayeeInfo
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.AsciiEqualFold(ffjKeytransferAPIRequestOrderTitle, kn) {
+					currentKey = ffjttransferAPIRequestOrderTitle
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeytransferAPIRequestBizScene, kn) {
+					currentKey = ffjttransferAPIRequestBizScene
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.AsciiEqualFold(ffjKeytransferAPIRequestProductCode, kn) {
+					currentKey = ffjttransferAPIRequestProductCode
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeytransferAPIRequestTransAmount, kn) {
+					currentKey = ffjttransferAPIRequestTransAmount
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.AsciiEqualFold(ffjKeytransferAPIRequestOutBizNo, kn) {
+					currentKey = ffjttransferAPIRequestOutBizNo
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				currentKey = ffjttransferAPIRequestnosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			}
+
+		case fflib.FFParse_want_colon:
+			if tok != fflib.FFTok_colon {
+				wantedTok = fflib.FFTok_colon
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_value
+			continue
+		case fflib.FFParse_want_value:
+
+			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
+				switch currentKey {
+
+				case ffjttransferAPIRequestOutBizNo:
+					goto handle_OutBizNo
+
+				case ffjttransferAPIRequestTransAmount:
+					goto handle_TransAmount
+
+				case ffjttransferAPIRequestProductCode:
+					goto handle_ProductCode
+
+				case ffjttransferAPIRequestBizScene:
+					goto handle_BizScene
+
+				case ffjttransferAPIRequestOrderTitle:
+					goto handle_OrderTitle
+
+				case ffjttransferAPIRequestPayeeInfo:
+					goto handle_PayeeInfo
+
+				case ffjttransferAPIRequestRemark:
+					goto handle_Remark
+
+				case ffjttransferAPIRequestnosuchkey:
+					err = fs.SkipField(tok)
+					if err != nil {
+						return fs.WrapErr(err)
+					}
+					state = fflib.FFParse_after_value
+					goto mainparse
+				}
+			} else {
+				goto wantedvalue
+			}
+		}
+	}
+
+handle_OutBizNo:
+
+	/* handler: j.OutBizNo type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.OutBizNo = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_TransAmount:
+
+	/* handler: j.TransAmount type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.TransAmount = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_ProductCode:
+
+	/* handler: j.ProductCode type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.ProductCode = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_BizScene:
+
+	/* handler: j.BizScene type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.BizScene = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_OrderTitle:
+
+	/* handler: j.OrderTitle type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.OrderTitle = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_PayeeInfo:
+
+	/* handler: j.PayeeInfo type=alipay.transferPayee kind=struct quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+			j.PayeeInfo = nil
+
+		} else {
+
+			if j.PayeeInfo == nil {
+				j.PayeeInfo = new(transferPayee)
+			}
+
+			err = j.PayeeInfo.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+			if err != nil {
+				return err
+			}
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Remark:
+
+	/* handler: j.Remark type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.Remark = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+wantedvalue:
+	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+wrongtokenerror:
+	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
+tokerror:
+	if fs.BigError != nil {
+		return fs.WrapErr(fs.BigError)
+	}
+	err = fs.Error.ToError()
+	if err != nil {
+		return fs.WrapErr(err)
+	}
+	panic("ffjson-generated: unreachable, please report bug.")
+done:
+
+	return nil
+}
+
+// MarshalJSON marshal bytes to json - template
+func (j *transferAPIResp) MarshalJSON() ([]byte, error) {
+	var buf fflib.Buffer
+	if j == nil {
+		buf.WriteString("null")
+		return buf.Bytes(), nil
+	}
+	err := j.MarshalJSONBuf(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// MarshalJSONBuf marshal buff to json - template
+func (j *transferAPIResp) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+	if j == nil {
+		buf.WriteString("null")
+		return nil
+	}
+	var err error
+	var obj []byte
+	_ = obj
+	_ = err
+	if j.Method != nil {
+		buf.WriteString(`{"alipay_fund_trans_uni_transfer_response":`)
+
+		{
+
+			err = j.Method.MarshalJSONBuf(buf)
+			if err != nil {
+				return err
+			}
+
+		}
+	} else {
+		buf.WriteString(`{"alipay_fund_trans_uni_transfer_response":null`)
+	}
+	buf.WriteString(`,"sign":`)
+	fflib.WriteJsonString(buf, string(j.Sign))
+	buf.WriteByte('}')
+	return nil
+}
+
+const (
+	ffjttransferAPIRespbase = iota
+	ffjttransferAPIRespnosuchkey
+
+	ffjttransferAPIRespMethod
+
+	ffjttransferAPIRespSign
+)
+
+var ffjKeytransferAPIRespMethod = []byte("alipay_fund_trans_uni_transfer_response")
+
+var ffjKeytransferAPIRespSign = []byte("sign")
+
+// UnmarshalJSON umarshall json - template of ffjson
+func (j *transferAPIResp) UnmarshalJSON(input []byte) error {
+	fs := fflib.NewFFLexer(input)
+	return j.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
+}
+
+// UnmarshalJSONFFLexer fast json unmarshall - template ffjson
+func (j *transferAPIResp) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+	var err error
+	currentKey := ffjttransferAPIRespbase
+	_ = currentKey
+	tok := fflib.FFTok_init
+	wantedTok := fflib.FFTok_init
+
+mainparse:
+	for {
+		tok = fs.Scan()
+		//	println(fmt.Sprintf("debug: tok: %v  state: %v", tok, state))
+		if tok == fflib.FFTok_error {
+			goto tokerror
+		}
+
+		switch state {
+
+		case fflib.FFParse_map_start:
+			if tok != fflib.FFTok_left_bracket {
+				wantedTok = fflib.FFTok_left_bracket
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_key
+			continue
+
+		case fflib.FFParse_after_value:
+			if tok == fflib.FFTok_comma {
+				state = fflib.FFParse_want_key
+			} else if tok == fflib.FFTok_right_bracket {
+				goto done
+			} else {
+				wantedTok = fflib.FFTok_comma
+				goto wrongtokenerror
+			}
+
+		case fflib.FFParse_want_key:
+			// json {} ended. goto exit. woo.
+			if tok == fflib.FFTok_right_bracket {
+				goto done
+			}
+			if tok != fflib.FFTok_string {
+				wantedTok = fflib.FFTok_string
+				goto wrongtokenerror
+			}
+
+			kn := fs.Output.Bytes()
+			if len(kn) <= 0 {
+				// "" case. hrm.
+				currentKey = ffjttransferAPIRespnosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			} else {
+				switch kn[0] {
+
+				case 'a':
+
+					if bytes.Equal(ffjKeytransferAPIRespMethod, kn) {
+						currentKey = ffjttransferAPIRespMethod
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 's':
+
+					if bytes.Equal(ffjKeytransferAPIRespSign, kn) {
+						currentKey = ffjttransferAPIRespSign
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				}
+
+				if fflib.EqualFoldRight(ffjKeytransferAPIRespSign, kn) {
+					currentKey = ffjttransferAPIRespSign
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeytransferAPIRespMethod, kn) {
+					currentKey = ffjttransferAPIRespMethod
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				currentKey = ffjttransferAPIRespnosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			}
+
+		case fflib.FFParse_want_colon:
+			if tok != fflib.FFTok_colon {
+				wantedTok = fflib.FFTok_colon
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_value
+			continue
+		case fflib.FFParse_want_value:
+
+			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
+				switch currentKey {
+
+				case ffjttransferAPIRespMethod:
+					goto handle_Method
+
+				case ffjttransferAPIRespSign:
+					goto handle_Sign
+
+				case ffjttransferAPIRespnosuchkey:
+					err = fs.SkipField(tok)
+					if err != nil {
+						return fs.WrapErr(err)
+					}
+					state = fflib.FFParse_after_value
+					goto mainparse
+				}
+			} else {
+				goto wantedvalue
+			}
+		}
+	}
+
+handle_Method:
+
+	/* handler: j.Method type=alipay.transferAPIResponse kind=struct quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+			j.Method = nil
+
+		} else {
+
+			if j.Method == nil {
+				j.Method = new(transferAPIResponse)
+			}
+
+			err = j.Method.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+			if err != nil {
+				return err
+			}
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Sign:
+
+	/* handler: j.Sign type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
 
-var ffjKeyappPayResponseSellerID = []byte("seller_id")
+		if tok == fflib.FFTok_null {
 
-var ffjKeyappPayResponseCharset = []byte("charset")
+		} else {
 
-var ffjKeyappPayResponseTimestamp = []byte("timestamp")
+			outBuf := fs.Output.Bytes()
+
+			j.Sign = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+wantedvalue:
+	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+wrongtokenerror:
+	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
+tokerror:
+	if fs.BigError != nil {
+		return fs.WrapErr(fs.BigError)
+	}
+	err = fs.Error.ToError()
+	if err != nil {
+		return fs.WrapErr(err)
+	}
+	panic("ffjson-generated: unreachable, please report bug.")
+done:
+
+	return nil
+}
+
+// MarshalJSON marshal bytes to json - template
+func (j *transferAPIResponse) MarshalJSON() ([]byte, error) {
+	var buf fflib.Buffer
+	if j == nil {
+		buf.WriteString("null")
+		return buf.Bytes(), nil
+	}
+	err := j.MarshalJSONBuf(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// MarshalJSONBuf marshal buff to json - template
+func (j *transferAPIResponse) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+	if j == nil {
+		buf.WriteString("null")
+		return nil
+	}
+	var err error
+	var obj []byte
+	_ = obj
+	_ = err
+	buf.WriteString(`{"code":`)
+	fflib.WriteJsonString(buf, string(j.Code))
+	buf.WriteString(`,"msg":`)
+	fflib.WriteJsonString(buf, string(j.Msg))
+	buf.WriteString(`,"sub_code":`)
+	fflib.WriteJsonString(buf, string(j.SubCode))
+	buf.WriteString(`,"sub_msg":`)
+	fflib.WriteJsonString(buf, string(j.SubMsg))
+	buf.WriteString(`,"out_biz_no":`)
+	fflib.WriteJsonString(buf, string(j.OutBizNo))
+	buf.WriteString(`,"order_id":`)
+	fflib.WriteJsonString(buf, string(j.OrderID))
+	buf.WriteString(`,"pay_fund_order_id":`)
+	fflib.WriteJsonString(buf, string(j.PayFundOrderID))
+	buf.WriteString(`,"status":`)
+	fflib.WriteJsonString(buf, string(j.Status))
+	buf.WriteString(`,"trans_date":`)
+	fflib.WriteJsonString(buf, string(j.TransDate))
+	buf.WriteByte('}')
+	return nil
+}
+
+const (
+	ffjttransferAPIResponsebase = iota
+	ffjttransferAPIResponsenosuchkey
+
+	ffjttransferAPIResponseCode
+
+	ffjttransferAPIResponseMsg
+
+	ffjttransferAPIResponseSubCode
+
+	ffjttransferAPIResponseSubMsg
+
+	ffjttransferAPIResponseOutBizNo
+
+	ffjttransferAPIResponseOrderID
+
+	ffjttransferAPIResponsePayFundOrderID
+
+	ffjttransferAPIResponseStatus
+
+	ffjttransferAPIResponseTransDate
+)
+
+var ffjKeytransferAPIResponseCode = []byte("code")
+
+var ffjKeytransferAPIResponseMsg = []byte("msg")
+
+var ffjKeytransferAPIResponseSubCode = []byte("sub_code")
+
+var ffjKeytransferAPIResponseSubMsg = []byte("sub_msg")
+
+var ffjKeytransferAPIResponseOutBizNo = []byte("out_biz_no")
+
+var ffjKeytransferAPIResponseOrderID = []byte("order_id")
+
+var ffjKeytransferAPIResponsePayFundOrderID = []byte("pay_fund_order_id")
+
+var ffjKeytransferAPIResponseStatus = []byte("status")
+
+var ffjKeytransferAPIResponseTransDate = []byte("trans_date")
 
 // UnmarshalJSON umarshall json - template of ffjson
-func (j *appPayResponse) UnmarshalJSON(input []byte) error {
+func (j *transferAPIResponse) UnmarshalJSON(input []byte) error {
 	fs := fflib.NewFFLexer(input)
 	return j.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
 }
 
 // UnmarshalJSONFFLexer fast json unmarshall - template ffjson
-func (j *appPayResponse) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+func (j *transferAPIResponse) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
 	var err error
-	currentKey := ffjtappPayResponsebase
+	currentKey := ffjttransferAPIResponsebase
 	_ = currentKey
 	tok := fflib.FFTok_init
 	wantedTok := fflib.FFTok_init
@@ -695,132 +10043,132 @@ mainparse:
 			kn := fs.Output.Bytes()
 			if len(kn) <= 0 {
 				// "" case. hrm.
-				currentKey = ffjtappPayResponsenosuchkey
+				currentKey = ffjttransferAPIResponsenosuchkey
 				state = fflib.FFParse_want_colon
 				goto mainparse
 			} else {
 				switch kn[0] {
 
-				case 'a':
+				case 'c':
 
-					if bytes.Equal(ffjKeyappPayResponseAppID, kn) {
-						currentKey = ffjtappPayResponseAppID
+					if bytes.Equal(ffjKeytransferAPIResponseCode, kn) {
+						currentKey = ffjttransferAPIResponseCode
 						state = fflib.FFParse_want_colon
 						goto mainparse
 					}
 
-				case 'c':
+				case 'm':
 
-					if bytes.Equal(ffjKeyappPayResponseCode, kn) {
-						currentKey = ffjtappPayResponseCode
+					if bytes.Equal(ffjKeytransferAPIResponseMsg, kn) {
+						currentKey = ffjttransferAPIResponseMsg
 						state = fflib.FFParse_want_colon
 						goto mainparse
+					}
 
-					} else if bytes.Equal(ffjKeyappPayResponseCharset, kn) {
-						currentKey = ffjtappPayResponseCharset
+				case 'o':
+
+					if bytes.Equal(ffjKeytransferAPIResponseOutBizNo, kn) {
+						currentKey = ffjttransferAPIResponseOutBizNo
 						state = fflib.FFParse_want_colon
 						goto mainparse
-					}
 
-				case 'm':
-
-					if bytes.Equal(ffjKeyappPayResponseMsg, kn) {
-						currentKey = ffjtappPayResponseMsg
+					} else if bytes.Equal(ffjKeytransferAPIResponseOrderID, kn) {
+						currentKey = ffjttransferAPIResponseOrderID
 						state = fflib.FFParse_want_colon
 						goto mainparse
 					}
 
-				case 'o':
+				case 'p':
 
-					if bytes.Equal(ffjKeyappPayResponseOutTradeNo, kn) {
-						currentKey = ffjtappPayResponseOutTradeNo
+					if bytes.Equal(ffjKeytransferAPIResponsePayFundOrderID, kn) {
+						currentKey = ffjttransferAPIResponsePayFundOrderID
 						state = fflib.FFParse_want_colon
 						goto mainparse
 					}
 
 				case 's':
 
-					if bytes.Equal(ffjKeyappPayResponseSellerID, kn) {
-						currentKey = ffjtappPayResponseSellerID
+					if bytes.Equal(ffjKeytransferAPIResponseSubCode, kn) {
+						currentKey = ffjttransferAPIResponseSubCode
 						state = fflib.FFParse_want_colon
 						goto mainparse
-					}
-
-				case 't':
 
-					if bytes.Equal(ffjKeyappPayResponseTradeNo, kn) {
-						currentKey = ffjtappPayResponseTradeNo
+					} else if bytes.Equal(ffjKeytransferAPIResponseSubMsg, kn) {
+						currentKey = ffjttransferAPIResponseSubMsg
 						state = fflib.FFParse_want_colon
 						goto mainparse
 
-					} else if bytes.Equal(ffjKeyappPayResponseTotalAmount, kn) {
-						currentKey = ffjtappPayResponseTotalAmount
+					} else if bytes.Equal(ffjKeytransferAPIResponseStatus, kn) {
+						currentKey = ffjttransferAPIResponseStatus
 						state = fflib.FFParse_want_colon
 						goto mainparse
+					}
 
-					} else if bytes.Equal(ffjKeyappPayResponseTimestamp, kn) {
-						currentKey = ffjtappPayResponseTimestamp
+				case 't':
+
+					if bytes.Equal(ffjKeytransferAPIResponseTransDate, kn) {
+						currentKey = ffjttransferAPIResponseTransDate
 						state = fflib.FFParse_want_colon
 						goto mainparse
 					}
 
 				}
 
-				if fflib.EqualFoldRight(ffjKeyappPayResponseTimestamp, kn) {
-					currentKey = ffjtappPayResponseTimestamp
+				if fflib.EqualFoldRight(ffjKeytransferAPIResponseTransDate, kn) {
+					currentKey = ffjttransferAPIResponseTransDate
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.EqualFoldRight(ffjKeyappPayResponseCharset, kn) {
-					currentKey = ffjtappPayResponseCharset
+				if fflib.EqualFoldRight(ffjKeytransferAPIResponseStatus, kn) {
+					currentKey = ffjttransferAPIResponseStatus
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.EqualFoldRight(ffjKeyappPayResponseSellerID, kn) {
-					currentKey = ffjtappPayResponseSellerID
+				if fflib.AsciiEqualFold(ffjKeytransferAPIResponsePayFundOrderID, kn) {
+					currentKey = ffjttransferAPIResponsePayFundOrderID
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.AsciiEqualFold(ffjKeyappPayResponseTotalAmount, kn) {
-					currentKey = ffjtappPayResponseTotalAmount
+				if fflib.AsciiEqualFold(ffjKeytransferAPIResponseOrderID, kn) {
+					currentKey = ffjttransferAPIResponseOrderID
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.AsciiEqualFold(ffjKeyappPayResponseTradeNo, kn) {
-					currentKey = ffjtappPayResponseTradeNo
+				if fflib.AsciiEqualFold(ffjKeytransferAPIResponseOutBizNo, kn) {
+					currentKey = ffjttransferAPIResponseOutBizNo
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.AsciiEqualFold(ffjKeyappPayResponseOutTradeNo, kn) {
-					currentKey = ffjtappPayResponseOutTradeNo
+				if fflib.EqualFoldRight(ffjKeytransferAPIResponseSubMsg, kn) {
+					currentKey = ffjttransferAPIResponseSubMsg
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.AsciiEqualFold(ffjKeyappPayResponseAppID, kn) {
-					currentKey = ffjtappPayResponseAppID
+				if fflib.EqualFoldRight(ffjKeytransferAPIResponseSubCode, kn) {
+					currentKey = ffjttransferAPIResponseSubCode
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.EqualFoldRight(ffjKeyappPayResponseMsg, kn) {
-					currentKey = ffjtappPayResponseMsg
+				if fflib.EqualFoldRight(ffjKeytransferAPIResponseMsg, kn) {
+					currentKey = ffjttransferAPIResponseMsg
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.SimpleLetterEqualFold(ffjKeyappPayResponseCode, kn) {
-					currentKey = ffjtappPayResponseCode
+				if fflib.SimpleLetterEqualFold(ffjKeytransferAPIResponseCode, kn) {
+					currentKey = ffjttransferAPIResponseCode
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				currentKey = ffjtappPayResponsenosuchkey
+				currentKey = ffjttransferAPIResponsenosuchkey
 				state = fflib.FFParse_want_colon
 				goto mainparse
 			}
@@ -837,34 +10185,34 @@ mainparse:
 			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
 				switch currentKey {
 
-				case ffjtappPayResponseCode:
+				case ffjttransferAPIResponseCode:
 					goto handle_Code
 
-				case ffjtappPayResponseMsg:
+				case ffjttransferAPIResponseMsg:
 					goto handle_Msg
 
-				case ffjtappPayResponseAppID:
-					goto handle_AppID
+				case ffjttransferAPIResponseSubCode:
+					goto handle_SubCode
 
-				case ffjtappPayResponseOutTradeNo:
-					goto handle_OutTradeNo
+				case ffjttransferAPIResponseSubMsg:
+					goto handle_SubMsg
 
-				case ffjtappPayResponseTradeNo:
-					goto handle_TradeNo
+				case ffjttransferAPIResponseOutBizNo:
+					goto handle_OutBizNo
 
-				case ffjtappPayResponseTotalAmount:
-					goto handle_TotalAmount
+				case ffjttransferAPIResponseOrderID:
+					goto handle_OrderID
 
-				case ffjtappPayResponseSellerID:
-					goto handle_SellerID
+				case ffjttransferAPIResponsePayFundOrderID:
+					goto handle_PayFundOrderID
 
-				case ffjtappPayResponseCharset:
-					goto handle_Charset
+				case ffjttransferAPIResponseStatus:
+					goto handle_Status
 
-				case ffjtappPayResponseTimestamp:
-					goto handle_Timestamp
+				case ffjttransferAPIResponseTransDate:
+					goto handle_TransDate
 
-				case ffjtappPayResponsenosuchkey:
+				case ffjttransferAPIResponsenosuchkey:
 					err = fs.SkipField(tok)
 					if err != nil {
 						return fs.WrapErr(err)
@@ -873,92 +10221,14 @@ mainparse:
 					goto mainparse
 				}
 			} else {
-				goto wantedvalue
-			}
-		}
-	}
-
-handle_Code:
-
-	/* handler: j.Code type=string kind=string quoted=false*/
-
-	{
-
-		{
-			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
-				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
-			}
-		}
-
-		if tok == fflib.FFTok_null {
-
-		} else {
-
-			outBuf := fs.Output.Bytes()
-
-			j.Code = string(string(outBuf))
-
-		}
-	}
-
-	state = fflib.FFParse_after_value
-	goto mainparse
-
-handle_Msg:
-
-	/* handler: j.Msg type=string kind=string quoted=false*/
-
-	{
-
-		{
-			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
-				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
-			}
-		}
-
-		if tok == fflib.FFTok_null {
-
-		} else {
-
-			outBuf := fs.Output.Bytes()
-
-			j.Msg = string(string(outBuf))
-
-		}
-	}
-
-	state = fflib.FFParse_after_value
-	goto mainparse
-
-handle_AppID:
-
-	/* handler: j.AppID type=string kind=string quoted=false*/
-
-	{
-
-		{
-			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
-				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
-			}
-		}
-
-		if tok == fflib.FFTok_null {
-
-		} else {
-
-			outBuf := fs.Output.Bytes()
-
-			j.AppID = string(string(outBuf))
-
+				goto wantedvalue
+			}
 		}
 	}
 
-	state = fflib.FFParse_after_value
-	goto mainparse
-
-handle_OutTradeNo:
+handle_Code:
 
-	/* handler: j.OutTradeNo type=string kind=string quoted=false*/
+	/* handler: j.Code type=string kind=string quoted=false*/
 
 	{
 
@@ -974,7 +10244,7 @@ handle_OutTradeNo:
 
 			outBuf := fs.Output.Bytes()
 
-			j.OutTradeNo = string(string(outBuf))
+			j.Code = string(string(outBuf))
 
 		}
 	}
@@ -982,9 +10252,9 @@ handle_OutTradeNo:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_TradeNo:
+handle_Msg:
 
-	/* handler: j.TradeNo type=string kind=string quoted=false*/
+	/* handler: j.Msg type=string kind=string quoted=false*/
 
 	{
 
@@ -1000,37 +10270,7 @@ handle_TradeNo:
 
 			outBuf := fs.Output.Bytes()
 
-			j.TradeNo = string(string(outBuf))
-
-		}
-	}
-
-	state = fflib.FFParse_after_value
-	goto mainparse
-
-handle_TotalAmount:
-
-	/* handler: j.TotalAmount type=float64 kind=float64 quoted=false*/
-
-	{
-		if tok != fflib.FFTok_double && tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
-			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for float64", tok))
-		}
-	}
-
-	{
-
-		if tok == fflib.FFTok_null {
-
-		} else {
-
-			tval, err := fflib.ParseFloat(fs.Output.Bytes(), 64)
-
-			if err != nil {
-				return fs.WrapErr(err)
-			}
-
-			j.TotalAmount = float64(tval)
+			j.Msg = string(string(outBuf))
 
 		}
 	}
@@ -1038,9 +10278,9 @@ handle_TotalAmount:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_SellerID:
+handle_SubCode:
 
-	/* handler: j.SellerID type=string kind=string quoted=false*/
+	/* handler: j.SubCode type=string kind=string quoted=false*/
 
 	{
 
@@ -1056,7 +10296,7 @@ handle_SellerID:
 
 			outBuf := fs.Output.Bytes()
 
-			j.SellerID = string(string(outBuf))
+			j.SubCode = string(string(outBuf))
 
 		}
 	}
@@ -1064,9 +10304,9 @@ handle_SellerID:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_Charset:
+handle_SubMsg:
 
-	/* handler: j.Charset type=string kind=string quoted=false*/
+	/* handler: j.SubMsg type=string kind=string quoted=false*/
 
 	{
 
@@ -1082,7 +10322,7 @@ handle_Charset:
 
 			outBuf := fs.Output.Bytes()
 
-			j.Charset = string(string(outBuf))
+			j.SubMsg = string(string(outBuf))
 
 		}
 	}
@@ -1090,9 +10330,9 @@ handle_Charset:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_Timestamp:
+handle_OutBizNo:
 
-	/* handler: j.Timestamp type=string kind=string quoted=false*/
+	/* handler: j.OutBizNo type=string kind=string quoted=false*/
 
 	{
 
@@ -1108,7 +10348,7 @@ handle_Timestamp:
 
 			outBuf := fs.Output.Bytes()
 
-			j.Timestamp = string(string(outBuf))
+			j.OutBizNo = string(string(outBuf))
 
 		}
 	}
@@ -1116,258 +10356,61 @@ handle_Timestamp:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-wantedvalue:
-	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
-wrongtokenerror:
-	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
-tokerror:
-	if fs.BigError != nil {
-		return fs.WrapErr(fs.BigError)
-	}
-	err = fs.Error.ToError()
-	if err != nil {
-		return fs.WrapErr(err)
-	}
-	panic("ffjson-generated: unreachable, please report bug.")
-done:
-
-	return nil
-}
+handle_OrderID:
 
-// MarshalJSON marshal bytes to json - template
-func (j *appPayResult) MarshalJSON() ([]byte, error) {
-	var buf fflib.Buffer
-	if j == nil {
-		buf.WriteString("null")
-		return buf.Bytes(), nil
-	}
-	err := j.MarshalJSONBuf(&buf)
-	if err != nil {
-		return nil, err
-	}
-	return buf.Bytes(), nil
-}
+	/* handler: j.OrderID type=string kind=string quoted=false*/
 
-// MarshalJSONBuf marshal buff to json - template
-func (j *appPayResult) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
-	if j == nil {
-		buf.WriteString("null")
-		return nil
-	}
-	var err error
-	var obj []byte
-	_ = obj
-	_ = err
-	if j.Response != nil {
-		buf.WriteString(`{"alipay_trade_app_pay_response":`)
+	{
 
 		{
-
-			err = j.Response.MarshalJSONBuf(buf)
-			if err != nil {
-				return err
-			}
-
-		}
-	} else {
-		buf.WriteString(`{"alipay_trade_app_pay_response":null`)
-	}
-	buf.WriteString(`,"sign":`)
-	fflib.WriteJsonString(buf, string(j.Sign))
-	buf.WriteString(`,"sign_type":`)
-	fflib.WriteJsonString(buf, string(j.SignType))
-	buf.WriteByte('}')
-	return nil
-}
-
-const (
-	ffjtappPayResultbase = iota
-	ffjtappPayResultnosuchkey
-
-	ffjtappPayResultResponse
-
-	ffjtappPayResultSign
-
-	ffjtappPayResultSignType
-)
-
-var ffjKeyappPayResultResponse = []byte("alipay_trade_app_pay_response")
-
-var ffjKeyappPayResultSign = []byte("sign")
-
-var ffjKeyappPayResultSignType = []byte("sign_type")
-
-// UnmarshalJSON umarshall json - template of ffjson
-func (j *appPayResult) UnmarshalJSON(input []byte) error {
-	fs := fflib.NewFFLexer(input)
-	return j.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
-}
-
-// UnmarshalJSONFFLexer fast json unmarshall - template ffjson
-func (j *appPayResult) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
-	var err error
-	currentKey := ffjtappPayResultbase
-	_ = currentKey
-	tok := fflib.FFTok_init
-	wantedTok := fflib.FFTok_init
-
-mainparse:
-	for {
-		tok = fs.Scan()
-		//	println(fmt.Sprintf("debug: tok: %v  state: %v", tok, state))
-		if tok == fflib.FFTok_error {
-			goto tokerror
-		}
-
-		switch state {
-
-		case fflib.FFParse_map_start:
-			if tok != fflib.FFTok_left_bracket {
-				wantedTok = fflib.FFTok_left_bracket
-				goto wrongtokenerror
-			}
-			state = fflib.FFParse_want_key
-			continue
-
-		case fflib.FFParse_after_value:
-			if tok == fflib.FFTok_comma {
-				state = fflib.FFParse_want_key
-			} else if tok == fflib.FFTok_right_bracket {
-				goto done
-			} else {
-				wantedTok = fflib.FFTok_comma
-				goto wrongtokenerror
-			}
-
-		case fflib.FFParse_want_key:
-			// json {} ended. goto exit. woo.
-			if tok == fflib.FFTok_right_bracket {
-				goto done
-			}
-			if tok != fflib.FFTok_string {
-				wantedTok = fflib.FFTok_string
-				goto wrongtokenerror
-			}
-
-			kn := fs.Output.Bytes()
-			if len(kn) <= 0 {
-				// "" case. hrm.
-				currentKey = ffjtappPayResultnosuchkey
-				state = fflib.FFParse_want_colon
-				goto mainparse
-			} else {
-				switch kn[0] {
-
-				case 'a':
-
-					if bytes.Equal(ffjKeyappPayResultResponse, kn) {
-						currentKey = ffjtappPayResultResponse
-						state = fflib.FFParse_want_colon
-						goto mainparse
-					}
-
-				case 's':
-
-					if bytes.Equal(ffjKeyappPayResultSign, kn) {
-						currentKey = ffjtappPayResultSign
-						state = fflib.FFParse_want_colon
-						goto mainparse
-
-					} else if bytes.Equal(ffjKeyappPayResultSignType, kn) {
-						currentKey = ffjtappPayResultSignType
-						state = fflib.FFParse_want_colon
-						goto mainparse
-					}
-
-				}
-
-				if fflib.EqualFoldRight(ffjKeyappPayResultSignType, kn) {
-					currentKey = ffjtappPayResultSignType
-					state = fflib.FFParse_want_colon
-					goto mainparse
-				}
-
-				if fflib.EqualFoldRight(ffjKeyappPayResultSign, kn) {
-					currentKey = ffjtappPayResultSign
-					state = fflib.FFParse_want_colon
-					goto mainparse
-				}
-
-				if fflib.EqualFoldRight(ffjKeyappPayResultResponse, kn) {
-					currentKey = ffjtappPayResultResponse
-					state = fflib.FFParse_want_colon
-					goto mainparse
-				}
-
-				currentKey = ffjtappPayResultnosuchkey
-				state = fflib.FFParse_want_colon
-				goto mainparse
-			}
-
-		case fflib.FFParse_want_colon:
-			if tok != fflib.FFTok_colon {
-				wantedTok = fflib.FFTok_colon
-				goto wrongtokenerror
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
 			}
-			state = fflib.FFParse_want_value
-			continue
-		case fflib.FFParse_want_value:
+		}
 
-			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
-				switch currentKey {
+		if tok == fflib.FFTok_null {
 
-				case ffjtappPayResultResponse:
-					goto handle_Response
+		} else {
 
-				case ffjtappPayResultSign:
-					goto handle_Sign
+			outBuf := fs.Output.Bytes()
 
-				case ffjtappPayResultSignType:
-					goto handle_SignType
+			j.OrderID = string(string(outBuf))
 
-				case ffjtappPayResultnosuchkey:
-					err = fs.SkipField(tok)
-					if err != nil {
-						return fs.WrapErr(err)
-					}
-					state = fflib.FFParse_after_value
-					goto mainparse
-				}
-			} else {
-				goto wantedvalue
-			}
 		}
 	}
 
-handle_Response:
+	state = fflib.FFParse_after_value
+	goto mainparse
 
-	/* handler: j.Response type=alipay.appPayResponse kind=struct quoted=false*/
+handle_PayFundOrderID:
+
+	/* handler: j.PayFundOrderID type=string kind=string quoted=false*/
 
 	{
-		if tok == fflib.FFTok_null {
 
-			j.Response = nil
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
 
 		} else {
 
-			if j.Response == nil {
-				j.Response = new(appPayResponse)
-			}
+			outBuf := fs.Output.Bytes()
+
+			j.PayFundOrderID = string(string(outBuf))
 
-			err = j.Response.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
-			if err != nil {
-				return err
-			}
 		}
-		state = fflib.FFParse_after_value
 	}
 
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_Sign:
+handle_Status:
 
-	/* handler: j.Sign type=string kind=string quoted=false*/
+	/* handler: j.Status type=string kind=string quoted=false*/
 
 	{
 
@@ -1383,7 +10426,7 @@ handle_Sign:
 
 			outBuf := fs.Output.Bytes()
 
-			j.Sign = string(string(outBuf))
+			j.Status = string(string(outBuf))
 
 		}
 	}
@@ -1391,9 +10434,9 @@ handle_Sign:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_SignType:
+handle_TransDate:
 
-	/* handler: j.SignType type=string kind=string quoted=false*/
+	/* handler: j.TransDate type=string kind=string quoted=false*/
 
 	{
 
@@ -1409,7 +10452,7 @@ handle_SignType:
 
 			outBuf := fs.Output.Bytes()
 
-			j.SignType = string(string(outBuf))
+			j.TransDate = string(string(outBuf))
 
 		}
 	}
@@ -1436,7 +10479,7 @@ done:
 }
 
 // MarshalJSON marshal bytes to json - template
-func (j *appPayReturn) MarshalJSON() ([]byte, error) {
+func (j *transferBankcard) MarshalJSON() ([]byte, error) {
 	var buf fflib.Buffer
 	if j == nil {
 		buf.WriteString("null")
@@ -1450,7 +10493,7 @@ func (j *appPayReturn) MarshalJSON() ([]byte, error) {
 }
 
 // MarshalJSONBuf marshal buff to json - template
-func (j *appPayReturn) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+func (j *transferBankcard) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 	if j == nil {
 		buf.WriteString("null")
 		return nil
@@ -1459,53 +10502,57 @@ func (j *appPayReturn) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 	var obj []byte
 	_ = obj
 	_ = err
-	buf.WriteString(`{"result":`)
-	if j.Result != nil {
-		buf.WriteString(`"`)
-		{
-			enc := base64.NewEncoder(base64.StdEncoding, buf)
-			enc.Write(reflect.Indirect(reflect.ValueOf(j.Result)).Bytes())
-			enc.Close()
-		}
-		buf.WriteString(`"`)
-	} else {
-		buf.WriteString(`null`)
+	buf.WriteString(`{ "account_type":`)
+	fflib.WriteJsonString(buf, string(j.AccountType))
+	buf.WriteString(`,"inst_name":`)
+	fflib.WriteJsonString(buf, string(j.InstName))
+	buf.WriteByte(',')
+	if len(j.InstProvince) != 0 {
+		buf.WriteString(`"inst_province":`)
+		fflib.WriteJsonString(buf, string(j.InstProvince))
+		buf.WriteByte(',')
 	}
-	buf.WriteString(`,"resultStatus":`)
-	fflib.WriteJsonString(buf, string(j.Status))
-	buf.WriteString(`,"memo":`)
-	fflib.WriteJsonString(buf, string(j.Memo))
+	if len(j.InstCity) != 0 {
+		buf.WriteString(`"inst_city":`)
+		fflib.WriteJsonString(buf, string(j.InstCity))
+		buf.WriteByte(',')
+	}
+	buf.Rewind(1)
 	buf.WriteByte('}')
 	return nil
 }
 
 const (
-	ffjtappPayReturnbase = iota
-	ffjtappPayReturnnosuchkey
+	ffjttransferBankcardbase = iota
+	ffjttransferBankcardnosuchkey
 
-	ffjtappPayReturnResult
+	ffjttransferBankcardAccountType
 
-	ffjtappPayReturnStatus
+	ffjttransferBankcardInstName
 
-	ffjtappPayReturnMemo
+	ffjttransferBankcardInstProvince
+
+	ffjttransferBankcardInstCity
 )
 
-var ffjKeyappPayReturnResult = []byte("result")
+var ffjKeytransferBankcardAccountType = []byte("account_type")
 
-var ffjKeyappPayReturnStatus = []byte("resultStatus")
+var ffjKeytransferBankcardInstName = []byte("inst_name")
 
-var ffjKeyappPayReturnMemo = []byte("memo")
+var ffjKeytransferBankcardInstProvince = []byte("inst_province")
+
+var ffjKeytransferBankcardInstCity = []byte("inst_city")
 
 // UnmarshalJSON umarshall json - template of ffjson
-func (j *appPayReturn) UnmarshalJSON(input []byte) error {
+func (j *transferBankcard) UnmarshalJSON(input []byte) error {
 	fs := fflib.NewFFLexer(input)
 	return j.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
 }
 
 // UnmarshalJSONFFLexer fast json unmarshall - template ffjson
-func (j *appPayReturn) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+func (j *transferBankcard) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
 	var err error
-	currentKey := ffjtappPayReturnbase
+	currentKey := ffjttransferBankcardbase
 	_ = currentKey
 	tok := fflib.FFTok_init
 	wantedTok := fflib.FFTok_init
@@ -1551,54 +10598,65 @@ mainparse:
 			kn := fs.Output.Bytes()
 			if len(kn) <= 0 {
 				// "" case. hrm.
-				currentKey = ffjtappPayReturnnosuchkey
+				currentKey = ffjttransferBankcardnosuchkey
 				state = fflib.FFParse_want_colon
 				goto mainparse
 			} else {
 				switch kn[0] {
 
-				case 'm':
+				case 'a':
 
-					if bytes.Equal(ffjKeyappPayReturnMemo, kn) {
-						currentKey = ffjtappPayReturnMemo
+					if bytes.Equal(ffjKeytransferBankcardAccountType, kn) {
+						currentKey = ffjttransferBankcardAccountType
 						state = fflib.FFParse_want_colon
 						goto mainparse
 					}
 
-				case 'r':
+				case 'i':
 
-					if bytes.Equal(ffjKeyappPayReturnResult, kn) {
-						currentKey = ffjtappPayReturnResult
+					if bytes.Equal(ffjKeytransferBankcardInstName, kn) {
+						currentKey = ffjttransferBankcardInstName
 						state = fflib.FFParse_want_colon
 						goto mainparse
 
-					} else if bytes.Equal(ffjKeyappPayReturnStatus, kn) {
-						currentKey = ffjtappPayReturnStatus
+					} else if bytes.Equal(ffjKeytransferBankcardInstProvince, kn) {
+						currentKey = ffjttransferBankcardInstProvince
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeytransferBankcardInstCity, kn) {
+						currentKey = ffjttransferBankcardInstCity
 						state = fflib.FFParse_want_colon
 						goto mainparse
 					}
 
 				}
 
-				if fflib.SimpleLetterEqualFold(ffjKeyappPayReturnMemo, kn) {
-					currentKey = ffjtappPayReturnMemo
+				if fflib.EqualFoldRight(ffjKeytransferBankcardInstCity, kn) {
+					currentKey = ffjttransferBankcardInstCity
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.EqualFoldRight(ffjKeyappPayReturnStatus, kn) {
-					currentKey = ffjtappPayReturnStatus
+				if fflib.EqualFoldRight(ffjKeytransferBankcardInstProvince, kn) {
+					currentKey = ffjttransferBankcardInstProvince
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.EqualFoldRight(ffjKeyappPayReturnResult, kn) {
-					currentKey = ffjtappPayReturnResult
+				if fflib.EqualFoldRight(ffjKeytransferBankcardInstName, kn) {
+					currentKey = ffjttransferBankcardInstName
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				currentKey = ffjtappPayReturnnosuchkey
+				if fflib.AsciiEqualFold(ffjKeytransferBankcardAccountType, kn) {
+					currentKey = ffjttransferBankcardAccountType
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				currentKey = ffjttransferBankcardnosuchkey
 				state = fflib.FFParse_want_colon
 				goto mainparse
 			}
@@ -1615,16 +10673,19 @@ mainparse:
 			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
 				switch currentKey {
 
-				case ffjtappPayReturnResult:
-					goto handle_Result
+				case ffjttransferBankcardAccountType:
+					goto handle_AccountType
 
-				case ffjtappPayReturnStatus:
-					goto handle_Status
+				case ffjttransferBankcardInstName:
+					goto handle_InstName
 
-				case ffjtappPayReturnMemo:
-					goto handle_Memo
+				case ffjttransferBankcardInstProvince:
+					goto handle_InstProvince
 
-				case ffjtappPayReturnnosuchkey:
+				case ffjttransferBankcardInstCity:
+					goto handle_InstCity
+
+				case ffjttransferBankcardnosuchkey:
 					err = fs.SkipField(tok)
 					if err != nil {
 						return fs.WrapErr(err)
@@ -1638,29 +10699,51 @@ mainparse:
 		}
 	}
 
-handle_Result:
+handle_AccountType:
 
-	/* handler: j.Result type=[]uint8 kind=slice quoted=false*/
+	/* handler: j.AccountType type=string kind=string quoted=false*/
 
 	{
 
 		{
 			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
-				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
 			}
 		}
 
 		if tok == fflib.FFTok_null {
-			j.Result = nil
+
 		} else {
-			b := make([]byte, base64.StdEncoding.DecodedLen(fs.Output.Len()))
-			n, err := base64.StdEncoding.Decode(b, fs.Output.Bytes())
-			if err != nil {
-				return fs.WrapErr(err)
+
+			outBuf := fs.Output.Bytes()
+
+			j.AccountType = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_InstName:
+
+	/* handler: j.InstName type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
 			}
+		}
 
-			v := reflect.ValueOf(&j.Result).Elem()
-			v.SetBytes(b[0:n])
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.InstName = string(string(outBuf))
 
 		}
 	}
@@ -1668,9 +10751,9 @@ handle_Result:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_Status:
+handle_InstProvince:
 
-	/* handler: j.Status type=string kind=string quoted=false*/
+	/* handler: j.InstProvince type=string kind=string quoted=false*/
 
 	{
 
@@ -1686,7 +10769,7 @@ handle_Status:
 
 			outBuf := fs.Output.Bytes()
 
-			j.Status = string(string(outBuf))
+			j.InstProvince = string(string(outBuf))
 
 		}
 	}
@@ -1694,9 +10777,9 @@ handle_Status:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_Memo:
+handle_InstCity:
 
-	/* handler: j.Memo type=string kind=string quoted=false*/
+	/* handler: j.InstCity type=string kind=string quoted=false*/
 
 	{
 
@@ -1712,7 +10795,7 @@ handle_Memo:
 
 			outBuf := fs.Output.Bytes()
 
-			j.Memo = string(string(outBuf))
+			j.InstCity = string(string(outBuf))
 
 		}
 	}
@@ -1739,7 +10822,7 @@ done:
 }
 
 // MarshalJSON marshal bytes to json - template
-func (j *withdrawAPIRequest) MarshalJSON() ([]byte, error) {
+func (j *transferPayee) MarshalJSON() ([]byte, error) {
 	var buf fflib.Buffer
 	if j == nil {
 		buf.WriteString("null")
@@ -1753,7 +10836,7 @@ func (j *withdrawAPIRequest) MarshalJSON() ([]byte, error) {
 }
 
 // MarshalJSONBuf marshal buff to json - template
-func (j *withdrawAPIRequest) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+func (j *transferPayee) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 	if j == nil {
 		buf.WriteString("null")
 		return nil
@@ -1762,61 +10845,67 @@ func (j *withdrawAPIRequest) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 	var obj []byte
 	_ = obj
 	_ = err
-	buf.WriteString(`{"out_biz_no":`)
-	fflib.WriteJsonString(buf, string(j.OutBizNo))
-	buf.WriteString(`,"payee_type":`)
-	fflib.WriteJsonString(buf, string(j.Type))
-	buf.WriteString(`,"payee_account":`)
-	fflib.WriteJsonString(buf, string(j.Account))
-	buf.WriteString(`,"amount":`)
-	fflib.WriteJsonString(buf, string(j.Amount))
-	buf.WriteString(`,"payee_real_name":`)
-	fflib.WriteJsonString(buf, string(j.RealName))
-	buf.WriteString(`,"remark":`)
-	fflib.WriteJsonString(buf, string(j.Remark))
+	buf.WriteString(`{ "identity":`)
+	fflib.WriteJsonString(buf, string(j.Identity))
+	buf.WriteString(`,"identity_type":`)
+	fflib.WriteJsonString(buf, string(j.IdentityType))
+	buf.WriteByte(',')
+	if len(j.Name) != 0 {
+		buf.WriteString(`"name":`)
+		fflib.WriteJsonString(buf, string(j.Name))
+		buf.WriteByte(',')
+	}
+	if j.BankcardExtInfo != nil {
+		if true {
+			buf.WriteString(`"bankcard_ext_info":`)
+
+			{
+
+				err = j.BankcardExtInfo.MarshalJSONBuf(buf)
+				if err != nil {
+					return err
+				}
+
+			}
+			buf.WriteByte(',')
+		}
+	}
+	buf.Rewind(1)
 	buf.WriteByte('}')
 	return nil
 }
 
 const (
-	ffjtwithdrawAPIRequestbase = iota
-	ffjtwithdrawAPIRequestnosuchkey
-
-	ffjtwithdrawAPIRequestOutBizNo
+	ffjttransferPayeebase = iota
+	ffjttransferPayeenosuchkey
 
-	ffjtwithdrawAPIRequestType
+	ffjttransferPayeeIdentity
 
-	ffjtwithdrawAPIRequestAccount
+	ffjttransferPayeeIdentityType
 
-	ffjtwithdrawAPIRequestAmount
+	ffjttransferPayeeName
 
-	ffjtwithdrawAPIRequestRealName
-
-	ffjtwithdrawAPIRequestRemark
+	ffjttransferPayeeBankcardExtInfo
 )
 
-var ffjKeywithdrawAPIRequestOutBizNo = []byte("out_biz_no")
-
-var ffjKeywithdrawAPIRequestType = []byte("payee_type")
+var ffjKeytransferPayeeIdentity = []byte("identity")
 
-var ffjKeywithdrawAPIRequestAccount = []byte("payee_account")
+var ffjKeytransferPayeeIdentityType = []byte("identity_type")
 
-var ffjKeywithdrawAPIRequestAmount = []byte("amount")
+var ffjKeytransferPayeeName = []byte("name")
 
-var ffjKeywithdrawAPIRequestRealName = []byte("payee_real_name")
-
-var ffjKeywithdrawAPIRequestRemark = []byte("remark")
+var ffjKeytransferPayeeBankcardExtInfo = []byte("bankcard_ext_info")
 
 // UnmarshalJSON umarshall json - template of ffjson
-func (j *withdrawAPIRequest) UnmarshalJSON(input []byte) error {
+func (j *transferPayee) UnmarshalJSON(input []byte) error {
 	fs := fflib.NewFFLexer(input)
 	return j.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
 }
 
 // UnmarshalJSONFFLexer fast json unmarshall - template ffjson
-func (j *withdrawAPIRequest) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+func (j *transferPayee) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
 	var err error
-	currentKey := ffjtwithdrawAPIRequestbase
+	currentKey := ffjttransferPayeebase
 	_ = currentKey
 	tok := fflib.FFTok_init
 	wantedTok := fflib.FFTok_init
@@ -1862,93 +10951,68 @@ mainparse:
 			kn := fs.Output.Bytes()
 			if len(kn) <= 0 {
 				// "" case. hrm.
-				currentKey = ffjtwithdrawAPIRequestnosuchkey
+				currentKey = ffjttransferPayeenosuchkey
 				state = fflib.FFParse_want_colon
 				goto mainparse
 			} else {
 				switch kn[0] {
 
-				case 'a':
-
-					if bytes.Equal(ffjKeywithdrawAPIRequestAmount, kn) {
-						currentKey = ffjtwithdrawAPIRequestAmount
-						state = fflib.FFParse_want_colon
-						goto mainparse
-					}
-
-				case 'o':
+				case 'b':
 
-					if bytes.Equal(ffjKeywithdrawAPIRequestOutBizNo, kn) {
-						currentKey = ffjtwithdrawAPIRequestOutBizNo
+					if bytes.Equal(ffjKeytransferPayeeBankcardExtInfo, kn) {
+						currentKey = ffjttransferPayeeBankcardExtInfo
 						state = fflib.FFParse_want_colon
 						goto mainparse
 					}
 
-				case 'p':
-
-					if bytes.Equal(ffjKeywithdrawAPIRequestType, kn) {
-						currentKey = ffjtwithdrawAPIRequestType
-						state = fflib.FFParse_want_colon
-						goto mainparse
+				case 'i':
 
-					} else if bytes.Equal(ffjKeywithdrawAPIRequestAccount, kn) {
-						currentKey = ffjtwithdrawAPIRequestAccount
+					if bytes.Equal(ffjKeytransferPayeeIdentity, kn) {
+						currentKey = ffjttransferPayeeIdentity
 						state = fflib.FFParse_want_colon
 						goto mainparse
 
-					} else if bytes.Equal(ffjKeywithdrawAPIRequestRealName, kn) {
-						currentKey = ffjtwithdrawAPIRequestRealName
+					} else if bytes.Equal(ffjKeytransferPayeeIdentityType, kn) {
+						currentKey = ffjttransferPayeeIdentityType
 						state = fflib.FFParse_want_colon
 						goto mainparse
 					}
 
-				case 'r':
+				case 'n':
 
-					if bytes.Equal(ffjKeywithdrawAPIRequestRemark, kn) {
-						currentKey = ffjtwithdrawAPIRequestRemark
+					if bytes.Equal(ffjKeytransferPayeeName, kn) {
+						currentKey = ffjttransferPayeeName
 						state = fflib.FFParse_want_colon
 						goto mainparse
 					}
 
 				}
 
-				if fflib.EqualFoldRight(ffjKeywithdrawAPIRequestRemark, kn) {
-					currentKey = ffjtwithdrawAPIRequestRemark
-					state = fflib.FFParse_want_colon
-					goto mainparse
-				}
-
-				if fflib.AsciiEqualFold(ffjKeywithdrawAPIRequestRealName, kn) {
-					currentKey = ffjtwithdrawAPIRequestRealName
-					state = fflib.FFParse_want_colon
-					goto mainparse
-				}
-
-				if fflib.SimpleLetterEqualFold(ffjKeywithdrawAPIRequestAmount, kn) {
-					currentKey = ffjtwithdrawAPIRequestAmount
+				if fflib.EqualFoldRight(ffjKeytransferPayeeBankcardExtInfo, kn) {
+					currentKey = ffjttransferPayeeBankcardExtInfo
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.AsciiEqualFold(ffjKeywithdrawAPIRequestAccount, kn) {
-					currentKey = ffjtwithdrawAPIRequestAccount
+				if fflib.SimpleLetterEqualFold(ffjKeytransferPayeeName, kn) {
+					currentKey = ffjttransferPayeeName
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.AsciiEqualFold(ffjKeywithdrawAPIRequestType, kn) {
-					currentKey = ffjtwithdrawAPIRequestType
+				if fflib.AsciiEqualFold(ffjKeytransferPayeeIdentityType, kn) {
+					currentKey = ffjttransferPayeeIdentityType
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.AsciiEqualFold(ffjKeywithdrawAPIRequestOutBizNo, kn) {
-					currentKey = ffjtwithdrawAPIRequestOutBizNo
+				if fflib.SimpleLetterEqualFold(ffjKeytransferPayeeIdentity, kn) {
+					currentKey = ffjttransferPayeeIdentity
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				currentKey = ffjtwithdrawAPIRequestnosuchkey
+				currentKey = ffjttransferPayeenosuchkey
 				state = fflib.FFParse_want_colon
 				goto mainparse
 			}
@@ -1965,25 +11029,19 @@ mainparse:
 			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
 				switch currentKey {
 
-				case ffjtwithdrawAPIRequestOutBizNo:
-					goto handle_OutBizNo
-
-				case ffjtwithdrawAPIRequestType:
-					goto handle_Type
-
-				case ffjtwithdrawAPIRequestAccount:
-					goto handle_Account
+				case ffjttransferPayeeIdentity:
+					goto handle_Identity
 
-				case ffjtwithdrawAPIRequestAmount:
-					goto handle_Amount
+				case ffjttransferPayeeIdentityType:
+					goto handle_IdentityType
 
-				case ffjtwithdrawAPIRequestRealName:
-					goto handle_RealName
+				case ffjttransferPayeeName:
+					goto handle_Name
 
-				case ffjtwithdrawAPIRequestRemark:
-					goto handle_Remark
+				case ffjttransferPayeeBankcardExtInfo:
+					goto handle_BankcardExtInfo
 
-				case ffjtwithdrawAPIRequestnosuchkey:
+				case ffjttransferPayeenosuchkey:
 					err = fs.SkipField(tok)
 					if err != nil {
 						return fs.WrapErr(err)
@@ -1995,63 +11053,11 @@ mainparse:
 				goto wantedvalue
 			}
 		}
-	}
-
-handle_OutBizNo:
-
-	/* handler: j.OutBizNo type=string kind=string quoted=false*/
-
-	{
-
-		{
-			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
-				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
-			}
-		}
-
-		if tok == fflib.FFTok_null {
-
-		} else {
-
-			outBuf := fs.Output.Bytes()
-
-			j.OutBizNo = string(string(outBuf))
-
-		}
-	}
-
-	state = fflib.FFParse_after_value
-	goto mainparse
-
-handle_Type:
-
-	/* handler: j.Type type=string kind=string quoted=false*/
-
-	{
-
-		{
-			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
-				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
-			}
-		}
-
-		if tok == fflib.FFTok_null {
-
-		} else {
-
-			outBuf := fs.Output.Bytes()
-
-			j.Type = string(string(outBuf))
-
-		}
-	}
-
-	state = fflib.FFParse_after_value
-	goto mainparse
+	}
 
-handle_Account:
+handle_Identity:
 
-	/* handler: j.Account type=string kind=string quoted=false*/
+	/* handler: j.Identity type=string kind=string quoted=false*/
 
 	{
 
@@ -2067,7 +11073,7 @@ handle_Account:
 
 			outBuf := fs.Output.Bytes()
 
-			j.Account = string(string(outBuf))
+			j.Identity = string(string(outBuf))
 
 		}
 	}
@@ -2075,9 +11081,9 @@ handle_Account:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_Amount:
+handle_IdentityType:
 
-	/* handler: j.Amount type=string kind=string quoted=false*/
+	/* handler: j.IdentityType type=string kind=string quoted=false*/
 
 	{
 
@@ -2093,7 +11099,7 @@ handle_Amount:
 
 			outBuf := fs.Output.Bytes()
 
-			j.Amount = string(string(outBuf))
+			j.IdentityType = string(string(outBuf))
 
 		}
 	}
@@ -2101,9 +11107,9 @@ handle_Amount:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_RealName:
+handle_Name:
 
-	/* handler: j.RealName type=string kind=string quoted=false*/
+	/* handler: j.Name type=string kind=string quoted=false*/
 
 	{
 
@@ -2119,7 +11125,7 @@ handle_RealName:
 
 			outBuf := fs.Output.Bytes()
 
-			j.RealName = string(string(outBuf))
+			j.Name = string(string(outBuf))
 
 		}
 	}
@@ -2127,27 +11133,27 @@ handle_RealName:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_Remark:
+handle_BankcardExtInfo:
 
-	/* handler: j.Remark type=string kind=string quoted=false*/
+	/* handler: j.BankcardExtInfo type=alipay.transferBankcard kind=struct quoted=false*/
 
 	{
-
-		{
-			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
-				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
-			}
-		}
-
 		if tok == fflib.FFTok_null {
 
-		} else {
+			j.BankcardExtInfo = nil
 
-			outBuf := fs.Output.Bytes()
+		} else {
 
-			j.Remark = string(string(outBuf))
+			if j.BankcardExtInfo == nil {
+				j.BankcardExtInfo = new(transferBankcard)
+			}
 
+			err = j.BankcardExtInfo.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+			if err != nil {
+				return err
+			}
 		}
+		state = fflib.FFParse_after_value
 	}
 
 	state = fflib.FFParse_after_value
@@ -2172,7 +11178,7 @@ done:
 }
 
 // MarshalJSON marshal bytes to json - template
-func (j *withdrawAPIResp) MarshalJSON() ([]byte, error) {
+func (j *transferQueryAPIResp) MarshalJSON() ([]byte, error) {
 	var buf fflib.Buffer
 	if j == nil {
 		buf.WriteString("null")
@@ -2186,7 +11192,7 @@ func (j *withdrawAPIResp) MarshalJSON() ([]byte, error) {
 }
 
 // MarshalJSONBuf marshal buff to json - template
-func (j *withdrawAPIResp) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+func (j *transferQueryAPIResp) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 	if j == nil {
 		buf.WriteString("null")
 		return nil
@@ -2196,7 +11202,7 @@ func (j *withdrawAPIResp) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 	_ = obj
 	_ = err
 	if j.Method != nil {
-		buf.WriteString(`{"alipay_fund_trans_toaccount_transfer_response":`)
+		buf.WriteString(`{"alipay_fund_trans_common_query_response":`)
 
 		{
 
@@ -2207,7 +11213,7 @@ func (j *withdrawAPIResp) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 
 		}
 	} else {
-		buf.WriteString(`{"alipay_fund_trans_toaccount_transfer_response":null`)
+		buf.WriteString(`{"alipay_fund_trans_common_query_response":null`)
 	}
 	buf.WriteString(`,"sign":`)
 	fflib.WriteJsonString(buf, string(j.Sign))
@@ -2216,28 +11222,28 @@ func (j *withdrawAPIResp) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 }
 
 const (
-	ffjtwithdrawAPIRespbase = iota
-	ffjtwithdrawAPIRespnosuchkey
+	ffjttransferQueryAPIRespbase = iota
+	ffjttransferQueryAPIRespnosuchkey
 
-	ffjtwithdrawAPIRespMethod
+	ffjttransferQueryAPIRespMethod
 
-	ffjtwithdrawAPIRespSign
+	ffjttransferQueryAPIRespSign
 )
 
-var ffjKeywithdrawAPIRespMethod = []byte("alipay_fund_trans_toaccount_transfer_response")
+var ffjKeytransferQueryAPIRespMethod = []byte("alipay_fund_trans_common_query_response")
 
-var ffjKeywithdrawAPIRespSign = []byte("sign")
+var ffjKeytransferQueryAPIRespSign = []byte("sign")
 
 // UnmarshalJSON umarshall json - template of ffjson
-func (j *withdrawAPIResp) UnmarshalJSON(input []byte) error {
+func (j *transferQueryAPIResp) UnmarshalJSON(input []byte) error {
 	fs := fflib.NewFFLexer(input)
 	return j.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
 }
 
 // UnmarshalJSONFFLexer fast json unmarshall - template ffjson
-func (j *withdrawAPIResp) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+func (j *transferQueryAPIResp) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
 	var err error
-	currentKey := ffjtwithdrawAPIRespbase
+	currentKey := ffjttransferQueryAPIRespbase
 	_ = currentKey
 	tok := fflib.FFTok_init
 	wantedTok := fflib.FFTok_init
@@ -2283,7 +11289,7 @@ mainparse:
 			kn := fs.Output.Bytes()
 			if len(kn) <= 0 {
 				// "" case. hrm.
-				currentKey = ffjtwithdrawAPIRespnosuchkey
+				currentKey = ffjttransferQueryAPIRespnosuchkey
 				state = fflib.FFParse_want_colon
 				goto mainparse
 			} else {
@@ -2291,35 +11297,35 @@ mainparse:
 
 				case 'a':
 
-					if bytes.Equal(ffjKeywithdrawAPIRespMethod, kn) {
-						currentKey = ffjtwithdrawAPIRespMethod
+					if bytes.Equal(ffjKeytransferQueryAPIRespMethod, kn) {
+						currentKey = ffjttransferQueryAPIRespMethod
 						state = fflib.FFParse_want_colon
 						goto mainparse
 					}
 
 				case 's':
 
-					if bytes.Equal(ffjKeywithdrawAPIRespSign, kn) {
-						currentKey = ffjtwithdrawAPIRespSign
+					if bytes.Equal(ffjKeytransferQueryAPIRespSign, kn) {
+						currentKey = ffjttransferQueryAPIRespSign
 						state = fflib.FFParse_want_colon
 						goto mainparse
 					}
 
 				}
 
-				if fflib.EqualFoldRight(ffjKeywithdrawAPIRespSign, kn) {
-					currentKey = ffjtwithdrawAPIRespSign
+				if fflib.EqualFoldRight(ffjKeytransferQueryAPIRespSign, kn) {
+					currentKey = ffjttransferQueryAPIRespSign
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.EqualFoldRight(ffjKeywithdrawAPIRespMethod, kn) {
-					currentKey = ffjtwithdrawAPIRespMethod
+				if fflib.EqualFoldRight(ffjKeytransferQueryAPIRespMethod, kn) {
+					currentKey = ffjttransferQueryAPIRespMethod
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				currentKey = ffjtwithdrawAPIRespnosuchkey
+				currentKey = ffjttransferQueryAPIRespnosuchkey
 				state = fflib.FFParse_want_colon
 				goto mainparse
 			}
@@ -2336,13 +11342,13 @@ mainparse:
 			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
 				switch currentKey {
 
-				case ffjtwithdrawAPIRespMethod:
+				case ffjttransferQueryAPIRespMethod:
 					goto handle_Method
 
-				case ffjtwithdrawAPIRespSign:
+				case ffjttransferQueryAPIRespSign:
 					goto handle_Sign
 
-				case ffjtwithdrawAPIRespnosuchkey:
+				case ffjttransferQueryAPIRespnosuchkey:
 					err = fs.SkipField(tok)
 					if err != nil {
 						return fs.WrapErr(err)
@@ -2358,7 +11364,7 @@ mainparse:
 
 handle_Method:
 
-	/* handler: j.Method type=alipay.withdrawAPIResponse kind=struct quoted=false*/
+	/* handler: j.Method type=alipay.transferQueryAPIResponse kind=struct quoted=false*/
 
 	{
 		if tok == fflib.FFTok_null {
@@ -2368,7 +11374,7 @@ handle_Method:
 		} else {
 
 			if j.Method == nil {
-				j.Method = new(withdrawAPIResponse)
+				j.Method = new(transferQueryAPIResponse)
 			}
 
 			err = j.Method.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
@@ -2427,7 +11433,7 @@ done:
 }
 
 // MarshalJSON marshal bytes to json - template
-func (j *withdrawAPIResponse) MarshalJSON() ([]byte, error) {
+func (j *transferQueryAPIResponse) MarshalJSON() ([]byte, error) {
 	var buf fflib.Buffer
 	if j == nil {
 		buf.WriteString("null")
@@ -2441,7 +11447,7 @@ func (j *withdrawAPIResponse) MarshalJSON() ([]byte, error) {
 }
 
 // MarshalJSONBuf marshal buff to json - template
-func (j *withdrawAPIResponse) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+func (j *transferQueryAPIResponse) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 	if j == nil {
 		buf.WriteString("null")
 		return nil
@@ -2458,65 +11464,101 @@ func (j *withdrawAPIResponse) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 	fflib.WriteJsonString(buf, string(j.SubCode))
 	buf.WriteString(`,"sub_msg":`)
 	fflib.WriteJsonString(buf, string(j.SubMsg))
-	buf.WriteString(`,"sign":`)
-	fflib.WriteJsonString(buf, string(j.Sign))
-	buf.WriteString(`,"out_biz_no":`)
-	fflib.WriteJsonString(buf, string(j.OutBizNo))
 	buf.WriteString(`,"order_id":`)
 	fflib.WriteJsonString(buf, string(j.OrderID))
+	buf.WriteString(`,"pay_fund_order_id":`)
+	fflib.WriteJsonString(buf, string(j.PayFundOrderID))
+	buf.WriteString(`,"out_biz_no":`)
+	fflib.WriteJsonString(buf, string(j.OutBizNo))
+	buf.WriteString(`,"trans_amount":`)
+	fflib.WriteJsonString(buf, string(j.TransAmount))
+	buf.WriteString(`,"status":`)
+	fflib.WriteJsonString(buf, string(j.Status))
 	buf.WriteString(`,"pay_date":`)
 	fflib.WriteJsonString(buf, string(j.PayDate))
+	buf.WriteString(`,"arrival_time_end":`)
+	fflib.WriteJsonString(buf, string(j.ArrivalTimeEnd))
+	buf.WriteString(`,"order_fee":`)
+	fflib.WriteJsonString(buf, string(j.OrderFee))
+	buf.WriteString(`,"error_code":`)
+	fflib.WriteJsonString(buf, string(j.ErrorCode))
+	buf.WriteString(`,"fail_reason":`)
+	fflib.WriteJsonString(buf, string(j.FailReason))
 	buf.WriteByte('}')
 	return nil
 }
 
 const (
-	ffjtwithdrawAPIResponsebase = iota
-	ffjtwithdrawAPIResponsenosuchkey
+	ffjttransferQueryAPIResponsebase = iota
+	ffjttransferQueryAPIResponsenosuchkey
+
+	ffjttransferQueryAPIResponseCode
+
+	ffjttransferQueryAPIResponseMsg
+
+	ffjttransferQueryAPIResponseSubCode
+
+	ffjttransferQueryAPIResponseSubMsg
+
+	ffjttransferQueryAPIResponseOrderID
+
+	ffjttransferQueryAPIResponsePayFundOrderID
 
-	ffjtwithdrawAPIResponseCode
+	ffjttransferQueryAPIResponseOutBizNo
 
-	ffjtwithdrawAPIResponseMsg
+	ffjttransferQueryAPIResponseTransAmount
 
-	ffjtwithdrawAPIResponseSubCode
+	ffjttransferQueryAPIResponseStatus
 
-	ffjtwithdrawAPIResponseSubMsg
+	ffjttransferQueryAPIResponsePayDate
 
-	ffjtwithdrawAPIResponseSign
+	ffjttransferQueryAPIResponseArrivalTimeEnd
 
-	ffjtwithdrawAPIResponseOutBizNo
+	ffjttransferQueryAPIResponseOrderFee
 
-	ffjtwithdrawAPIResponseOrderID
+	ffjttransferQueryAPIResponseErrorCode
 
-	ffjtwithdrawAPIResponsePayDate
+	ffjttransferQueryAPIResponseFailReason
 )
 
-var ffjKeywithdrawAPIResponseCode = []byte("code")
+var ffjKeytransferQueryAPIResponseCode = []byte("code")
 
-var ffjKeywithdrawAPIResponseMsg = []byte("msg")
+var ffjKeytransferQueryAPIResponseMsg = []byte("msg")
 
-var ffjKeywithdrawAPIResponseSubCode = []byte("sub_code")
+var ffjKeytransferQueryAPIResponseSubCode = []byte("sub_code")
 
-var ffjKeywithdrawAPIResponseSubMsg = []byte("sub_msg")
+var ffjKeytransferQueryAPIResponseSubMsg = []byte("sub_msg")
 
-var ffjKeywithdrawAPIResponseSign = []byte("sign")
+var ffjKeytransferQueryAPIResponseOrderID = []byte("order_id")
 
-var ffjKeywithdrawAPIResponseOutBizNo = []byte("out_biz_no")
+var ffjKeytransferQueryAPIResponsePayFundOrderID = []byte("pay_fund_order_id")
 
-var ffjKeywithdrawAPIResponseOrderID = []byte("order_id")
+var ffjKeytransferQueryAPIResponseOutBizNo = []byte("out_biz_no")
 
-var ffjKeywithdrawAPIResponsePayDate = []byte("pay_date")
+var ffjKeytransferQueryAPIResponseTransAmount = []byte("trans_amount")
+
+var ffjKeytransferQueryAPIResponseStatus = []byte("status")
+
+var ffjKeytransferQueryAPIResponsePayDate = []byte("pay_date")
+
+var ffjKeytransferQueryAPIResponseArrivalTimeEnd = []byte("arrival_time_end")
+
+var ffjKeytransferQueryAPIResponseOrderFee = []byte("order_fee")
+
+var ffjKeytransferQueryAPIResponseErrorCode = []byte("error_code")
+
+var ffjKeytransferQueryAPIResponseFailReason = []byte("fail_reason")
 
 // UnmarshalJSON umarshall json - template of ffjson
-func (j *withdrawAPIResponse) UnmarshalJSON(input []byte) error {
+func (j *transferQueryAPIResponse) UnmarshalJSON(input []byte) error {
 	fs := fflib.NewFFLexer(input)
 	return j.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
 }
 
 // UnmarshalJSONFFLexer fast json unmarshall - template ffjson
-func (j *withdrawAPIResponse) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+func (j *transferQueryAPIResponse) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
 	var err error
-	currentKey := ffjtwithdrawAPIResponsebase
+	currentKey := ffjttransferQueryAPIResponsebase
 	_ = currentKey
 	tok := fflib.FFTok_init
 	wantedTok := fflib.FFTok_init
@@ -2562,118 +11604,196 @@ mainparse:
 			kn := fs.Output.Bytes()
 			if len(kn) <= 0 {
 				// "" case. hrm.
-				currentKey = ffjtwithdrawAPIResponsenosuchkey
+				currentKey = ffjttransferQueryAPIResponsenosuchkey
 				state = fflib.FFParse_want_colon
 				goto mainparse
 			} else {
 				switch kn[0] {
 
+				case 'a':
+
+					if bytes.Equal(ffjKeytransferQueryAPIResponseArrivalTimeEnd, kn) {
+						currentKey = ffjttransferQueryAPIResponseArrivalTimeEnd
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
 				case 'c':
 
-					if bytes.Equal(ffjKeywithdrawAPIResponseCode, kn) {
-						currentKey = ffjtwithdrawAPIResponseCode
+					if bytes.Equal(ffjKeytransferQueryAPIResponseCode, kn) {
+						currentKey = ffjttransferQueryAPIResponseCode
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'e':
+
+					if bytes.Equal(ffjKeytransferQueryAPIResponseErrorCode, kn) {
+						currentKey = ffjttransferQueryAPIResponseErrorCode
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'f':
+
+					if bytes.Equal(ffjKeytransferQueryAPIResponseFailReason, kn) {
+						currentKey = ffjttransferQueryAPIResponseFailReason
 						state = fflib.FFParse_want_colon
 						goto mainparse
 					}
 
 				case 'm':
 
-					if bytes.Equal(ffjKeywithdrawAPIResponseMsg, kn) {
-						currentKey = ffjtwithdrawAPIResponseMsg
+					if bytes.Equal(ffjKeytransferQueryAPIResponseMsg, kn) {
+						currentKey = ffjttransferQueryAPIResponseMsg
 						state = fflib.FFParse_want_colon
 						goto mainparse
 					}
 
 				case 'o':
 
-					if bytes.Equal(ffjKeywithdrawAPIResponseOutBizNo, kn) {
-						currentKey = ffjtwithdrawAPIResponseOutBizNo
+					if bytes.Equal(ffjKeytransferQueryAPIResponseOrderID, kn) {
+						currentKey = ffjttransferQueryAPIResponseOrderID
 						state = fflib.FFParse_want_colon
 						goto mainparse
 
-					} else if bytes.Equal(ffjKeywithdrawAPIResponseOrderID, kn) {
-						currentKey = ffjtwithdrawAPIResponseOrderID
+					} else if bytes.Equal(ffjKeytransferQueryAPIResponseOutBizNo, kn) {
+						currentKey = ffjttransferQueryAPIResponseOutBizNo
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeytransferQueryAPIResponseOrderFee, kn) {
+						currentKey = ffjttransferQueryAPIResponseOrderFee
 						state = fflib.FFParse_want_colon
 						goto mainparse
 					}
 
 				case 'p':
 
-					if bytes.Equal(ffjKeywithdrawAPIResponsePayDate, kn) {
-						currentKey = ffjtwithdrawAPIResponsePayDate
+					if bytes.Equal(ffjKeytransferQueryAPIResponsePayFundOrderID, kn) {
+						currentKey = ffjttransferQueryAPIResponsePayFundOrderID
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeytransferQueryAPIResponsePayDate, kn) {
+						currentKey = ffjttransferQueryAPIResponsePayDate
 						state = fflib.FFParse_want_colon
 						goto mainparse
 					}
 
 				case 's':
 
-					if bytes.Equal(ffjKeywithdrawAPIResponseSubCode, kn) {
-						currentKey = ffjtwithdrawAPIResponseSubCode
+					if bytes.Equal(ffjKeytransferQueryAPIResponseSubCode, kn) {
+						currentKey = ffjttransferQueryAPIResponseSubCode
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeytransferQueryAPIResponseSubMsg, kn) {
+						currentKey = ffjttransferQueryAPIResponseSubMsg
 						state = fflib.FFParse_want_colon
 						goto mainparse
 
-					} else if bytes.Equal(ffjKeywithdrawAPIResponseSubMsg, kn) {
-						currentKey = ffjtwithdrawAPIResponseSubMsg
+					} else if bytes.Equal(ffjKeytransferQueryAPIResponseStatus, kn) {
+						currentKey = ffjttransferQueryAPIResponseStatus
 						state = fflib.FFParse_want_colon
 						goto mainparse
+					}
+
+				case 't':
 
-					} else if bytes.Equal(ffjKeywithdrawAPIResponseSign, kn) {
-						currentKey = ffjtwithdrawAPIResponseSign
+					if bytes.Equal(ffjKeytransferQueryAPIResponseTransAmount, kn) {
+						currentKey = ffjttransferQueryAPIResponseTransAmount
 						state = fflib.FFParse_want_colon
 						goto mainparse
 					}
 
 				}
 
-				if fflib.AsciiEqualFold(ffjKeywithdrawAPIResponsePayDate, kn) {
-					currentKey = ffjtwithdrawAPIResponsePayDate
+				if fflib.EqualFoldRight(ffjKeytransferQueryAPIResponseFailReason, kn) {
+					currentKey = ffjttransferQueryAPIResponseFailReason
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.AsciiEqualFold(ffjKeytransferQueryAPIResponseErrorCode, kn) {
+					currentKey = ffjttransferQueryAPIResponseErrorCode
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.AsciiEqualFold(ffjKeytransferQueryAPIResponseOrderFee, kn) {
+					currentKey = ffjttransferQueryAPIResponseOrderFee
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.AsciiEqualFold(ffjKeytransferQueryAPIResponseArrivalTimeEnd, kn) {
+					currentKey = ffjttransferQueryAPIResponseArrivalTimeEnd
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.AsciiEqualFold(ffjKeytransferQueryAPIResponsePayDate, kn) {
+					currentKey = ffjttransferQueryAPIResponsePayDate
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeytransferQueryAPIResponseStatus, kn) {
+					currentKey = ffjttransferQueryAPIResponseStatus
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeytransferQueryAPIResponseTransAmount, kn) {
+					currentKey = ffjttransferQueryAPIResponseTransAmount
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.AsciiEqualFold(ffjKeywithdrawAPIResponseOrderID, kn) {
-					currentKey = ffjtwithdrawAPIResponseOrderID
+				if fflib.AsciiEqualFold(ffjKeytransferQueryAPIResponseOutBizNo, kn) {
+					currentKey = ffjttransferQueryAPIResponseOutBizNo
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.AsciiEqualFold(ffjKeywithdrawAPIResponseOutBizNo, kn) {
-					currentKey = ffjtwithdrawAPIResponseOutBizNo
+				if fflib.AsciiEqualFold(ffjKeytransferQueryAPIResponsePayFundOrderID, kn) {
+					currentKey = ffjttransferQueryAPIResponsePayFundOrderID
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.EqualFoldRight(ffjKeywithdrawAPIResponseSign, kn) {
-					currentKey = ffjtwithdrawAPIResponseSign
+				if fflib.AsciiEqualFold(ffjKeytransferQueryAPIResponseOrderID, kn) {
+					currentKey = ffjttransferQueryAPIResponseOrderID
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.EqualFoldRight(ffjKeywithdrawAPIResponseSubMsg, kn) {
-					currentKey = ffjtwithdrawAPIResponseSubMsg
+				if fflib.EqualFoldRight(ffjKeytransferQueryAPIResponseSubMsg, kn) {
+					currentKey = ffjttransferQueryAPIResponseSubMsg
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.EqualFoldRight(ffjKeywithdrawAPIResponseSubCode, kn) {
-					currentKey = ffjtwithdrawAPIResponseSubCode
+				if fflib.EqualFoldRight(ffjKeytransferQueryAPIResponseSubCode, kn) {
+					currentKey = ffjttransferQueryAPIResponseSubCode
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.EqualFoldRight(ffjKeywithdrawAPIResponseMsg, kn) {
-					currentKey = ffjtwithdrawAPIResponseMsg
+				if fflib.EqualFoldRight(ffjKeytransferQueryAPIResponseMsg, kn) {
+					currentKey = ffjttransferQueryAPIResponseMsg
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.SimpleLetterEqualFold(ffjKeywithdrawAPIResponseCode, kn) {
-					currentKey = ffjtwithdrawAPIResponseCode
+				if fflib.SimpleLetterEqualFold(ffjKeytransferQueryAPIResponseCode, kn) {
+					currentKey = ffjttransferQueryAPIResponseCode
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				currentKey = ffjtwithdrawAPIResponsenosuchkey
+				currentKey = ffjttransferQueryAPIResponsenosuchkey
 				state = fflib.FFParse_want_colon
 				goto mainparse
 			}
@@ -2690,31 +11810,49 @@ mainparse:
 			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
 				switch currentKey {
 
-				case ffjtwithdrawAPIResponseCode:
+				case ffjttransferQueryAPIResponseCode:
 					goto handle_Code
 
-				case ffjtwithdrawAPIResponseMsg:
+				case ffjttransferQueryAPIResponseMsg:
 					goto handle_Msg
 
-				case ffjtwithdrawAPIResponseSubCode:
+				case ffjttransferQueryAPIResponseSubCode:
 					goto handle_SubCode
 
-				case ffjtwithdrawAPIResponseSubMsg:
+				case ffjttransferQueryAPIResponseSubMsg:
 					goto handle_SubMsg
 
-				case ffjtwithdrawAPIResponseSign:
-					goto handle_Sign
+				case ffjttransferQueryAPIResponseOrderID:
+					goto handle_OrderID
 
-				case ffjtwithdrawAPIResponseOutBizNo:
+				case ffjttransferQueryAPIResponsePayFundOrderID:
+					goto handle_PayFundOrderID
+
+				case ffjttransferQueryAPIResponseOutBizNo:
 					goto handle_OutBizNo
 
-				case ffjtwithdrawAPIResponseOrderID:
-					goto handle_OrderID
+				case ffjttransferQueryAPIResponseTransAmount:
+					goto handle_TransAmount
 
-				case ffjtwithdrawAPIResponsePayDate:
+				case ffjttransferQueryAPIResponseStatus:
+					goto handle_Status
+
+				case ffjttransferQueryAPIResponsePayDate:
 					goto handle_PayDate
 
-				case ffjtwithdrawAPIResponsenosuchkey:
+				case ffjttransferQueryAPIResponseArrivalTimeEnd:
+					goto handle_ArrivalTimeEnd
+
+				case ffjttransferQueryAPIResponseOrderFee:
+					goto handle_OrderFee
+
+				case ffjttransferQueryAPIResponseErrorCode:
+					goto handle_ErrorCode
+
+				case ffjttransferQueryAPIResponseFailReason:
+					goto handle_FailReason
+
+				case ffjttransferQueryAPIResponsenosuchkey:
 					err = fs.SkipField(tok)
 					if err != nil {
 						return fs.WrapErr(err)
@@ -2832,9 +11970,9 @@ handle_SubMsg:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_Sign:
+handle_OrderID:
 
-	/* handler: j.Sign type=string kind=string quoted=false*/
+	/* handler: j.OrderID type=string kind=string quoted=false*/
 
 	{
 
@@ -2850,7 +11988,7 @@ handle_Sign:
 
 			outBuf := fs.Output.Bytes()
 
-			j.Sign = string(string(outBuf))
+			j.OrderID = string(string(outBuf))
 
 		}
 	}
@@ -2858,9 +11996,9 @@ handle_Sign:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_OutBizNo:
+handle_PayFundOrderID:
 
-	/* handler: j.OutBizNo type=string kind=string quoted=false*/
+	/* handler: j.PayFundOrderID type=string kind=string quoted=false*/
 
 	{
 
@@ -2876,7 +12014,7 @@ handle_OutBizNo:
 
 			outBuf := fs.Output.Bytes()
 
-			j.OutBizNo = string(string(outBuf))
+			j.PayFundOrderID = string(string(outBuf))
 
 		}
 	}
@@ -2884,9 +12022,9 @@ handle_OutBizNo:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_OrderID:
+handle_OutBizNo:
 
-	/* handler: j.OrderID type=string kind=string quoted=false*/
+	/* handler: j.OutBizNo type=string kind=string quoted=false*/
 
 	{
 
@@ -2902,7 +12040,7 @@ handle_OrderID:
 
 			outBuf := fs.Output.Bytes()
 
-			j.OrderID = string(string(outBuf))
+			j.OutBizNo = string(string(outBuf))
 
 		}
 	}
@@ -2910,9 +12048,9 @@ handle_OrderID:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_PayDate:
+handle_TransAmount:
 
-	/* handler: j.PayDate type=string kind=string quoted=false*/
+	/* handler: j.TransAmount type=string kind=string quoted=false*/
 
 	{
 
@@ -2928,7 +12066,7 @@ handle_PayDate:
 
 			outBuf := fs.Output.Bytes()
 
-			j.PayDate = string(string(outBuf))
+			j.TransAmount = string(string(outBuf))
 
 		}
 	}
@@ -2936,238 +12074,139 @@ handle_PayDate:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-wantedvalue:
-	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
-wrongtokenerror:
-	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
-tokerror:
-	if fs.BigError != nil {
-		return fs.WrapErr(fs.BigError)
-	}
-	err = fs.Error.ToError()
-	if err != nil {
-		return fs.WrapErr(err)
-	}
-	panic("ffjson-generated: unreachable, please report bug.")
-done:
-
-	return nil
-}
+handle_Status:
 
-// MarshalJSON marshal bytes to json - template
-func (j *withdrawQueryAPIResp) MarshalJSON() ([]byte, error) {
-	var buf fflib.Buffer
-	if j == nil {
-		buf.WriteString("null")
-		return buf.Bytes(), nil
-	}
-	err := j.MarshalJSONBuf(&buf)
-	if err != nil {
-		return nil, err
-	}
-	return buf.Bytes(), nil
-}
+	/* handler: j.Status type=string kind=string quoted=false*/
 
-// MarshalJSONBuf marshal buff to json - template
-func (j *withdrawQueryAPIResp) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
-	if j == nil {
-		buf.WriteString("null")
-		return nil
-	}
-	var err error
-	var obj []byte
-	_ = obj
-	_ = err
-	if j.Method != nil {
-		buf.WriteString(`{"alipay_fund_trans_order_query_response":`)
+	{
 
 		{
-
-			err = j.Method.MarshalJSONBuf(buf)
-			if err != nil {
-				return err
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
 			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.Status = string(string(outBuf))
 
 		}
-	} else {
-		buf.WriteString(`{"alipay_fund_trans_order_query_response":null`)
 	}
-	buf.WriteString(`,"sign":`)
-	fflib.WriteJsonString(buf, string(j.Sign))
-	buf.WriteByte('}')
-	return nil
-}
 
-const (
-	ffjtwithdrawQueryAPIRespbase = iota
-	ffjtwithdrawQueryAPIRespnosuchkey
+	state = fflib.FFParse_after_value
+	goto mainparse
 
-	ffjtwithdrawQueryAPIRespMethod
+handle_PayDate:
 
-	ffjtwithdrawQueryAPIRespSign
-)
+	/* handler: j.PayDate type=string kind=string quoted=false*/
 
-var ffjKeywithdrawQueryAPIRespMethod = []byte("alipay_fund_trans_order_query_response")
+	{
 
-var ffjKeywithdrawQueryAPIRespSign = []byte("sign")
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
 
-// UnmarshalJSON umarshall json - template of ffjson
-func (j *withdrawQueryAPIResp) UnmarshalJSON(input []byte) error {
-	fs := fflib.NewFFLexer(input)
-	return j.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
-}
+		if tok == fflib.FFTok_null {
 
-// UnmarshalJSONFFLexer fast json unmarshall - template ffjson
-func (j *withdrawQueryAPIResp) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
-	var err error
-	currentKey := ffjtwithdrawQueryAPIRespbase
-	_ = currentKey
-	tok := fflib.FFTok_init
-	wantedTok := fflib.FFTok_init
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.PayDate = string(string(outBuf))
 
-mainparse:
-	for {
-		tok = fs.Scan()
-		//	println(fmt.Sprintf("debug: tok: %v  state: %v", tok, state))
-		if tok == fflib.FFTok_error {
-			goto tokerror
 		}
+	}
 
-		switch state {
+	state = fflib.FFParse_after_value
+	goto mainparse
 
-		case fflib.FFParse_map_start:
-			if tok != fflib.FFTok_left_bracket {
-				wantedTok = fflib.FFTok_left_bracket
-				goto wrongtokenerror
-			}
-			state = fflib.FFParse_want_key
-			continue
+handle_ArrivalTimeEnd:
 
-		case fflib.FFParse_after_value:
-			if tok == fflib.FFTok_comma {
-				state = fflib.FFParse_want_key
-			} else if tok == fflib.FFTok_right_bracket {
-				goto done
-			} else {
-				wantedTok = fflib.FFTok_comma
-				goto wrongtokenerror
-			}
+	/* handler: j.ArrivalTimeEnd type=string kind=string quoted=false*/
 
-		case fflib.FFParse_want_key:
-			// json {} ended. goto exit. woo.
-			if tok == fflib.FFTok_right_bracket {
-				goto done
-			}
-			if tok != fflib.FFTok_string {
-				wantedTok = fflib.FFTok_string
-				goto wrongtokenerror
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
 			}
+		}
 
-			kn := fs.Output.Bytes()
-			if len(kn) <= 0 {
-				// "" case. hrm.
-				currentKey = ffjtwithdrawQueryAPIRespnosuchkey
-				state = fflib.FFParse_want_colon
-				goto mainparse
-			} else {
-				switch kn[0] {
+		if tok == fflib.FFTok_null {
 
-				case 'a':
+		} else {
 
-					if bytes.Equal(ffjKeywithdrawQueryAPIRespMethod, kn) {
-						currentKey = ffjtwithdrawQueryAPIRespMethod
-						state = fflib.FFParse_want_colon
-						goto mainparse
-					}
+			outBuf := fs.Output.Bytes()
 
-				case 's':
+			j.ArrivalTimeEnd = string(string(outBuf))
 
-					if bytes.Equal(ffjKeywithdrawQueryAPIRespSign, kn) {
-						currentKey = ffjtwithdrawQueryAPIRespSign
-						state = fflib.FFParse_want_colon
-						goto mainparse
-					}
+		}
+	}
 
-				}
+	state = fflib.FFParse_after_value
+	goto mainparse
 
-				if fflib.EqualFoldRight(ffjKeywithdrawQueryAPIRespSign, kn) {
-					currentKey = ffjtwithdrawQueryAPIRespSign
-					state = fflib.FFParse_want_colon
-					goto mainparse
-				}
+handle_OrderFee:
 
-				if fflib.EqualFoldRight(ffjKeywithdrawQueryAPIRespMethod, kn) {
-					currentKey = ffjtwithdrawQueryAPIRespMethod
-					state = fflib.FFParse_want_colon
-					goto mainparse
-				}
+	/* handler: j.OrderFee type=string kind=string quoted=false*/
 
-				currentKey = ffjtwithdrawQueryAPIRespnosuchkey
-				state = fflib.FFParse_want_colon
-				goto mainparse
-			}
+	{
 
-		case fflib.FFParse_want_colon:
-			if tok != fflib.FFTok_colon {
-				wantedTok = fflib.FFTok_colon
-				goto wrongtokenerror
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
 			}
-			state = fflib.FFParse_want_value
-			continue
-		case fflib.FFParse_want_value:
+		}
 
-			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
-				switch currentKey {
+		if tok == fflib.FFTok_null {
 
-				case ffjtwithdrawQueryAPIRespMethod:
-					goto handle_Method
+		} else {
 
-				case ffjtwithdrawQueryAPIRespSign:
-					goto handle_Sign
+			outBuf := fs.Output.Bytes()
+
+			j.OrderFee = string(string(outBuf))
 
-				case ffjtwithdrawQueryAPIRespnosuchkey:
-					err = fs.SkipField(tok)
-					if err != nil {
-						return fs.WrapErr(err)
-					}
-					state = fflib.FFParse_after_value
-					goto mainparse
-				}
-			} else {
-				goto wantedvalue
-			}
 		}
 	}
 
-handle_Method:
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_ErrorCode:
 
-	/* handler: j.Method type=alipay.withdrawQueryAPIResponse kind=struct quoted=false*/
+	/* handler: j.ErrorCode type=string kind=string quoted=false*/
 
 	{
-		if tok == fflib.FFTok_null {
 
-			j.Method = nil
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
 
 		} else {
 
-			if j.Method == nil {
-				j.Method = new(withdrawQueryAPIResponse)
-			}
+			outBuf := fs.Output.Bytes()
+
+			j.ErrorCode = string(string(outBuf))
 
-			err = j.Method.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
-			if err != nil {
-				return err
-			}
 		}
-		state = fflib.FFParse_after_value
 	}
 
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_Sign:
+handle_FailReason:
 
-	/* handler: j.Sign type=string kind=string quoted=false*/
+	/* handler: j.FailReason type=string kind=string quoted=false*/
 
 	{
 
@@ -3183,7 +12222,7 @@ handle_Sign:
 
 			outBuf := fs.Output.Bytes()
 
-			j.Sign = string(string(outBuf))
+			j.FailReason = string(string(outBuf))
 
 		}
 	}
@@ -3210,7 +12249,7 @@ done:
 }
 
 // MarshalJSON marshal bytes to json - template
-func (j *withdrawQueryAPIResponse) MarshalJSON() ([]byte, error) {
+func (j *withdrawNotifyContent) MarshalJSON() ([]byte, error) {
 	var buf fflib.Buffer
 	if j == nil {
 		buf.WriteString("null")
@@ -3224,7 +12263,7 @@ func (j *withdrawQueryAPIResponse) MarshalJSON() ([]byte, error) {
 }
 
 // MarshalJSONBuf marshal buff to json - template
-func (j *withdrawQueryAPIResponse) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+func (j *withdrawNotifyContent) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 	if j == nil {
 		buf.WriteString("null")
 		return nil
@@ -3233,91 +12272,79 @@ func (j *withdrawQueryAPIResponse) MarshalJSONBuf(buf fflib.EncodingBuffer) erro
 	var obj []byte
 	_ = obj
 	_ = err
-	buf.WriteString(`{"code":`)
-	fflib.WriteJsonString(buf, string(j.Code))
-	buf.WriteString(`,"msg":`)
-	fflib.WriteJsonString(buf, string(j.Msg))
-	buf.WriteString(`,"sub_code":`)
-	fflib.WriteJsonString(buf, string(j.SubCode))
-	buf.WriteString(`,"sub_msg":`)
-	fflib.WriteJsonString(buf, string(j.SubMsg))
-	buf.WriteString(`,"sign":`)
-	fflib.WriteJsonString(buf, string(j.Sign))
+	buf.WriteString(`{"out_biz_no":`)
+	fflib.WriteJsonString(buf, string(j.OutBizNo))
 	buf.WriteString(`,"order_id":`)
 	fflib.WriteJsonString(buf, string(j.OrderID))
-	buf.WriteString(`,"pay_date":`)
-	fflib.WriteJsonString(buf, string(j.PayDate))
 	buf.WriteString(`,"status":`)
 	fflib.WriteJsonString(buf, string(j.Status))
-	buf.WriteString(`,"out_biz_no":`)
-	fflib.WriteJsonString(buf, string(j.OutBizNo))
-	buf.WriteString(`,"fail_reason":`)
-	fflib.WriteJsonString(buf, string(j.FailReason))
+	buf.WriteString(`,"trans_amount":`)
+	fflib.WriteJsonString(buf, string(j.TransAmount))
+	buf.WriteString(`,"pay_date":`)
+	fflib.WriteJsonString(buf, string(j.PayDate))
 	buf.WriteString(`,"error_code":`)
 	fflib.WriteJsonString(buf, string(j.ErrorCode))
+	buf.WriteString(`,"fail_reason":`)
+	fflib.WriteJsonString(buf, string(j.FailReason))
+	buf.WriteString(`,"product_code":`)
+	fflib.WriteJsonString(buf, string(j.ProductCode))
+	buf.WriteString(`,"biz_scene":`)
+	fflib.WriteJsonString(buf, string(j.BizScene))
 	buf.WriteByte('}')
 	return nil
 }
 
 const (
-	ffjtwithdrawQueryAPIResponsebase = iota
-	ffjtwithdrawQueryAPIResponsenosuchkey
-
-	ffjtwithdrawQueryAPIResponseCode
+	ffjtwithdrawNotifyContentbase = iota
+	ffjtwithdrawNotifyContentnosuchkey
 
-	ffjtwithdrawQueryAPIResponseMsg
+	ffjtwithdrawNotifyContentOutBizNo
 
-	ffjtwithdrawQueryAPIResponseSubCode
+	ffjtwithdrawNotifyContentOrderID
 
-	ffjtwithdrawQueryAPIResponseSubMsg
+	ffjtwithdrawNotifyContentStatus
 
-	ffjtwithdrawQueryAPIResponseSign
+	ffjtwithdrawNotifyContentTransAmount
 
-	ffjtwithdrawQueryAPIResponseOrderID
+	ffjtwithdrawNotifyContentPayDate
 
-	ffjtwithdrawQueryAPIResponsePayDate
+	ffjtwithdrawNotifyContentErrorCode
 
-	ffjtwithdrawQueryAPIResponseStatus
+	ffjtwithdrawNotifyContentFailReason
 
-	ffjtwithdrawQueryAPIResponseOutBizNo
+	ffjtwithdrawNotifyContentProductCode
 
-	ffjtwithdrawQueryAPIResponseFailReason
-
-	ffjtwithdrawQueryAPIResponseErrorCode
+	ffjtwithdrawNotifyContentBizScene
 )
 
-var ffjKeywithdrawQueryAPIResponseCode = []byte("code")
-
-var ffjKeywithdrawQueryAPIResponseMsg = []byte("msg")
+var ffjKeywithdrawNotifyContentOutBizNo = []byte("out_biz_no")
 
-var ffjKeywithdrawQueryAPIResponseSubCode = []byte("sub_code")
+var ffjKeywithdrawNotifyContentOrderID = []byte("order_id")
 
-var ffjKeywithdrawQueryAPIResponseSubMsg = []byte("sub_msg")
+var ffjKeywithdrawNotifyContentStatus = []byte("status")
 
-var ffjKeywithdrawQueryAPIResponseSign = []byte("sign")
+var ffjKeywithdrawNotifyContentTransAmount = []byte("trans_amount")
 
-var ffjKeywithdrawQueryAPIResponseOrderID = []byte("order_id")
+var ffjKeywithdrawNotifyContentPayDate = []byte("pay_date")
 
-var ffjKeywithdrawQueryAPIResponsePayDate = []byte("pay_date")
+var ffjKeywithdrawNotifyContentErrorCode = []byte("error_code")
 
-var ffjKeywithdrawQueryAPIResponseStatus = []byte("status")
+var ffjKeywithdrawNotifyContentFailReason = []byte("fail_reason")
 
-var ffjKeywithdrawQueryAPIResponseOutBizNo = []byte("out_biz_no")
+var ffjKeywithdrawNotifyContentProductCode = []byte("product_code")
 
-var ffjKeywithdrawQueryAPIResponseFailReason = []byte("fail_reason")
-
-var ffjKeywithdrawQueryAPIResponseErrorCode = []byte("error_code")
+var ffjKeywithdrawNotifyContentBizScene = []byte("biz_scene")
 
 // UnmarshalJSON umarshall json - template of ffjson
-func (j *withdrawQueryAPIResponse) UnmarshalJSON(input []byte) error {
+func (j *withdrawNotifyContent) UnmarshalJSON(input []byte) error {
 	fs := fflib.NewFFLexer(input)
 	return j.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
 }
 
 // UnmarshalJSONFFLexer fast json unmarshall - template ffjson
-func (j *withdrawQueryAPIResponse) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+func (j *withdrawNotifyContent) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
 	var err error
-	currentKey := ffjtwithdrawQueryAPIResponsebase
+	currentKey := ffjtwithdrawNotifyContentbase
 	_ = currentKey
 	tok := fflib.FFTok_init
 	wantedTok := fflib.FFTok_init
@@ -3363,157 +12390,135 @@ mainparse:
 			kn := fs.Output.Bytes()
 			if len(kn) <= 0 {
 				// "" case. hrm.
-				currentKey = ffjtwithdrawQueryAPIResponsenosuchkey
+				currentKey = ffjtwithdrawNotifyContentnosuchkey
 				state = fflib.FFParse_want_colon
 				goto mainparse
 			} else {
 				switch kn[0] {
 
-				case 'c':
+				case 'b':
 
-					if bytes.Equal(ffjKeywithdrawQueryAPIResponseCode, kn) {
-						currentKey = ffjtwithdrawQueryAPIResponseCode
+					if bytes.Equal(ffjKeywithdrawNotifyContentBizScene, kn) {
+						currentKey = ffjtwithdrawNotifyContentBizScene
 						state = fflib.FFParse_want_colon
 						goto mainparse
 					}
 
 				case 'e':
 
-					if bytes.Equal(ffjKeywithdrawQueryAPIResponseErrorCode, kn) {
-						currentKey = ffjtwithdrawQueryAPIResponseErrorCode
+					if bytes.Equal(ffjKeywithdrawNotifyContentErrorCode, kn) {
+						currentKey = ffjtwithdrawNotifyContentErrorCode
 						state = fflib.FFParse_want_colon
 						goto mainparse
 					}
 
 				case 'f':
 
-					if bytes.Equal(ffjKeywithdrawQueryAPIResponseFailReason, kn) {
-						currentKey = ffjtwithdrawQueryAPIResponseFailReason
-						state = fflib.FFParse_want_colon
-						goto mainparse
-					}
-
-				case 'm':
-
-					if bytes.Equal(ffjKeywithdrawQueryAPIResponseMsg, kn) {
-						currentKey = ffjtwithdrawQueryAPIResponseMsg
+					if bytes.Equal(ffjKeywithdrawNotifyContentFailReason, kn) {
+						currentKey = ffjtwithdrawNotifyContentFailReason
 						state = fflib.FFParse_want_colon
 						goto mainparse
 					}
 
 				case 'o':
 
-					if bytes.Equal(ffjKeywithdrawQueryAPIResponseOrderID, kn) {
-						currentKey = ffjtwithdrawQueryAPIResponseOrderID
+					if bytes.Equal(ffjKeywithdrawNotifyContentOutBizNo, kn) {
+						currentKey = ffjtwithdrawNotifyContentOutBizNo
 						state = fflib.FFParse_want_colon
 						goto mainparse
 
-					} else if bytes.Equal(ffjKeywithdrawQueryAPIResponseOutBizNo, kn) {
-						currentKey = ffjtwithdrawQueryAPIResponseOutBizNo
+					} else if bytes.Equal(ffjKeywithdrawNotifyContentOrderID, kn) {
+						currentKey = ffjtwithdrawNotifyContentOrderID
 						state = fflib.FFParse_want_colon
 						goto mainparse
 					}
 
 				case 'p':
 
-					if bytes.Equal(ffjKeywithdrawQueryAPIResponsePayDate, kn) {
-						currentKey = ffjtwithdrawQueryAPIResponsePayDate
+					if bytes.Equal(ffjKeywithdrawNotifyContentPayDate, kn) {
+						currentKey = ffjtwithdrawNotifyContentPayDate
 						state = fflib.FFParse_want_colon
 						goto mainparse
-					}
-
-				case 's':
 
-					if bytes.Equal(ffjKeywithdrawQueryAPIResponseSubCode, kn) {
-						currentKey = ffjtwithdrawQueryAPIResponseSubCode
+					} else if bytes.Equal(ffjKeywithdrawNotifyContentProductCode, kn) {
+						currentKey = ffjtwithdrawNotifyContentProductCode
 						state = fflib.FFParse_want_colon
 						goto mainparse
+					}
 
-					} else if bytes.Equal(ffjKeywithdrawQueryAPIResponseSubMsg, kn) {
-						currentKey = ffjtwithdrawQueryAPIResponseSubMsg
-						state = fflib.FFParse_want_colon
-						goto mainparse
+				case 's':
 
-					} else if bytes.Equal(ffjKeywithdrawQueryAPIResponseSign, kn) {
-						currentKey = ffjtwithdrawQueryAPIResponseSign
+					if bytes.Equal(ffjKeywithdrawNotifyContentStatus, kn) {
+						currentKey = ffjtwithdrawNotifyContentStatus
 						state = fflib.FFParse_want_colon
 						goto mainparse
+					}
+
+				case 't':
 
-					} else if bytes.Equal(ffjKeywithdrawQueryAPIResponseStatus, kn) {
-						currentKey = ffjtwithdrawQueryAPIResponseStatus
+					if bytes.Equal(ffjKeywithdrawNotifyContentTransAmount, kn) {
+						currentKey = ffjtwithdrawNotifyContentTransAmount
 						state = fflib.FFParse_want_colon
 						goto mainparse
 					}
 
 				}
 
-				if fflib.AsciiEqualFold(ffjKeywithdrawQueryAPIResponseErrorCode, kn) {
-					currentKey = ffjtwithdrawQueryAPIResponseErrorCode
-					state = fflib.FFParse_want_colon
-					goto mainparse
-				}
-
-				if fflib.EqualFoldRight(ffjKeywithdrawQueryAPIResponseFailReason, kn) {
-					currentKey = ffjtwithdrawQueryAPIResponseFailReason
-					state = fflib.FFParse_want_colon
-					goto mainparse
-				}
-
-				if fflib.AsciiEqualFold(ffjKeywithdrawQueryAPIResponseOutBizNo, kn) {
-					currentKey = ffjtwithdrawQueryAPIResponseOutBizNo
+				if fflib.EqualFoldRight(ffjKeywithdrawNotifyContentBizScene, kn) {
+					currentKey = ffjtwithdrawNotifyContentBizScene
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.EqualFoldRight(ffjKeywithdrawQueryAPIResponseStatus, kn) {
-					currentKey = ffjtwithdrawQueryAPIResponseStatus
+				if fflib.AsciiEqualFold(ffjKeywithdrawNotifyContentProductCode, kn) {
+					currentKey = ffjtwithdrawNotifyContentProductCode
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.AsciiEqualFold(ffjKeywithdrawQueryAPIResponsePayDate, kn) {
-					currentKey = ffjtwithdrawQueryAPIResponsePayDate
+				if fflib.EqualFoldRight(ffjKeywithdrawNotifyContentFailReason, kn) {
+					currentKey = ffjtwithdrawNotifyContentFailReason
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.AsciiEqualFold(ffjKeywithdrawQueryAPIResponseOrderID, kn) {
-					currentKey = ffjtwithdrawQueryAPIResponseOrderID
+				if fflib.AsciiEqualFold(ffjKeywithdrawNotifyContentErrorCode, kn) {
+					currentKey = ffjtwithdrawNotifyContentErrorCode
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.EqualFoldRight(ffjKeywithdrawQueryAPIResponseSign, kn) {
-					currentKey = ffjtwithdrawQueryAPIResponseSign
+				if fflib.AsciiEqualFold(ffjKeywithdrawNotifyContentPayDate, kn) {
+					currentKey = ffjtwithdrawNotifyContentPayDate
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.EqualFoldRight(ffjKeywithdrawQueryAPIResponseSubMsg, kn) {
-					currentKey = ffjtwithdrawQueryAPIResponseSubMsg
+				if fflib.EqualFoldRight(ffjKeywithdrawNotifyContentTransAmount, kn) {
+					currentKey = ffjtwithdrawNotifyContentTransAmount
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.EqualFoldRight(ffjKeywithdrawQueryAPIResponseSubCode, kn) {
-					currentKey = ffjtwithdrawQueryAPIResponseSubCode
+				if fflib.EqualFoldRight(ffjKeywithdrawNotifyContentStatus, kn) {
+					currentKey = ffjtwithdrawNotifyContentStatus
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.EqualFoldRight(ffjKeywithdrawQueryAPIResponseMsg, kn) {
-					currentKey = ffjtwithdrawQueryAPIResponseMsg
+				if fflib.AsciiEqualFold(ffjKeywithdrawNotifyContentOrderID, kn) {
+					currentKey = ffjtwithdrawNotifyContentOrderID
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.SimpleLetterEqualFold(ffjKeywithdrawQueryAPIResponseCode, kn) {
-					currentKey = ffjtwithdrawQueryAPIResponseCode
+				if fflib.AsciiEqualFold(ffjKeywithdrawNotifyContentOutBizNo, kn) {
+					currentKey = ffjtwithdrawNotifyContentOutBizNo
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				currentKey = ffjtwithdrawQueryAPIResponsenosuchkey
+				currentKey = ffjtwithdrawNotifyContentnosuchkey
 				state = fflib.FFParse_want_colon
 				goto mainparse
 			}
@@ -3530,40 +12535,34 @@ mainparse:
 			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
 				switch currentKey {
 
-				case ffjtwithdrawQueryAPIResponseCode:
-					goto handle_Code
-
-				case ffjtwithdrawQueryAPIResponseMsg:
-					goto handle_Msg
-
-				case ffjtwithdrawQueryAPIResponseSubCode:
-					goto handle_SubCode
+				case ffjtwithdrawNotifyContentOutBizNo:
+					goto handle_OutBizNo
 
-				case ffjtwithdrawQueryAPIResponseSubMsg:
-					goto handle_SubMsg
+				case ffjtwithdrawNotifyContentOrderID:
+					goto handle_OrderID
 
-				case ffjtwithdrawQueryAPIResponseSign:
-					goto handle_Sign
+				case ffjtwithdrawNotifyContentStatus:
+					goto handle_Status
 
-				case ffjtwithdrawQueryAPIResponseOrderID:
-					goto handle_OrderID
+				case ffjtwithdrawNotifyContentTransAmount:
+					goto handle_TransAmount
 
-				case ffjtwithdrawQueryAPIResponsePayDate:
+				case ffjtwithdrawNotifyContentPayDate:
 					goto handle_PayDate
 
-				case ffjtwithdrawQueryAPIResponseStatus:
-					goto handle_Status
-
-				case ffjtwithdrawQueryAPIResponseOutBizNo:
-					goto handle_OutBizNo
+				case ffjtwithdrawNotifyContentErrorCode:
+					goto handle_ErrorCode
 
-				case ffjtwithdrawQueryAPIResponseFailReason:
+				case ffjtwithdrawNotifyContentFailReason:
 					goto handle_FailReason
 
-				case ffjtwithdrawQueryAPIResponseErrorCode:
-					goto handle_ErrorCode
+				case ffjtwithdrawNotifyContentProductCode:
+					goto handle_ProductCode
 
-				case ffjtwithdrawQueryAPIResponsenosuchkey:
+				case ffjtwithdrawNotifyContentBizScene:
+					goto handle_BizScene
+
+				case ffjtwithdrawNotifyContentnosuchkey:
 					err = fs.SkipField(tok)
 					if err != nil {
 						return fs.WrapErr(err)
@@ -3577,61 +12576,9 @@ mainparse:
 		}
 	}
 
-handle_Code:
-
-	/* handler: j.Code type=string kind=string quoted=false*/
-
-	{
-
-		{
-			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
-				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
-			}
-		}
-
-		if tok == fflib.FFTok_null {
-
-		} else {
-
-			outBuf := fs.Output.Bytes()
-
-			j.Code = string(string(outBuf))
-
-		}
-	}
-
-	state = fflib.FFParse_after_value
-	goto mainparse
-
-handle_Msg:
-
-	/* handler: j.Msg type=string kind=string quoted=false*/
-
-	{
-
-		{
-			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
-				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
-			}
-		}
-
-		if tok == fflib.FFTok_null {
-
-		} else {
-
-			outBuf := fs.Output.Bytes()
-
-			j.Msg = string(string(outBuf))
-
-		}
-	}
-
-	state = fflib.FFParse_after_value
-	goto mainparse
-
-handle_SubCode:
+handle_OutBizNo:
 
-	/* handler: j.SubCode type=string kind=string quoted=false*/
+	/* handler: j.OutBizNo type=string kind=string quoted=false*/
 
 	{
 
@@ -3647,7 +12594,7 @@ handle_SubCode:
 
 			outBuf := fs.Output.Bytes()
 
-			j.SubCode = string(string(outBuf))
+			j.OutBizNo = string(string(outBuf))
 
 		}
 	}
@@ -3655,9 +12602,9 @@ handle_SubCode:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_SubMsg:
+handle_OrderID:
 
-	/* handler: j.SubMsg type=string kind=string quoted=false*/
+	/* handler: j.OrderID type=string kind=string quoted=false*/
 
 	{
 
@@ -3673,7 +12620,7 @@ handle_SubMsg:
 
 			outBuf := fs.Output.Bytes()
 
-			j.SubMsg = string(string(outBuf))
+			j.OrderID = string(string(outBuf))
 
 		}
 	}
@@ -3681,9 +12628,9 @@ handle_SubMsg:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_Sign:
+handle_Status:
 
-	/* handler: j.Sign type=string kind=string quoted=false*/
+	/* handler: j.Status type=string kind=string quoted=false*/
 
 	{
 
@@ -3699,7 +12646,7 @@ handle_Sign:
 
 			outBuf := fs.Output.Bytes()
 
-			j.Sign = string(string(outBuf))
+			j.Status = string(string(outBuf))
 
 		}
 	}
@@ -3707,9 +12654,9 @@ handle_Sign:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_OrderID:
+handle_TransAmount:
 
-	/* handler: j.OrderID type=string kind=string quoted=false*/
+	/* handler: j.TransAmount type=string kind=string quoted=false*/
 
 	{
 
@@ -3725,7 +12672,7 @@ handle_OrderID:
 
 			outBuf := fs.Output.Bytes()
 
-			j.OrderID = string(string(outBuf))
+			j.TransAmount = string(string(outBuf))
 
 		}
 	}
@@ -3759,9 +12706,9 @@ handle_PayDate:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_Status:
+handle_ErrorCode:
 
-	/* handler: j.Status type=string kind=string quoted=false*/
+	/* handler: j.ErrorCode type=string kind=string quoted=false*/
 
 	{
 
@@ -3777,7 +12724,7 @@ handle_Status:
 
 			outBuf := fs.Output.Bytes()
 
-			j.Status = string(string(outBuf))
+			j.ErrorCode = string(string(outBuf))
 
 		}
 	}
@@ -3785,9 +12732,9 @@ handle_Status:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_OutBizNo:
+handle_FailReason:
 
-	/* handler: j.OutBizNo type=string kind=string quoted=false*/
+	/* handler: j.FailReason type=string kind=string quoted=false*/
 
 	{
 
@@ -3803,7 +12750,7 @@ handle_OutBizNo:
 
 			outBuf := fs.Output.Bytes()
 
-			j.OutBizNo = string(string(outBuf))
+			j.FailReason = string(string(outBuf))
 
 		}
 	}
@@ -3811,9 +12758,9 @@ handle_OutBizNo:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_FailReason:
+handle_ProductCode:
 
-	/* handler: j.FailReason type=string kind=string quoted=false*/
+	/* handler: j.ProductCode type=string kind=string quoted=false*/
 
 	{
 
@@ -3829,7 +12776,7 @@ handle_FailReason:
 
 			outBuf := fs.Output.Bytes()
 
-			j.FailReason = string(string(outBuf))
+			j.ProductCode = string(string(outBuf))
 
 		}
 	}
@@ -3837,9 +12784,9 @@ handle_FailReason:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_ErrorCode:
+handle_BizScene:
 
-	/* handler: j.ErrorCode type=string kind=string quoted=false*/
+	/* handler: j.BizScene type=string kind=string quoted=false*/
 
 	{
 
@@ -3855,7 +12802,7 @@ handle_ErrorCode:
 
 			outBuf := fs.Output.Bytes()
 
-			j.ErrorCode = string(string(outBuf))
+			j.BizScene = string(string(outBuf))
 
 		}
 	}
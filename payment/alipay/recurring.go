@@ -0,0 +1,249 @@
+package alipay
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/kinwyb/golang/payment"
+	"github.com/kinwyb/golang/utils"
+)
+
+//周期扣款(商家扣款)
+//	1. Sign 页面签约(alipay.user.agreement.page.sign),签约/解约结果推送到SignNotifyURL,由SignNotify处理
+//	2. Charge 协议扣款(alipay.trade.pay),扣款结果同步返回,异步通知与普通支付相同由Notify处理
+//	3. QueryAgreement/Unsign 查询协议(alipay.user.agreement.query)/解约(alipay.user.agreement.unsign)
+
+const (
+	personalProductCode = "CYCLE_PAY_AUTH_P"       //周期扣款个人签约产品码
+	signScene           = "INDUSTRY|DIGITAL_MEDIA" //默认签约场景码
+)
+
+//Sign 周期扣款签约,IsApp为true时返回唤起支付宝签约的地址,否则返回自动提交的签约页面表单
+func (a *alipay) Sign(req *payment.SignRequest) (string, error) {
+	if err := req.Validate(); err != nil {
+		return "", err
+	} else if req.PeriodType == "" || req.Period < 1 || req.ExecuteTime.IsZero() || req.SingleMoney <= 0 {
+		return "", errors.New("支付宝周期扣款签约[PeriodType/Period/ExecuteTime/SingleMoney]不能为空")
+	}
+	rule := map[string]interface{}{
+		"period_type":   req.PeriodType,
+		"period":        req.Period,
+		"execute_time":  req.ExecuteTime.Format("2006-01-02"),
+		"single_amount": fmt.Sprintf("%.2f", req.SingleMoney),
+	}
+	if req.TotalMoney > 0 {
+		rule["total_amount"] = fmt.Sprintf("%.2f", req.TotalMoney)
+	}
+	if req.TotalPayments > 0 {
+		rule["total_payments"] = req.TotalPayments
+	}
+	channel := "QRCODEORSMS" //扫码或短信签约
+	if req.IsApp {
+		channel = "ALIPAYAPP"
+	}
+	requestbytes, err := json.Marshal(map[string]interface{}{
+		"personal_product_code": personalProductCode,
+		"product_code":          "CYCLE_PAY_AUTH",
+		"sign_scene":            a.signScene(),
+		"external_agreement_no": req.No,
+		"external_logon_id":     req.MemberID,
+		"access_params":         map[string]string{"channel": channel},
+		"period_rule_params":    rule,
+	})
+	if err != nil {
+		return "", fmt.Errorf("参数序列化错误")
+	}
+	args := buildParams(&a.PayInfo, "alipay.user.agreement.page.sign", a.config, string(requestbytes))
+	if a.config.SignNotifyURL != "" { //签约通知地址与支付通知地址不同时重新签名
+		args["notify_url"] = a.config.SignNotifyURL
+		sign(&a.PayInfo, args, a.config.PrivateKey)
+	}
+	if req.IsApp {
+		values := url.Values{}
+		for k, v := range args {
+			values.Set(k, v)
+		}
+		return "alipays://platformapi/startapp?appId=60000157&appClearTop=false&startMultApp=YES&sign_params=" +
+			url.QueryEscape(values.Encode()), nil
+	}
+	return renderForm(args, a.gateway), nil
+}
+
+//SignNotify 签约/解约异步通知处理,notify_type为dut_user_sign/dut_user_unsign
+func (a *alipay) SignNotify(params map[string]string) *payment.AgreementResult {
+	delete(params, "request_post_body")
+	ret := &payment.AgreementResult{
+		PayCode:      a.Code(),
+		No:           params["external_agreement_no"],
+		AgreementNo:  params["agreement_no"],
+		ThirdAccount: params["alipay_user_id"],
+		Status:       agreementStatus(params["status"]),
+		SignTime:     params["sign_time"],
+		InvalidTime:  params["invalid_time"],
+		Navite:       params,
+	}
+	if params["notify_type"] == "dut_user_unsign" {
+		ret.Status = payment.AgreementStop
+		if params["unsign_time"] != "" {
+			ret.InvalidTime = params["unsign_time"]
+		}
+	}
+	if !a.verify(params) {
+		ret.Status = payment.AgreementUnknow
+		ret.ErrMsg = "支付宝签约回调数据验证失败"
+		return ret
+	}
+	ret.Succ = true
+	return ret
+}
+
+//SignNotifyResult 签约/解约异步通知处理结果返回内容
+func (a *alipay) SignNotifyResult(ret *payment.AgreementResult) string {
+	if ret != nil && ret.Succ {
+		return "success"
+	}
+	return "fail"
+}
+
+//QueryAgreement 查询协议,agreementNo为空时使用商户签约号查询
+func (a *alipay) QueryAgreement(agreementNo string, no string) *payment.AgreementResult {
+	ret := &payment.AgreementResult{
+		PayCode:     a.Code(),
+		No:          no,
+		AgreementNo: agreementNo,
+		Status:      payment.AgreementUnknow,
+	}
+	biz := map[string]string{"agreement_no": agreementNo}
+	if agreementNo == "" {
+		biz = map[string]string{
+			"personal_product_code": personalProductCode,
+			"sign_scene":            a.signScene(),
+			"external_agreement_no": no,
+		}
+	}
+	requestbytes, _ := json.Marshal(biz)
+	respdata, err := request(&a.PayInfo, "alipay.user.agreement.query", a.config, string(requestbytes), a.gateway)
+	if err != nil {
+		ret.ErrMsg = err.Error()
+		return ret
+	}
+	a.Log(utils.LogLevelInfo, "支付宝协议查询结果:%s", respdata)
+	vmap := &agreementQueryAPIResp{}
+	if err = json.Unmarshal(respdata, &vmap); err != nil || vmap.Method == nil {
+		ret.ErrMsg = "支付宝协议查询结果解析失败"
+		return ret
+	} else if (vmap.Sign != "" || vmap.Method.Code == "10000") && !verifyRaw(&a.PayInfo, respdata, vmap.Sign, "alipay_user_agreement_query_response", a.config.PublicKey) {
+		ret.ErrMsg = "支付宝协议查询结果签名验证失败"
+		return ret
+	}
+	response := vmap.Method
+	if response.Code != "10000" {
+		ret.ErrMsg = "支付宝协议查询失败:[" + response.SubCode + "]" + response.SubMsg
+		return ret
+	}
+	ret.Succ = true
+	ret.No = response.ExternalAgreementNo
+	ret.AgreementNo = response.AgreementNo
+	ret.ThirdAccount = response.PrincipalID
+	ret.Status = agreementStatus(response.Status)
+	ret.SignTime = response.SignTime
+	ret.InvalidTime = response.InvalidTime
+	return ret
+}
+
+//Unsign 解约,支付宝解约接口不支持解约备注remark
+func (a *alipay) Unsign(agreementNo string, remark string) error {
+	if agreementNo == "" {
+		return errors.New("协议号不能为空")
+	}
+	requestbytes, _ := json.Marshal(map[string]string{"agreement_no": agreementNo})
+	respdata, err := request(&a.PayInfo, "alipay.user.agreement.unsign", a.config, string(requestbytes), a.gateway)
+	if err != nil {
+		return err
+	}
+	a.Log(utils.LogLevelInfo, "支付宝解约结果:%s", respdata)
+	vmap := &agreementUnsignAPIResp{}
+	if err = json.Unmarshal(respdata, &vmap); err != nil || vmap.Method == nil {
+		return errors.New("支付宝解约结果解析失败")
+	} else if (vmap.Sign != "" || vmap.Method.Code == "10000") && !verifyRaw(&a.PayInfo, respdata, vmap.Sign, "alipay_user_agreement_unsign_response", a.config.PublicKey) {
+		return errors.New("支付宝解约结果签名验证失败")
+	} else if vmap.Method.Code != "10000" {
+		return errors.New("支付宝解约失败:[" + vmap.Method.SubCode + "]" + vmap.Method.SubMsg)
+	}
+	return nil
+}
+
+//Charge 协议扣款,扣款成功返回支付结果,支付宝处理中或结果未知时返回nil,需要通过异步通知确认
+func (a *alipay) Charge(req *payment.ChargeRequest) (*payment.PayResult, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	requestbytes, err := json.Marshal(map[string]interface{}{
+		"out_trade_no":     req.No,
+		"total_amount":     fmt.Sprintf("%.2f", req.Money),
+		"subject":          req.Desc,
+		"product_code":     "GENERAL_WITHHOLDING",
+		"agreement_params": map[string]string{"agreement_no": req.AgreementNo},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("参数序列化错误")
+	}
+	respdata, err := request(&a.PayInfo, "alipay.trade.pay", a.config, string(requestbytes), a.gateway)
+	if payment.IsRequestNotSent(err) {
+		return nil, err
+	} else if err != nil {
+		a.Log(utils.LogLevelError, "支付宝扣款结果未知:%s", err.Error())
+		return nil, nil
+	}
+	a.Log(utils.LogLevelInfo, "支付宝扣款结果:%s", respdata)
+	vmap := &tradePayAPIResp{}
+	if err = json.Unmarshal(respdata, &vmap); err != nil || vmap.Method == nil {
+		a.Log(utils.LogLevelError, "支付宝扣款结果解析失败:%s", respdata)
+		return nil, nil
+	} else if (vmap.Sign != "" || vmap.Method.Code == "10000") && !verifyRaw(&a.PayInfo, respdata, vmap.Sign, "alipay_trade_pay_response", a.config.PublicKey) {
+		a.Log(utils.LogLevelError, "支付宝扣款结果签名验证失败")
+		return nil, nil
+	}
+	response := vmap.Method
+	switch response.Code {
+	case "10000":
+		ret := &payment.PayResult{
+			Succ:         true,
+			PayCode:      a.Code(),
+			No:           req.No,
+			TradeNo:      response.OutTradeNo,
+			ThirdTradeNo: response.TradeNo,
+			ThirdAccount: response.BuyerUserID,
+			Currency:     payment.CNY,
+		}
+		ret.Money, _ = strconv.ParseFloat(response.TotalAmount, 64)
+		return a.FillPayFee(ret), nil
+	case "10003", "20000": //等待用户付款或服务不可用,结果以异步通知为准
+		return nil, nil
+	}
+	return nil, errors.New("支付宝扣款失败:[" + response.SubCode + "]" + response.SubMsg)
+}
+
+//签约场景码
+func (a *alipay) signScene() string {
+	if a.config.SignScene != "" {
+		return a.config.SignScene
+	}
+	return signScene
+}
+
+//协议状态
+func agreementStatus(status string) payment.AgreementStatus {
+	switch status {
+	case "TEMP":
+		return payment.AgreementSigning
+	case "NORMAL":
+		return payment.AgreementNormal
+	case "STOP", "UNSIGN":
+		return payment.AgreementStop
+	}
+	return payment.AgreementUnknow
+}
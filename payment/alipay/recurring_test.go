@@ -0,0 +1,146 @@
+package alipay
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kinwyb/golang/payment"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+//模拟支付宝网关,记录请求参数并返回预先录制的应答
+type recurringTestTransport struct {
+	req  url.Values
+	resp string
+}
+
+func (t *recurringTestTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	data, _ := ioutil.ReadAll(r.Body)
+	t.req, _ = url.ParseQuery(string(data))
+	return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader(t.resp)), Header: http.Header{}}, nil
+}
+
+//使用测试私钥签名
+func recurringTestSign(key *rsa.PrivateKey, content string) string {
+	dt := sha256.Sum256([]byte(content))
+	data, _ := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, dt[:])
+	return base64.StdEncoding.EncodeToString(data)
+}
+
+func Test_Recurring(t *testing.T) {
+	key, _ := rsa.GenerateKey(rand.Reader, 1024)
+	priv, _ := x509.MarshalPKCS8PrivateKey(key)
+	pub, _ := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	transport := &recurringTestTransport{}
+	cfg := &PayConfig{
+		Partner:    "2017031506224738",
+		PrivateKey: base64.StdEncoding.EncodeToString(priv),
+		PublicKey:  base64.StdEncoding.EncodeToString(pub),
+		NotifyURL:  "https://example.com/notify/alipay",
+	}
+	cfg.Code = "alipay"
+	cfg.Name = "支付宝"
+	cfg.State = true
+	cfg.Options = &payment.Options{
+		HTTPClient: &http.Client{Transport: transport},
+		Clock:      func() time.Time { return time.Date(2017, 10, 19, 10, 0, 0, 0, time.Local) },
+	}
+	a := (&alipay{}).GetPayment(cfg).(*alipay)
+	convey.Convey("协议扣款", t, func() {
+		raw := `{"code":"10000","msg":"Success","buyer_logon_id":"159****5620","buyer_user_id":"2088102177846875",` +
+			`"gmt_payment":"2017-10-19 10:00:01","out_trade_no":"201710190001","total_amount":"0.29","trade_no":"2017101921001004030200123456"}`
+		transport.resp = `{"alipay_trade_pay_response":` + raw + `,"sign":"` + recurringTestSign(key, raw) + `"}`
+		ret, err := a.Charge(&payment.ChargeRequest{No: "201710190001", AgreementNo: "20170322450983769228", Desc: "会员续费", Money: 0.29})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(transport.req.Get("method"), convey.ShouldEqual, "alipay.trade.pay")
+		biz := map[string]interface{}{}
+		json.Unmarshal([]byte(transport.req.Get("biz_content")), &biz)
+		convey.So(biz["total_amount"], convey.ShouldEqual, "0.29")
+		convey.So(biz["product_code"], convey.ShouldEqual, "GENERAL_WITHHOLDING")
+		convey.So(biz["agreement_params"], convey.ShouldResemble, map[string]interface{}{"agreement_no": "20170322450983769228"})
+		convey.So(ret.Succ, convey.ShouldBeTrue)
+		convey.So(ret.Money, convey.ShouldEqual, 0.29)
+		convey.So(ret.ThirdTradeNo, convey.ShouldEqual, "2017101921001004030200123456")
+
+		transport.resp = `{"alipay_trade_pay_response":` + strings.Replace(raw, "0.29", "2.90", 1) + `,"sign":"` + recurringTestSign(key, raw) + `"}`
+		ret, err = a.Charge(&payment.ChargeRequest{No: "201710190001", AgreementNo: "20170322450983769228", Money: 0.29})
+		convey.So(ret, convey.ShouldBeNil)
+		convey.So(err, convey.ShouldBeNil)
+
+		transport.resp = `{"alipay_trade_pay_response":` + raw + `}`
+		ret, err = a.Charge(&payment.ChargeRequest{No: "201710190001", AgreementNo: "20170322450983769228", Money: 0.29})
+		convey.So(ret, convey.ShouldBeNil)
+		convey.So(err, convey.ShouldBeNil)
+
+		raw = `{"code":"40004","msg":"Business Failed","sub_code":"ACQ.AGREEMENT_NOT_EXIST","sub_msg":"用户协议不存在"}`
+		transport.resp = `{"alipay_trade_pay_response":` + raw + `,"sign":"` + recurringTestSign(key, raw) + `"}`
+		_, err = a.Charge(&payment.ChargeRequest{No: "201710190002", AgreementNo: "20170322450983769228", Money: 1})
+		convey.So(err.Error(), convey.ShouldEqual, "支付宝扣款失败:[ACQ.AGREEMENT_NOT_EXIST]用户协议不存在")
+	})
+	convey.Convey("查询结果必须签名", t, func() {
+		raw := `{"code":"10000","msg":"Success","trade_no":"2017101921001004030200123456","out_trade_no":"201710190001",` +
+			`"buyer_logon_id":"159****5620","trade_status":"TRADE_SUCCESS","total_amount":"0.29"}`
+		transport.resp = `{"alipay_trade_query_response":` + raw + `,"sign":"` + recurringTestSign(key, raw) + `"}`
+		convey.So(a.Query("201710190001").Succ, convey.ShouldBeTrue)
+		transport.resp = `{"alipay_trade_query_response":` + raw + `}`
+		ret := a.Query("201710190001")
+		convey.So(ret.Succ, convey.ShouldBeFalse)
+		convey.So(ret.ErrMsg, convey.ShouldEqual, "支付宝查询交易结果签名验证失败")
+		transport.resp = `{"alipay_user_agreement_unsign_response":{"code":"10000","msg":"Success"}}`
+		convey.So(a.Unsign("20170322450983769228", "").Error(), convey.ShouldEqual, "支付宝解约结果签名验证失败")
+		transport.resp = `{"alipay_user_agreement_query_response":{"code":"10000","msg":"Success","agreement_no":"20170322450983769228","status":"NORMAL"}}`
+		convey.So(a.QueryAgreement("20170322450983769228", "").ErrMsg, convey.ShouldEqual, "支付宝协议查询结果签名验证失败")
+	})
+	convey.Convey("签约通知", t, func() {
+		params := map[string]string{
+			"notify_type":           "dut_user_sign",
+			"notify_id":             "ac05099524730693a8b330c5ecf72da9786",
+			"notify_time":           "2017-10-19 10:00:00",
+			"app_id":                cfg.Partner,
+			"external_agreement_no": "S001",
+			"agreement_no":          "20170322450983769228",
+			"alipay_user_id":        "2088101122675263",
+			"status":                "NORMAL",
+			"sign_time":             "2017-10-19 10:00:00",
+			"invalid_time":          "2115-02-01 00:00:00",
+			"sign_type":             "RSA2",
+		}
+		content := map[string]string{}
+		for k, v := range params {
+			content[k] = v
+		}
+		delete(content, "sign_type")
+		params["sign"] = recurringTestSign(key, createLinkString(paraFilter(content), content))
+		notify := func() map[string]string {
+			ret := map[string]string{"request_post_body": "x"}
+			for k, v := range params {
+				ret[k] = v
+			}
+			return ret
+		}
+		ret := a.SignNotify(notify())
+		convey.So(ret.Succ, convey.ShouldBeTrue)
+		convey.So(ret.Status, convey.ShouldEqual, payment.AgreementNormal)
+		convey.So(ret.No, convey.ShouldEqual, "S001")
+		convey.So(ret.AgreementNo, convey.ShouldEqual, "20170322450983769228")
+		convey.So(a.SignNotifyResult(ret), convey.ShouldEqual, "success")
+
+		params["status"] = "STOP"
+		ret = a.SignNotify(notify())
+		convey.So(ret.Succ, convey.ShouldBeFalse)
+		convey.So(ret.Status, convey.ShouldEqual, payment.AgreementUnknow)
+		convey.So(ret.ErrMsg, convey.ShouldEqual, "支付宝签约回调数据验证失败")
+		convey.So(a.SignNotifyResult(ret), convey.ShouldEqual, "fail")
+	})
+}
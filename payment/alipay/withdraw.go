@@ -1,15 +1,15 @@
 package alipay
 
 import (
-	"time"
-
-	"fmt"
-
 	"encoding/json"
-
-	"strings"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
 
 	"github.com/kinwyb/golang/payment"
+	"github.com/kinwyb/golang/payment/validate"
 	"github.com/kinwyb/golang/utils"
 )
 
@@ -22,152 +22,271 @@ type withdraw struct {
 	signType  string
 }
 
+//转账产品码
+const (
+	productAccount  = "TRANS_ACCOUNT_NO_PWD"  //单笔无密转账到支付宝账户
+	productBankcard = "TRANS_BANKCARD_NO_PWD" //单笔无密转账到银行卡
+)
+
+//支付宝用户号
+var regExpUserID = regexp.MustCompile(`^2088\d{12}$`)
+
+//提现单据不存在的宽限时间,超过宽限时间仍查询不到单据视为提现失败
+const orderNotExistGrace = 30 * time.Minute
+
 //提现操作,成功返回第三方交易流水,失败返回错误
 func (w *withdraw) Withdraw(info *payment.WithdrawInfo) *payment.WithdrawResult {
-	arg := &withdrawAPIRequest{
-		OutBizNo: info.TradeNo,
-		Type:     "ALIPAY_LOGONID",
-		Account:  info.CardNo,
-		RealName: info.UserName,
-		Amount:   fmt.Sprintf("%.2f", info.Money),
-		Remark:   info.Desc,
+	return w.FillWithdrawFee(w.withdraw(info), info)
+}
+
+//提现请求,OpenBank不为空时转账到银行卡,否则转账到支付宝账户
+func (w *withdraw) withdraw(info *payment.WithdrawInfo) *payment.WithdrawResult {
+	if !payment.IsCNY(info.Currency) {
+		return payment.WithdrawCurrencyNotSupport
+	}
+	arg := &transferAPIRequest{
+		OutBizNo:    info.TradeNo,
+		TransAmount: fmt.Sprintf("%.2f", info.Money),
+		ProductCode: productAccount,
+		BizScene:    "DIRECT_TRANSFER",
+		OrderTitle:  info.Desc,
+		Remark:      info.Desc,
+		PayeeInfo: &transferPayee{
+			Identity:     info.CardNo,
+			IdentityType: "ALIPAY_LOGON_ID",
+			Name:         info.UserName,
+		},
+	}
+	if arg.OrderTitle == "" {
+		arg.OrderTitle = "转账"
+	}
+	if info.OpenBank != "" {
+		if err := validate.BankWithdraw(info); err != nil {
+			return payment.WithdrawParamsInvalid(err)
+		}
+		arg.ProductCode = productBankcard
+		arg.PayeeInfo.IdentityType = "BANKCARD_ACCOUNT"
+		arg.PayeeInfo.BankcardExtInfo = &transferBankcard{
+			AccountType:  "2", //对私
+			InstName:     info.OpenBank,
+			InstProvince: info.Prov,
+			InstCity:     info.City,
+		}
+		if !info.People {
+			arg.PayeeInfo.BankcardExtInfo.AccountType = "1" //对公
+		}
+	} else if regExpUserID.MatchString(info.CardNo) {
+		arg.PayeeInfo.IdentityType = "ALIPAY_USER_ID"
 	}
 	requestbytes, err := arg.MarshalJSON()
 	if err != nil {
 		return payment.WithdrawParamsSerializeFail
 	}
-	respdata, err := request("alipay.fund.trans.toaccount.transfer", w.config, string(requestbytes), w.gateway)
-	if err != nil {
+	respdata, err := request(&w.PayInfo, "alipay.fund.trans.uni.transfer", w.config, string(requestbytes), w.gateway)
+	if payment.IsRequestNotSent(err) {
+		return payment.WithdrawRequestNotSent
+	} else if err != nil {
 		return payment.WithdrawResponseReadFail
 	}
-	log(utils.LogLevelInfo, "支付宝提现结果:%s", respdata)
-	vmap := &withdrawAPIResp{}
+	w.Log(utils.LogLevelInfo, "支付宝提现结果:%s", respdata)
+	vmap := &transferAPIResp{}
 	err = json.Unmarshal(respdata, &vmap)
-	if err != nil {
-		log(utils.LogLevelError, "结果解析错误:%s", err.Error())
-	}
-	if vmap.Sign != "" && !w.verify(string(respdata), vmap.Sign, 49) {
-		log(utils.LogLevelError, "支付宝提现请求结果签名验证异常")
-		return &payment.WithdrawResult{
-			TradeNo:      info.TradeNo,
-			CardNo:       info.CardNo,
-			CertID:       info.CertID,
-			Money:        info.Money,
-			UserName:     info.UserName,
-			WithdrawCode: w.Code(),
-			Status:       payment.DEALING,
-		}
+	if err != nil || vmap.Method == nil {
+		w.Log(utils.LogLevelError, "支付宝提现结果解析错误:%s", respdata)
+		return payment.WithdrawResponseUnserializeFail
+	}
+	ret := &payment.WithdrawResult{
+		TradeNo:      info.TradeNo,
+		CardNo:       info.CardNo,
+		CertID:       info.CertID,
+		Money:        info.Money,
+		UserName:     info.UserName,
+		WithdrawCode: w.Code(),
+		WithdrawName: w.Name(),
+		Status:       payment.DEALING,
+	}
+	if vmap.Sign != "" && !w.verify(respdata, vmap.Sign, "alipay_fund_trans_uni_transfer_response") {
+		w.Log(utils.LogLevelError, "支付宝提现请求结果签名验证异常")
+		return ret
 	}
 	response := vmap.Method
 	if response.Code == "10000" {
-		return &payment.WithdrawResult{
-			TradeNo:      response.OutBizNo,
-			ThridFlowNo:  response.OrderID,
-			CardNo:       info.CardNo,
-			CertID:       info.CertID,
-			Money:        info.Money,
-			PayTime:      response.PayDate,
-			UserName:     info.UserName,
-			WithdrawCode: w.Code(),
-			WithdrawName: w.Name(),
-			Status:       payment.SUCCESS,
-		}
-	} else if response.SubCode == "SYSTEM_ERROR" { //请求结果提示业务繁忙的,调用查询接口确认一下业务是否真实失败
-		qret := w.QueryWithdraw(info.TradeNo)
-		if qret.Status == payment.FAIL { //提现失败
-			return &payment.WithdrawResult{
-				Status:   payment.FAIL,
-				FailCode: qret.FailCode,
-				FailMsg:  qret.FailMsg,
-			}
-		} else if qret.Status == payment.SUCCESS { //查询出来是成功的返回成功
-			return &payment.WithdrawResult{
-				TradeNo:      response.OutBizNo,
-				ThridFlowNo:  qret.ThridFlowNo,
-				CardNo:       info.CardNo,
-				CertID:       info.CertID,
-				Money:        info.Money,
-				PayTime:      response.PayDate,
-				UserName:     info.UserName,
-				WithdrawCode: w.Code(),
-				WithdrawName: w.Name(),
-				Status:       payment.SUCCESS,
+		ret.ThridFlowNo = response.OrderID
+		switch response.Status {
+		case "SUCCESS":
+			ret.Status = payment.SUCCESS
+			ret.PayTime = response.TransDate
+		case "FAIL":
+			ret.Status = payment.FAIL
+			ret.FailCode = response.SubCode
+			ret.FailMsg = response.SubMsg
+			if ret.FailCode == "" { //转账应答中没有失败原因,通过查询接口获取
+				if qret := w.QueryWithdraw(info.TradeNo); qret.Status == payment.FAIL {
+					ret.FailCode = qret.FailCode
+					ret.FailMsg = qret.FailMsg
+				}
 			}
-		} else { //处理中的返回处理中
-			return &payment.WithdrawResult{
-				TradeNo:      response.OutBizNo,
-				ThridFlowNo:  qret.ThridFlowNo,
-				CardNo:       info.CardNo,
-				CertID:       info.CertID,
-				Money:        info.Money,
-				PayTime:      response.PayDate,
-				UserName:     info.UserName,
-				WithdrawCode: w.Code(),
-				WithdrawName: w.Name(),
-				Status:       payment.DEALING,
+			if ret.FailMsg == "" {
+				ret.FailMsg = "转账失败"
 			}
 		}
+		return ret
+	} else if response.Code == "20000" || response.SubCode == "SYSTEM_ERROR" { //服务不可用或业务繁忙的,调用查询接口确认一下业务是否真实失败
+		qret := w.QueryWithdraw(info.TradeNo)
+		ret.Status = qret.Status
+		ret.ThridFlowNo = qret.ThridFlowNo
+		ret.PayTime = qret.PayTime
+		ret.FailCode = qret.FailCode
+		ret.FailMsg = qret.FailMsg
+		return ret
 	}
-	return &payment.WithdrawResult{
-		Status:   payment.FAIL,
-		FailCode: response.SubCode,
-		FailMsg:  response.SubMsg,
-	}
+	ret.Status = payment.FAIL
+	ret.FailCode = response.SubCode
+	ret.FailMsg = response.SubMsg
+	return ret
 }
 
-//根据交易单号查询提现信息
+//根据交易单号查询提现信息,先按转账到支付宝账户查询,单据不存在时再按转账到银行卡查询
+//	tradeDate为提现发起时间,两种单据都不存在且超过宽限时间(30分钟)时返回失败,没有tradeDate时返回处理中
 func (w *withdraw) QueryWithdraw(tradeno string, tradeDate ...time.Time) *payment.WithdrawQueryResult {
-	respdata, err := request("alipay.fund.trans.order.query", w.config,
-		`{"out_biz_no":"`+tradeno+`"}`, w.gateway)
+	ret := &payment.WithdrawQueryResult{
+		Status:  payment.DEALING, //默认处理中
+		TradeNo: tradeno,
+	}
+	response := w.query(tradeno, productAccount)
+	if response != nil && response.SubCode == "ORDER_NOT_EXIST" {
+		response = w.query(tradeno, productBankcard)
+	}
+	if response != nil && response.SubCode == "ORDER_NOT_EXIST" &&
+		len(tradeDate) > 0 && w.Now().Sub(tradeDate[0]) > orderNotExistGrace {
+		ret.Status = payment.FAIL
+		ret.FailCode = response.SubCode
+		ret.FailMsg = "转账单据不存在"
+		return ret
+	} else if response == nil || response.Code != "10000" {
+		return ret
+	}
+	ret.ThridFlowNo = response.OrderID
+	switch response.Status {
+	case "SUCCESS":
+		ret.Status = payment.SUCCESS
+		ret.PayTime = response.PayDate
+	case "FAIL", "REFUND", "CLOSED":
+		ret.Status = payment.FAIL
+		ret.FailCode = response.ErrorCode
+		ret.FailMsg = response.FailReason
+	}
+	return ret
+}
+
+//查询转账单据,请求失败或验签失败返回nil
+func (w *withdraw) query(tradeno string, productCode string) *transferQueryAPIResponse {
+	bizContent, _ := json.Marshal(map[string]string{
+		"product_code": productCode,
+		"biz_scene":    "DIRECT_TRANSFER",
+		"out_biz_no":   tradeno,
+	})
+	respdata, err := request(&w.PayInfo, "alipay.fund.trans.common.query", w.config, string(bizContent), w.gateway)
 	if err != nil {
-		return &payment.WithdrawQueryResult{
-			Status:  payment.DEALING,
-			TradeNo: tradeno,
-		}
+		return nil
 	}
-	log(utils.LogLevelInfo, "支付宝提现查询结果:%s", respdata)
-	vmap := &withdrawQueryAPIResp{}
+	w.Log(utils.LogLevelInfo, "支付宝提现查询结果:%s", respdata)
+	vmap := &transferQueryAPIResp{}
 	err = json.Unmarshal(respdata, &vmap)
+	if err != nil || vmap.Method == nil {
+		w.Log(utils.LogLevelError, "支付宝提现查询结果解析错误:%s", respdata)
+		return nil
+	}
+	if vmap.Sign != "" && !w.verify(respdata, vmap.Sign, "alipay_fund_trans_common_query_response") {
+		w.Log(utils.LogLevelError, "支付宝提现查询请求结果签名验证异常:%s", respdata)
+		return nil
+	}
+	return vmap.Method
+}
+
+//Balance 查询出款支付宝账户余额,账户为配置中的SellerID
+func (w *withdraw) Balance() (*payment.BalanceResult, error) {
+	if w.config.SellerID == "" {
+		return nil, errors.New("支付宝用户号[SellerID]未配置")
+	}
+	bizContent, _ := json.Marshal(map[string]string{
+		"alipay_user_id": w.config.SellerID,
+		"account_type":   "ACCTRANS_ACCOUNT",
+	})
+	respdata, err := request(&w.PayInfo, "alipay.fund.account.query", w.config, string(bizContent), w.gateway)
 	if err != nil {
-		log(utils.LogLevelError, "支付宝提现查询结果解析错误:%s", err.Error())
+		return nil, err
 	}
-	if vmap.Sign != "" && !w.verify(string(respdata), vmap.Sign, 42) {
-		log(utils.LogLevelError, "支付宝提现查询请求结果签名验证异常:%v", vmap)
-		return &payment.WithdrawQueryResult{
-			Status:  payment.DEALING,
-			TradeNo: tradeno,
-		}
+	w.Log(utils.LogLevelInfo, "支付宝余额查询结果:%s", respdata)
+	vmap := &accountQueryAPIResp{}
+	err = json.Unmarshal(respdata, &vmap)
+	if err != nil || vmap.Method == nil {
+		w.Log(utils.LogLevelError, "支付宝余额查询结果解析错误:%s", respdata)
+		return nil, errors.New("支付宝余额查询结果解析失败")
+	} else if vmap.Sign != "" && !w.verify(respdata, vmap.Sign, "alipay_fund_account_query_response") {
+		return nil, errors.New("支付宝余额查询结果签名验证失败")
+	} else if vmap.Method.Code != "10000" {
+		return nil, fmt.Errorf("支付宝余额查询失败:[%s]%s", vmap.Method.SubCode, vmap.Method.SubMsg)
+	}
+	ret := &payment.BalanceResult{Currency: payment.CNY}
+	ret.Available, _ = strconv.ParseFloat(vmap.Method.AvailableAmount, 64)
+	ret.Freeze, _ = strconv.ParseFloat(vmap.Method.FreezeAmount, 64)
+	return ret, nil
+}
+
+//WithdrawNotify 提现异步通知处理,处理支付宝转账状态变更通知alipay.fund.trans.order.changed
+//	通知发送到应用网关地址,验证签名和app_id后按biz_content中的转账状态返回结果
+func (w *withdraw) WithdrawNotify(params map[string]string) *payment.WithdrawQueryResult {
+	delete(params, "request_post_body")
+	content := &withdrawNotifyContent{}
+	if err := content.UnmarshalJSON([]byte(params["biz_content"])); err != nil {
+		w.Log(utils.LogLevelError, "支付宝提现回调数据解析失败:%s", err.Error())
+		return payment.WithdrawNotifyVerifyFail("")
+	}
+	if params["msg_method"] != "alipay.fund.trans.order.changed" || params["app_id"] != w.config.Partner {
+		w.Log(utils.LogLevelError, "支付宝提现回调数据错误:%v", params)
+		return payment.WithdrawNotifyVerifyFail(content.OutBizNo)
+	}
+	signString := params["sign"]
+	delete(params, "sign_type")
+	keys := paraFilter(params)
+	if !verify(&w.PayInfo, createLinkString(keys, params), signString, w.config.PublicKey) {
+		w.Log(utils.LogLevelError, "支付宝提现回调数据验证失败")
+		return payment.WithdrawNotifyVerifyFail(content.OutBizNo)
 	}
-	response := vmap.Method
 	ret := &payment.WithdrawQueryResult{
-		Status:  payment.DEALING, //默认处理中
-		TradeNo: tradeno,
+		Status:      payment.DEALING, //默认处理中
+		TradeNo:     content.OutBizNo,
+		ThridFlowNo: content.OrderID,
 	}
-	if response.Code == "10000" { //业务请求成功
-		log(utils.LogLevelError, "请求成功")
-		switch response.Status {
-		case "SUCCESS":
-			ret.Status = payment.SUCCESS
-			ret.PayTime = response.PayDate
-			ret.ThridFlowNo = response.OrderID
-		case "FAIL", "REFUND":
-			ret.Status = payment.FAIL
-			ret.FailCode = response.ErrorCode
-			ret.FailMsg = response.FailReason
-		}
+	switch content.Status {
+	case "SUCCESS":
+		ret.Status = payment.SUCCESS
+		ret.PayTime = content.PayDate
+	case "FAIL", "REFUND", "CLOSED":
+		ret.Status = payment.FAIL
+		ret.FailCode = content.ErrorCode
+		ret.FailMsg = content.FailReason
 	}
 	return ret
 }
 
+//WithdrawNotifyResult 提现异步通知处理结果返回内容
+func (w *withdraw) WithdrawNotifyResult(ret *payment.WithdrawQueryResult) string {
+	if ret == nil || ret.Status == payment.UNKNOW {
+		return "fail"
+	}
+	return "success"
+}
+
 //获取驱动编码
 func (w *withdraw) Driver() string {
 	return "alipay"
 }
 
-//提现验证签名
-func (w *withdraw) verify(response string, signString string, start int) bool {
-	response = response[start:strings.LastIndex(response, ",\"sign\":")]
-	return verify(response, signString, w.config.PublicKey)
+//提现验证签名,name为接口返回内容中结果对象的字段名称
+func (w *withdraw) verify(response []byte, signString string, name string) bool {
+	return verifyRaw(&w.PayInfo, response, signString, name, w.config.PublicKey)
 }
 
 //生成一个提现对象
@@ -188,5 +307,14 @@ func (w *withdraw) GetWithdraw(cfg interface{}) payment.Withdraw {
 		config:    c,
 	}
 	obj.Init(obj.config.Code, obj.config.Name, obj.config.State)
+	obj.SetFee(obj.config.Fee)
+	obj.SetOptions(obj.config.Options, &lg)
 	return obj
 }
+
+//Capabilities 提现能力,CardNo为收款支付宝账号或支付宝用户号,OpenBank不为空时为收款银行卡号
+func (w *withdraw) Capabilities() *payment.Capabilities {
+	return &payment.Capabilities{
+		Required: []string{"TradeNo", "UserName", "CardNo", "Money"},
+	}
+}
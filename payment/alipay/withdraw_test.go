@@ -1,12 +1,20 @@
 package alipay
 
 import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
 	"io/ioutil"
+	"net/http"
 	"os"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/astaxie/beego/logs"
 	"github.com/kinwyb/golang/payment"
+	"github.com/smartystreets/goconvey/convey"
 )
 
 func TestWithdraw_Withdraw(t *testing.T) {
@@ -52,11 +60,9 @@ func TestWithdraw_Withdraw(t *testing.T) {
 		Desc:     "支付宝提现测试",
 		People:   true,
 	}
-	result, err := ww.Withdraw(winfo)
-	if err != nil {
-		t.Fatalf("提现错误:%s", err.Error())
-	} else if result.Status != payment.SUCCESS {
-		t.Fatalf("提现失败")
+	result := ww.Withdraw(winfo)
+	if result.Status != payment.SUCCESS {
+		t.Fatalf("提现失败:%s", result.FailMsg)
 	}
 	t.Logf("提现成功")
 	res := ww.QueryWithdraw(winfo.TradeNo)
@@ -65,3 +71,68 @@ func TestWithdraw_Withdraw(t *testing.T) {
 	}
 	t.Logf("查询成功")
 }
+
+//按顺序返回预先录制的应答
+type withdrawTestTransport struct {
+	resps []string
+}
+
+func (t *withdrawTestTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	resp := t.resps[0]
+	t.resps = t.resps[1:]
+	return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader(resp)), Header: http.Header{}}, nil
+}
+
+func Test_WithdrawFail(t *testing.T) {
+	key, _ := rsa.GenerateKey(rand.Reader, 1024)
+	priv, _ := x509.MarshalPKCS8PrivateKey(key)
+	pub, _ := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	transport := &withdrawTestTransport{}
+	now := time.Date(2017, 10, 19, 10, 0, 0, 0, time.Local)
+	cfg := &PayConfig{
+		Partner:    "2017031506224738",
+		PrivateKey: base64.StdEncoding.EncodeToString(priv),
+		PublicKey:  base64.StdEncoding.EncodeToString(pub),
+	}
+	cfg.Code = "alipay"
+	cfg.Name = "支付宝提现"
+	cfg.State = true
+	cfg.Options = &payment.Options{
+		HTTPClient: &http.Client{Transport: transport},
+		Clock:      func() time.Time { return now },
+	}
+	w := (&withdraw{}).GetWithdraw(cfg).(*withdraw)
+	response := func(method, raw string) string {
+		return `{"` + method + `":` + raw + `,"sign":"` + recurringTestSign(key, raw) + `"}`
+	}
+	notExist := response("alipay_fund_trans_common_query_response",
+		`{"code":"40004","msg":"Business Failed","sub_code":"ORDER_NOT_EXIST","sub_msg":"转账订单不存在"}`)
+	convey.Convey("转账失败使用支付宝错误码", t, func() {
+		transport.resps = []string{
+			response("alipay_fund_trans_uni_transfer_response", `{"code":"10000","msg":"Success","out_biz_no":"W001","order_id":"20171019110070000006210000012345","status":"FAIL"}`),
+			response("alipay_fund_trans_common_query_response", `{"code":"10000","msg":"Success","order_id":"20171019110070000006210000012345",`+
+				`"out_biz_no":"W001","status":"FAIL","error_code":"PAYEE_NOT_EXIST","fail_reason":"收款账号不存在"}`),
+		}
+		ret := w.Withdraw(&payment.WithdrawInfo{TradeNo: "W001", CardNo: "13800000000", UserName: "张三", Money: 1})
+		convey.So(ret.Status, convey.ShouldEqual, payment.FAIL)
+		convey.So(ret.FailCode, convey.ShouldEqual, "PAYEE_NOT_EXIST")
+		convey.So(ret.FailMsg, convey.ShouldEqual, "收款账号不存在")
+		transport.resps = []string{
+			response("alipay_fund_trans_uni_transfer_response", `{"code":"40004","msg":"Business Failed","sub_code":"PAYEE_ACCOUNT_STATUS_ERROR","sub_msg":"收款方账户状态异常"}`),
+		}
+		ret = w.Withdraw(&payment.WithdrawInfo{TradeNo: "W002", CardNo: "13800000000", UserName: "张三", Money: 1})
+		convey.So(ret.FailCode, convey.ShouldEqual, "PAYEE_ACCOUNT_STATUS_ERROR")
+	})
+	convey.Convey("单据不存在超过宽限时间视为失败", t, func() {
+		transport.resps = []string{notExist, notExist}
+		ret := w.QueryWithdraw("W003")
+		convey.So(ret.Status, convey.ShouldEqual, payment.DEALING)
+		transport.resps = []string{notExist, notExist}
+		ret = w.QueryWithdraw("W003", now.Add(-10*time.Minute))
+		convey.So(ret.Status, convey.ShouldEqual, payment.DEALING)
+		transport.resps = []string{notExist, notExist}
+		ret = w.QueryWithdraw("W003", now.Add(-time.Hour))
+		convey.So(ret.Status, convey.ShouldEqual, payment.FAIL)
+		convey.So(ret.FailCode, convey.ShouldEqual, "ORDER_NOT_EXIST")
+	})
+}
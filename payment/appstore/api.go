@@ -0,0 +1,135 @@
+package appstore
+
+import (
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/kinwyb/golang/payment"
+	"github.com/kinwyb/golang/utils"
+)
+
+//App Store Server API地址
+const (
+	ProductionURL = "https://api.storekit.itunes.apple.com"
+	SandboxURL    = "https://api.storekit-sandbox.itunes.apple.com"
+)
+
+//ErrTransactionNotExists App Store Server API中交易不存在
+var ErrTransactionNotExists = errors.New("苹果交易不存在")
+
+//App Store Server API错误结果
+type apiError struct {
+	ErrorCode    int64  `json:"errorCode"`
+	ErrorMessage string `json:"errorMessage"`
+}
+
+//交易查询结果
+type transactionInfoResponse struct {
+	SignedTransactionInfo string `json:"signedTransactionInfo"`
+}
+
+//API地址
+func (a *appstore) apiURL() string {
+	if a.config.APIURL != "" {
+		return strings.TrimRight(a.config.APIURL, "/")
+	} else if a.config.Sandbox {
+		return SandboxURL
+	}
+	return ProductionURL
+}
+
+//API请求令牌,ES256签名的JWT,有效期5分钟
+func (a *appstore) token() (string, error) {
+	if a.config.IssuerID == "" || a.config.KeyID == "" || a.config.PrivateKey == "" {
+		return "", errors.New("App Store Server API密钥未配置")
+	}
+	block, _ := pem.Decode([]byte(a.config.PrivateKey))
+	if block == nil {
+		return "", errors.New("App Store Server API私钥格式错误")
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return "", errors.New("App Store Server API私钥解析失败:" + err.Error())
+	}
+	ecKey, ok := key.(*ecdsa.PrivateKey)
+	if !ok {
+		return "", errors.New("App Store Server API私钥不是ECDSA私钥")
+	}
+	now := a.Now().Unix()
+	header, _ := json.Marshal(map[string]string{"alg": "ES256", "kid": a.config.KeyID, "typ": "JWT"})
+	claims, _ := json.Marshal(map[string]interface{}{
+		"iss": a.config.IssuerID,
+		"iat": now,
+		"exp": now + 300,
+		"aud": "appstoreconnect-v1",
+		"bid": a.config.BundleID,
+	})
+	signing := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(claims)
+	sig, err := SignES256(ecKey, []byte(signing))
+	if err != nil {
+		return "", err
+	}
+	return signing + "." + base64.RawURLEncoding.EncodeToString(sig), nil
+}
+
+//SignES256 ES256签名,返回32字节R和32字节S拼接的签名
+func SignES256(key *ecdsa.PrivateKey, data []byte) ([]byte, error) {
+	digest := sha256.Sum256(data)
+	r, s, err := ecdsa.Sign(rand.Reader, key, digest[:])
+	if err != nil {
+		return nil, err
+	}
+	sig := make([]byte, 64)
+	rb, sb := r.Bytes(), s.Bytes()
+	copy(sig[32-len(rb):32], rb)
+	copy(sig[64-len(sb):], sb)
+	return sig, nil
+}
+
+//transaction 从App Store Server API查询签名交易(Get Transaction Info)
+func (a *appstore) transaction(transactionID string) (string, error) {
+	token, err := a.token()
+	if err != nil {
+		return "", err
+	}
+	req, err := http.NewRequest(http.MethodGet, a.apiURL()+"/inApps/v1/transactions/"+url.PathEscape(transactionID), nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	resp, err := a.HTTPClient().Do(req)
+	if err != nil {
+		a.Log(utils.LogLevelError, "App Store Server API请求异常:%s", err.Error())
+		return "", payment.WrapRequestError(err, fmt.Errorf("请求失败"))
+	}
+	data, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		a.Log(utils.LogLevelError, "App Store Server API结果读取异常:%s", err.Error())
+		return "", &payment.RequestFailed{Err: fmt.Errorf("结果读取失败")}
+	}
+	a.Log(utils.LogLevelDebug, "App Store Server API交易[%s]查询结果:%d %s", transactionID, resp.StatusCode, data)
+	if resp.StatusCode == http.StatusNotFound {
+		return "", ErrTransactionNotExists
+	} else if resp.StatusCode != http.StatusOK {
+		e := &apiError{}
+		json.Unmarshal(data, e)
+		return "", fmt.Errorf("App Store Server API请求失败:[%d]%d %s", resp.StatusCode, e.ErrorCode, e.ErrorMessage)
+	}
+	ret := &transactionInfoResponse{}
+	if err = json.Unmarshal(data, ret); err != nil || ret.SignedTransactionInfo == "" {
+		return "", errors.New("App Store Server API结果解析失败")
+	}
+	return ret.SignedTransactionInfo, nil
+}
@@ -0,0 +1,335 @@
+package appstore
+
+import (
+	"crypto/md5"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/kinwyb/golang/payment"
+	"github.com/kinwyb/golang/utils"
+)
+
+//苹果应用内购买,客户端使用StoreKit完成支付,服务端验证签名交易和App Store Server Notifications V2通知
+//	1. Pay 返回商品ID和订单对应的appAccountToken,客户端购买时必须传入该令牌
+//	2. PayConfirm 验证客户端上传的签名交易,令牌与订单不一致时失败,防止交易被用于其他订单
+//	3. Notify 处理购买/续订通知,RefundNotify 处理退款通知,两者使用同一个通知地址
+
+type appstore struct {
+	payment.PayInfo
+	config   *PayConfig
+	verifier *Verifier
+}
+
+//AccountToken 订单号对应的appAccountToken,基于订单号MD5的UUID(版本3)
+func AccountToken(no string) string {
+	h := md5.Sum([]byte(no))
+	h[6] = h[6]&0x0f | 0x30
+	h[8] = h[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", h[0:4], h[4:6], h[6:8], h[8:10], h[10:])
+}
+
+//支付,返回客户端StoreKit购买参数json{"productId":"","quantity":1,"appAccountToken":""}
+func (a *appstore) Pay(req *payment.PayRequest) (string, error) {
+	ext := &PayRequestExt{}
+	if err := req.DecodeExt(ext); err != nil {
+		return "", err
+	}
+	if ext.Quantity < 1 {
+		ext.Quantity = 1
+	}
+	data, err := json.Marshal(map[string]interface{}{
+		"productId":       ext.ProductID,
+		"quantity":        ext.Quantity,
+		"appAccountToken": AccountToken(req.No),
+	})
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+//PayConfirm 验证客户端上传的签名交易,VerifyCode为空时根据ThirdNo从App Store Server API查询
+func (a *appstore) PayConfirm(req *payment.PayConfirmRequest) *payment.PayResult {
+	ret := &payment.PayResult{
+		No:      req.No,
+		TradeNo: req.No,
+		PayCode: a.Code(),
+	}
+	jws := req.VerifyCode
+	if jws == "" {
+		if req.ThirdNo == "" {
+			ret.ErrMsg = "签名交易和交易ID不能同时为空"
+			return ret
+		}
+		var err error
+		if jws, err = a.transaction(req.ThirdNo); err != nil {
+			ret.ErrMsg = err.Error()
+			return ret
+		}
+	}
+	tx, err := a.verifyTransaction(jws)
+	if err != nil {
+		a.Log(utils.LogLevelWarn, "苹果签名交易[%s]验证失败:%s", req.No, err.Error())
+		ret.ErrMsg = err.Error()
+		return ret
+	}
+	fillResult(ret, tx)
+	if req.ThirdNo != "" && req.ThirdNo != tx.TransactionID {
+		ret.ErrMsg = "交易ID不一致:" + tx.TransactionID
+	} else if tx.AppAccountToken != AccountToken(req.No) {
+		ret.ErrMsg = "交易账户令牌与订单不一致"
+	} else if tx.RevocationDate > 0 {
+		ret.ErrMsg = "交易已退款"
+	} else if err = a.verifyOrder(ret); err != nil {
+		ret.ErrMsg = err.Error()
+	} else {
+		ret.Succ = true
+	}
+	return a.FillPayFee(ret)
+}
+
+//异步结果通知处理,params["signedPayload"]或params["request_post_body"]为通知内容
+//	购买/续订通知返回支付结果,找不到对应订单号时返回失败
+//	其他通知验证通过后返回失败结果,在Navite中记录通知类型并标记ignored
+func (a *appstore) Notify(params map[string]string) *payment.PayResult {
+	ret := &payment.PayResult{PayCode: a.Code()}
+	n, err := a.notification(params)
+	if err != nil {
+		a.Log(utils.LogLevelWarn, "苹果通知验证失败:%s", err.Error())
+		ret.ErrMsg = err.Error()
+		return ret
+	}
+	ret.Navite = map[string]string{}
+	if n.Transaction != nil {
+		fillResult(ret, n.Transaction)
+		ret.No = a.orderNo(n.Transaction)
+		ret.TradeNo = ret.No
+	}
+	ret.Navite["notificationType"] = n.NotificationType
+	ret.Navite["subtype"] = n.Subtype
+	ret.Navite["notificationUUID"] = n.NotificationUUID
+	switch n.NotificationType {
+	case NotificationSubscribed, NotificationDidRenew, NotificationOneTimeCharge, NotificationOfferRedeemed:
+		if n.Transaction == nil {
+			ret.ErrMsg = "通知中没有交易信息"
+		} else if ret.No == "" {
+			ret.ErrMsg = "通知交易没有对应的订单号"
+		} else if n.Transaction.RevocationDate > 0 {
+			ret.ErrMsg = "交易已退款"
+		} else if err = a.verifyOrder(ret); err != nil {
+			ret.ErrMsg = err.Error()
+		} else {
+			ret.Succ = true
+		}
+	default:
+		ret.ErrMsg = "非支付通知:" + n.NotificationType
+		ret.Navite["ignored"] = "true"
+	}
+	return a.FillPayFee(ret)
+}
+
+//NotifyResult 支付成功或无需处理的非支付通知返回success,苹果只根据HTTP状态码判断,返回fail时应用应响应非200状态码让苹果重新推送
+func (a *appstore) NotifyResult(payResult *payment.PayResult) string {
+	if payResult.Succ || payResult.Navite["ignored"] == "true" {
+		return "success"
+	}
+	return "fail"
+}
+
+//同步结果处理,params["no"]订单号、params["signedTransaction"]签名交易、params["transactionId"]交易ID,同PayConfirm
+func (a *appstore) Result(params map[string]string) *payment.PayResult {
+	return a.PayConfirm(&payment.PayConfirmRequest{
+		No:         params["no"],
+		ThirdNo:    params["transactionId"],
+		VerifyCode: params["signedTransaction"],
+	})
+}
+
+//RefundNotify 退款通知处理,REFUND/REVOKE返回退款成功,REFUND_DECLINED/REFUND_REVERSED返回失败,其他通知返回UNKNOW
+//	退款单号和第三方退款流水号为苹果交易ID
+func (a *appstore) RefundNotify(params map[string]string) *payment.RefundResult {
+	ret := &payment.RefundResult{
+		Status:  payment.UNKNOW,
+		PayCode: a.Code(),
+	}
+	n, err := a.notification(params)
+	if err != nil {
+		ret.FailCode = "NOTIFY_VERIFY_FAIL"
+		ret.FailMsg = err.Error()
+		return ret
+	} else if n.Transaction == nil {
+		ret.FailMsg = "通知中没有交易信息"
+		return ret
+	}
+	tx := n.Transaction
+	ret.No = a.orderNo(tx)
+	ret.RefundNo = tx.TransactionID
+	ret.ThirdTradeNo = tx.TransactionID
+	ret.Money = float64(tx.Price) / 1000
+	ret.Navite = transactionMap(tx)
+	ret.Navite["notificationType"] = n.NotificationType
+	ret.Navite["notificationUUID"] = n.NotificationUUID
+	switch n.NotificationType {
+	case NotificationRefund, NotificationRevoke:
+		ret.Status = payment.SUCCESS
+	case NotificationRefundDeclined:
+		ret.Status = payment.FAIL
+		ret.FailCode = n.NotificationType
+		ret.FailMsg = "退款被拒绝"
+	case NotificationRefundReversed:
+		ret.Status = payment.FAIL
+		ret.FailCode = n.NotificationType
+		ret.FailMsg = "退款已撤销"
+	default:
+		ret.FailMsg = "非退款通知:" + n.NotificationType
+	}
+	return ret
+}
+
+//验证签名交易,校验bundleId和交易环境
+func (a *appstore) verifyTransaction(jws string) (*Transaction, error) {
+	tx := &Transaction{}
+	if err := a.verifier.Verify(jws, tx); err != nil {
+		return nil, err
+	} else if tx.BundleID != a.config.BundleID {
+		return nil, errors.New("交易bundleId不一致:" + tx.BundleID)
+	} else if err = a.checkEnvironment(tx.Environment); err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+//验证通知及通知中的签名交易
+func (a *appstore) notification(params map[string]string) (*Notification, error) {
+	payload := params["signedPayload"]
+	if payload == "" {
+		body := &struct {
+			SignedPayload string `json:"signedPayload"`
+		}{}
+		if err := json.Unmarshal([]byte(params["request_post_body"]), body); err != nil || body.SignedPayload == "" {
+			return nil, errors.New("苹果通知内容解析失败")
+		}
+		payload = body.SignedPayload
+	}
+	n := &Notification{}
+	if err := a.verifier.Verify(payload, n); err != nil {
+		return nil, err
+	} else if n.Data == nil {
+		return n, nil
+	} else if n.Data.BundleID != a.config.BundleID {
+		return nil, errors.New("通知bundleId不一致:" + n.Data.BundleID)
+	} else if err = a.checkEnvironment(n.Data.Environment); err != nil {
+		return nil, err
+	} else if a.config.AppAppleID > 0 && n.Data.Environment == EnvProduction && n.Data.AppAppleID != a.config.AppAppleID {
+		return nil, errors.New("通知appAppleId不一致:" + strconv.FormatInt(n.Data.AppAppleID, 10))
+	}
+	if n.Data.SignedTransactionInfo != "" {
+		tx, err := a.verifyTransaction(n.Data.SignedTransactionInfo)
+		if err != nil {
+			return nil, err
+		}
+		n.Transaction = tx
+	}
+	return n, nil
+}
+
+//校验交易环境,沙盒配置接受Sandbox和Xcode环境
+func (a *appstore) checkEnvironment(env string) error {
+	if a.config.Sandbox && (env == EnvSandbox || env == EnvXcode) || !a.config.Sandbox && env == EnvProduction {
+		return nil
+	}
+	return errors.New("交易环境不一致:" + env)
+}
+
+//通知交易对应的订单号
+func (a *appstore) orderNo(tx *Transaction) string {
+	if a.config.OrderNo == nil {
+		return ""
+	}
+	return a.config.OrderNo(tx)
+}
+
+//订单业务校验
+func (a *appstore) verifyOrder(result *payment.PayResult) error {
+	return payment.VerifyNotifyOrder(a.config.OrderVerifier, &payment.NotifyOrder{
+		PayCode:      result.PayCode,
+		No:           result.No,
+		TradeNo:      result.TradeNo,
+		ThirdTradeNo: result.ThirdTradeNo,
+		Money:        result.Money,
+		AppID:        a.config.BundleID,
+		Navite:       result.Navite,
+	})
+}
+
+//交易内容写入支付结果
+func fillResult(ret *payment.PayResult, tx *Transaction) {
+	ret.Money = float64(tx.Price) / 1000
+	ret.Currency = tx.Currency
+	ret.ThirdAccount = tx.AppAccountToken
+	ret.ThirdTradeNo = tx.TransactionID
+	ret.Navite = transactionMap(tx)
+}
+
+//交易原始数据
+func transactionMap(tx *Transaction) map[string]string {
+	return map[string]string{
+		"transactionId":         tx.TransactionID,
+		"originalTransactionId": tx.OriginalTransactionID,
+		"bundleId":              tx.BundleID,
+		"productId":             tx.ProductID,
+		"quantity":              strconv.Itoa(tx.Quantity),
+		"type":                  tx.Type,
+		"appAccountToken":       tx.AppAccountToken,
+		"environment":           tx.Environment,
+		"purchaseDate":          strconv.FormatInt(tx.PurchaseDate, 10),
+		"revocationDate":        strconv.FormatInt(tx.RevocationDate, 10),
+		"currency":              tx.Currency,
+		"price":                 strconv.FormatInt(tx.Price, 10),
+	}
+}
+
+//GetPayment 生成一个支付对象
+func (a *appstore) GetPayment(cfg interface{}) payment.Payment {
+	var c *PayConfig
+	ok := false
+	if c, ok = cfg.(*PayConfig); !ok || c == nil {
+		log(utils.LogLevelWarn, "传递的配置信息不是一个有效的苹果应用内购买配置")
+		return nil
+	}
+	if c.Name == "" || c.Code == "" || c.BundleID == "" {
+		return nil
+	}
+	obj := &appstore{config: c}
+	obj.Init(obj.config.Code, obj.config.Name, obj.config.State)
+	obj.SetFee(obj.config.Fee)
+	obj.SetOptions(obj.config.Options, &lg)
+	verifier, err := NewVerifier([]byte(c.RootCert), obj.Now)
+	if err != nil {
+		log(utils.LogLevelWarn, "苹果根证书解析失败:%s", err.Error())
+		return nil
+	}
+	obj.verifier = verifier
+	return obj
+}
+
+//Driver 驱动编码
+func (a *appstore) Driver() string {
+	return "appstore"
+}
+
+//NewExt 支付请求扩展信息
+func (a *appstore) NewExt() payment.PayExt {
+	return &PayRequestExt{}
+}
+
+//Capabilities 支付能力,Pay只返回StoreKit购买参数,需要PayConfirm验证客户端购买结果
+func (a *appstore) Capabilities() *payment.Capabilities {
+	return &payment.Capabilities{
+		Operations: []string{payment.OpPay, payment.OpPayConfirm, payment.OpNotify, payment.OpResult},
+		TradeTypes: []string{payment.TradeApp},
+		Required:   []string{"No", "Ext"},
+	}
+}
@@ -0,0 +1,111 @@
+package appstore_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/kinwyb/golang/payment"
+	"github.com/kinwyb/golang/payment/appstore"
+	"github.com/kinwyb/golang/payment/appstore/appstoretest"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func Test_AppStore(t *testing.T) {
+	server := appstoretest.NewServer("com.example.app")
+	defer server.Close()
+	registry := payment.NewRegistry()
+	appstore.Driver(registry.RegDriver, nil)
+	cfg := server.Config("appstore")
+	cfg.OrderNo = func(tx *appstore.Transaction) string {
+		if tx.AppAccountToken == appstore.AccountToken("001") {
+			return "001"
+		}
+		return ""
+	}
+	if _, err := registry.AddPayment("appstore", cfg); err != nil {
+		t.Fatal(err)
+	}
+	convey.Convey("签名交易验证", t, func() {
+		req := &payment.PayRequest{No: "001"}
+		req.SetExt(&appstore.PayRequestExt{ProductID: "coin.60"})
+		code, err := registry.Pay("appstore", req)
+		convey.So(err, convey.ShouldBeNil)
+		params := map[string]interface{}{}
+		json.Unmarshal([]byte(code), &params)
+		convey.So(params["productId"], convey.ShouldEqual, "coin.60")
+		convey.So(params["appAccountToken"], convey.ShouldEqual, appstore.AccountToken("001"))
+		_, err = registry.Pay("appstore", &payment.PayRequest{No: "001", Ext: "{}"})
+		convey.So(err, convey.ShouldNotBeNil)
+
+		tx := &appstore.Transaction{ProductID: "coin.60", AppAccountToken: appstore.AccountToken("001"), Price: 6000}
+		jws := server.AddTransaction(tx)
+		ret := registry.PayConfirm("appstore", &payment.PayConfirmRequest{No: "001", VerifyCode: jws})
+		convey.So(ret.Succ, convey.ShouldBeTrue)
+		convey.So(ret.Money, convey.ShouldEqual, 6)
+		convey.So(ret.ThirdTradeNo, convey.ShouldEqual, tx.TransactionID)
+		convey.So(ret.Navite["productId"], convey.ShouldEqual, "coin.60")
+		ret = registry.PayConfirm("appstore", &payment.PayConfirmRequest{No: "002", VerifyCode: jws})
+		convey.So(ret.Succ, convey.ShouldBeFalse)
+		convey.So(ret.ErrMsg, convey.ShouldEqual, "交易账户令牌与订单不一致")
+
+		ret = registry.PayConfirm("appstore", &payment.PayConfirmRequest{No: "001", ThirdNo: tx.TransactionID})
+		convey.So(ret.Succ, convey.ShouldBeTrue)
+		ret = registry.PayConfirm("appstore", &payment.PayConfirmRequest{No: "001", ThirdNo: "404"})
+		convey.So(ret.ErrMsg, convey.ShouldEqual, appstore.ErrTransactionNotExists.Error())
+
+		parts := strings.Split(jws, ".")
+		other := server.Sign(map[string]interface{}{"bundleId": "com.example.app", "price": 1})
+		ret = registry.PayConfirm("appstore", &payment.PayConfirmRequest{No: "001", VerifyCode: parts[0] + "." + strings.Split(other, ".")[1] + "." + parts[2]})
+		convey.So(ret.ErrMsg, convey.ShouldEqual, "签名验证失败")
+		fake := appstoretest.NewServer("com.example.app")
+		defer fake.Close()
+		ret = registry.PayConfirm("appstore", &payment.PayConfirmRequest{No: "001", VerifyCode: fake.AddTransaction(&appstore.Transaction{AppAccountToken: appstore.AccountToken("001")})})
+		convey.So(ret.ErrMsg, convey.ShouldEqual, "签名根证书不是苹果根证书")
+		ret = registry.PayConfirm("appstore", &payment.PayConfirmRequest{No: "001", VerifyCode: server.AddTransaction(&appstore.Transaction{AppAccountToken: appstore.AccountToken("001"), Environment: appstore.EnvProduction})})
+		convey.So(ret.ErrMsg, convey.ShouldEqual, "交易环境不一致:Production")
+		ret = registry.PayConfirm("appstore", &payment.PayConfirmRequest{No: "001", VerifyCode: server.AddTransaction(&appstore.Transaction{AppAccountToken: appstore.AccountToken("001"), BundleID: "com.example.other"})})
+		convey.So(ret.ErrMsg, convey.ShouldEqual, "交易bundleId不一致:com.example.other")
+		ret = registry.PayConfirm("appstore", &payment.PayConfirmRequest{No: "001", VerifyCode: server.AddTransaction(&appstore.Transaction{AppAccountToken: appstore.AccountToken("001"), Environment: appstore.EnvXcode})})
+		convey.So(ret.Succ, convey.ShouldBeTrue)
+	})
+	convey.Convey("通知", t, func() {
+		tx := &appstore.Transaction{ProductID: "coin.60", AppAccountToken: appstore.AccountToken("001"), Price: 6000}
+		ret := registry.Notify("appstore", server.Notification(appstore.NotificationOneTimeCharge, "", tx))
+		reply := registry.Payment("appstore").NotifyResult(ret)
+		convey.So(ret.Succ, convey.ShouldBeTrue)
+		convey.So(ret.No, convey.ShouldEqual, "001")
+		convey.So(ret.ThirdTradeNo, convey.ShouldEqual, tx.TransactionID)
+		convey.So(reply, convey.ShouldEqual, "success")
+
+		ret = registry.Notify("appstore", server.Notification(appstore.NotificationTest, "", nil))
+		reply = registry.Payment("appstore").NotifyResult(ret)
+		convey.So(ret.Succ, convey.ShouldBeFalse)
+		convey.So(ret.Navite["notificationType"], convey.ShouldEqual, appstore.NotificationTest)
+		convey.So(reply, convey.ShouldEqual, "success")
+		ret = registry.Notify("appstore", map[string]string{"request_post_body": `{"signedPayload":"a.b.c"}`})
+		reply = registry.Payment("appstore").NotifyResult(ret)
+		convey.So(ret.Succ, convey.ShouldBeFalse)
+		convey.So(reply, convey.ShouldEqual, "fail")
+		ret = registry.Notify("appstore", server.Notification(appstore.NotificationOneTimeCharge, "", &appstore.Transaction{AppAccountToken: appstore.AccountToken("404"), Price: 6000}))
+		reply = registry.Payment("appstore").NotifyResult(ret)
+		convey.So(ret.Succ, convey.ShouldBeFalse)
+		convey.So(ret.ErrMsg, convey.ShouldEqual, "通知交易没有对应的订单号")
+		convey.So(reply, convey.ShouldEqual, "fail")
+
+		refund := registry.RefundNotify("appstore", server.Notification(appstore.NotificationRefund, "", &appstore.Transaction{
+			TransactionID: tx.TransactionID, AppAccountToken: tx.AppAccountToken, Price: 6000, RevocationDate: tx.PurchaseDate + 1000,
+		}))
+		convey.So(refund.Status, convey.ShouldEqual, payment.SUCCESS)
+		convey.So(refund.No, convey.ShouldEqual, "001")
+		convey.So(refund.Money, convey.ShouldEqual, 6)
+		convey.So(refund.ThirdTradeNo, convey.ShouldEqual, tx.TransactionID)
+		ret = registry.PayConfirm("appstore", &payment.PayConfirmRequest{No: "001", ThirdNo: tx.TransactionID})
+		convey.So(ret.ErrMsg, convey.ShouldEqual, "交易已退款")
+		refund = registry.RefundNotify("appstore", server.Notification(appstore.NotificationRefundDeclined, "", tx))
+		convey.So(refund.Status, convey.ShouldEqual, payment.FAIL)
+		refund = registry.RefundNotify("appstore", server.Notification(appstore.NotificationDidRenew, "", tx))
+		convey.So(refund.Status, convey.ShouldEqual, payment.UNKNOW)
+		convey.So(registry.PaymentCapabilities("appstore").Has(payment.OpRefundNotify), convey.ShouldBeTrue)
+	})
+}
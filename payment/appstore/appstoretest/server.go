@@ -0,0 +1,271 @@
+package appstoretest
+
+//本地模拟的苹果服务,用于应用内购买的测试,不访问苹果服务器
+//	使用自签名的根证书、中间证书和签名证书生成与苹果格式一致的签名交易和通知,并模拟App Store Server API的交易查询接口
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kinwyb/golang/payment"
+	"github.com/kinwyb/golang/payment/appstore"
+)
+
+//Server 模拟的苹果服务
+type Server struct {
+	*httptest.Server
+	BundleID    string //应用Bundle ID
+	Environment string //交易环境,默认Sandbox
+	RootCert    string //模拟根证书(PEM),配置到PayConfig.RootCert
+	IssuerID    string //模拟API密钥Issuer ID
+	KeyID       string //模拟API密钥ID
+	PrivateKey  string //模拟API私钥(PEM)
+
+	lock         sync.Mutex
+	seq          int
+	x5c          []string
+	signKey      *ecdsa.PrivateKey
+	apiKey       *ecdsa.PrivateKey
+	transactions map[string]*appstore.Transaction
+}
+
+//NewServer 创建并启动模拟服务,使用完成后调用Close
+func NewServer(bundleID string) *Server {
+	s := &Server{
+		BundleID:     bundleID,
+		Environment:  appstore.EnvSandbox,
+		IssuerID:     "57246542-96fe-1a63-e053-0824d011072a",
+		KeyID:        "FAKEKEY001",
+		transactions: map[string]*appstore.Transaction{},
+	}
+	rootKey, root := newCert("Fake Apple Root CA - G3", nil, nil, nil, true)
+	interKey, inter := newCert("Fake Apple Worldwide Developer Relations CA - G6", appstore.OIDIntermediateCert, root, rootKey, true)
+	leafKey, leaf := newCert("Fake Prod ECC Mac App Store and iTunes Store Receipt Signing", appstore.OIDLeafCert, inter, interKey, false)
+	s.signKey = leafKey
+	for _, c := range []*x509.Certificate{leaf, inter, root} {
+		s.x5c = append(s.x5c, base64.StdEncoding.EncodeToString(c.Raw))
+	}
+	s.RootCert = string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: root.Raw}))
+	s.apiKey = newKey()
+	der, err := x509.MarshalPKCS8PrivateKey(s.apiKey)
+	if err != nil {
+		panic(err)
+	}
+	s.PrivateKey = string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+//Config 生成连接模拟服务的支付配置
+func (s *Server) Config(code string) *appstore.PayConfig {
+	return &appstore.PayConfig{
+		Config:     payment.Config{Code: code, Name: "苹果应用内购买", State: true},
+		BundleID:   s.BundleID,
+		RootCert:   s.RootCert,
+		Sandbox:    s.Environment != appstore.EnvProduction,
+		IssuerID:   s.IssuerID,
+		KeyID:      s.KeyID,
+		PrivateKey: s.PrivateKey,
+		APIURL:     s.URL,
+	}
+}
+
+//Sign 使用模拟证书链签名内容,生成JWS
+func (s *Server) Sign(payload interface{}) string {
+	header, _ := json.Marshal(map[string]interface{}{"alg": "ES256", "x5c": s.x5c})
+	body, err := json.Marshal(payload)
+	if err != nil {
+		panic(err)
+	}
+	signing := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(body)
+	sig, err := appstore.SignES256(s.signKey, []byte(signing))
+	if err != nil {
+		panic(err)
+	}
+	return signing + "." + base64.RawURLEncoding.EncodeToString(sig)
+}
+
+//AddTransaction 登记交易并返回签名交易,交易ID为空时自动生成,bundleId、环境、时间为空时使用默认值
+//	登记后可以通过App Store Server API查询,相同交易ID再次登记时覆盖(如设置退款时间)
+func (s *Server) AddTransaction(tx *appstore.Transaction) string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	now := time.Now().UnixNano() / int64(time.Millisecond)
+	if tx.TransactionID == "" {
+		s.seq++
+		tx.TransactionID = fmt.Sprintf("2000000%09d", s.seq)
+	}
+	if tx.OriginalTransactionID == "" {
+		tx.OriginalTransactionID = tx.TransactionID
+	}
+	if tx.BundleID == "" {
+		tx.BundleID = s.BundleID
+	}
+	if tx.Environment == "" {
+		tx.Environment = s.Environment
+	}
+	if tx.PurchaseDate == 0 {
+		tx.PurchaseDate = now
+	}
+	if tx.OriginalPurchaseDate == 0 {
+		tx.OriginalPurchaseDate = tx.PurchaseDate
+	}
+	if tx.Quantity == 0 {
+		tx.Quantity = 1
+	}
+	if tx.Type == "" {
+		tx.Type = "Consumable"
+	}
+	if tx.InAppOwnershipType == "" {
+		tx.InAppOwnershipType = "PURCHASED"
+	}
+	if tx.Currency == "" {
+		tx.Currency = "CNY"
+	}
+	tx.SignedDate = now
+	v := *tx
+	s.transactions[tx.TransactionID] = &v
+	return s.Sign(tx)
+}
+
+//Notification 生成App Store Server Notifications V2通知,返回值可直接作为Notify/RefundNotify的参数
+//	tx为空时通知中没有交易信息(如TEST通知)
+func (s *Server) Notification(notificationType, subtype string, tx *appstore.Transaction) map[string]string {
+	data := &appstore.NotificationData{
+		AppAppleID:    1234567890,
+		BundleID:      s.BundleID,
+		BundleVersion: "1",
+		Environment:   s.Environment,
+	}
+	if tx != nil {
+		data.SignedTransactionInfo = s.AddTransaction(tx)
+	}
+	n := &appstore.Notification{
+		NotificationType: notificationType,
+		Subtype:          subtype,
+		NotificationUUID: newUUID(),
+		Version:          "2.0",
+		SignedDate:       time.Now().UnixNano() / int64(time.Millisecond),
+		Data:             data,
+	}
+	body, _ := json.Marshal(map[string]string{"signedPayload": s.Sign(n)})
+	return map[string]string{"request_post_body": string(body)}
+}
+
+//模拟App Store Server API
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	if !s.authorized(r.Header.Get("Authorization")) {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	const prefix = "/inApps/v1/transactions/"
+	if r.Method != http.MethodGet || !strings.HasPrefix(r.URL.Path, prefix) {
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(map[string]interface{}{"errorCode": 4040000, "errorMessage": "Not found."})
+		return
+	}
+	s.lock.Lock()
+	tx, ok := s.transactions[strings.TrimPrefix(r.URL.Path, prefix)]
+	s.lock.Unlock()
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(map[string]interface{}{"errorCode": 4040010, "errorMessage": "Transaction id not found."})
+		return
+	}
+	json.NewEncoder(w).Encode(map[string]string{"signedTransactionInfo": s.Sign(tx)})
+}
+
+//校验API请求令牌
+func (s *Server) authorized(auth string) bool {
+	parts := strings.Split(strings.TrimPrefix(auth, "Bearer "), ".")
+	if len(parts) != 3 {
+		return false
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil || len(sig) != 64 {
+		return false
+	}
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	if !ecdsa.Verify(&s.apiKey.PublicKey, digest[:], new(big.Int).SetBytes(sig[:32]), new(big.Int).SetBytes(sig[32:])) {
+		return false
+	}
+	header, claims := map[string]interface{}{}, map[string]interface{}{}
+	data, _ := base64.RawURLEncoding.DecodeString(parts[0])
+	if json.Unmarshal(data, &header) != nil || header["kid"] != s.KeyID {
+		return false
+	}
+	data, _ = base64.RawURLEncoding.DecodeString(parts[1])
+	if json.Unmarshal(data, &claims) != nil {
+		return false
+	}
+	exp, _ := claims["exp"].(float64)
+	return claims["iss"] == s.IssuerID && claims["aud"] == "appstoreconnect-v1" &&
+		claims["bid"] == s.BundleID && int64(exp) > time.Now().Unix()
+}
+
+//生成ECDSA P-256密钥
+func newKey() *ecdsa.PrivateKey {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		panic(err)
+	}
+	return key
+}
+
+//生成证书,parent为空时生成自签名证书,ext不为空时添加苹果证书扩展
+func newCert(name string, ext asn1.ObjectIdentifier, parent *x509.Certificate, parentKey *ecdsa.PrivateKey, ca bool) (*ecdsa.PrivateKey, *x509.Certificate) {
+	key := newKey()
+	serial, _ := rand.Int(rand.Reader, big.NewInt(1<<62))
+	tpl := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{CommonName: name, Organization: []string{"Apple Inc."}},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  ca,
+	}
+	if ca {
+		tpl.KeyUsage |= x509.KeyUsageCertSign
+	}
+	if ext != nil {
+		tpl.ExtraExtensions = []pkix.Extension{{Id: ext, Value: []byte{0x05, 0x00}}}
+	}
+	if parent == nil {
+		parent, parentKey = tpl, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tpl, parent, &key.PublicKey, parentKey)
+	if err != nil {
+		panic(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		panic(err)
+	}
+	return key, cert
+}
+
+//随机UUID
+func newUUID() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
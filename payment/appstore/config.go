@@ -0,0 +1,110 @@
+package appstore
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/kinwyb/golang/payment"
+)
+
+//PayConfig 苹果应用内购买配置信息
+//	支付请求PayRequest中：Ext 必填 结构为PayRequestExt
+//	确认支付PayConfirmRequest中：VerifyCode为客户端StoreKit返回的签名交易(jwsRepresentation),
+//	为空时使用ThirdNo(transactionId)从App Store Server API查询
+type PayConfig struct {
+	payment.Config
+	BundleID   string //应用Bundle ID,校验交易和通知的bundleId
+	AppAppleID int64  //应用Apple ID,不为空时校验生产环境通知的appAppleId
+	RootCert   string //苹果根证书AppleRootCA-G3(PEM),签名交易的x5c证书链必须由该证书签发
+	Sandbox    bool   //沙盒环境,只接受Sandbox/Xcode环境的交易,否则只接受Production环境的交易
+	IssuerID   string //App Store Connect API密钥Issuer ID,使用App Store Server API时必填
+	KeyID      string //App Store Connect API密钥ID
+	PrivateKey string //App Store Connect API私钥(.p8文件内容)
+	APIURL     string //App Store Server API地址,为空时根据Sandbox选择苹果正式地址
+	//OrderNo 根据通知中的交易信息查询订单号,为空时通知结果的订单号为空
+	OrderNo func(tx *Transaction) string `json:"-"`
+	//OrderVerifier 订单业务校验,签名交易验证通过后调用
+	OrderVerifier payment.OrderVerifier `json:"-"`
+}
+
+//PayRequestExt 支付请求扩展信息
+type PayRequestExt struct {
+	ProductID string `description:"App Store Connect中配置的商品ID"`
+	Quantity  int    `description:"购买数量,默认1"`
+}
+
+//Validate 校验扩展信息
+func (p *PayRequestExt) Validate() error {
+	if strings.TrimSpace(p.ProductID) == "" {
+		return errors.New("商品ID[ProductID]不能为空")
+	} else if p.Quantity < 0 {
+		return errors.New("购买数量[Quantity]不能小于0")
+	}
+	return nil
+}
+
+//交易环境
+const (
+	EnvProduction = "Production" //生产环境
+	EnvSandbox    = "Sandbox"    //沙盒环境
+	EnvXcode      = "Xcode"      //Xcode本地StoreKit测试环境
+)
+
+//通知类型
+const (
+	NotificationSubscribed     = "SUBSCRIBED"      //订阅
+	NotificationDidRenew       = "DID_RENEW"       //自动续订成功
+	NotificationOneTimeCharge  = "ONE_TIME_CHARGE" //一次性购买
+	NotificationOfferRedeemed  = "OFFER_REDEEMED"  //兑换优惠
+	NotificationRefund         = "REFUND"          //已退款
+	NotificationRefundDeclined = "REFUND_DECLINED" //退款被拒绝
+	NotificationRefundReversed = "REFUND_REVERSED" //退款被撤销
+	NotificationRevoke         = "REVOKE"          //家人共享的购买被撤销
+	NotificationTest           = "TEST"            //测试通知
+)
+
+//Transaction 签名交易内容(JWSTransactionDecodedPayload)
+type Transaction struct {
+	TransactionID         string `json:"transactionId"`         //交易ID
+	OriginalTransactionID string `json:"originalTransactionId"` //原始交易ID
+	WebOrderLineItemID    string `json:"webOrderLineItemId"`    //订阅续订ID
+	BundleID              string `json:"bundleId"`              //应用Bundle ID
+	ProductID             string `json:"productId"`             //商品ID
+	PurchaseDate          int64  `json:"purchaseDate"`          //购买时间,毫秒时间戳
+	OriginalPurchaseDate  int64  `json:"originalPurchaseDate"`  //原始购买时间,毫秒时间戳
+	ExpiresDate           int64  `json:"expiresDate"`           //订阅到期时间,毫秒时间戳
+	Quantity              int    `json:"quantity"`              //购买数量
+	Type                  string `json:"type"`                  //商品类型
+	AppAccountToken       string `json:"appAccountToken"`       //购买时传入的账户令牌
+	InAppOwnershipType    string `json:"inAppOwnershipType"`    //所有权类型 PURCHASED/FAMILY_SHARED
+	SignedDate            int64  `json:"signedDate"`            //签名时间,毫秒时间戳
+	RevocationReason      *int   `json:"revocationReason"`      //撤销原因
+	RevocationDate        int64  `json:"revocationDate"`        //撤销(退款)时间,毫秒时间戳
+	Environment           string `json:"environment"`           //交易环境
+	Storefront            string `json:"storefront"`            //App Store地区
+	TransactionReason     string `json:"transactionReason"`     //交易原因 PURCHASE/RENEWAL
+	Currency              string `json:"currency"`              //币种
+	Price                 int64  `json:"price"`                 //价格,千分之一货币单位
+}
+
+//Notification App Store Server Notifications V2通知内容(responseBodyV2DecodedPayload)
+type Notification struct {
+	NotificationType string            `json:"notificationType"` //通知类型
+	Subtype          string            `json:"subtype"`          //通知子类型
+	NotificationUUID string            `json:"notificationUUID"` //通知唯一ID,重复推送时不变
+	Version          string            `json:"version"`          //通知版本
+	SignedDate       int64             `json:"signedDate"`       //签名时间,毫秒时间戳
+	Data             *NotificationData `json:"data"`             //通知数据
+	Transaction      *Transaction      `json:"-"`                //验证后的交易内容
+}
+
+//NotificationData 通知数据
+type NotificationData struct {
+	AppAppleID            int64  `json:"appAppleId"`            //应用Apple ID
+	BundleID              string `json:"bundleId"`              //应用Bundle ID
+	BundleVersion         string `json:"bundleVersion"`         //应用版本
+	Environment           string `json:"environment"`           //通知环境
+	SignedTransactionInfo string `json:"signedTransactionInfo"` //签名交易
+	SignedRenewalInfo     string `json:"signedRenewalInfo"`     //签名续订信息
+	Status                int    `json:"status"`                //订阅状态
+}
@@ -0,0 +1,166 @@
+package appstore
+
+import (
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"strings"
+	"time"
+)
+
+//苹果证书扩展,签名证书和中间证书必须包含
+var (
+	OIDLeafCert         = asn1.ObjectIdentifier{1, 2, 840, 113635, 100, 6, 11, 1} //App Store签名证书
+	OIDIntermediateCert = asn1.ObjectIdentifier{1, 2, 840, 113635, 100, 6, 2, 1}  //Apple Worldwide Developer Relations中间证书
+)
+
+//ErrJWSInvalid 签名数据格式错误
+var ErrJWSInvalid = errors.New("签名数据格式错误")
+
+//jws头
+type jwsHeader struct {
+	Alg string   `json:"alg"`
+	X5c []string `json:"x5c"`
+}
+
+//Verifier 苹果签名数据(JWS)验证
+//	1. 头部alg必须为ES256,x5c为签名证书、中间证书、根证书
+//	2. 证书链必须由配置的根证书签发,签名证书和中间证书包含苹果扩展
+//	3. 使用签名证书公钥验证ES256签名
+type Verifier struct {
+	roots *x509.CertPool
+	root  *x509.Certificate
+	clock func() time.Time
+}
+
+//NewVerifier 创建签名验证,rootCert为PEM或DER格式的苹果根证书,clock为空时使用time.Now
+func NewVerifier(rootCert []byte, clock func() time.Time) (*Verifier, error) {
+	root, err := ParseCert(rootCert)
+	if err != nil {
+		return nil, err
+	}
+	if clock == nil {
+		clock = time.Now
+	}
+	roots := x509.NewCertPool()
+	roots.AddCert(root)
+	return &Verifier{roots: roots, root: root, clock: clock}, nil
+}
+
+//ParseCert 解析PEM或DER格式证书
+func ParseCert(data []byte) (*x509.Certificate, error) {
+	if block, _ := pem.Decode(data); block != nil {
+		data = block.Bytes
+	}
+	cert, err := x509.ParseCertificate(data)
+	if err != nil {
+		return nil, errors.New("证书解析失败:" + err.Error())
+	}
+	return cert, nil
+}
+
+//Verify 验证签名数据并解析内容到v
+//	证书有效期按内容中的signedDate校验,没有signedDate时使用当前时间
+func (v *Verifier) Verify(jws string, payload interface{}) error {
+	parts := strings.Split(jws, ".")
+	if len(parts) != 3 {
+		return ErrJWSInvalid
+	}
+	headerBytes, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return ErrJWSInvalid
+	}
+	payloadBytes, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return ErrJWSInvalid
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil || len(sig) != 64 {
+		return ErrJWSInvalid
+	}
+	header := &jwsHeader{}
+	if err = json.Unmarshal(headerBytes, header); err != nil {
+		return ErrJWSInvalid
+	} else if header.Alg != "ES256" {
+		return errors.New("签名算法不支持:" + header.Alg)
+	} else if len(header.X5c) != 3 {
+		return errors.New("签名证书链不完整")
+	}
+	signed := &struct {
+		SignedDate int64 `json:"signedDate"`
+	}{}
+	if err = json.Unmarshal(payloadBytes, signed); err != nil {
+		return ErrJWSInvalid
+	}
+	at := v.clock()
+	if signed.SignedDate > 0 {
+		at = time.Unix(0, signed.SignedDate*int64(time.Millisecond))
+	}
+	leaf, err := v.verifyChain(header.X5c, at)
+	if err != nil {
+		return err
+	}
+	pub, ok := leaf.PublicKey.(*ecdsa.PublicKey)
+	if !ok {
+		return errors.New("签名证书公钥不是ECDSA公钥")
+	}
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	r := new(big.Int).SetBytes(sig[:32])
+	s := new(big.Int).SetBytes(sig[32:])
+	if !ecdsa.Verify(pub, digest[:], r, s) {
+		return errors.New("签名验证失败")
+	}
+	if err = json.Unmarshal(payloadBytes, payload); err != nil {
+		return errors.New("签名内容解析失败:" + err.Error())
+	}
+	return nil
+}
+
+//验证证书链,返回签名证书
+func (v *Verifier) verifyChain(x5c []string, at time.Time) (*x509.Certificate, error) {
+	certs := make([]*x509.Certificate, len(x5c))
+	for i, c := range x5c {
+		data, err := base64.StdEncoding.DecodeString(c)
+		if err != nil {
+			return nil, errors.New("签名证书解码失败")
+		}
+		if certs[i], err = x509.ParseCertificate(data); err != nil {
+			return nil, errors.New("签名证书解析失败:" + err.Error())
+		}
+	}
+	if !certs[2].Equal(v.root) {
+		return nil, errors.New("签名根证书不是苹果根证书")
+	} else if !hasExtension(certs[0], OIDLeafCert) {
+		return nil, errors.New("签名证书不是App Store签名证书")
+	} else if !hasExtension(certs[1], OIDIntermediateCert) {
+		return nil, errors.New("中间证书不是苹果中间证书")
+	}
+	intermediates := x509.NewCertPool()
+	intermediates.AddCert(certs[1])
+	_, err := certs[0].Verify(x509.VerifyOptions{
+		Roots:         v.roots,
+		Intermediates: intermediates,
+		CurrentTime:   at,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	})
+	if err != nil {
+		return nil, errors.New("签名证书链验证失败:" + err.Error())
+	}
+	return certs[0], nil
+}
+
+//证书是否包含扩展
+func hasExtension(cert *x509.Certificate, oid asn1.ObjectIdentifier) bool {
+	for _, ext := range cert.Extensions {
+		if ext.Id.Equal(oid) {
+			return true
+		}
+	}
+	return false
+}
@@ -1,5 +1,9 @@
 package payment
 
+import (
+	"github.com/kinwyb/golang/utils"
+)
+
 //RegDriverFun 驱动注入函数
 type RegDriverFun func(Driver) error
 
@@ -41,6 +45,7 @@ type WithdrawInfo struct {
 	Desc     string  `description:"描述"`
 	IP       string  `description:"提现的IP地址"`
 	People   bool    `description:"是个人，否企业"`
+	Currency string  `description:"提现币种[ISO 4217,为空表示CNY]"`
 }
 
 //提现结果
@@ -54,6 +59,8 @@ type WithdrawResult struct {
 	UserName     string  `description:"收款人姓名"`
 	CertID       string  `description:"收款人身份证号"`
 	Money        float64 `description:"提现金额"`
+	Currency     string  `description:"提现币种"`
+	Fee          float64 `description:"预计手续费"`
 	PayTime      string  `description:"完成时间"`
 	Status       Status  `description:"提现状态"`
 	FailCode     string  `description:"错误编码"`
@@ -70,6 +77,13 @@ type WithdrawQueryResult struct {
 	FailMsg     string //错误原因
 }
 
+//BalanceResult 出款账户余额
+type BalanceResult struct {
+	Available float64 `description:"可用余额"`
+	Freeze    float64 `description:"冻结金额"`
+	Currency  string  `description:"币种"`
+}
+
 //PayRequest 支付请求
 type PayRequest struct {
 	No       string  `description:"交易单号"`
@@ -80,6 +94,13 @@ type PayRequest struct {
 	IP       string  `description:"交易发起端IP"`
 	MemberID string  `description:"商户网站用户唯一标识[部分支付方式必填]"`
 	Ext      string  `description:"支付方式扩展内容[部分支付方式需填写,json字符串]"`
+	Currency string  `description:"交易币种[ISO 4217,为空表示CNY]"`
+}
+
+//QRCodeResult 扫码支付结果
+type QRCodeResult struct {
+	Code  string `description:"二维码内容"`
+	Image string `description:"二维码图片[未指定生成参数时为空]"`
 }
 
 //PayConfirmRequest 支付确认请求参数
@@ -91,23 +112,63 @@ type PayConfirmRequest struct {
 
 //PayResult 支付结果
 type PayResult struct {
-	Succ         bool              //是否成功
-	ErrMsg       string            //错误消息
-	No           string            //订单号
-	TradeNo      string            //交易单号
-	Money        float64           //交易金额
+	Succ           bool              //是否成功
+	ErrMsg         string            //错误消息
+	No             string            //订单号
+	TradeNo        string            //交易单号
+	Money          float64           //交易金额
+	Currency       string            //交易币种,为空表示CNY
+	SettleMoney    float64           //结算金额[跨境交易]
+	SettleCurrency string            //结算币种[跨境交易]
+	ExchangeRate   float64           //结算汇率,1交易币种兑换的结算币种金额[跨境交易]
+	Fee            float64           //预计手续费
+	PayCode        string            //交易方式编码
+	ThirdAccount   string            //第三方交易帐号
+	ThirdTradeNo   string            //第三方交易流水号
+	Navite         map[string]string //原始数据
+	Err            error             `json:"-"` //请求错误,RequestNotSent表示请求未发送到第三方(如参数错误),不计入渠道健康状态
+}
+
+//RefundRequest 退款请求
+type RefundRequest struct {
+	No           string  `description:"原交易单号"`
+	RefundNo     string  `description:"退款单号"`
+	ThirdTradeNo string  `description:"原交易第三方交易流水号"`
+	Money        float64 `description:"退款金额"`
+	TotalMoney   float64 `description:"原交易金额"`
+	Reason       string  `description:"退款原因"`
+}
+
+//RefundResult 退款结果
+type RefundResult struct {
+	Status       Status            //退款状态
+	No           string            //原交易单号
+	RefundNo     string            //退款单号
+	ThirdTradeNo string            //第三方退款流水号
+	Money        float64           //退款金额
 	PayCode      string            //交易方式编码
-	ThirdAccount string            //第三方交易帐号
-	ThirdTradeNo string            //第三方交易流水号
+	FailCode     string            //错误代码
+	FailMsg      string            //错误原因
 	Navite       map[string]string //原始数据
 }
 
+//BillResult 对账单查询结果
+type BillResult struct {
+	PayCode string //交易方式编码
+	Date    string //对账日期[yyyy-MM-dd]
+	URL     string //对账单下载地址[第三方返回下载地址时]
+	Content string //对账单内容[第三方直接返回内容时]
+}
+
 //PayInfo 支付方式基础信息
 type PayInfo struct {
-	code  string
-	name  string
-	start bool
-	drive string
+	code   string
+	name   string
+	start  bool
+	drive  string
+	fee    *FeeSchedule
+	opts   *Options
+	logger *utils.Logger
 }
 
 //Init 初始化基本信息
@@ -117,6 +178,45 @@ func (p *PayInfo) Init(code, name string, start bool) {
 	p.start = start
 }
 
+//SetFee 设置手续费配置
+func (p *PayInfo) SetFee(fee *FeeSchedule) {
+	p.fee = fee
+}
+
+//PayFee 计算支付请求的预计手续费
+func (p *PayInfo) PayFee(req *PayRequest) float64 {
+	return p.fee.Fee(req.Money, false)
+}
+
+//WithdrawFee 计算提现请求的预计手续费
+func (p *PayInfo) WithdrawFee(info *WithdrawInfo) float64 {
+	return p.fee.Fee(info.Money, false)
+}
+
+//FillPayFee 支付成功时设置支付结果的预计手续费
+func (p *PayInfo) FillPayFee(result *PayResult) *PayResult {
+	return p.FillCardPayFee(result, false)
+}
+
+//FillCardPayFee 支付成功时按付款卡类型设置支付结果的预计手续费,credit为true使用信用卡费率
+func (p *PayInfo) FillCardPayFee(result *PayResult, credit bool) *PayResult {
+	if result != nil && result.Succ {
+		result.Fee = p.fee.Fee(result.Money, credit)
+	}
+	return result
+}
+
+//FillWithdrawFee 提现未失败时设置提现结果的预计手续费
+//	提现结果可能是公共的失败结果变量,所以返回设置手续费后的副本
+func (p *PayInfo) FillWithdrawFee(ret *WithdrawResult, info *WithdrawInfo) *WithdrawResult {
+	if ret == nil || ret.Status == FAIL || p.fee == nil {
+		return ret
+	}
+	r := *ret
+	r.Fee = p.fee.Fee(info.Money, false)
+	return &r
+}
+
 //Code 支付方式编码
 func (p *PayInfo) Code() string {
 	return p.code
@@ -134,7 +234,10 @@ func (p *PayInfo) Start() bool {
 
 //Config 支付方式配置基础字段
 type Config struct {
-	Code  string //支付编码
-	Name  string //支付名称
-	State bool   //是否启用
+	Code  string       //支付编码
+	Name  string       //支付名称
+	State bool         //是否启用
+	Fee   *FeeSchedule //手续费配置,为空不计算手续费
+	//Options 运行参数,为空使用默认值
+	Options *Options `json:"-"`
 }
@@ -5,6 +5,7 @@ package payment
 
 import (
 	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
 
@@ -12,7 +13,7 @@ import (
 )
 
 // MarshalJSON marshal bytes to json - template
-func (j *Config) MarshalJSON() ([]byte, error) {
+func (j *BalanceResult) MarshalJSON() ([]byte, error) {
 	var buf fflib.Buffer
 	if j == nil {
 		buf.WriteString("null")
@@ -26,7 +27,7 @@ func (j *Config) MarshalJSON() ([]byte, error) {
 }
 
 // MarshalJSONBuf marshal buff to json - template
-func (j *Config) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+func (j *BalanceResult) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 	if j == nil {
 		buf.WriteString("null")
 		return nil
@@ -35,46 +36,43 @@ func (j *Config) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 	var obj []byte
 	_ = obj
 	_ = err
-	buf.WriteString(`{"Code":`)
-	fflib.WriteJsonString(buf, string(j.Code))
-	buf.WriteString(`,"Name":`)
-	fflib.WriteJsonString(buf, string(j.Name))
-	if j.State {
-		buf.WriteString(`,"State":true`)
-	} else {
-		buf.WriteString(`,"State":false`)
-	}
+	buf.WriteString(`{"Available":`)
+	fflib.AppendFloat(buf, float64(j.Available), 'g', -1, 64)
+	buf.WriteString(`,"Freeze":`)
+	fflib.AppendFloat(buf, float64(j.Freeze), 'g', -1, 64)
+	buf.WriteString(`,"Currency":`)
+	fflib.WriteJsonString(buf, string(j.Currency))
 	buf.WriteByte('}')
 	return nil
 }
 
 const (
-	ffjtConfigbase = iota
-	ffjtConfignosuchkey
+	ffjtBalanceResultbase = iota
+	ffjtBalanceResultnosuchkey
 
-	ffjtConfigCode
+	ffjtBalanceResultAvailable
 
-	ffjtConfigName
+	ffjtBalanceResultFreeze
 
-	ffjtConfigState
+	ffjtBalanceResultCurrency
 )
 
-var ffjKeyConfigCode = []byte("Code")
+var ffjKeyBalanceResultAvailable = []byte("Available")
 
-var ffjKeyConfigName = []byte("Name")
+var ffjKeyBalanceResultFreeze = []byte("Freeze")
 
-var ffjKeyConfigState = []byte("State")
+var ffjKeyBalanceResultCurrency = []byte("Currency")
 
 // UnmarshalJSON umarshall json - template of ffjson
-func (j *Config) UnmarshalJSON(input []byte) error {
+func (j *BalanceResult) UnmarshalJSON(input []byte) error {
 	fs := fflib.NewFFLexer(input)
 	return j.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
 }
 
 // UnmarshalJSONFFLexer fast json unmarshall - template ffjson
-func (j *Config) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+func (j *BalanceResult) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
 	var err error
-	currentKey := ffjtConfigbase
+	currentKey := ffjtBalanceResultbase
 	_ = currentKey
 	tok := fflib.FFTok_init
 	wantedTok := fflib.FFTok_init
@@ -120,57 +118,57 @@ mainparse:
 			kn := fs.Output.Bytes()
 			if len(kn) <= 0 {
 				// "" case. hrm.
-				currentKey = ffjtConfignosuchkey
+				currentKey = ffjtBalanceResultnosuchkey
 				state = fflib.FFParse_want_colon
 				goto mainparse
 			} else {
 				switch kn[0] {
 
-				case 'C':
+				case 'A':
 
-					if bytes.Equal(ffjKeyConfigCode, kn) {
-						currentKey = ffjtConfigCode
+					if bytes.Equal(ffjKeyBalanceResultAvailable, kn) {
+						currentKey = ffjtBalanceResultAvailable
 						state = fflib.FFParse_want_colon
 						goto mainparse
 					}
 
-				case 'N':
+				case 'C':
 
-					if bytes.Equal(ffjKeyConfigName, kn) {
-						currentKey = ffjtConfigName
+					if bytes.Equal(ffjKeyBalanceResultCurrency, kn) {
+						currentKey = ffjtBalanceResultCurrency
 						state = fflib.FFParse_want_colon
 						goto mainparse
 					}
 
-				case 'S':
+				case 'F':
 
-					if bytes.Equal(ffjKeyConfigState, kn) {
-						currentKey = ffjtConfigState
+					if bytes.Equal(ffjKeyBalanceResultFreeze, kn) {
+						currentKey = ffjtBalanceResultFreeze
 						state = fflib.FFParse_want_colon
 						goto mainparse
 					}
 
 				}
 
-				if fflib.EqualFoldRight(ffjKeyConfigState, kn) {
-					currentKey = ffjtConfigState
+				if fflib.SimpleLetterEqualFold(ffjKeyBalanceResultCurrency, kn) {
+					currentKey = ffjtBalanceResultCurrency
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.SimpleLetterEqualFold(ffjKeyConfigName, kn) {
-					currentKey = ffjtConfigName
+				if fflib.SimpleLetterEqualFold(ffjKeyBalanceResultFreeze, kn) {
+					currentKey = ffjtBalanceResultFreeze
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.SimpleLetterEqualFold(ffjKeyConfigCode, kn) {
-					currentKey = ffjtConfigCode
+				if fflib.SimpleLetterEqualFold(ffjKeyBalanceResultAvailable, kn) {
+					currentKey = ffjtBalanceResultAvailable
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				currentKey = ffjtConfignosuchkey
+				currentKey = ffjtBalanceResultnosuchkey
 				state = fflib.FFParse_want_colon
 				goto mainparse
 			}
@@ -187,16 +185,16 @@ mainparse:
 			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
 				switch currentKey {
 
-				case ffjtConfigCode:
-					goto handle_Code
+				case ffjtBalanceResultAvailable:
+					goto handle_Available
 
-				case ffjtConfigName:
-					goto handle_Name
+				case ffjtBalanceResultFreeze:
+					goto handle_Freeze
 
-				case ffjtConfigState:
-					goto handle_State
+				case ffjtBalanceResultCurrency:
+					goto handle_Currency
 
-				case ffjtConfignosuchkey:
+				case ffjtBalanceResultnosuchkey:
 					err = fs.SkipField(tok)
 					if err != nil {
 						return fs.WrapErr(err)
@@ -210,25 +208,29 @@ mainparse:
 		}
 	}
 
-handle_Code:
+handle_Available:
 
-	/* handler: j.Code type=string kind=string quoted=false*/
+	/* handler: j.Available type=float64 kind=float64 quoted=false*/
 
 	{
-
-		{
-			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
-				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
-			}
+		if tok != fflib.FFTok_double && tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for float64", tok))
 		}
+	}
+
+	{
 
 		if tok == fflib.FFTok_null {
 
 		} else {
 
-			outBuf := fs.Output.Bytes()
+			tval, err := fflib.ParseFloat(fs.Output.Bytes(), 64)
 
-			j.Code = string(string(outBuf))
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			j.Available = float64(tval)
 
 		}
 	}
@@ -236,25 +238,29 @@ handle_Code:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_Name:
+handle_Freeze:
 
-	/* handler: j.Name type=string kind=string quoted=false*/
+	/* handler: j.Freeze type=float64 kind=float64 quoted=false*/
 
 	{
-
-		{
-			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
-				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
-			}
+		if tok != fflib.FFTok_double && tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for float64", tok))
 		}
+	}
+
+	{
 
 		if tok == fflib.FFTok_null {
 
 		} else {
 
-			outBuf := fs.Output.Bytes()
+			tval, err := fflib.ParseFloat(fs.Output.Bytes(), 64)
 
-			j.Name = string(string(outBuf))
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			j.Freeze = float64(tval)
 
 		}
 	}
@@ -262,34 +268,25 @@ handle_Name:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_State:
+handle_Currency:
 
-	/* handler: j.State type=bool kind=bool quoted=false*/
+	/* handler: j.Currency type=string kind=string quoted=false*/
 
 	{
-		if tok != fflib.FFTok_bool && tok != fflib.FFTok_null {
-			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for bool", tok))
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
 		}
-	}
 
-	{
 		if tok == fflib.FFTok_null {
 
 		} else {
-			tmpb := fs.Output.Bytes()
-
-			if bytes.Compare([]byte{'t', 'r', 'u', 'e'}, tmpb) == 0 {
-
-				j.State = true
-
-			} else if bytes.Compare([]byte{'f', 'a', 'l', 's', 'e'}, tmpb) == 0 {
 
-				j.State = false
+			outBuf := fs.Output.Bytes()
 
-			} else {
-				err = errors.New("unexpected bytes for true/false value")
-				return fs.WrapErr(err)
-			}
+			j.Currency = string(string(outBuf))
 
 		}
 	}
@@ -316,7 +313,7 @@ done:
 }
 
 // MarshalJSON marshal bytes to json - template
-func (j *PayConfirmRequest) MarshalJSON() ([]byte, error) {
+func (j *BillResult) MarshalJSON() ([]byte, error) {
 	var buf fflib.Buffer
 	if j == nil {
 		buf.WriteString("null")
@@ -330,7 +327,7 @@ func (j *PayConfirmRequest) MarshalJSON() ([]byte, error) {
 }
 
 // MarshalJSONBuf marshal buff to json - template
-func (j *PayConfirmRequest) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+func (j *BillResult) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 	if j == nil {
 		buf.WriteString("null")
 		return nil
@@ -339,43 +336,49 @@ func (j *PayConfirmRequest) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 	var obj []byte
 	_ = obj
 	_ = err
-	buf.WriteString(`{"No":`)
-	fflib.WriteJsonString(buf, string(j.No))
-	buf.WriteString(`,"ThirdNo":`)
-	fflib.WriteJsonString(buf, string(j.ThirdNo))
-	buf.WriteString(`,"VerifyCode":`)
-	fflib.WriteJsonString(buf, string(j.VerifyCode))
+	buf.WriteString(`{"PayCode":`)
+	fflib.WriteJsonString(buf, string(j.PayCode))
+	buf.WriteString(`,"Date":`)
+	fflib.WriteJsonString(buf, string(j.Date))
+	buf.WriteString(`,"URL":`)
+	fflib.WriteJsonString(buf, string(j.URL))
+	buf.WriteString(`,"Content":`)
+	fflib.WriteJsonString(buf, string(j.Content))
 	buf.WriteByte('}')
 	return nil
 }
 
 const (
-	ffjtPayConfirmRequestbase = iota
-	ffjtPayConfirmRequestnosuchkey
+	ffjtBillResultbase = iota
+	ffjtBillResultnosuchkey
 
-	ffjtPayConfirmRequestNo
+	ffjtBillResultPayCode
 
-	ffjtPayConfirmRequestThirdNo
+	ffjtBillResultDate
 
-	ffjtPayConfirmRequestVerifyCode
+	ffjtBillResultURL
+
+	ffjtBillResultContent
 )
 
-var ffjKeyPayConfirmRequestNo = []byte("No")
+var ffjKeyBillResultPayCode = []byte("PayCode")
 
-var ffjKeyPayConfirmRequestThirdNo = []byte("ThirdNo")
+var ffjKeyBillResultDate = []byte("Date")
 
-var ffjKeyPayConfirmRequestVerifyCode = []byte("VerifyCode")
+var ffjKeyBillResultURL = []byte("URL")
+
+var ffjKeyBillResultContent = []byte("Content")
 
 // UnmarshalJSON umarshall json - template of ffjson
-func (j *PayConfirmRequest) UnmarshalJSON(input []byte) error {
+func (j *BillResult) UnmarshalJSON(input []byte) error {
 	fs := fflib.NewFFLexer(input)
 	return j.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
 }
 
 // UnmarshalJSONFFLexer fast json unmarshall - template ffjson
-func (j *PayConfirmRequest) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+func (j *BillResult) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
 	var err error
-	currentKey := ffjtPayConfirmRequestbase
+	currentKey := ffjtBillResultbase
 	_ = currentKey
 	tok := fflib.FFTok_init
 	wantedTok := fflib.FFTok_init
@@ -421,57 +424,71 @@ mainparse:
 			kn := fs.Output.Bytes()
 			if len(kn) <= 0 {
 				// "" case. hrm.
-				currentKey = ffjtPayConfirmRequestnosuchkey
+				currentKey = ffjtBillResultnosuchkey
 				state = fflib.FFParse_want_colon
 				goto mainparse
 			} else {
 				switch kn[0] {
 
-				case 'N':
+				case 'C':
 
-					if bytes.Equal(ffjKeyPayConfirmRequestNo, kn) {
-						currentKey = ffjtPayConfirmRequestNo
+					if bytes.Equal(ffjKeyBillResultContent, kn) {
+						currentKey = ffjtBillResultContent
 						state = fflib.FFParse_want_colon
 						goto mainparse
 					}
 
-				case 'T':
+				case 'D':
 
-					if bytes.Equal(ffjKeyPayConfirmRequestThirdNo, kn) {
-						currentKey = ffjtPayConfirmRequestThirdNo
+					if bytes.Equal(ffjKeyBillResultDate, kn) {
+						currentKey = ffjtBillResultDate
 						state = fflib.FFParse_want_colon
 						goto mainparse
 					}
 
-				case 'V':
+				case 'P':
 
-					if bytes.Equal(ffjKeyPayConfirmRequestVerifyCode, kn) {
-						currentKey = ffjtPayConfirmRequestVerifyCode
+					if bytes.Equal(ffjKeyBillResultPayCode, kn) {
+						currentKey = ffjtBillResultPayCode
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'U':
+
+					if bytes.Equal(ffjKeyBillResultURL, kn) {
+						currentKey = ffjtBillResultURL
 						state = fflib.FFParse_want_colon
 						goto mainparse
 					}
 
 				}
 
-				if fflib.SimpleLetterEqualFold(ffjKeyPayConfirmRequestVerifyCode, kn) {
-					currentKey = ffjtPayConfirmRequestVerifyCode
+				if fflib.SimpleLetterEqualFold(ffjKeyBillResultContent, kn) {
+					currentKey = ffjtBillResultContent
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.SimpleLetterEqualFold(ffjKeyPayConfirmRequestThirdNo, kn) {
-					currentKey = ffjtPayConfirmRequestThirdNo
+				if fflib.SimpleLetterEqualFold(ffjKeyBillResultURL, kn) {
+					currentKey = ffjtBillResultURL
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.SimpleLetterEqualFold(ffjKeyPayConfirmRequestNo, kn) {
-					currentKey = ffjtPayConfirmRequestNo
+				if fflib.SimpleLetterEqualFold(ffjKeyBillResultDate, kn) {
+					currentKey = ffjtBillResultDate
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				currentKey = ffjtPayConfirmRequestnosuchkey
+				if fflib.SimpleLetterEqualFold(ffjKeyBillResultPayCode, kn) {
+					currentKey = ffjtBillResultPayCode
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				currentKey = ffjtBillResultnosuchkey
 				state = fflib.FFParse_want_colon
 				goto mainparse
 			}
@@ -488,16 +505,19 @@ mainparse:
 			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
 				switch currentKey {
 
-				case ffjtPayConfirmRequestNo:
-					goto handle_No
+				case ffjtBillResultPayCode:
+					goto handle_PayCode
 
-				case ffjtPayConfirmRequestThirdNo:
-					goto handle_ThirdNo
+				case ffjtBillResultDate:
+					goto handle_Date
 
-				case ffjtPayConfirmRequestVerifyCode:
-					goto handle_VerifyCode
+				case ffjtBillResultURL:
+					goto handle_URL
 
-				case ffjtPayConfirmRequestnosuchkey:
+				case ffjtBillResultContent:
+					goto handle_Content
+
+				case ffjtBillResultnosuchkey:
 					err = fs.SkipField(tok)
 					if err != nil {
 						return fs.WrapErr(err)
@@ -511,9 +531,9 @@ mainparse:
 		}
 	}
 
-handle_No:
+handle_PayCode:
 
-	/* handler: j.No type=string kind=string quoted=false*/
+	/* handler: j.PayCode type=string kind=string quoted=false*/
 
 	{
 
@@ -529,7 +549,7 @@ handle_No:
 
 			outBuf := fs.Output.Bytes()
 
-			j.No = string(string(outBuf))
+			j.PayCode = string(string(outBuf))
 
 		}
 	}
@@ -537,9 +557,9 @@ handle_No:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_ThirdNo:
+handle_Date:
 
-	/* handler: j.ThirdNo type=string kind=string quoted=false*/
+	/* handler: j.Date type=string kind=string quoted=false*/
 
 	{
 
@@ -555,7 +575,7 @@ handle_ThirdNo:
 
 			outBuf := fs.Output.Bytes()
 
-			j.ThirdNo = string(string(outBuf))
+			j.Date = string(string(outBuf))
 
 		}
 	}
@@ -563,9 +583,9 @@ handle_ThirdNo:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_VerifyCode:
+handle_URL:
 
-	/* handler: j.VerifyCode type=string kind=string quoted=false*/
+	/* handler: j.URL type=string kind=string quoted=false*/
 
 	{
 
@@ -581,7 +601,33 @@ handle_VerifyCode:
 
 			outBuf := fs.Output.Bytes()
 
-			j.VerifyCode = string(string(outBuf))
+			j.URL = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Content:
+
+	/* handler: j.Content type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.Content = string(string(outBuf))
 
 		}
 	}
@@ -608,7 +654,7 @@ done:
 }
 
 // MarshalJSON marshal bytes to json - template
-func (j *PayInfo) MarshalJSON() ([]byte, error) {
+func (j *Config) MarshalJSON() ([]byte, error) {
 	var buf fflib.Buffer
 	if j == nil {
 		buf.WriteString("null")
@@ -622,7 +668,7 @@ func (j *PayInfo) MarshalJSON() ([]byte, error) {
 }
 
 // MarshalJSONBuf marshal buff to json - template
-func (j *PayInfo) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+func (j *Config) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 	if j == nil {
 		buf.WriteString("null")
 		return nil
@@ -631,25 +677,60 @@ func (j *PayInfo) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 	var obj []byte
 	_ = obj
 	_ = err
-	buf.WriteString(`{}`)
+	buf.WriteString(`{"Code":`)
+	fflib.WriteJsonString(buf, string(j.Code))
+	buf.WriteString(`,"Name":`)
+	fflib.WriteJsonString(buf, string(j.Name))
+	if j.State {
+		buf.WriteString(`,"State":true`)
+	} else {
+		buf.WriteString(`,"State":false`)
+	}
+	if j.Fee != nil {
+		/* Struct fall back. type=payment.FeeSchedule kind=struct */
+		buf.WriteString(`,"Fee":`)
+		err = buf.Encode(j.Fee)
+		if err != nil {
+			return err
+		}
+	} else {
+		buf.WriteString(`,"Fee":null`)
+	}
+	buf.WriteByte('}')
 	return nil
 }
 
 const (
-	ffjtPayInfobase = iota
-	ffjtPayInfonosuchkey
+	ffjtConfigbase = iota
+	ffjtConfignosuchkey
+
+	ffjtConfigCode
+
+	ffjtConfigName
+
+	ffjtConfigState
+
+	ffjtConfigFee
 )
 
+var ffjKeyConfigCode = []byte("Code")
+
+var ffjKeyConfigName = []byte("Name")
+
+var ffjKeyConfigState = []byte("State")
+
+var ffjKeyConfigFee = []byte("Fee")
+
 // UnmarshalJSON umarshall json - template of ffjson
-func (j *PayInfo) UnmarshalJSON(input []byte) error {
+func (j *Config) UnmarshalJSON(input []byte) error {
 	fs := fflib.NewFFLexer(input)
 	return j.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
 }
 
 // UnmarshalJSONFFLexer fast json unmarshall - template ffjson
-func (j *PayInfo) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+func (j *Config) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
 	var err error
-	currentKey := ffjtPayInfobase
+	currentKey := ffjtConfigbase
 	_ = currentKey
 	tok := fflib.FFTok_init
 	wantedTok := fflib.FFTok_init
@@ -695,17 +776,2341 @@ mainparse:
 			kn := fs.Output.Bytes()
 			if len(kn) <= 0 {
 				// "" case. hrm.
-				currentKey = ffjtPayInfonosuchkey
+				currentKey = ffjtConfignosuchkey
 				state = fflib.FFParse_want_colon
 				goto mainparse
 			} else {
 				switch kn[0] {
 
-				}
+				case 'C':
 
-				currentKey = ffjtPayInfonosuchkey
-				state = fflib.FFParse_want_colon
-				goto mainparse
+					if bytes.Equal(ffjKeyConfigCode, kn) {
+						currentKey = ffjtConfigCode
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'F':
+
+					if bytes.Equal(ffjKeyConfigFee, kn) {
+						currentKey = ffjtConfigFee
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'N':
+
+					if bytes.Equal(ffjKeyConfigName, kn) {
+						currentKey = ffjtConfigName
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'S':
+
+					if bytes.Equal(ffjKeyConfigState, kn) {
+						currentKey = ffjtConfigState
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeyConfigFee, kn) {
+					currentKey = ffjtConfigFee
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeyConfigState, kn) {
+					currentKey = ffjtConfigState
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeyConfigName, kn) {
+					currentKey = ffjtConfigName
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeyConfigCode, kn) {
+					currentKey = ffjtConfigCode
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				currentKey = ffjtConfignosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			}
+
+		case fflib.FFParse_want_colon:
+			if tok != fflib.FFTok_colon {
+				wantedTok = fflib.FFTok_colon
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_value
+			continue
+		case fflib.FFParse_want_value:
+
+			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
+				switch currentKey {
+
+				case ffjtConfigCode:
+					goto handle_Code
+
+				case ffjtConfigName:
+					goto handle_Name
+
+				case ffjtConfigState:
+					goto handle_State
+
+				case ffjtConfigFee:
+					goto handle_Fee
+
+				case ffjtConfignosuchkey:
+					err = fs.SkipField(tok)
+					if err != nil {
+						return fs.WrapErr(err)
+					}
+					state = fflib.FFParse_after_value
+					goto mainparse
+				}
+			} else {
+				goto wantedvalue
+			}
+		}
+	}
+
+handle_Code:
+
+	/* handler: j.Code type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.Code = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Name:
+
+	/* handler: j.Name type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.Name = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_State:
+
+	/* handler: j.State type=bool kind=bool quoted=false*/
+
+	{
+		if tok != fflib.FFTok_bool && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for bool", tok))
+		}
+	}
+
+	{
+		if tok == fflib.FFTok_null {
+
+		} else {
+			tmpb := fs.Output.Bytes()
+
+			if bytes.Compare([]byte{'t', 'r', 'u', 'e'}, tmpb) == 0 {
+
+				j.State = true
+
+			} else if bytes.Compare([]byte{'f', 'a', 'l', 's', 'e'}, tmpb) == 0 {
+
+				j.State = false
+
+			} else {
+				err = errors.New("unexpected bytes for true/false value")
+				return fs.WrapErr(err)
+			}
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Fee:
+
+	/* handler: j.Fee type=payment.FeeSchedule kind=struct quoted=false*/
+
+	{
+		/* Falling back. type=payment.FeeSchedule kind=struct */
+		tbuf, err := fs.CaptureField(tok)
+		if err != nil {
+			return fs.WrapErr(err)
+		}
+
+		err = json.Unmarshal(tbuf, &j.Fee)
+		if err != nil {
+			return fs.WrapErr(err)
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+wantedvalue:
+	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+wrongtokenerror:
+	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
+tokerror:
+	if fs.BigError != nil {
+		return fs.WrapErr(fs.BigError)
+	}
+	err = fs.Error.ToError()
+	if err != nil {
+		return fs.WrapErr(err)
+	}
+	panic("ffjson-generated: unreachable, please report bug.")
+done:
+
+	return nil
+}
+
+// MarshalJSON marshal bytes to json - template
+func (j *PayConfirmRequest) MarshalJSON() ([]byte, error) {
+	var buf fflib.Buffer
+	if j == nil {
+		buf.WriteString("null")
+		return buf.Bytes(), nil
+	}
+	err := j.MarshalJSONBuf(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// MarshalJSONBuf marshal buff to json - template
+func (j *PayConfirmRequest) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+	if j == nil {
+		buf.WriteString("null")
+		return nil
+	}
+	var err error
+	var obj []byte
+	_ = obj
+	_ = err
+	buf.WriteString(`{"No":`)
+	fflib.WriteJsonString(buf, string(j.No))
+	buf.WriteString(`,"ThirdNo":`)
+	fflib.WriteJsonString(buf, string(j.ThirdNo))
+	buf.WriteString(`,"VerifyCode":`)
+	fflib.WriteJsonString(buf, string(j.VerifyCode))
+	buf.WriteByte('}')
+	return nil
+}
+
+const (
+	ffjtPayConfirmRequestbase = iota
+	ffjtPayConfirmRequestnosuchkey
+
+	ffjtPayConfirmRequestNo
+
+	ffjtPayConfirmRequestThirdNo
+
+	ffjtPayConfirmRequestVerifyCode
+)
+
+var ffjKeyPayConfirmRequestNo = []byte("No")
+
+var ffjKeyPayConfirmRequestThirdNo = []byte("ThirdNo")
+
+var ffjKeyPayConfirmRequestVerifyCode = []byte("VerifyCode")
+
+// UnmarshalJSON umarshall json - template of ffjson
+func (j *PayConfirmRequest) UnmarshalJSON(input []byte) error {
+	fs := fflib.NewFFLexer(input)
+	return j.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
+}
+
+// UnmarshalJSONFFLexer fast json unmarshall - template ffjson
+func (j *PayConfirmRequest) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+	var err error
+	currentKey := ffjtPayConfirmRequestbase
+	_ = currentKey
+	tok := fflib.FFTok_init
+	wantedTok := fflib.FFTok_init
+
+mainparse:
+	for {
+		tok = fs.Scan()
+		//	println(fmt.Sprintf("debug: tok: %v  state: %v", tok, state))
+		if tok == fflib.FFTok_error {
+			goto tokerror
+		}
+
+		switch state {
+
+		case fflib.FFParse_map_start:
+			if tok != fflib.FFTok_left_bracket {
+				wantedTok = fflib.FFTok_left_bracket
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_key
+			continue
+
+		case fflib.FFParse_after_value:
+			if tok == fflib.FFTok_comma {
+				state = fflib.FFParse_want_key
+			} else if tok == fflib.FFTok_right_bracket {
+				goto done
+			} else {
+				wantedTok = fflib.FFTok_comma
+				goto wrongtokenerror
+			}
+
+		case fflib.FFParse_want_key:
+			// json {} ended. goto exit. woo.
+			if tok == fflib.FFTok_right_bracket {
+				goto done
+			}
+			if tok != fflib.FFTok_string {
+				wantedTok = fflib.FFTok_string
+				goto wrongtokenerror
+			}
+
+			kn := fs.Output.Bytes()
+			if len(kn) <= 0 {
+				// "" case. hrm.
+				currentKey = ffjtPayConfirmRequestnosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			} else {
+				switch kn[0] {
+
+				case 'N':
+
+					if bytes.Equal(ffjKeyPayConfirmRequestNo, kn) {
+						currentKey = ffjtPayConfirmRequestNo
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'T':
+
+					if bytes.Equal(ffjKeyPayConfirmRequestThirdNo, kn) {
+						currentKey = ffjtPayConfirmRequestThirdNo
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'V':
+
+					if bytes.Equal(ffjKeyPayConfirmRequestVerifyCode, kn) {
+						currentKey = ffjtPayConfirmRequestVerifyCode
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeyPayConfirmRequestVerifyCode, kn) {
+					currentKey = ffjtPayConfirmRequestVerifyCode
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeyPayConfirmRequestThirdNo, kn) {
+					currentKey = ffjtPayConfirmRequestThirdNo
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeyPayConfirmRequestNo, kn) {
+					currentKey = ffjtPayConfirmRequestNo
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				currentKey = ffjtPayConfirmRequestnosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			}
+
+		case fflib.FFParse_want_colon:
+			if tok != fflib.FFTok_colon {
+				wantedTok = fflib.FFTok_colon
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_value
+			continue
+		case fflib.FFParse_want_value:
+
+			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
+				switch currentKey {
+
+				case ffjtPayConfirmRequestNo:
+					goto handle_No
+
+				case ffjtPayConfirmRequestThirdNo:
+					goto handle_ThirdNo
+
+				case ffjtPayConfirmRequestVerifyCode:
+					goto handle_VerifyCode
+
+				case ffjtPayConfirmRequestnosuchkey:
+					err = fs.SkipField(tok)
+					if err != nil {
+						return fs.WrapErr(err)
+					}
+					state = fflib.FFParse_after_value
+					goto mainparse
+				}
+			} else {
+				goto wantedvalue
+			}
+		}
+	}
+
+handle_No:
+
+	/* handler: j.No type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.No = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_ThirdNo:
+
+	/* handler: j.ThirdNo type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.ThirdNo = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_VerifyCode:
+
+	/* handler: j.VerifyCode type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.VerifyCode = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+wantedvalue:
+	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+wrongtokenerror:
+	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
+tokerror:
+	if fs.BigError != nil {
+		return fs.WrapErr(fs.BigError)
+	}
+	err = fs.Error.ToError()
+	if err != nil {
+		return fs.WrapErr(err)
+	}
+	panic("ffjson-generated: unreachable, please report bug.")
+done:
+
+	return nil
+}
+
+// MarshalJSON marshal bytes to json - template
+func (j *PayInfo) MarshalJSON() ([]byte, error) {
+	var buf fflib.Buffer
+	if j == nil {
+		buf.WriteString("null")
+		return buf.Bytes(), nil
+	}
+	err := j.MarshalJSONBuf(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// MarshalJSONBuf marshal buff to json - template
+func (j *PayInfo) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+	if j == nil {
+		buf.WriteString("null")
+		return nil
+	}
+	var err error
+	var obj []byte
+	_ = obj
+	_ = err
+	buf.WriteString(`{}`)
+	return nil
+}
+
+const (
+	ffjtPayInfobase = iota
+	ffjtPayInfonosuchkey
+)
+
+// UnmarshalJSON umarshall json - template of ffjson
+func (j *PayInfo) UnmarshalJSON(input []byte) error {
+	fs := fflib.NewFFLexer(input)
+	return j.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
+}
+
+// UnmarshalJSONFFLexer fast json unmarshall - template ffjson
+func (j *PayInfo) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+	var err error
+	currentKey := ffjtPayInfobase
+	_ = currentKey
+	tok := fflib.FFTok_init
+	wantedTok := fflib.FFTok_init
+
+mainparse:
+	for {
+		tok = fs.Scan()
+		//	println(fmt.Sprintf("debug: tok: %v  state: %v", tok, state))
+		if tok == fflib.FFTok_error {
+			goto tokerror
+		}
+
+		switch state {
+
+		case fflib.FFParse_map_start:
+			if tok != fflib.FFTok_left_bracket {
+				wantedTok = fflib.FFTok_left_bracket
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_key
+			continue
+
+		case fflib.FFParse_after_value:
+			if tok == fflib.FFTok_comma {
+				state = fflib.FFParse_want_key
+			} else if tok == fflib.FFTok_right_bracket {
+				goto done
+			} else {
+				wantedTok = fflib.FFTok_comma
+				goto wrongtokenerror
+			}
+
+		case fflib.FFParse_want_key:
+			// json {} ended. goto exit. woo.
+			if tok == fflib.FFTok_right_bracket {
+				goto done
+			}
+			if tok != fflib.FFTok_string {
+				wantedTok = fflib.FFTok_string
+				goto wrongtokenerror
+			}
+
+			kn := fs.Output.Bytes()
+			if len(kn) <= 0 {
+				// "" case. hrm.
+				currentKey = ffjtPayInfonosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			} else {
+				switch kn[0] {
+
+				}
+
+				currentKey = ffjtPayInfonosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			}
+
+		case fflib.FFParse_want_colon:
+			if tok != fflib.FFTok_colon {
+				wantedTok = fflib.FFTok_colon
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_value
+			continue
+		case fflib.FFParse_want_value:
+
+			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
+				switch currentKey {
+
+				case ffjtPayInfonosuchkey:
+					err = fs.SkipField(tok)
+					if err != nil {
+						return fs.WrapErr(err)
+					}
+					state = fflib.FFParse_after_value
+					goto mainparse
+				}
+			} else {
+				goto wantedvalue
+			}
+		}
+	}
+
+wantedvalue:
+	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+wrongtokenerror:
+	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
+tokerror:
+	if fs.BigError != nil {
+		return fs.WrapErr(fs.BigError)
+	}
+	err = fs.Error.ToError()
+	if err != nil {
+		return fs.WrapErr(err)
+	}
+	panic("ffjson-generated: unreachable, please report bug.")
+done:
+
+	return nil
+}
+
+// MarshalJSON marshal bytes to json - template
+func (j *PayRequest) MarshalJSON() ([]byte, error) {
+	var buf fflib.Buffer
+	if j == nil {
+		buf.WriteString("null")
+		return buf.Bytes(), nil
+	}
+	err := j.MarshalJSONBuf(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// MarshalJSONBuf marshal buff to json - template
+func (j *PayRequest) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+	if j == nil {
+		buf.WriteString("null")
+		return nil
+	}
+	var err error
+	var obj []byte
+	_ = obj
+	_ = err
+	buf.WriteString(`{"No":`)
+	fflib.WriteJsonString(buf, string(j.No))
+	buf.WriteString(`,"Desc":`)
+	fflib.WriteJsonString(buf, string(j.Desc))
+	buf.WriteString(`,"Money":`)
+	fflib.AppendFloat(buf, float64(j.Money), 'g', -1, 64)
+	if j.IsApp {
+		buf.WriteString(`,"IsApp":true`)
+	} else {
+		buf.WriteString(`,"IsApp":false`)
+	}
+	buf.WriteString(`,"PayCode":`)
+	fflib.WriteJsonString(buf, string(j.PayCode))
+	buf.WriteString(`,"IP":`)
+	fflib.WriteJsonString(buf, string(j.IP))
+	buf.WriteString(`,"MemberID":`)
+	fflib.WriteJsonString(buf, string(j.MemberID))
+	buf.WriteString(`,"Ext":`)
+	fflib.WriteJsonString(buf, string(j.Ext))
+	buf.WriteString(`,"Currency":`)
+	fflib.WriteJsonString(buf, string(j.Currency))
+	buf.WriteByte('}')
+	return nil
+}
+
+const (
+	ffjtPayRequestbase = iota
+	ffjtPayRequestnosuchkey
+
+	ffjtPayRequestNo
+
+	ffjtPayRequestDesc
+
+	ffjtPayRequestMoney
+
+	ffjtPayRequestIsApp
+
+	ffjtPayRequestPayCode
+
+	ffjtPayRequestIP
+
+	ffjtPayRequestMemberID
+
+	ffjtPayRequestExt
+
+	ffjtPayRequestCurrency
+)
+
+var ffjKeyPayRequestNo = []byte("No")
+
+var ffjKeyPayRequestDesc = []byte("Desc")
+
+var ffjKeyPayRequestMoney = []byte("Money")
+
+var ffjKeyPayRequestIsApp = []byte("IsApp")
+
+var ffjKeyPayRequestPayCode = []byte("PayCode")
+
+var ffjKeyPayRequestIP = []byte("IP")
+
+var ffjKeyPayRequestMemberID = []byte("MemberID")
+
+var ffjKeyPayRequestExt = []byte("Ext")
+
+var ffjKeyPayRequestCurrency = []byte("Currency")
+
+// UnmarshalJSON umarshall json - template of ffjson
+func (j *PayRequest) UnmarshalJSON(input []byte) error {
+	fs := fflib.NewFFLexer(input)
+	return j.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
+}
+
+// UnmarshalJSONFFLexer fast json unmarshall - template ffjson
+func (j *PayRequest) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+	var err error
+	currentKey := ffjtPayRequestbase
+	_ = currentKey
+	tok := fflib.FFTok_init
+	wantedTok := fflib.FFTok_init
+
+mainparse:
+	for {
+		tok = fs.Scan()
+		//	println(fmt.Sprintf("debug: tok: %v  state: %v", tok, state))
+		if tok == fflib.FFTok_error {
+			goto tokerror
+		}
+
+		switch state {
+
+		case fflib.FFParse_map_start:
+			if tok != fflib.FFTok_left_bracket {
+				wantedTok = fflib.FFTok_left_bracket
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_key
+			continue
+
+		case fflib.FFParse_after_value:
+			if tok == fflib.FFTok_comma {
+				state = fflib.FFParse_want_key
+			} else if tok == fflib.FFTok_right_bracket {
+				goto done
+			} else {
+				wantedTok = fflib.FFTok_comma
+				goto wrongtokenerror
+			}
+
+		case fflib.FFParse_want_key:
+			// json {} ended. goto exit. woo.
+			if tok == fflib.FFTok_right_bracket {
+				goto done
+			}
+			if tok != fflib.FFTok_string {
+				wantedTok = fflib.FFTok_string
+				goto wrongtokenerror
+			}
+
+			kn := fs.Output.Bytes()
+			if len(kn) <= 0 {
+				// "" case. hrm.
+				currentKey = ffjtPayRequestnosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			} else {
+				switch kn[0] {
+
+				case 'C':
+
+					if bytes.Equal(ffjKeyPayRequestCurrency, kn) {
+						currentKey = ffjtPayRequestCurrency
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'D':
+
+					if bytes.Equal(ffjKeyPayRequestDesc, kn) {
+						currentKey = ffjtPayRequestDesc
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'E':
+
+					if bytes.Equal(ffjKeyPayRequestExt, kn) {
+						currentKey = ffjtPayRequestExt
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'I':
+
+					if bytes.Equal(ffjKeyPayRequestIsApp, kn) {
+						currentKey = ffjtPayRequestIsApp
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeyPayRequestIP, kn) {
+						currentKey = ffjtPayRequestIP
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'M':
+
+					if bytes.Equal(ffjKeyPayRequestMoney, kn) {
+						currentKey = ffjtPayRequestMoney
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeyPayRequestMemberID, kn) {
+						currentKey = ffjtPayRequestMemberID
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'N':
+
+					if bytes.Equal(ffjKeyPayRequestNo, kn) {
+						currentKey = ffjtPayRequestNo
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'P':
+
+					if bytes.Equal(ffjKeyPayRequestPayCode, kn) {
+						currentKey = ffjtPayRequestPayCode
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeyPayRequestCurrency, kn) {
+					currentKey = ffjtPayRequestCurrency
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeyPayRequestExt, kn) {
+					currentKey = ffjtPayRequestExt
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeyPayRequestMemberID, kn) {
+					currentKey = ffjtPayRequestMemberID
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeyPayRequestIP, kn) {
+					currentKey = ffjtPayRequestIP
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeyPayRequestPayCode, kn) {
+					currentKey = ffjtPayRequestPayCode
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeyPayRequestIsApp, kn) {
+					currentKey = ffjtPayRequestIsApp
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeyPayRequestMoney, kn) {
+					currentKey = ffjtPayRequestMoney
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeyPayRequestDesc, kn) {
+					currentKey = ffjtPayRequestDesc
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeyPayRequestNo, kn) {
+					currentKey = ffjtPayRequestNo
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				currentKey = ffjtPayRequestnosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			}
+
+		case fflib.FFParse_want_colon:
+			if tok != fflib.FFTok_colon {
+				wantedTok = fflib.FFTok_colon
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_value
+			continue
+		case fflib.FFParse_want_value:
+
+			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
+				switch currentKey {
+
+				case ffjtPayRequestNo:
+					goto handle_No
+
+				case ffjtPayRequestDesc:
+					goto handle_Desc
+
+				case ffjtPayRequestMoney:
+					goto handle_Money
+
+				case ffjtPayRequestIsApp:
+					goto handle_IsApp
+
+				case ffjtPayRequestPayCode:
+					goto handle_PayCode
+
+				case ffjtPayRequestIP:
+					goto handle_IP
+
+				case ffjtPayRequestMemberID:
+					goto handle_MemberID
+
+				case ffjtPayRequestExt:
+					goto handle_Ext
+
+				case ffjtPayRequestCurrency:
+					goto handle_Currency
+
+				case ffjtPayRequestnosuchkey:
+					err = fs.SkipField(tok)
+					if err != nil {
+						return fs.WrapErr(err)
+					}
+					state = fflib.FFParse_after_value
+					goto mainparse
+				}
+			} else {
+				goto wantedvalue
+			}
+		}
+	}
+
+handle_No:
+
+	/* handler: j.No type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.No = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Desc:
+
+	/* handler: j.Desc type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.Desc = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Money:
+
+	/* handler: j.Money type=float64 kind=float64 quoted=false*/
+
+	{
+		if tok != fflib.FFTok_double && tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for float64", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseFloat(fs.Output.Bytes(), 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			j.Money = float64(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_IsApp:
+
+	/* handler: j.IsApp type=bool kind=bool quoted=false*/
+
+	{
+		if tok != fflib.FFTok_bool && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for bool", tok))
+		}
+	}
+
+	{
+		if tok == fflib.FFTok_null {
+
+		} else {
+			tmpb := fs.Output.Bytes()
+
+			if bytes.Compare([]byte{'t', 'r', 'u', 'e'}, tmpb) == 0 {
+
+				j.IsApp = true
+
+			} else if bytes.Compare([]byte{'f', 'a', 'l', 's', 'e'}, tmpb) == 0 {
+
+				j.IsApp = false
+
+			} else {
+				err = errors.New("unexpected bytes for true/false value")
+				return fs.WrapErr(err)
+			}
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_PayCode:
+
+	/* handler: j.PayCode type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.PayCode = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_IP:
+
+	/* handler: j.IP type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.IP = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_MemberID:
+
+	/* handler: j.MemberID type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.MemberID = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Ext:
+
+	/* handler: j.Ext type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.Ext = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Currency:
+
+	/* handler: j.Currency type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.Currency = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+wantedvalue:
+	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+wrongtokenerror:
+	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
+tokerror:
+	if fs.BigError != nil {
+		return fs.WrapErr(fs.BigError)
+	}
+	err = fs.Error.ToError()
+	if err != nil {
+		return fs.WrapErr(err)
+	}
+	panic("ffjson-generated: unreachable, please report bug.")
+done:
+
+	return nil
+}
+
+// MarshalJSON marshal bytes to json - template
+func (j *PayResult) MarshalJSON() ([]byte, error) {
+	var buf fflib.Buffer
+	if j == nil {
+		buf.WriteString("null")
+		return buf.Bytes(), nil
+	}
+	err := j.MarshalJSONBuf(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// MarshalJSONBuf marshal buff to json - template
+func (j *PayResult) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+	if j == nil {
+		buf.WriteString("null")
+		return nil
+	}
+	var err error
+	var obj []byte
+	_ = obj
+	_ = err
+	if j.Succ {
+		buf.WriteString(`{"Succ":true`)
+	} else {
+		buf.WriteString(`{"Succ":false`)
+	}
+	buf.WriteString(`,"ErrMsg":`)
+	fflib.WriteJsonString(buf, string(j.ErrMsg))
+	buf.WriteString(`,"No":`)
+	fflib.WriteJsonString(buf, string(j.No))
+	buf.WriteString(`,"TradeNo":`)
+	fflib.WriteJsonString(buf, string(j.TradeNo))
+	buf.WriteString(`,"Money":`)
+	fflib.AppendFloat(buf, float64(j.Money), 'g', -1, 64)
+	buf.WriteString(`,"Currency":`)
+	fflib.WriteJsonString(buf, string(j.Currency))
+	buf.WriteString(`,"SettleMoney":`)
+	fflib.AppendFloat(buf, float64(j.SettleMoney), 'g', -1, 64)
+	buf.WriteString(`,"SettleCurrency":`)
+	fflib.WriteJsonString(buf, string(j.SettleCurrency))
+	buf.WriteString(`,"ExchangeRate":`)
+	fflib.AppendFloat(buf, float64(j.ExchangeRate), 'g', -1, 64)
+	buf.WriteString(`,"Fee":`)
+	fflib.AppendFloat(buf, float64(j.Fee), 'g', -1, 64)
+	buf.WriteString(`,"PayCode":`)
+	fflib.WriteJsonString(buf, string(j.PayCode))
+	buf.WriteString(`,"ThirdAccount":`)
+	fflib.WriteJsonString(buf, string(j.ThirdAccount))
+	buf.WriteString(`,"ThirdTradeNo":`)
+	fflib.WriteJsonString(buf, string(j.ThirdTradeNo))
+	if j.Navite == nil {
+		buf.WriteString(`,"Navite":null`)
+	} else {
+		buf.WriteString(`,"Navite":{ `)
+		for key, value := range j.Navite {
+			fflib.WriteJsonString(buf, key)
+			buf.WriteString(`:`)
+			fflib.WriteJsonString(buf, string(value))
+			buf.WriteByte(',')
+		}
+		buf.Rewind(1)
+		buf.WriteByte('}')
+	}
+	buf.WriteByte('}')
+	return nil
+}
+
+const (
+	ffjtPayResultbase = iota
+	ffjtPayResultnosuchkey
+
+	ffjtPayResultSucc
+
+	ffjtPayResultErrMsg
+
+	ffjtPayResultNo
+
+	ffjtPayResultTradeNo
+
+	ffjtPayResultMoney
+
+	ffjtPayResultCurrency
+
+	ffjtPayResultSettleMoney
+
+	ffjtPayResultSettleCurrency
+
+	ffjtPayResultExchangeRate
+
+	ffjtPayResultFee
+
+	ffjtPayResultPayCode
+
+	ffjtPayResultThirdAccount
+
+	ffjtPayResultThirdTradeNo
+
+	ffjtPayResultNavite
+)
+
+var ffjKeyPayResultSucc = []byte("Succ")
+
+var ffjKeyPayResultErrMsg = []byte("ErrMsg")
+
+var ffjKeyPayResultNo = []byte("No")
+
+var ffjKeyPayResultTradeNo = []byte("TradeNo")
+
+var ffjKeyPayResultMoney = []byte("Money")
+
+var ffjKeyPayResultCurrency = []byte("Currency")
+
+var ffjKeyPayResultSettleMoney = []byte("SettleMoney")
+
+var ffjKeyPayResultSettleCurrency = []byte("SettleCurrency")
+
+var ffjKeyPayResultExchangeRate = []byte("ExchangeRate")
+
+var ffjKeyPayResultFee = []byte("Fee")
+
+var ffjKeyPayResultPayCode = []byte("PayCode")
+
+var ffjKeyPayResultThirdAccount = []byte("ThirdAccount")
+
+var ffjKeyPayResultThirdTradeNo = []byte("ThirdTradeNo")
+
+var ffjKeyPayResultNavite = []byte("Navite")
+
+// UnmarshalJSON umarshall json - template of ffjson
+func (j *PayResult) UnmarshalJSON(input []byte) error {
+	fs := fflib.NewFFLexer(input)
+	return j.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
+}
+
+// UnmarshalJSONFFLexer fast json unmarshall - template ffjson
+func (j *PayResult) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+	var err error
+	currentKey := ffjtPayResultbase
+	_ = currentKey
+	tok := fflib.FFTok_init
+	wantedTok := fflib.FFTok_init
+
+mainparse:
+	for {
+		tok = fs.Scan()
+		//	println(fmt.Sprintf("debug: tok: %v  state: %v", tok, state))
+		if tok == fflib.FFTok_error {
+			goto tokerror
+		}
+
+		switch state {
+
+		case fflib.FFParse_map_start:
+			if tok != fflib.FFTok_left_bracket {
+				wantedTok = fflib.FFTok_left_bracket
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_key
+			continue
+
+		case fflib.FFParse_after_value:
+			if tok == fflib.FFTok_comma {
+				state = fflib.FFParse_want_key
+			} else if tok == fflib.FFTok_right_bracket {
+				goto done
+			} else {
+				wantedTok = fflib.FFTok_comma
+				goto wrongtokenerror
+			}
+
+		case fflib.FFParse_want_key:
+			// json {} ended. goto exit. woo.
+			if tok == fflib.FFTok_right_bracket {
+				goto done
+			}
+			if tok != fflib.FFTok_string {
+				wantedTok = fflib.FFTok_string
+				goto wrongtokenerror
+			}
+
+			kn := fs.Output.Bytes()
+			if len(kn) <= 0 {
+				// "" case. hrm.
+				currentKey = ffjtPayResultnosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			} else {
+				switch kn[0] {
+
+				case 'C':
+
+					if bytes.Equal(ffjKeyPayResultCurrency, kn) {
+						currentKey = ffjtPayResultCurrency
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'E':
+
+					if bytes.Equal(ffjKeyPayResultErrMsg, kn) {
+						currentKey = ffjtPayResultErrMsg
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeyPayResultExchangeRate, kn) {
+						currentKey = ffjtPayResultExchangeRate
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'F':
+
+					if bytes.Equal(ffjKeyPayResultFee, kn) {
+						currentKey = ffjtPayResultFee
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'M':
+
+					if bytes.Equal(ffjKeyPayResultMoney, kn) {
+						currentKey = ffjtPayResultMoney
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'N':
+
+					if bytes.Equal(ffjKeyPayResultNo, kn) {
+						currentKey = ffjtPayResultNo
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeyPayResultNavite, kn) {
+						currentKey = ffjtPayResultNavite
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'P':
+
+					if bytes.Equal(ffjKeyPayResultPayCode, kn) {
+						currentKey = ffjtPayResultPayCode
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'S':
+
+					if bytes.Equal(ffjKeyPayResultSucc, kn) {
+						currentKey = ffjtPayResultSucc
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeyPayResultSettleMoney, kn) {
+						currentKey = ffjtPayResultSettleMoney
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeyPayResultSettleCurrency, kn) {
+						currentKey = ffjtPayResultSettleCurrency
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'T':
+
+					if bytes.Equal(ffjKeyPayResultTradeNo, kn) {
+						currentKey = ffjtPayResultTradeNo
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeyPayResultThirdAccount, kn) {
+						currentKey = ffjtPayResultThirdAccount
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeyPayResultThirdTradeNo, kn) {
+						currentKey = ffjtPayResultThirdTradeNo
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeyPayResultNavite, kn) {
+					currentKey = ffjtPayResultNavite
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeyPayResultThirdTradeNo, kn) {
+					currentKey = ffjtPayResultThirdTradeNo
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeyPayResultThirdAccount, kn) {
+					currentKey = ffjtPayResultThirdAccount
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeyPayResultPayCode, kn) {
+					currentKey = ffjtPayResultPayCode
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeyPayResultFee, kn) {
+					currentKey = ffjtPayResultFee
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeyPayResultExchangeRate, kn) {
+					currentKey = ffjtPayResultExchangeRate
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeyPayResultSettleCurrency, kn) {
+					currentKey = ffjtPayResultSettleCurrency
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeyPayResultSettleMoney, kn) {
+					currentKey = ffjtPayResultSettleMoney
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeyPayResultCurrency, kn) {
+					currentKey = ffjtPayResultCurrency
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeyPayResultMoney, kn) {
+					currentKey = ffjtPayResultMoney
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeyPayResultTradeNo, kn) {
+					currentKey = ffjtPayResultTradeNo
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeyPayResultNo, kn) {
+					currentKey = ffjtPayResultNo
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeyPayResultErrMsg, kn) {
+					currentKey = ffjtPayResultErrMsg
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeyPayResultSucc, kn) {
+					currentKey = ffjtPayResultSucc
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				currentKey = ffjtPayResultnosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			}
+
+		case fflib.FFParse_want_colon:
+			if tok != fflib.FFTok_colon {
+				wantedTok = fflib.FFTok_colon
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_value
+			continue
+		case fflib.FFParse_want_value:
+
+			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
+				switch currentKey {
+
+				case ffjtPayResultSucc:
+					goto handle_Succ
+
+				case ffjtPayResultErrMsg:
+					goto handle_ErrMsg
+
+				case ffjtPayResultNo:
+					goto handle_No
+
+				case ffjtPayResultTradeNo:
+					goto handle_TradeNo
+
+				case ffjtPayResultMoney:
+					goto handle_Money
+
+				case ffjtPayResultCurrency:
+					goto handle_Currency
+
+				case ffjtPayResultSettleMoney:
+					goto handle_SettleMoney
+
+				case ffjtPayResultSettleCurrency:
+					goto handle_SettleCurrency
+
+				case ffjtPayResultExchangeRate:
+					goto handle_ExchangeRate
+
+				case ffjtPayResultFee:
+					goto handle_Fee
+
+				case ffjtPayResultPayCode:
+					goto handle_PayCode
+
+				case ffjtPayResultThirdAccount:
+					goto handle_ThirdAccount
+
+				case ffjtPayResultThirdTradeNo:
+					goto handle_ThirdTradeNo
+
+				case ffjtPayResultNavite:
+					goto handle_Navite
+
+				case ffjtPayResultnosuchkey:
+					err = fs.SkipField(tok)
+					if err != nil {
+						return fs.WrapErr(err)
+					}
+					state = fflib.FFParse_after_value
+					goto mainparse
+				}
+			} else {
+				goto wantedvalue
+			}
+		}
+	}
+
+handle_Succ:
+
+	/* handler: j.Succ type=bool kind=bool quoted=false*/
+
+	{
+		if tok != fflib.FFTok_bool && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for bool", tok))
+		}
+	}
+
+	{
+		if tok == fflib.FFTok_null {
+
+		} else {
+			tmpb := fs.Output.Bytes()
+
+			if bytes.Compare([]byte{'t', 'r', 'u', 'e'}, tmpb) == 0 {
+
+				j.Succ = true
+
+			} else if bytes.Compare([]byte{'f', 'a', 'l', 's', 'e'}, tmpb) == 0 {
+
+				j.Succ = false
+
+			} else {
+				err = errors.New("unexpected bytes for true/false value")
+				return fs.WrapErr(err)
+			}
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_ErrMsg:
+
+	/* handler: j.ErrMsg type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.ErrMsg = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_No:
+
+	/* handler: j.No type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.No = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_TradeNo:
+
+	/* handler: j.TradeNo type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.TradeNo = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Money:
+
+	/* handler: j.Money type=float64 kind=float64 quoted=false*/
+
+	{
+		if tok != fflib.FFTok_double && tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for float64", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseFloat(fs.Output.Bytes(), 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			j.Money = float64(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Currency:
+
+	/* handler: j.Currency type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.Currency = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_SettleMoney:
+
+	/* handler: j.SettleMoney type=float64 kind=float64 quoted=false*/
+
+	{
+		if tok != fflib.FFTok_double && tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for float64", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseFloat(fs.Output.Bytes(), 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			j.SettleMoney = float64(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_SettleCurrency:
+
+	/* handler: j.SettleCurrency type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.SettleCurrency = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_ExchangeRate:
+
+	/* handler: j.ExchangeRate type=float64 kind=float64 quoted=false*/
+
+	{
+		if tok != fflib.FFTok_double && tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for float64", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseFloat(fs.Output.Bytes(), 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			j.ExchangeRate = float64(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Fee:
+
+	/* handler: j.Fee type=float64 kind=float64 quoted=false*/
+
+	{
+		if tok != fflib.FFTok_double && tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for float64", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseFloat(fs.Output.Bytes(), 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			j.Fee = float64(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_PayCode:
+
+	/* handler: j.PayCode type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.PayCode = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_ThirdAccount:
+
+	/* handler: j.ThirdAccount type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.ThirdAccount = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_ThirdTradeNo:
+
+	/* handler: j.ThirdTradeNo type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.ThirdTradeNo = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Navite:
+
+	/* handler: j.Navite type=map[string]string kind=map quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_left_bracket && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+			j.Navite = nil
+		} else {
+
+			j.Navite = make(map[string]string, 0)
+
+			wantVal := true
+
+			for {
+
+				var k string
+
+				var tmpJNavite string
+
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_bracket {
+					break
+				}
+
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
+
+				/* handler: k type=string kind=string quoted=false*/
+
+				{
+
+					{
+						if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+							return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+						}
+					}
+
+					if tok == fflib.FFTok_null {
+
+					} else {
+
+						outBuf := fs.Output.Bytes()
+
+						k = string(string(outBuf))
+
+					}
+				}
+
+				// Expect ':' after key
+				tok = fs.Scan()
+				if tok != fflib.FFTok_colon {
+					return fs.WrapErr(fmt.Errorf("wanted colon token, but got token: %v", tok))
+				}
+
+				tok = fs.Scan()
+				/* handler: tmpJNavite type=string kind=string quoted=false*/
+
+				{
+
+					{
+						if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+							return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+						}
+					}
+
+					if tok == fflib.FFTok_null {
+
+					} else {
+
+						outBuf := fs.Output.Bytes()
+
+						tmpJNavite = string(string(outBuf))
+
+					}
+				}
+
+				j.Navite[k] = tmpJNavite
+
+				wantVal = false
+			}
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+wantedvalue:
+	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+wrongtokenerror:
+	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
+tokerror:
+	if fs.BigError != nil {
+		return fs.WrapErr(fs.BigError)
+	}
+	err = fs.Error.ToError()
+	if err != nil {
+		return fs.WrapErr(err)
+	}
+	panic("ffjson-generated: unreachable, please report bug.")
+done:
+
+	return nil
+}
+
+// MarshalJSON marshal bytes to json - template
+func (j *QRCodeResult) MarshalJSON() ([]byte, error) {
+	var buf fflib.Buffer
+	if j == nil {
+		buf.WriteString("null")
+		return buf.Bytes(), nil
+	}
+	err := j.MarshalJSONBuf(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// MarshalJSONBuf marshal buff to json - template
+func (j *QRCodeResult) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+	if j == nil {
+		buf.WriteString("null")
+		return nil
+	}
+	var err error
+	var obj []byte
+	_ = obj
+	_ = err
+	buf.WriteString(`{"Code":`)
+	fflib.WriteJsonString(buf, string(j.Code))
+	buf.WriteString(`,"Image":`)
+	fflib.WriteJsonString(buf, string(j.Image))
+	buf.WriteByte('}')
+	return nil
+}
+
+const (
+	ffjtQRCodeResultbase = iota
+	ffjtQRCodeResultnosuchkey
+
+	ffjtQRCodeResultCode
+
+	ffjtQRCodeResultImage
+)
+
+var ffjKeyQRCodeResultCode = []byte("Code")
+
+var ffjKeyQRCodeResultImage = []byte("Image")
+
+// UnmarshalJSON umarshall json - template of ffjson
+func (j *QRCodeResult) UnmarshalJSON(input []byte) error {
+	fs := fflib.NewFFLexer(input)
+	return j.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
+}
+
+// UnmarshalJSONFFLexer fast json unmarshall - template ffjson
+func (j *QRCodeResult) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+	var err error
+	currentKey := ffjtQRCodeResultbase
+	_ = currentKey
+	tok := fflib.FFTok_init
+	wantedTok := fflib.FFTok_init
+
+mainparse:
+	for {
+		tok = fs.Scan()
+		//	println(fmt.Sprintf("debug: tok: %v  state: %v", tok, state))
+		if tok == fflib.FFTok_error {
+			goto tokerror
+		}
+
+		switch state {
+
+		case fflib.FFParse_map_start:
+			if tok != fflib.FFTok_left_bracket {
+				wantedTok = fflib.FFTok_left_bracket
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_key
+			continue
+
+		case fflib.FFParse_after_value:
+			if tok == fflib.FFTok_comma {
+				state = fflib.FFParse_want_key
+			} else if tok == fflib.FFTok_right_bracket {
+				goto done
+			} else {
+				wantedTok = fflib.FFTok_comma
+				goto wrongtokenerror
+			}
+
+		case fflib.FFParse_want_key:
+			// json {} ended. goto exit. woo.
+			if tok == fflib.FFTok_right_bracket {
+				goto done
+			}
+			if tok != fflib.FFTok_string {
+				wantedTok = fflib.FFTok_string
+				goto wrongtokenerror
+			}
+
+			kn := fs.Output.Bytes()
+			if len(kn) <= 0 {
+				// "" case. hrm.
+				currentKey = ffjtQRCodeResultnosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			} else {
+				switch kn[0] {
+
+				case 'C':
+
+					if bytes.Equal(ffjKeyQRCodeResultCode, kn) {
+						currentKey = ffjtQRCodeResultCode
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'I':
+
+					if bytes.Equal(ffjKeyQRCodeResultImage, kn) {
+						currentKey = ffjtQRCodeResultImage
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeyQRCodeResultImage, kn) {
+					currentKey = ffjtQRCodeResultImage
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeyQRCodeResultCode, kn) {
+					currentKey = ffjtQRCodeResultCode
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				currentKey = ffjtQRCodeResultnosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
 			}
 
 		case fflib.FFParse_want_colon:
@@ -720,7 +3125,13 @@ mainparse:
 			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
 				switch currentKey {
 
-				case ffjtPayInfonosuchkey:
+				case ffjtQRCodeResultCode:
+					goto handle_Code
+
+				case ffjtQRCodeResultImage:
+					goto handle_Image
+
+				case ffjtQRCodeResultnosuchkey:
 					err = fs.SkipField(tok)
 					if err != nil {
 						return fs.WrapErr(err)
@@ -734,6 +3145,58 @@ mainparse:
 		}
 	}
 
+handle_Code:
+
+	/* handler: j.Code type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.Code = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Image:
+
+	/* handler: j.Image type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.Image = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
 wantedvalue:
 	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
 wrongtokenerror:
@@ -753,7 +3216,7 @@ done:
 }
 
 // MarshalJSON marshal bytes to json - template
-func (j *PayRequest) MarshalJSON() ([]byte, error) {
+func (j *RefundRequest) MarshalJSON() ([]byte, error) {
 	var buf fflib.Buffer
 	if j == nil {
 		buf.WriteString("null")
@@ -767,7 +3230,7 @@ func (j *PayRequest) MarshalJSON() ([]byte, error) {
 }
 
 // MarshalJSONBuf marshal buff to json - template
-func (j *PayRequest) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+func (j *RefundRequest) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 	if j == nil {
 		buf.WriteString("null")
 		return nil
@@ -778,74 +3241,59 @@ func (j *PayRequest) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 	_ = err
 	buf.WriteString(`{"No":`)
 	fflib.WriteJsonString(buf, string(j.No))
-	buf.WriteString(`,"Desc":`)
-	fflib.WriteJsonString(buf, string(j.Desc))
+	buf.WriteString(`,"RefundNo":`)
+	fflib.WriteJsonString(buf, string(j.RefundNo))
+	buf.WriteString(`,"ThirdTradeNo":`)
+	fflib.WriteJsonString(buf, string(j.ThirdTradeNo))
 	buf.WriteString(`,"Money":`)
 	fflib.AppendFloat(buf, float64(j.Money), 'g', -1, 64)
-	if j.IsApp {
-		buf.WriteString(`,"IsApp":true`)
-	} else {
-		buf.WriteString(`,"IsApp":false`)
-	}
-	buf.WriteString(`,"PayCode":`)
-	fflib.WriteJsonString(buf, string(j.PayCode))
-	buf.WriteString(`,"IP":`)
-	fflib.WriteJsonString(buf, string(j.IP))
-	buf.WriteString(`,"MemberID":`)
-	fflib.WriteJsonString(buf, string(j.MemberID))
-	buf.WriteString(`,"Ext":`)
-	fflib.WriteJsonString(buf, string(j.Ext))
+	buf.WriteString(`,"TotalMoney":`)
+	fflib.AppendFloat(buf, float64(j.TotalMoney), 'g', -1, 64)
+	buf.WriteString(`,"Reason":`)
+	fflib.WriteJsonString(buf, string(j.Reason))
 	buf.WriteByte('}')
 	return nil
 }
 
 const (
-	ffjtPayRequestbase = iota
-	ffjtPayRequestnosuchkey
-
-	ffjtPayRequestNo
-
-	ffjtPayRequestDesc
+	ffjtRefundRequestbase = iota
+	ffjtRefundRequestnosuchkey
 
-	ffjtPayRequestMoney
+	ffjtRefundRequestNo
 
-	ffjtPayRequestIsApp
+	ffjtRefundRequestRefundNo
 
-	ffjtPayRequestPayCode
+	ffjtRefundRequestThirdTradeNo
 
-	ffjtPayRequestIP
+	ffjtRefundRequestMoney
 
-	ffjtPayRequestMemberID
+	ffjtRefundRequestTotalMoney
 
-	ffjtPayRequestExt
+	ffjtRefundRequestReason
 )
 
-var ffjKeyPayRequestNo = []byte("No")
-
-var ffjKeyPayRequestDesc = []byte("Desc")
-
-var ffjKeyPayRequestMoney = []byte("Money")
+var ffjKeyRefundRequestNo = []byte("No")
 
-var ffjKeyPayRequestIsApp = []byte("IsApp")
+var ffjKeyRefundRequestRefundNo = []byte("RefundNo")
 
-var ffjKeyPayRequestPayCode = []byte("PayCode")
+var ffjKeyRefundRequestThirdTradeNo = []byte("ThirdTradeNo")
 
-var ffjKeyPayRequestIP = []byte("IP")
+var ffjKeyRefundRequestMoney = []byte("Money")
 
-var ffjKeyPayRequestMemberID = []byte("MemberID")
+var ffjKeyRefundRequestTotalMoney = []byte("TotalMoney")
 
-var ffjKeyPayRequestExt = []byte("Ext")
+var ffjKeyRefundRequestReason = []byte("Reason")
 
 // UnmarshalJSON umarshall json - template of ffjson
-func (j *PayRequest) UnmarshalJSON(input []byte) error {
+func (j *RefundRequest) UnmarshalJSON(input []byte) error {
 	fs := fflib.NewFFLexer(input)
 	return j.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
 }
 
 // UnmarshalJSONFFLexer fast json unmarshall - template ffjson
-func (j *PayRequest) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+func (j *RefundRequest) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
 	var err error
-	currentKey := ffjtPayRequestbase
+	currentKey := ffjtRefundRequestbase
 	_ = currentKey
 	tok := fflib.FFTok_init
 	wantedTok := fflib.FFTok_init
@@ -891,121 +3339,93 @@ mainparse:
 			kn := fs.Output.Bytes()
 			if len(kn) <= 0 {
 				// "" case. hrm.
-				currentKey = ffjtPayRequestnosuchkey
+				currentKey = ffjtRefundRequestnosuchkey
 				state = fflib.FFParse_want_colon
 				goto mainparse
 			} else {
 				switch kn[0] {
 
-				case 'D':
-
-					if bytes.Equal(ffjKeyPayRequestDesc, kn) {
-						currentKey = ffjtPayRequestDesc
-						state = fflib.FFParse_want_colon
-						goto mainparse
-					}
-
-				case 'E':
+				case 'M':
 
-					if bytes.Equal(ffjKeyPayRequestExt, kn) {
-						currentKey = ffjtPayRequestExt
+					if bytes.Equal(ffjKeyRefundRequestMoney, kn) {
+						currentKey = ffjtRefundRequestMoney
 						state = fflib.FFParse_want_colon
 						goto mainparse
 					}
 
-				case 'I':
-
-					if bytes.Equal(ffjKeyPayRequestIsApp, kn) {
-						currentKey = ffjtPayRequestIsApp
-						state = fflib.FFParse_want_colon
-						goto mainparse
+				case 'N':
 
-					} else if bytes.Equal(ffjKeyPayRequestIP, kn) {
-						currentKey = ffjtPayRequestIP
+					if bytes.Equal(ffjKeyRefundRequestNo, kn) {
+						currentKey = ffjtRefundRequestNo
 						state = fflib.FFParse_want_colon
 						goto mainparse
 					}
 
-				case 'M':
+				case 'R':
 
-					if bytes.Equal(ffjKeyPayRequestMoney, kn) {
-						currentKey = ffjtPayRequestMoney
+					if bytes.Equal(ffjKeyRefundRequestRefundNo, kn) {
+						currentKey = ffjtRefundRequestRefundNo
 						state = fflib.FFParse_want_colon
 						goto mainparse
 
-					} else if bytes.Equal(ffjKeyPayRequestMemberID, kn) {
-						currentKey = ffjtPayRequestMemberID
+					} else if bytes.Equal(ffjKeyRefundRequestReason, kn) {
+						currentKey = ffjtRefundRequestReason
 						state = fflib.FFParse_want_colon
 						goto mainparse
 					}
 
-				case 'N':
+				case 'T':
 
-					if bytes.Equal(ffjKeyPayRequestNo, kn) {
-						currentKey = ffjtPayRequestNo
+					if bytes.Equal(ffjKeyRefundRequestThirdTradeNo, kn) {
+						currentKey = ffjtRefundRequestThirdTradeNo
 						state = fflib.FFParse_want_colon
 						goto mainparse
-					}
-
-				case 'P':
 
-					if bytes.Equal(ffjKeyPayRequestPayCode, kn) {
-						currentKey = ffjtPayRequestPayCode
+					} else if bytes.Equal(ffjKeyRefundRequestTotalMoney, kn) {
+						currentKey = ffjtRefundRequestTotalMoney
 						state = fflib.FFParse_want_colon
 						goto mainparse
 					}
 
 				}
 
-				if fflib.SimpleLetterEqualFold(ffjKeyPayRequestExt, kn) {
-					currentKey = ffjtPayRequestExt
-					state = fflib.FFParse_want_colon
-					goto mainparse
-				}
-
-				if fflib.SimpleLetterEqualFold(ffjKeyPayRequestMemberID, kn) {
-					currentKey = ffjtPayRequestMemberID
-					state = fflib.FFParse_want_colon
-					goto mainparse
-				}
-
-				if fflib.SimpleLetterEqualFold(ffjKeyPayRequestIP, kn) {
-					currentKey = ffjtPayRequestIP
+				if fflib.EqualFoldRight(ffjKeyRefundRequestReason, kn) {
+					currentKey = ffjtRefundRequestReason
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.SimpleLetterEqualFold(ffjKeyPayRequestPayCode, kn) {
-					currentKey = ffjtPayRequestPayCode
+				if fflib.SimpleLetterEqualFold(ffjKeyRefundRequestTotalMoney, kn) {
+					currentKey = ffjtRefundRequestTotalMoney
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.EqualFoldRight(ffjKeyPayRequestIsApp, kn) {
-					currentKey = ffjtPayRequestIsApp
+				if fflib.SimpleLetterEqualFold(ffjKeyRefundRequestMoney, kn) {
+					currentKey = ffjtRefundRequestMoney
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.SimpleLetterEqualFold(ffjKeyPayRequestMoney, kn) {
-					currentKey = ffjtPayRequestMoney
+				if fflib.SimpleLetterEqualFold(ffjKeyRefundRequestThirdTradeNo, kn) {
+					currentKey = ffjtRefundRequestThirdTradeNo
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.EqualFoldRight(ffjKeyPayRequestDesc, kn) {
-					currentKey = ffjtPayRequestDesc
+				if fflib.SimpleLetterEqualFold(ffjKeyRefundRequestRefundNo, kn) {
+					currentKey = ffjtRefundRequestRefundNo
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.SimpleLetterEqualFold(ffjKeyPayRequestNo, kn) {
-					currentKey = ffjtPayRequestNo
+				if fflib.SimpleLetterEqualFold(ffjKeyRefundRequestNo, kn) {
+					currentKey = ffjtRefundRequestNo
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				currentKey = ffjtPayRequestnosuchkey
+				currentKey = ffjtRefundRequestnosuchkey
 				state = fflib.FFParse_want_colon
 				goto mainparse
 			}
@@ -1022,31 +3442,25 @@ mainparse:
 			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
 				switch currentKey {
 
-				case ffjtPayRequestNo:
+				case ffjtRefundRequestNo:
 					goto handle_No
 
-				case ffjtPayRequestDesc:
-					goto handle_Desc
-
-				case ffjtPayRequestMoney:
-					goto handle_Money
-
-				case ffjtPayRequestIsApp:
-					goto handle_IsApp
+				case ffjtRefundRequestRefundNo:
+					goto handle_RefundNo
 
-				case ffjtPayRequestPayCode:
-					goto handle_PayCode
+				case ffjtRefundRequestThirdTradeNo:
+					goto handle_ThirdTradeNo
 
-				case ffjtPayRequestIP:
-					goto handle_IP
+				case ffjtRefundRequestMoney:
+					goto handle_Money
 
-				case ffjtPayRequestMemberID:
-					goto handle_MemberID
+				case ffjtRefundRequestTotalMoney:
+					goto handle_TotalMoney
 
-				case ffjtPayRequestExt:
-					goto handle_Ext
+				case ffjtRefundRequestReason:
+					goto handle_Reason
 
-				case ffjtPayRequestnosuchkey:
+				case ffjtRefundRequestnosuchkey:
 					err = fs.SkipField(tok)
 					if err != nil {
 						return fs.WrapErr(err)
@@ -1086,9 +3500,9 @@ handle_No:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_Desc:
+handle_RefundNo:
 
-	/* handler: j.Desc type=string kind=string quoted=false*/
+	/* handler: j.RefundNo type=string kind=string quoted=false*/
 
 	{
 
@@ -1104,7 +3518,7 @@ handle_Desc:
 
 			outBuf := fs.Output.Bytes()
 
-			j.Desc = string(string(outBuf))
+			j.RefundNo = string(string(outBuf))
 
 		}
 	}
@@ -1112,29 +3526,25 @@ handle_Desc:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_Money:
+handle_ThirdTradeNo:
 
-	/* handler: j.Money type=float64 kind=float64 quoted=false*/
+	/* handler: j.ThirdTradeNo type=string kind=string quoted=false*/
 
 	{
-		if tok != fflib.FFTok_double && tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
-			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for float64", tok))
-		}
-	}
 
-	{
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
 
 		if tok == fflib.FFTok_null {
 
 		} else {
 
-			tval, err := fflib.ParseFloat(fs.Output.Bytes(), 64)
-
-			if err != nil {
-				return fs.WrapErr(err)
-			}
+			outBuf := fs.Output.Bytes()
 
-			j.Money = float64(tval)
+			j.ThirdTradeNo = string(string(outBuf))
 
 		}
 	}
@@ -1142,112 +3552,59 @@ handle_Money:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_IsApp:
+handle_Money:
 
-	/* handler: j.IsApp type=bool kind=bool quoted=false*/
+	/* handler: j.Money type=float64 kind=float64 quoted=false*/
 
 	{
-		if tok != fflib.FFTok_bool && tok != fflib.FFTok_null {
-			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for bool", tok))
+		if tok != fflib.FFTok_double && tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for float64", tok))
 		}
 	}
 
 	{
+
 		if tok == fflib.FFTok_null {
 
 		} else {
-			tmpb := fs.Output.Bytes()
-
-			if bytes.Compare([]byte{'t', 'r', 'u', 'e'}, tmpb) == 0 {
-
-				j.IsApp = true
-
-			} else if bytes.Compare([]byte{'f', 'a', 'l', 's', 'e'}, tmpb) == 0 {
 
-				j.IsApp = false
+			tval, err := fflib.ParseFloat(fs.Output.Bytes(), 64)
 
-			} else {
-				err = errors.New("unexpected bytes for true/false value")
+			if err != nil {
 				return fs.WrapErr(err)
 			}
 
+			j.Money = float64(tval)
+
 		}
 	}
 
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_PayCode:
+handle_TotalMoney:
 
-	/* handler: j.PayCode type=string kind=string quoted=false*/
+	/* handler: j.TotalMoney type=float64 kind=float64 quoted=false*/
 
 	{
-
-		{
-			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
-				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
-			}
-		}
-
-		if tok == fflib.FFTok_null {
-
-		} else {
-
-			outBuf := fs.Output.Bytes()
-
-			j.PayCode = string(string(outBuf))
-
+		if tok != fflib.FFTok_double && tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for float64", tok))
 		}
 	}
 
-	state = fflib.FFParse_after_value
-	goto mainparse
-
-handle_IP:
-
-	/* handler: j.IP type=string kind=string quoted=false*/
-
 	{
 
-		{
-			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
-				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
-			}
-		}
-
 		if tok == fflib.FFTok_null {
 
 		} else {
 
-			outBuf := fs.Output.Bytes()
-
-			j.IP = string(string(outBuf))
-
-		}
-	}
-
-	state = fflib.FFParse_after_value
-	goto mainparse
-
-handle_MemberID:
-
-	/* handler: j.MemberID type=string kind=string quoted=false*/
-
-	{
+			tval, err := fflib.ParseFloat(fs.Output.Bytes(), 64)
 
-		{
-			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
-				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			if err != nil {
+				return fs.WrapErr(err)
 			}
-		}
-
-		if tok == fflib.FFTok_null {
-
-		} else {
-
-			outBuf := fs.Output.Bytes()
 
-			j.MemberID = string(string(outBuf))
+			j.TotalMoney = float64(tval)
 
 		}
 	}
@@ -1255,9 +3612,9 @@ handle_MemberID:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_Ext:
+handle_Reason:
 
-	/* handler: j.Ext type=string kind=string quoted=false*/
+	/* handler: j.Reason type=string kind=string quoted=false*/
 
 	{
 
@@ -1273,7 +3630,7 @@ handle_Ext:
 
 			outBuf := fs.Output.Bytes()
 
-			j.Ext = string(string(outBuf))
+			j.Reason = string(string(outBuf))
 
 		}
 	}
@@ -1300,7 +3657,7 @@ done:
 }
 
 // MarshalJSON marshal bytes to json - template
-func (j *PayResult) MarshalJSON() ([]byte, error) {
+func (j *RefundResult) MarshalJSON() ([]byte, error) {
 	var buf fflib.Buffer
 	if j == nil {
 		buf.WriteString("null")
@@ -1314,7 +3671,7 @@ func (j *PayResult) MarshalJSON() ([]byte, error) {
 }
 
 // MarshalJSONBuf marshal buff to json - template
-func (j *PayResult) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+func (j *RefundResult) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 	if j == nil {
 		buf.WriteString("null")
 		return nil
@@ -1323,25 +3680,22 @@ func (j *PayResult) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 	var obj []byte
 	_ = obj
 	_ = err
-	if j.Succ {
-		buf.WriteString(`{"Succ":true`)
-	} else {
-		buf.WriteString(`{"Succ":false`)
-	}
-	buf.WriteString(`,"ErrMsg":`)
-	fflib.WriteJsonString(buf, string(j.ErrMsg))
+	buf.WriteString(`{"Status":`)
+	fflib.WriteJsonString(buf, string(j.Status))
 	buf.WriteString(`,"No":`)
 	fflib.WriteJsonString(buf, string(j.No))
-	buf.WriteString(`,"TradeNo":`)
-	fflib.WriteJsonString(buf, string(j.TradeNo))
+	buf.WriteString(`,"RefundNo":`)
+	fflib.WriteJsonString(buf, string(j.RefundNo))
+	buf.WriteString(`,"ThirdTradeNo":`)
+	fflib.WriteJsonString(buf, string(j.ThirdTradeNo))
 	buf.WriteString(`,"Money":`)
 	fflib.AppendFloat(buf, float64(j.Money), 'g', -1, 64)
 	buf.WriteString(`,"PayCode":`)
-	fflib.WriteJsonString(buf, string(j.PayCode))
-	buf.WriteString(`,"ThirdAccount":`)
-	fflib.WriteJsonString(buf, string(j.ThirdAccount))
-	buf.WriteString(`,"ThirdTradeNo":`)
-	fflib.WriteJsonString(buf, string(j.ThirdTradeNo))
+	fflib.WriteJsonString(buf, string(j.PayCode))
+	buf.WriteString(`,"FailCode":`)
+	fflib.WriteJsonString(buf, string(j.FailCode))
+	buf.WriteString(`,"FailMsg":`)
+	fflib.WriteJsonString(buf, string(j.FailMsg))
 	if j.Navite == nil {
 		buf.WriteString(`,"Navite":null`)
 	} else {
@@ -1360,56 +3714,56 @@ func (j *PayResult) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 }
 
 const (
-	ffjtPayResultbase = iota
-	ffjtPayResultnosuchkey
+	ffjtRefundResultbase = iota
+	ffjtRefundResultnosuchkey
 
-	ffjtPayResultSucc
+	ffjtRefundResultStatus
 
-	ffjtPayResultErrMsg
+	ffjtRefundResultNo
 
-	ffjtPayResultNo
+	ffjtRefundResultRefundNo
 
-	ffjtPayResultTradeNo
+	ffjtRefundResultThirdTradeNo
 
-	ffjtPayResultMoney
+	ffjtRefundResultMoney
 
-	ffjtPayResultPayCode
+	ffjtRefundResultPayCode
 
-	ffjtPayResultThirdAccount
+	ffjtRefundResultFailCode
 
-	ffjtPayResultThirdTradeNo
+	ffjtRefundResultFailMsg
 
-	ffjtPayResultNavite
+	ffjtRefundResultNavite
 )
 
-var ffjKeyPayResultSucc = []byte("Succ")
+var ffjKeyRefundResultStatus = []byte("Status")
 
-var ffjKeyPayResultErrMsg = []byte("ErrMsg")
+var ffjKeyRefundResultNo = []byte("No")
 
-var ffjKeyPayResultNo = []byte("No")
+var ffjKeyRefundResultRefundNo = []byte("RefundNo")
 
-var ffjKeyPayResultTradeNo = []byte("TradeNo")
+var ffjKeyRefundResultThirdTradeNo = []byte("ThirdTradeNo")
 
-var ffjKeyPayResultMoney = []byte("Money")
+var ffjKeyRefundResultMoney = []byte("Money")
 
-var ffjKeyPayResultPayCode = []byte("PayCode")
+var ffjKeyRefundResultPayCode = []byte("PayCode")
 
-var ffjKeyPayResultThirdAccount = []byte("ThirdAccount")
+var ffjKeyRefundResultFailCode = []byte("FailCode")
 
-var ffjKeyPayResultThirdTradeNo = []byte("ThirdTradeNo")
+var ffjKeyRefundResultFailMsg = []byte("FailMsg")
 
-var ffjKeyPayResultNavite = []byte("Navite")
+var ffjKeyRefundResultNavite = []byte("Navite")
 
 // UnmarshalJSON umarshall json - template of ffjson
-func (j *PayResult) UnmarshalJSON(input []byte) error {
+func (j *RefundResult) UnmarshalJSON(input []byte) error {
 	fs := fflib.NewFFLexer(input)
 	return j.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
 }
 
 // UnmarshalJSONFFLexer fast json unmarshall - template ffjson
-func (j *PayResult) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+func (j *RefundResult) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
 	var err error
-	currentKey := ffjtPayResultbase
+	currentKey := ffjtRefundResultbase
 	_ = currentKey
 	tok := fflib.FFTok_init
 	wantedTok := fflib.FFTok_init
@@ -1455,132 +3809,135 @@ mainparse:
 			kn := fs.Output.Bytes()
 			if len(kn) <= 0 {
 				// "" case. hrm.
-				currentKey = ffjtPayResultnosuchkey
+				currentKey = ffjtRefundResultnosuchkey
 				state = fflib.FFParse_want_colon
 				goto mainparse
 			} else {
 				switch kn[0] {
 
-				case 'E':
+				case 'F':
 
-					if bytes.Equal(ffjKeyPayResultErrMsg, kn) {
-						currentKey = ffjtPayResultErrMsg
+					if bytes.Equal(ffjKeyRefundResultFailCode, kn) {
+						currentKey = ffjtRefundResultFailCode
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeyRefundResultFailMsg, kn) {
+						currentKey = ffjtRefundResultFailMsg
 						state = fflib.FFParse_want_colon
 						goto mainparse
 					}
 
 				case 'M':
 
-					if bytes.Equal(ffjKeyPayResultMoney, kn) {
-						currentKey = ffjtPayResultMoney
+					if bytes.Equal(ffjKeyRefundResultMoney, kn) {
+						currentKey = ffjtRefundResultMoney
 						state = fflib.FFParse_want_colon
 						goto mainparse
 					}
 
 				case 'N':
 
-					if bytes.Equal(ffjKeyPayResultNo, kn) {
-						currentKey = ffjtPayResultNo
+					if bytes.Equal(ffjKeyRefundResultNo, kn) {
+						currentKey = ffjtRefundResultNo
 						state = fflib.FFParse_want_colon
 						goto mainparse
 
-					} else if bytes.Equal(ffjKeyPayResultNavite, kn) {
-						currentKey = ffjtPayResultNavite
+					} else if bytes.Equal(ffjKeyRefundResultNavite, kn) {
+						currentKey = ffjtRefundResultNavite
 						state = fflib.FFParse_want_colon
 						goto mainparse
 					}
 
 				case 'P':
 
-					if bytes.Equal(ffjKeyPayResultPayCode, kn) {
-						currentKey = ffjtPayResultPayCode
+					if bytes.Equal(ffjKeyRefundResultPayCode, kn) {
+						currentKey = ffjtRefundResultPayCode
 						state = fflib.FFParse_want_colon
 						goto mainparse
 					}
 
-				case 'S':
+				case 'R':
 
-					if bytes.Equal(ffjKeyPayResultSucc, kn) {
-						currentKey = ffjtPayResultSucc
+					if bytes.Equal(ffjKeyRefundResultRefundNo, kn) {
+						currentKey = ffjtRefundResultRefundNo
 						state = fflib.FFParse_want_colon
 						goto mainparse
 					}
 
-				case 'T':
+				case 'S':
 
-					if bytes.Equal(ffjKeyPayResultTradeNo, kn) {
-						currentKey = ffjtPayResultTradeNo
+					if bytes.Equal(ffjKeyRefundResultStatus, kn) {
+						currentKey = ffjtRefundResultStatus
 						state = fflib.FFParse_want_colon
 						goto mainparse
+					}
 
-					} else if bytes.Equal(ffjKeyPayResultThirdAccount, kn) {
-						currentKey = ffjtPayResultThirdAccount
-						state = fflib.FFParse_want_colon
-						goto mainparse
+				case 'T':
 
-					} else if bytes.Equal(ffjKeyPayResultThirdTradeNo, kn) {
-						currentKey = ffjtPayResultThirdTradeNo
+					if bytes.Equal(ffjKeyRefundResultThirdTradeNo, kn) {
+						currentKey = ffjtRefundResultThirdTradeNo
 						state = fflib.FFParse_want_colon
 						goto mainparse
 					}
 
 				}
 
-				if fflib.SimpleLetterEqualFold(ffjKeyPayResultNavite, kn) {
-					currentKey = ffjtPayResultNavite
+				if fflib.SimpleLetterEqualFold(ffjKeyRefundResultNavite, kn) {
+					currentKey = ffjtRefundResultNavite
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.SimpleLetterEqualFold(ffjKeyPayResultThirdTradeNo, kn) {
-					currentKey = ffjtPayResultThirdTradeNo
+				if fflib.EqualFoldRight(ffjKeyRefundResultFailMsg, kn) {
+					currentKey = ffjtRefundResultFailMsg
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.SimpleLetterEqualFold(ffjKeyPayResultThirdAccount, kn) {
-					currentKey = ffjtPayResultThirdAccount
+				if fflib.SimpleLetterEqualFold(ffjKeyRefundResultFailCode, kn) {
+					currentKey = ffjtRefundResultFailCode
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.SimpleLetterEqualFold(ffjKeyPayResultPayCode, kn) {
-					currentKey = ffjtPayResultPayCode
+				if fflib.SimpleLetterEqualFold(ffjKeyRefundResultPayCode, kn) {
+					currentKey = ffjtRefundResultPayCode
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.SimpleLetterEqualFold(ffjKeyPayResultMoney, kn) {
-					currentKey = ffjtPayResultMoney
+				if fflib.SimpleLetterEqualFold(ffjKeyRefundResultMoney, kn) {
+					currentKey = ffjtRefundResultMoney
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.SimpleLetterEqualFold(ffjKeyPayResultTradeNo, kn) {
-					currentKey = ffjtPayResultTradeNo
+				if fflib.SimpleLetterEqualFold(ffjKeyRefundResultThirdTradeNo, kn) {
+					currentKey = ffjtRefundResultThirdTradeNo
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.SimpleLetterEqualFold(ffjKeyPayResultNo, kn) {
-					currentKey = ffjtPayResultNo
+				if fflib.SimpleLetterEqualFold(ffjKeyRefundResultRefundNo, kn) {
+					currentKey = ffjtRefundResultRefundNo
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.EqualFoldRight(ffjKeyPayResultErrMsg, kn) {
-					currentKey = ffjtPayResultErrMsg
+				if fflib.SimpleLetterEqualFold(ffjKeyRefundResultNo, kn) {
+					currentKey = ffjtRefundResultNo
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.EqualFoldRight(ffjKeyPayResultSucc, kn) {
-					currentKey = ffjtPayResultSucc
+				if fflib.EqualFoldRight(ffjKeyRefundResultStatus, kn) {
+					currentKey = ffjtRefundResultStatus
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				currentKey = ffjtPayResultnosuchkey
+				currentKey = ffjtRefundResultnosuchkey
 				state = fflib.FFParse_want_colon
 				goto mainparse
 			}
@@ -1597,34 +3954,34 @@ mainparse:
 			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
 				switch currentKey {
 
-				case ffjtPayResultSucc:
-					goto handle_Succ
-
-				case ffjtPayResultErrMsg:
-					goto handle_ErrMsg
+				case ffjtRefundResultStatus:
+					goto handle_Status
 
-				case ffjtPayResultNo:
+				case ffjtRefundResultNo:
 					goto handle_No
 
-				case ffjtPayResultTradeNo:
-					goto handle_TradeNo
+				case ffjtRefundResultRefundNo:
+					goto handle_RefundNo
 
-				case ffjtPayResultMoney:
+				case ffjtRefundResultThirdTradeNo:
+					goto handle_ThirdTradeNo
+
+				case ffjtRefundResultMoney:
 					goto handle_Money
 
-				case ffjtPayResultPayCode:
+				case ffjtRefundResultPayCode:
 					goto handle_PayCode
 
-				case ffjtPayResultThirdAccount:
-					goto handle_ThirdAccount
+				case ffjtRefundResultFailCode:
+					goto handle_FailCode
 
-				case ffjtPayResultThirdTradeNo:
-					goto handle_ThirdTradeNo
+				case ffjtRefundResultFailMsg:
+					goto handle_FailMsg
 
-				case ffjtPayResultNavite:
+				case ffjtRefundResultNavite:
 					goto handle_Navite
 
-				case ffjtPayResultnosuchkey:
+				case ffjtRefundResultnosuchkey:
 					err = fs.SkipField(tok)
 					if err != nil {
 						return fs.WrapErr(err)
@@ -1638,34 +3995,25 @@ mainparse:
 		}
 	}
 
-handle_Succ:
+handle_Status:
 
-	/* handler: j.Succ type=bool kind=bool quoted=false*/
+	/* handler: j.Status type=payment.Status kind=string quoted=false*/
 
 	{
-		if tok != fflib.FFTok_bool && tok != fflib.FFTok_null {
-			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for bool", tok))
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for Status", tok))
+			}
 		}
-	}
 
-	{
 		if tok == fflib.FFTok_null {
 
 		} else {
-			tmpb := fs.Output.Bytes()
-
-			if bytes.Compare([]byte{'t', 'r', 'u', 'e'}, tmpb) == 0 {
-
-				j.Succ = true
-
-			} else if bytes.Compare([]byte{'f', 'a', 'l', 's', 'e'}, tmpb) == 0 {
 
-				j.Succ = false
+			outBuf := fs.Output.Bytes()
 
-			} else {
-				err = errors.New("unexpected bytes for true/false value")
-				return fs.WrapErr(err)
-			}
+			j.Status = Status(string(outBuf))
 
 		}
 	}
@@ -1673,9 +4021,9 @@ handle_Succ:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_ErrMsg:
+handle_No:
 
-	/* handler: j.ErrMsg type=string kind=string quoted=false*/
+	/* handler: j.No type=string kind=string quoted=false*/
 
 	{
 
@@ -1691,7 +4039,7 @@ handle_ErrMsg:
 
 			outBuf := fs.Output.Bytes()
 
-			j.ErrMsg = string(string(outBuf))
+			j.No = string(string(outBuf))
 
 		}
 	}
@@ -1699,9 +4047,9 @@ handle_ErrMsg:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_No:
+handle_RefundNo:
 
-	/* handler: j.No type=string kind=string quoted=false*/
+	/* handler: j.RefundNo type=string kind=string quoted=false*/
 
 	{
 
@@ -1717,7 +4065,7 @@ handle_No:
 
 			outBuf := fs.Output.Bytes()
 
-			j.No = string(string(outBuf))
+			j.RefundNo = string(string(outBuf))
 
 		}
 	}
@@ -1725,9 +4073,9 @@ handle_No:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_TradeNo:
+handle_ThirdTradeNo:
 
-	/* handler: j.TradeNo type=string kind=string quoted=false*/
+	/* handler: j.ThirdTradeNo type=string kind=string quoted=false*/
 
 	{
 
@@ -1743,7 +4091,7 @@ handle_TradeNo:
 
 			outBuf := fs.Output.Bytes()
 
-			j.TradeNo = string(string(outBuf))
+			j.ThirdTradeNo = string(string(outBuf))
 
 		}
 	}
@@ -1807,9 +4155,9 @@ handle_PayCode:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_ThirdAccount:
+handle_FailCode:
 
-	/* handler: j.ThirdAccount type=string kind=string quoted=false*/
+	/* handler: j.FailCode type=string kind=string quoted=false*/
 
 	{
 
@@ -1825,7 +4173,7 @@ handle_ThirdAccount:
 
 			outBuf := fs.Output.Bytes()
 
-			j.ThirdAccount = string(string(outBuf))
+			j.FailCode = string(string(outBuf))
 
 		}
 	}
@@ -1833,9 +4181,9 @@ handle_ThirdAccount:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_ThirdTradeNo:
+handle_FailMsg:
 
-	/* handler: j.ThirdTradeNo type=string kind=string quoted=false*/
+	/* handler: j.FailMsg type=string kind=string quoted=false*/
 
 	{
 
@@ -1851,7 +4199,7 @@ handle_ThirdTradeNo:
 
 			outBuf := fs.Output.Bytes()
 
-			j.ThirdTradeNo = string(string(outBuf))
+			j.FailMsg = string(string(outBuf))
 
 		}
 	}
@@ -2031,6 +4379,8 @@ func (j *WithdrawInfo) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 	} else {
 		buf.WriteString(`,"People":false`)
 	}
+	buf.WriteString(`,"Currency":`)
+	fflib.WriteJsonString(buf, string(j.Currency))
 	buf.WriteByte('}')
 	return nil
 }
@@ -2060,6 +4410,8 @@ const (
 	ffjtWithdrawInfoIP
 
 	ffjtWithdrawInfoPeople
+
+	ffjtWithdrawInfoCurrency
 )
 
 var ffjKeyWithdrawInfoTradeNo = []byte("TradeNo")
@@ -2084,6 +4436,8 @@ var ffjKeyWithdrawInfoIP = []byte("IP")
 
 var ffjKeyWithdrawInfoPeople = []byte("People")
 
+var ffjKeyWithdrawInfoCurrency = []byte("Currency")
+
 // UnmarshalJSON umarshall json - template of ffjson
 func (j *WithdrawInfo) UnmarshalJSON(input []byte) error {
 	fs := fflib.NewFFLexer(input)
@@ -2161,6 +4515,11 @@ mainparse:
 						currentKey = ffjtWithdrawInfoCity
 						state = fflib.FFParse_want_colon
 						goto mainparse
+
+					} else if bytes.Equal(ffjKeyWithdrawInfoCurrency, kn) {
+						currentKey = ffjtWithdrawInfoCurrency
+						state = fflib.FFParse_want_colon
+						goto mainparse
 					}
 
 				case 'D':
@@ -2226,6 +4585,12 @@ mainparse:
 
 				}
 
+				if fflib.SimpleLetterEqualFold(ffjKeyWithdrawInfoCurrency, kn) {
+					currentKey = ffjtWithdrawInfoCurrency
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
 				if fflib.SimpleLetterEqualFold(ffjKeyWithdrawInfoPeople, kn) {
 					currentKey = ffjtWithdrawInfoPeople
 					state = fflib.FFParse_want_colon
@@ -2342,6 +4707,9 @@ mainparse:
 				case ffjtWithdrawInfoPeople:
 					goto handle_People
 
+				case ffjtWithdrawInfoCurrency:
+					goto handle_Currency
+
 				case ffjtWithdrawInfonosuchkey:
 					err = fs.SkipField(tok)
 					if err != nil {
@@ -2655,6 +5023,32 @@ handle_People:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
+handle_Currency:
+
+	/* handler: j.Currency type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.Currency = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
 wantedvalue:
 	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
 wrongtokenerror:
@@ -3148,6 +5542,10 @@ func (j *WithdrawResult) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 	fflib.WriteJsonString(buf, string(j.CertID))
 	buf.WriteString(`,"Money":`)
 	fflib.AppendFloat(buf, float64(j.Money), 'g', -1, 64)
+	buf.WriteString(`,"Currency":`)
+	fflib.WriteJsonString(buf, string(j.Currency))
+	buf.WriteString(`,"Fee":`)
+	fflib.AppendFloat(buf, float64(j.Fee), 'g', -1, 64)
 	buf.WriteString(`,"PayTime":`)
 	fflib.WriteJsonString(buf, string(j.PayTime))
 	buf.WriteString(`,"Status":`)
@@ -3182,6 +5580,10 @@ const (
 
 	ffjtWithdrawResultMoney
 
+	ffjtWithdrawResultCurrency
+
+	ffjtWithdrawResultFee
+
 	ffjtWithdrawResultPayTime
 
 	ffjtWithdrawResultStatus
@@ -3209,6 +5611,10 @@ var ffjKeyWithdrawResultCertID = []byte("CertID")
 
 var ffjKeyWithdrawResultMoney = []byte("Money")
 
+var ffjKeyWithdrawResultCurrency = []byte("Currency")
+
+var ffjKeyWithdrawResultFee = []byte("Fee")
+
 var ffjKeyWithdrawResultPayTime = []byte("PayTime")
 
 var ffjKeyWithdrawResultStatus = []byte("Status")
@@ -3297,11 +5703,21 @@ mainparse:
 						currentKey = ffjtWithdrawResultCertID
 						state = fflib.FFParse_want_colon
 						goto mainparse
+
+					} else if bytes.Equal(ffjKeyWithdrawResultCurrency, kn) {
+						currentKey = ffjtWithdrawResultCurrency
+						state = fflib.FFParse_want_colon
+						goto mainparse
 					}
 
 				case 'F':
 
-					if bytes.Equal(ffjKeyWithdrawResultFailCode, kn) {
+					if bytes.Equal(ffjKeyWithdrawResultFee, kn) {
+						currentKey = ffjtWithdrawResultFee
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeyWithdrawResultFailCode, kn) {
 						currentKey = ffjtWithdrawResultFailCode
 						state = fflib.FFParse_want_colon
 						goto mainparse
@@ -3396,6 +5812,18 @@ mainparse:
 					goto mainparse
 				}
 
+				if fflib.SimpleLetterEqualFold(ffjKeyWithdrawResultFee, kn) {
+					currentKey = ffjtWithdrawResultFee
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeyWithdrawResultCurrency, kn) {
+					currentKey = ffjtWithdrawResultCurrency
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
 				if fflib.SimpleLetterEqualFold(ffjKeyWithdrawResultMoney, kn) {
 					currentKey = ffjtWithdrawResultMoney
 					state = fflib.FFParse_want_colon
@@ -3494,6 +5922,12 @@ mainparse:
 				case ffjtWithdrawResultMoney:
 					goto handle_Money
 
+				case ffjtWithdrawResultCurrency:
+					goto handle_Currency
+
+				case ffjtWithdrawResultFee:
+					goto handle_Fee
+
 				case ffjtWithdrawResultPayTime:
 					goto handle_PayTime
 
@@ -3762,6 +6196,62 @@ handle_Money:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
+handle_Currency:
+
+	/* handler: j.Currency type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.Currency = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Fee:
+
+	/* handler: j.Fee type=float64 kind=float64 quoted=false*/
+
+	{
+		if tok != fflib.FFTok_double && tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for float64", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseFloat(fs.Output.Bytes(), 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			j.Fee = float64(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
 handle_PayTime:
 
 	/* handler: j.PayTime type=string kind=string quoted=false*/
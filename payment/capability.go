@@ -0,0 +1,212 @@
+package payment
+
+import (
+	"reflect"
+)
+
+//渠道能力中的操作,补充observer中的调用操作
+const (
+	OpResult       = "result"        //同步结果跳转处理
+	OpRefund       = "refund"        //退款
+	OpQRCodePay    = "qrcode_pay"    //扫码支付
+	OpClose        = "close"         //关闭交易
+	OpBill         = "bill"          //对账单下载
+	OpRefundNotify = "refund_notify" //退款异步通知
+	OpBalance      = "balance"       //出款账户余额查询
+	OpRecurring    = "recurring"     //代扣协议签约及扣款
+)
+
+//交易类型
+const (
+	TradeWeb    = "web"    //电脑网页
+	TradeApp    = "app"    //APP
+	TradeJSAPI  = "jsapi"  //公众号/小程序
+	TradeQRCode = "qrcode" //扫码
+	TradeQuick  = "quick"  //快捷支付(API直接扣款)
+	TradeBank   = "bank"   //网银
+	TradeWallet = "wallet" //余额
+)
+
+//Ext扩展内容格式
+const (
+	ExtNone   = ""       //不使用Ext
+	ExtString = "string" //字符串
+	ExtJSON   = "json"   //json字符串
+)
+
+//ExtField 扩展内容字段说明
+type ExtField struct {
+	Name     string `description:"字段名称"`
+	Type     string `description:"字段类型 string/bool/int/float"`
+	Required bool   `description:"是否必填"`
+	Desc     string `description:"字段描述"`
+}
+
+//Capabilities 支付/提现方式能力说明,用于生成收银台界面
+type Capabilities struct {
+	Code       string     `description:"支付/提现方式编码"`
+	Name       string     `description:"支付/提现方式名称"`
+	Operations []string   `description:"支持的操作"`
+	TradeTypes []string   `description:"支持的交易类型"`
+	Currencies []string   `description:"支持的交易币种"`
+	Required   []string   `description:"请求中必填的字段[PayRequest/WithdrawInfo字段名]"`
+	ExtFormat  string     `description:"Ext扩展内容格式 string/json,为空表示不使用"`
+	Ext        []ExtField `description:"Ext扩展内容字段,ExtFormat为string时只有一个字段"`
+	MaxTradeNo int        `description:"交易单号[PayRequest.No]最大长度,已扣除渠道自动添加的前缀,0表示不限制"`
+}
+
+//Has 是否支持操作
+func (c *Capabilities) Has(op string) bool {
+	return contains(c.Operations, op)
+}
+
+//Capable 能力声明接口,支付/提现方式实现该接口声明无法自动识别的能力
+//	Code、Name、币种以及Query/Refund/RefundNotify/Close/Bill/QRCodePay/Recurring/WithdrawNotify/WithdrawBalance接口会自动补充
+type Capable interface {
+	Capabilities() *Capabilities
+}
+
+//PaymentCapabilities 支付方式能力
+//	未实现Capable时默认支持Pay、Notify、Result操作
+func PaymentCapabilities(p Payment) *Capabilities {
+	ret := declared(p)
+	if len(ret.Operations) < 1 {
+		ret.Operations = []string{OpPay, OpNotify, OpResult}
+	}
+	if _, ok := p.(Query); ok {
+		ret.Operations = appendOnce(ret.Operations, OpQuery)
+	}
+	if _, ok := p.(Refund); ok {
+		ret.Operations = appendOnce(ret.Operations, OpRefund)
+	}
+	if _, ok := p.(RefundNotify); ok {
+		ret.Operations = appendOnce(ret.Operations, OpRefundNotify)
+	}
+	if _, ok := p.(Recurring); ok {
+		ret.Operations = appendOnce(ret.Operations, OpRecurring)
+	}
+	if _, ok := p.(Close); ok {
+		ret.Operations = appendOnce(ret.Operations, OpClose)
+	}
+	if _, ok := p.(Bill); ok {
+		ret.Operations = appendOnce(ret.Operations, OpBill)
+	}
+	if _, ok := p.(QRCodePay); ok {
+		ret.Operations = appendOnce(ret.Operations, OpQRCodePay)
+		ret.TradeTypes = appendOnce(ret.TradeTypes, TradeQRCode)
+	}
+	if len(ret.TradeTypes) < 1 {
+		ret.TradeTypes = []string{TradeWeb}
+	}
+	ret.Code = p.Code()
+	ret.Name = p.Name()
+	fillCurrencies(ret, p)
+	return ret
+}
+
+//WithdrawCapabilities 提现方式能力
+//	未声明操作时根据WithdrawNotify/WithdrawBalance接口识别是否支持异步通知和余额查询,包装其他提现方式的对象应声明被包装对象的操作
+func WithdrawCapabilities(w Withdraw) *Capabilities {
+	ret := declared(w)
+	if len(ret.Operations) < 1 {
+		if _, ok := w.(WithdrawNotify); ok {
+			ret.Operations = append(ret.Operations, OpNotifyWithdraw)
+		}
+		if _, ok := w.(WithdrawBalance); ok {
+			ret.Operations = append(ret.Operations, OpBalance)
+		}
+	}
+	ret.Operations = appendOnce(ret.Operations, OpWithdraw)
+	ret.Operations = appendOnce(ret.Operations, OpQueryWithdraw)
+	if len(ret.Required) < 1 {
+		ret.Required = []string{"TradeNo", "UserName", "CardNo", "Money"}
+	}
+	ret.Code = w.Code()
+	ret.Name = w.Name()
+	fillCurrencies(ret, w)
+	return ret
+}
+
+//ExtFields 根据扩展信息结构体生成字段说明,字段描述读取description标签
+//	required为必填字段名称
+func ExtFields(v interface{}, required ...string) []ExtField {
+	t := reflect.TypeOf(v)
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil
+	}
+	var ret []ExtField
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" { //未导出字段
+			continue
+		}
+		ret = append(ret, ExtField{
+			Name:     f.Name,
+			Type:     fieldType(f.Type.Kind()),
+			Required: contains(required, f.Name),
+			Desc:     f.Tag.Get("description"),
+		})
+	}
+	return ret
+}
+
+//字段类型名称
+func fieldType(kind reflect.Kind) string {
+	switch kind {
+	case reflect.Bool:
+		return "bool"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "int"
+	case reflect.Float32, reflect.Float64:
+		return "float"
+	}
+	return "string"
+}
+
+//读取声明的能力,返回副本避免修改驱动数据
+func declared(v interface{}) *Capabilities {
+	ret := &Capabilities{}
+	if c, ok := v.(Capable); ok {
+		if d := c.Capabilities(); d != nil {
+			*ret = *d
+			ret.Operations = append([]string{}, d.Operations...)
+			ret.TradeTypes = append([]string{}, d.TradeTypes...)
+			ret.Required = append([]string{}, d.Required...)
+			ret.Ext = append([]ExtField{}, d.Ext...)
+		}
+	}
+	return ret
+}
+
+//补充支持的币种
+func fillCurrencies(ret *Capabilities, v interface{}) {
+	if c, ok := v.(Currencies); ok {
+		ret.Currencies = nil
+		for _, cur := range c.Currencies() {
+			ret.Currencies = appendOnce(ret.Currencies, Currency(cur))
+		}
+	}
+	if len(ret.Currencies) < 1 {
+		ret.Currencies = []string{CNY}
+	}
+}
+
+func contains(items []string, v string) bool {
+	for _, item := range items {
+		if item == v {
+			return true
+		}
+	}
+	return false
+}
+
+func appendOnce(items []string, v string) []string {
+	if contains(items, v) {
+		return items
+	}
+	return append(items, v)
+}
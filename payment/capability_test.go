@@ -0,0 +1,135 @@
+package payment
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartystreets/goconvey/convey"
+)
+
+type capabilityTestPay struct {
+	healthTestPay
+}
+
+func (t *capabilityTestPay) Query(tradeno string, tradeDate ...time.Time) *PayResult { return nil }
+func (t *capabilityTestPay) Currencies() []string                                    { return []string{"cny", "usd"} }
+func (t *capabilityTestPay) Capabilities() *Capabilities {
+	return &Capabilities{
+		Operations: []string{OpPay, OpPayConfirm, OpNotify},
+		TradeTypes: []string{TradeQuick},
+		Required:   []string{"MemberID", "Ext"},
+		ExtFormat:  ExtJSON,
+		Ext: ExtFields(&struct {
+			CardNo string `description:"银行卡号"`
+			Credit bool   `description:"是否是信用卡"`
+			Times  int
+			secret string
+		}{}, "CardNo"),
+	}
+}
+
+type notifyTestWithdraw struct {
+	PayInfo
+}
+
+func (t *notifyTestWithdraw) Withdraw(info *WithdrawInfo) *WithdrawResult { return nil }
+func (t *notifyTestWithdraw) QueryWithdraw(tradeno string, tradeDate ...time.Time) *WithdrawQueryResult {
+	return nil
+}
+func (t *notifyTestWithdraw) WithdrawNotify(params map[string]string) *WithdrawQueryResult {
+	if params["sign"] != "ok" {
+		return WithdrawNotifyVerifyFail(params["no"])
+	}
+	return &WithdrawQueryResult{TradeNo: params["no"], Status: SUCCESS}
+}
+func (t *notifyTestWithdraw) Balance() (*BalanceResult, error) {
+	return &BalanceResult{Available: 100, Currency: CNY}, nil
+}
+func (t *notifyTestWithdraw) WithdrawNotifyResult(ret *WithdrawQueryResult) string {
+	if ret == nil || ret.Status == UNKNOW {
+		return "fail"
+	}
+	return "success"
+}
+
+func Test_Capabilities(t *testing.T) {
+	convey.Convey("默认能力", t, func() {
+		p := &healthTestPay{}
+		p.Init("a", "A", true)
+		c := PaymentCapabilities(p)
+		convey.So(c.Code, convey.ShouldEqual, "a")
+		convey.So(c.Name, convey.ShouldEqual, "A")
+		convey.So(c.Operations, convey.ShouldResemble, []string{OpPay, OpNotify, OpResult})
+		convey.So(c.TradeTypes, convey.ShouldResemble, []string{TradeWeb})
+		convey.So(c.Currencies, convey.ShouldResemble, []string{CNY})
+		convey.So(c.Has(OpPayConfirm), convey.ShouldBeFalse)
+	})
+	convey.Convey("声明能力", t, func() {
+		p := &capabilityTestPay{}
+		p.Init("b", "B", true)
+		c := PaymentCapabilities(p)
+		convey.So(c.Code, convey.ShouldEqual, "b")
+		convey.So(c.Operations, convey.ShouldResemble, []string{OpPay, OpPayConfirm, OpNotify, OpQuery})
+		convey.So(c.TradeTypes, convey.ShouldResemble, []string{TradeQuick})
+		convey.So(c.Currencies, convey.ShouldResemble, []string{"CNY", "USD"})
+		convey.So(c.Ext, convey.ShouldResemble, []ExtField{
+			{Name: "CardNo", Type: "string", Required: true, Desc: "银行卡号"},
+			{Name: "Credit", Type: "bool", Desc: "是否是信用卡"},
+			{Name: "Times", Type: "int"},
+		})
+		c.Operations[0] = OpRefund
+		convey.So(PaymentCapabilities(p).Operations[0], convey.ShouldEqual, OpPay)
+	})
+	convey.Convey("注册中心", t, func() {
+		r := NewRegistry()
+		p := &capabilityTestPay{}
+		p.Init("b", "B", true)
+		r.SetPayment(p)
+		q := &healthTestPay{}
+		q.Init("a", "A", false)
+		r.SetPayment(q)
+		convey.So(r.PaymentCapabilities("c"), convey.ShouldBeNil)
+		convey.So(r.PaymentCapabilities("a").Code, convey.ShouldEqual, "a")
+		list := r.PaymentsCapabilities()
+		convey.So(len(list), convey.ShouldEqual, 1)
+		convey.So(list[0].Code, convey.ShouldEqual, "b")
+	})
+	convey.Convey("提现异步通知", t, func() {
+		w := &notifyTestWithdraw{}
+		w.Init("w", "W", true)
+		convey.So(WithdrawCapabilities(w).Has(OpNotifyWithdraw), convey.ShouldBeTrue)
+		r := NewRegistry()
+		r.SetWithdraw(w)
+		var ops []string
+		r.SetObserver(ObserverFunc(func(op, code, outcome, failCode string, latency time.Duration) {
+			ops = append(ops, op+":"+outcome+":"+failCode)
+		}))
+		ret, resp := r.WithdrawNotify("w", map[string]string{"no": "1", "sign": "ok"})
+		convey.So(ret.Status, convey.ShouldEqual, SUCCESS)
+		convey.So(resp, convey.ShouldEqual, "success")
+		ret, resp = r.WithdrawNotify("w", map[string]string{"no": "2"})
+		convey.So(ret.Status, convey.ShouldEqual, UNKNOW)
+		convey.So(ret.TradeNo, convey.ShouldEqual, "2")
+		convey.So(resp, convey.ShouldEqual, "fail")
+		convey.So(ops, convey.ShouldResemble, []string{
+			OpNotifyWithdraw + ":" + OutcomeSuccess + ":",
+			OpNotifyWithdraw + ":" + OutcomeUnknow + ":NOTIFY_VERIFY_FAIL",
+		})
+		ret, resp = r.WithdrawNotify("none", nil)
+		convey.So(ret.Status, convey.ShouldEqual, UNKNOW)
+		convey.So(resp, convey.ShouldEqual, "")
+	})
+	convey.Convey("余额查询", t, func() {
+		w := &notifyTestWithdraw{}
+		w.Init("w", "W", true)
+		convey.So(WithdrawCapabilities(w).Operations, convey.ShouldResemble,
+			[]string{OpNotifyWithdraw, OpBalance, OpWithdraw, OpQueryWithdraw})
+		r := NewRegistry()
+		r.SetWithdraw(w)
+		ret, err := r.WithdrawBalance("w")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ret.Available, convey.ShouldEqual, 100)
+		_, err = r.WithdrawBalance("none")
+		convey.So(err, convey.ShouldEqual, ErrPaymentNotFound)
+	})
+}
@@ -0,0 +1,537 @@
+package cashier
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/kinwyb/golang/payment"
+	"github.com/kinwyb/golang/payment/qrcode"
+	"github.com/kinwyb/golang/payment/store"
+	"github.com/kinwyb/golang/utils"
+)
+
+//收银台HTTP服务,路径相对于Config.BasePath
+//	POST /order   创建订单(需要设置Authorize),返回收银台地址
+//	GET  /        支付方式选择页面 ?no=订单号
+//	GET  /pay     支付确认页面 ?no=订单号&code=支付方式,需要填写扩展信息的支付方式同时显示信息填写表单
+//	POST /pay     记录支付请求并发起支付 ?no=订单号&code=支付方式,表单为扩展信息
+//	POST /confirm 短信验证码确认支付 no/code/third_no/verify_code
+//	GET  /status  订单状态[json] ?no=订单号,前端轮询使用
+//	支付结果仍然通过各支付方式的异步通知更新订单,收银台只负责发起支付和展示
+
+//支付页面类型
+const (
+	ModeForm    = "form"    //跳转第三方页面(支付宝/银联等表单自动提交)
+	ModeQRCode  = "qrcode"  //扫码支付(微信NATIVE等)
+	ModeConfirm = "confirm" //短信验证码确认(畅捷快捷支付等)
+)
+
+//Config 收银台配置
+type Config struct {
+	Registry  *payment.Registry           //支付注册中心
+	Orders    Orders                      //订单存储
+	BasePath  string                      //收银台路径,默认/cashier
+	Title     string                      //页面标题,默认"收银台"
+	Codes     []string                    //可用的支付方式编码,为空时使用注册中心中所有启用的支付方式
+	Templates map[string]string           //页面模版,按页面名称替换默认模版
+	QRCode    *qrcode.Options             //二维码生成参数,默认DataURI
+	ReturnURL func(o *store.Order) string //订单完成后前端跳转地址,为空时只显示结果
+	Authorize func(r *http.Request) error //创建订单接口鉴权,为空时不开放创建订单接口
+	TradeNo   func(o *store.Order) string //生成支付请求的交易单号,每次发起支付必须不同,默认订单号+两位支付请求序号
+	Logger    utils.Logger                //日志
+}
+
+//默认值
+func (c *Config) defaults() {
+	c.BasePath = "/" + strings.Trim(c.BasePath, "/")
+	if c.BasePath == "/" {
+		c.BasePath = "/cashier"
+	}
+	if c.Title == "" {
+		c.Title = "收银台"
+	}
+	if c.QRCode == nil {
+		c.QRCode = &qrcode.Options{Format: qrcode.DataURI}
+	}
+}
+
+//Cashier 收银台
+type Cashier struct {
+	cfg       *Config
+	tplLock   sync.Mutex //模版引擎不支持并发
+	tpl       *utils.JSTemplate
+	templates map[string]string
+}
+
+//New 创建收银台
+func New(c *Config) *Cashier {
+	c.defaults()
+	ret := &Cashier{
+		cfg:       c,
+		tpl:       utils.NewJSTemplate("", ""),
+		templates: map[string]string{},
+	}
+	for k, v := range defaultTemplates {
+		ret.templates[k] = v
+	}
+	for k, v := range c.Templates {
+		ret.templates[k] = v
+	}
+	return ret
+}
+
+//Channel 收银台支付方式
+type Channel struct {
+	Code string //支付方式编码
+	Name string //支付方式名称
+	Mode string //支付页面类型
+	caps *payment.Capabilities
+	p    payment.Payment
+}
+
+//Channels 订单可用的支付方式,过滤未启用、不可用、不支持订单币种及没有网页支付页面的支付方式
+func (c *Cashier) Channels(o *store.Order) []*Channel {
+	var ret []*Channel
+	for _, p := range c.cfg.Registry.Payments() {
+		if ch := c.channel(p, o); ch != nil {
+			ret = append(ret, ch)
+		}
+	}
+	return ret
+}
+
+//CreateOrder 创建订单,返回收银台地址(相对路径)
+func (c *Cashier) CreateOrder(o *store.Order) (string, error) {
+	if o == nil || o.No == "" {
+		return "", errors.New("订单号不能为空")
+	} else if o.Money <= 0 {
+		return "", errors.New("订单金额必须大于0")
+	}
+	if err := c.cfg.Orders.CreateOrder(o); err != nil {
+		return "", err
+	}
+	return c.cfg.BasePath + "/?no=" + url.QueryEscape(o.No), nil
+}
+
+//ServeHTTP 处理收银台请求
+func (c *Cashier) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	path := strings.TrimPrefix(r.URL.Path, c.cfg.BasePath)
+	switch strings.Trim(path, "/") {
+	case "":
+		c.index(w, r)
+	case "order":
+		c.order(w, r)
+	case "pay":
+		c.pay(w, r)
+	case "confirm":
+		c.confirm(w, r)
+	case "status":
+		c.status(w, r)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+//创建订单接口
+func (c *Cashier) order(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		c.writeJSON(w, http.StatusMethodNotAllowed, map[string]interface{}{"msg": "请求方式错误"})
+		return
+	} else if c.cfg.Authorize == nil {
+		c.writeJSON(w, http.StatusForbidden, map[string]interface{}{"msg": "未开放创建订单接口"})
+		return
+	} else if err := c.cfg.Authorize(r); err != nil {
+		c.writeJSON(w, http.StatusForbidden, map[string]interface{}{"msg": err.Error()})
+		return
+	}
+	req := &struct {
+		No       string  `json:"no"`
+		MemberID string  `json:"member_id"`
+		Money    float64 `json:"money"`
+		Currency string  `json:"currency"`
+		Desc     string  `json:"desc"`
+	}{}
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+		c.writeJSON(w, http.StatusBadRequest, map[string]interface{}{"msg": "请求数据解析失败"})
+		return
+	}
+	u, err := c.CreateOrder(&store.Order{
+		No:       req.No,
+		MemberID: req.MemberID,
+		Money:    req.Money,
+		Currency: req.Currency,
+		Desc:     req.Desc,
+	})
+	if err != nil {
+		c.writeJSON(w, http.StatusBadRequest, map[string]interface{}{"msg": err.Error()})
+		return
+	}
+	c.writeJSON(w, http.StatusOK, map[string]interface{}{"no": req.No, "url": u})
+}
+
+//支付方式选择页面
+func (c *Cashier) index(w http.ResponseWriter, r *http.Request) {
+	o, ok := c.payableOrder(w, r.FormValue("no"))
+	if !ok {
+		return
+	}
+	channels := []map[string]interface{}{}
+	for _, v := range c.Channels(o) {
+		channels = append(channels, channelData(v))
+	}
+	c.render(w, http.StatusOK, PageIndex, map[string]interface{}{"order": orderData(o), "channels": channels})
+}
+
+//支付页面
+func (c *Cashier) pay(w http.ResponseWriter, r *http.Request) {
+	o, ok := c.payableOrder(w, r.FormValue("no"))
+	if !ok {
+		return
+	}
+	p := c.cfg.Registry.Payment(r.FormValue("code"))
+	var ch *Channel
+	if p != nil {
+		ch = c.channel(p, o)
+	}
+	if ch == nil {
+		c.renderError(w, http.StatusBadRequest, "支付方式不可用")
+		return
+	}
+	data := map[string]interface{}{
+		"order":   orderData(o),
+		"channel": channelData(ch),
+	}
+	if r.Method != http.MethodPost { //GET只显示确认页面,避免链接预取或刷新时重复发起支付
+		fields := []map[string]interface{}{}
+		if contains(ch.caps.Required, "Ext") {
+			for _, f := range ch.caps.Ext {
+				fields = append(fields, map[string]interface{}{"name": f.Name, "type": f.Type, "required": f.Required, "desc": f.Desc})
+			}
+		}
+		data["fields"] = fields
+		c.render(w, http.StatusOK, PageExt, data)
+		return
+	}
+	tradeNo, err := c.tradeNo(o, ch)
+	if err != nil {
+		c.renderError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	req := o.PayRequest(ch.Code)
+	req.No = tradeNo
+	req.IP = clientIP(r)
+	if contains(ch.caps.Required, "Ext") {
+		ext, err := formExt(r, ch.caps)
+		if err != nil {
+			c.renderError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		req.Ext = ext
+	}
+	attempt := &store.Attempt{OrderNo: o.No, PayCode: ch.Code, TradeNo: req.No, Money: o.Money}
+	if err := c.cfg.Orders.CreateAttempt(attempt); err != nil {
+		c.renderError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	page, err := c.startPay(ch, req, data)
+	if err != nil {
+		c.log(utils.LogLevelError, "收银台订单[%s]%s发起支付失败:%s", o.No, ch.Code, err.Error())
+		if !payment.IsRequestFailed(err) { //请求已发送但结果未知时保留支付请求,以异步通知为准
+			if e := c.cfg.Orders.FailAttempt(attempt.ID, err.Error()); e != nil {
+				c.log(utils.LogLevelError, "收银台订单[%s]支付请求状态更新失败:%s", o.No, e.Error())
+			}
+		}
+		c.renderError(w, http.StatusBadGateway, err.Error())
+		return
+	}
+	if form, ok := data["form"].(string); ok && (strings.HasPrefix(form, "http://") || strings.HasPrefix(form, "https://")) {
+		http.Redirect(w, r, form, http.StatusFound)
+		return
+	}
+	c.render(w, http.StatusOK, page, data)
+}
+
+//生成支付请求的交易单号,同一订单多次发起支付时渠道不允许重复使用交易单号
+//	默认为订单号+两位支付请求序号,序号定长所以不同订单不会生成相同单号,不加分隔符以满足银联只允许字母数字的要求
+//	超过支付方式的单号长度限制时返回错误
+func (c *Cashier) tradeNo(o *store.Order, ch *Channel) (string, error) {
+	no := ""
+	if c.cfg.TradeNo != nil {
+		no = c.cfg.TradeNo(o)
+	} else {
+		attempts, err := c.cfg.Orders.Attempts(o.No)
+		if err != nil {
+			return "", err
+		} else if len(attempts) >= 99 {
+			return "", errors.New("订单发起支付次数过多")
+		}
+		no = fmt.Sprintf("%s%02d", o.No, len(attempts)+1)
+	}
+	if ch.caps.MaxTradeNo > 0 && len(no) > ch.caps.MaxTradeNo {
+		return "", fmt.Errorf("交易单号[%s]超过%s单号长度限制%d位", no, ch.Name, ch.caps.MaxTradeNo)
+	}
+	return no, nil
+}
+
+//发起支付,返回页面名称
+func (c *Cashier) startPay(ch *Channel, req *payment.PayRequest, data map[string]interface{}) (string, error) {
+	switch ch.Mode {
+	case ModeQRCode:
+		ret, err := c.cfg.Registry.QRCodePay(ch.Code, req, c.cfg.QRCode)
+		if err != nil {
+			return "", err
+		}
+		data["code"] = ret.Code
+		data["image"] = ret.Image
+		return PageQRCode, nil
+	case ModeConfirm:
+		thirdNo, err := c.cfg.Registry.Pay(ch.Code, req)
+		if err != nil {
+			return "", err
+		}
+		data["thirdNo"] = thirdNo
+		return PageConfirm, nil
+	}
+	form, err := c.cfg.Registry.Pay(ch.Code, req)
+	if err != nil {
+		return "", err
+	}
+	data["form"] = form
+	return PageForm, nil
+}
+
+//短信验证码确认支付
+func (c *Cashier) confirm(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		c.renderError(w, http.StatusMethodNotAllowed, "请求方式错误")
+		return
+	}
+	o, ok := c.payableOrder(w, r.FormValue("no"))
+	if !ok {
+		return
+	}
+	p := c.cfg.Registry.Payment(r.FormValue("code"))
+	var ch *Channel
+	if p != nil {
+		ch = c.channel(p, o)
+	}
+	if ch == nil || ch.Mode != ModeConfirm {
+		c.renderError(w, http.StatusBadRequest, "支付方式不可用")
+		return
+	}
+	thirdNo := r.FormValue("third_no")
+	data := map[string]interface{}{
+		"order":   orderData(o),
+		"channel": channelData(ch),
+		"thirdNo": thirdNo,
+	}
+	ret := c.cfg.Registry.PayConfirm(ch.Code, &payment.PayConfirmRequest{
+		No:         thirdNo,
+		ThirdNo:    thirdNo,
+		VerifyCode: r.FormValue("verify_code"),
+	})
+	if ret == nil || !ret.Succ {
+		msg := "支付确认失败"
+		if ret != nil && ret.ErrMsg != "" {
+			msg = ret.ErrMsg
+		}
+		data["msg"] = msg
+	} else {
+		data["msg"] = "支付已提交,等待支付结果"
+	}
+	c.render(w, http.StatusOK, PageConfirm, data)
+}
+
+//订单状态
+func (c *Cashier) status(w http.ResponseWriter, r *http.Request) {
+	o, err := c.cfg.Orders.GetOrder(r.FormValue("no"))
+	if err != nil {
+		c.writeJSON(w, http.StatusInternalServerError, map[string]interface{}{"msg": err.Error()})
+		return
+	} else if o == nil {
+		c.writeJSON(w, http.StatusNotFound, map[string]interface{}{"msg": "订单不存在"})
+		return
+	}
+	ret := map[string]interface{}{
+		"no":      o.No,
+		"status":  string(o.Status),
+		"payCode": o.PayCode,
+	}
+	if o.Status != payment.DEALING && c.cfg.ReturnURL != nil {
+		ret["returnURL"] = c.cfg.ReturnURL(o)
+	}
+	c.writeJSON(w, http.StatusOK, ret)
+}
+
+//查询可以支付的订单,失败时输出错误页面
+func (c *Cashier) payableOrder(w http.ResponseWriter, no string) (*store.Order, bool) {
+	if no == "" {
+		c.renderError(w, http.StatusBadRequest, "订单号不能为空")
+		return nil, false
+	}
+	o, err := c.cfg.Orders.GetOrder(no)
+	if err != nil {
+		c.log(utils.LogLevelError, "收银台订单[%s]查询失败:%s", no, err.Error())
+		c.renderError(w, http.StatusInternalServerError, "订单查询失败")
+		return nil, false
+	} else if o == nil {
+		c.renderError(w, http.StatusNotFound, "订单不存在")
+		return nil, false
+	} else if !payable(o) {
+		c.renderError(w, http.StatusBadRequest, "订单已支付或已关闭")
+		return nil, false
+	}
+	return o, true
+}
+
+//收银台支付方式,不可用时返回nil
+func (c *Cashier) channel(p payment.Payment, o *store.Order) *Channel {
+	if !p.Start() || !c.cfg.Registry.PaymentAvailable(p.Code()) || !payment.SupportCurrency(p, o.Currency) {
+		return nil
+	} else if len(c.cfg.Codes) > 0 && !contains(c.cfg.Codes, p.Code()) {
+		return nil
+	}
+	caps := c.cfg.Registry.PaymentCapabilities(p.Code())
+	if caps == nil {
+		return nil
+	}
+	ch := &Channel{Code: p.Code(), Name: p.Name(), caps: caps, p: p}
+	_, qr := p.(payment.QRCodePay)
+	switch {
+	case caps.Has(payment.OpPayConfirm) && contains(caps.TradeTypes, payment.TradeQuick):
+		ch.Mode = ModeConfirm
+	case contains(caps.TradeTypes, payment.TradeWeb) || contains(caps.TradeTypes, payment.TradeBank):
+		ch.Mode = ModeForm
+	case qr && contains(caps.TradeTypes, payment.TradeQRCode):
+		ch.Mode = ModeQRCode
+	default: //只支持APP/公众号等的支付方式不在收银台显示
+		return nil
+	}
+	return ch
+}
+
+//渲染页面
+func (c *Cashier) render(w http.ResponseWriter, status int, page string, data map[string]interface{}) {
+	data["base"] = c.cfg.BasePath
+	data["title"] = c.cfg.Title
+	c.tplLock.Lock()
+	body, err := c.tpl.Template([]byte(c.templates[page]), data)
+	c.tplLock.Unlock()
+	if err != nil {
+		c.log(utils.LogLevelError, "收银台页面[%s]渲染失败:%s", page, err.Error())
+		http.Error(w, "页面渲染失败", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html;charset=utf-8")
+	w.WriteHeader(status)
+	w.Write(body)
+}
+
+//错误页面
+func (c *Cashier) renderError(w http.ResponseWriter, status int, msg string) {
+	c.render(w, status, PageError, map[string]interface{}{"msg": msg})
+}
+
+//输出json
+func (c *Cashier) writeJSON(w http.ResponseWriter, status int, data interface{}) {
+	w.Header().Set("Content-Type", "application/json;charset=utf-8")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(data)
+}
+
+//日志输出
+func (c *Cashier) log(level utils.LoggerLevel, format string, args ...interface{}) {
+	utils.WriteLog(c.cfg.Logger, level, format, args...)
+}
+
+//模版中使用的订单数据
+func orderData(o *store.Order) map[string]interface{} {
+	return map[string]interface{}{
+		"no":       o.No,
+		"query":    url.QueryEscape(o.No),
+		"desc":     o.Desc,
+		"money":    fmt.Sprintf("%.2f", o.Money),
+		"currency": payment.Currency(o.Currency),
+		"status":   string(o.Status),
+	}
+}
+
+//模版中使用的支付方式数据
+func channelData(ch *Channel) map[string]interface{} {
+	return map[string]interface{}{
+		"code":  ch.Code,
+		"query": url.QueryEscape(ch.Code),
+		"name":  ch.Name,
+		"mode":  ch.Mode,
+	}
+}
+
+//根据表单生成扩展信息,字段按扩展字段说明的类型转换
+func formExt(r *http.Request, caps *payment.Capabilities) (string, error) {
+	if caps.ExtFormat == payment.ExtString {
+		if len(caps.Ext) < 1 {
+			return "", nil
+		}
+		return r.FormValue(caps.Ext[0].Name), nil
+	}
+	ext := map[string]interface{}{}
+	for _, f := range caps.Ext {
+		v := strings.TrimSpace(r.FormValue(f.Name))
+		if v == "" {
+			if f.Required {
+				return "", errors.New(f.Desc + "不能为空")
+			}
+			continue
+		}
+		switch f.Type {
+		case "bool":
+			ext[f.Name] = v == "true" || v == "on" || v == "1"
+		case "int":
+			n, err := strconv.ParseInt(v, 10, 64)
+			if err != nil {
+				return "", errors.New(f.Desc + "格式错误")
+			}
+			ext[f.Name] = n
+		case "float":
+			n, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				return "", errors.New(f.Desc + "格式错误")
+			}
+			ext[f.Name] = n
+		default:
+			ext[f.Name] = v
+		}
+	}
+	data, _ := json.Marshal(ext)
+	return string(data), nil
+}
+
+//请求端IP
+func clientIP(r *http.Request) string {
+	if ip := r.Header.Get("X-Real-IP"); ip != "" {
+		return ip
+	} else if ip = r.Header.Get("X-Forwarded-For"); ip != "" {
+		return strings.TrimSpace(strings.Split(ip, ",")[0])
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+func contains(items []string, v string) bool {
+	for _, item := range items {
+		if item == v {
+			return true
+		}
+	}
+	return false
+}
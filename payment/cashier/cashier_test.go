@@ -0,0 +1,227 @@
+package cashier
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/kinwyb/golang/payment"
+	"github.com/kinwyb/golang/payment/qrcode"
+	"github.com/kinwyb/golang/payment/store"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+type cashierTestPay struct {
+	code string
+	caps *payment.Capabilities
+	reqs []*payment.PayRequest
+	ret  string
+}
+
+func (p *cashierTestPay) Pay(req *payment.PayRequest) (string, error) {
+	p.reqs = append(p.reqs, req)
+	return p.ret, nil
+}
+func (p *cashierTestPay) PayConfirm(req *payment.PayConfirmRequest) *payment.PayResult {
+	if req.VerifyCode != "123456" {
+		return &payment.PayResult{ErrMsg: "验证码错误"}
+	}
+	return &payment.PayResult{Succ: true, No: req.No}
+}
+func (p *cashierTestPay) Notify(params map[string]string) *payment.PayResult { return nil }
+func (p *cashierTestPay) NotifyResult(ret *payment.PayResult) string         { return "" }
+func (p *cashierTestPay) Result(params map[string]string) *payment.PayResult { return nil }
+func (p *cashierTestPay) Code() string                                       { return p.code }
+func (p *cashierTestPay) Name() string                                       { return p.code + "支付" }
+func (p *cashierTestPay) Start() bool                                        { return true }
+func (p *cashierTestPay) Capabilities() *payment.Capabilities                { return p.caps }
+
+type cashierTestQRPay struct {
+	cashierTestPay
+}
+
+func (p *cashierTestQRPay) QRCodePay(req *payment.PayRequest, opt *qrcode.Options) (*payment.QRCodeResult, error) {
+	p.reqs = append(p.reqs, req)
+	return &payment.QRCodeResult{Code: "weixin://wxpay/bizpayurl?pr=test", Image: "data:image/png;base64,test"}, nil
+}
+
+type cashierTestOrders struct {
+	orders   map[string]*store.Order
+	attempts []*store.Attempt
+}
+
+func (o *cashierTestOrders) CreateOrder(order *store.Order) error {
+	if o.orders[order.No] != nil {
+		return errors.New("单号已存在")
+	}
+	order.Status = payment.DEALING
+	o.orders[order.No] = order
+	return nil
+}
+func (o *cashierTestOrders) GetOrder(no string) (*store.Order, error) { return o.orders[no], nil }
+func (o *cashierTestOrders) CreateAttempt(a *store.Attempt) error {
+	a.ID = int64(len(o.attempts) + 1)
+	a.Status = payment.DEALING
+	o.attempts = append(o.attempts, a)
+	return nil
+}
+func (o *cashierTestOrders) FailAttempt(id int64, errMsg string) error {
+	o.attempts[id-1].Status = payment.FAIL
+	return nil
+}
+func (o *cashierTestOrders) Attempts(no string) ([]*store.Attempt, error) {
+	var ret []*store.Attempt
+	for _, a := range o.attempts {
+		if a.OrderNo == no {
+			ret = append(ret, a)
+		}
+	}
+	return ret, nil
+}
+
+func cashierGet(c *Cashier, target string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	c.ServeHTTP(w, httptest.NewRequest("GET", target, nil))
+	return w
+}
+
+func cashierPost(c *Cashier, target string, form url.Values) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest("POST", target, strings.NewReader(form.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	c.ServeHTTP(w, r)
+	return w
+}
+
+func Test_Cashier(t *testing.T) {
+	registry := payment.NewRegistry()
+	alipay := &cashierTestPay{code: "alipay", ret: `<form id="alipaysubmit"></form>`, caps: &payment.Capabilities{
+		Operations: []string{payment.OpPay, payment.OpNotify},
+		TradeTypes: []string{payment.TradeWeb, payment.TradeApp},
+		MaxTradeNo: 5,
+	}}
+	wxpay := &cashierTestQRPay{cashierTestPay{code: "wxpay", caps: &payment.Capabilities{
+		Operations: []string{payment.OpPay, payment.OpNotify},
+		TradeTypes: []string{payment.TradeQRCode, payment.TradeApp},
+	}}}
+	quick := &cashierTestPay{code: "quick", ret: "T001", caps: &payment.Capabilities{
+		Operations: []string{payment.OpPay, payment.OpPayConfirm, payment.OpNotify},
+		TradeTypes: []string{payment.TradeQuick},
+		Required:   []string{"No", "Money", "Ext"},
+		ExtFormat:  payment.ExtJSON,
+		Ext: []payment.ExtField{
+			{Name: "BkAcctNo", Type: "string", Required: true, Desc: "银行卡账号"},
+			{Name: "IsCreditCard", Type: "bool", Desc: "是否是信用卡"},
+		},
+	}}
+	app := &cashierTestPay{code: "app", caps: &payment.Capabilities{TradeTypes: []string{payment.TradeApp}}}
+	registry.SetPayment(alipay)
+	registry.SetPayment(wxpay)
+	registry.SetPayment(quick)
+	registry.SetPayment(app)
+	orders := &cashierTestOrders{orders: map[string]*store.Order{}}
+	c := New(&Config{
+		Registry:  registry,
+		Orders:    orders,
+		BasePath:  "/pay/cashier/",
+		ReturnURL: func(o *store.Order) string { return "/order/" + o.No },
+		Authorize: func(r *http.Request) error {
+			if r.Header.Get("Token") != "secret" {
+				return errors.New("鉴权失败")
+			}
+			return nil
+		},
+	})
+	convey.Convey("创建订单", t, func() {
+		u, err := c.CreateOrder(&store.Order{No: "001", Money: 10, Desc: "测试订单"})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(u, convey.ShouldEqual, "/pay/cashier/?no=001")
+		_, err = c.CreateOrder(&store.Order{No: "A&B 1", Money: 1})
+		convey.So(err, convey.ShouldBeNil)
+		_, err = c.CreateOrder(&store.Order{No: "002"})
+		convey.So(err, convey.ShouldNotBeNil)
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("POST", "/pay/cashier/order", strings.NewReader(`{"no":"002","money":5}`))
+		c.ServeHTTP(w, r)
+		convey.So(w.Code, convey.ShouldEqual, http.StatusForbidden)
+		w = httptest.NewRecorder()
+		r = httptest.NewRequest("POST", "/pay/cashier/order", strings.NewReader(`{"no":"002","money":5}`))
+		r.Header.Set("Token", "secret")
+		c.ServeHTTP(w, r)
+		convey.So(w.Code, convey.ShouldEqual, http.StatusOK)
+		convey.So(w.Body.String(), convey.ShouldContainSubstring, `"url":"/pay/cashier/?no=002"`)
+	})
+	convey.Convey("支付方式选择", t, func() {
+		channels := c.Channels(orders.orders["001"])
+		convey.So(len(channels), convey.ShouldEqual, 3)
+		convey.So(channels[0].Mode, convey.ShouldEqual, ModeForm)
+		convey.So(channels[1].Mode, convey.ShouldEqual, ModeConfirm)
+		convey.So(channels[2].Mode, convey.ShouldEqual, ModeQRCode)
+		w := cashierGet(c, "/pay/cashier/?no=001")
+		convey.So(w.Code, convey.ShouldEqual, http.StatusOK)
+		body := w.Body.String()
+		convey.So(body, convey.ShouldContainSubstring, "测试订单")
+		convey.So(body, convey.ShouldContainSubstring, "10.00 CNY")
+		convey.So(body, convey.ShouldContainSubstring, "/pay/cashier/pay?no=001&code=wxpay")
+		convey.So(body, convey.ShouldNotContainSubstring, "code=app")
+		convey.So(cashierGet(c, "/pay/cashier/?no=999").Code, convey.ShouldEqual, http.StatusNotFound)
+		body = cashierGet(c, "/pay/cashier/?no="+url.QueryEscape("A&B 1")).Body.String()
+		convey.So(body, convey.ShouldContainSubstring, "/pay/cashier/pay?no=A%26B+1&code=alipay")
+	})
+	convey.Convey("支付页面", t, func() {
+		w := cashierGet(c, "/pay/cashier/pay?no=001&code=alipay")
+		convey.So(w.Code, convey.ShouldEqual, http.StatusOK)
+		convey.So(w.Body.String(), convey.ShouldContainSubstring, `method="post" action="/pay/cashier/pay?no=001&code=alipay"`)
+		convey.So(len(alipay.reqs), convey.ShouldEqual, 0)
+		convey.So(len(orders.attempts), convey.ShouldEqual, 0)
+		w = cashierPost(c, "/pay/cashier/pay?no=001&code=alipay", nil)
+		convey.So(w.Code, convey.ShouldEqual, http.StatusOK)
+		convey.So(w.Body.String(), convey.ShouldContainSubstring, `<form id="alipaysubmit"></form>`)
+		convey.So(alipay.reqs[0].No, convey.ShouldEqual, "00101")
+		convey.So(orders.attempts[0].TradeNo, convey.ShouldEqual, alipay.reqs[0].No)
+		convey.So(alipay.reqs[0].Money, convey.ShouldEqual, 10)
+
+		w = cashierPost(c, "/pay/cashier/pay?no=001&code=wxpay", nil)
+		convey.So(w.Code, convey.ShouldEqual, http.StatusOK)
+		convey.So(w.Body.String(), convey.ShouldContainSubstring, "data:image/png;base64,test")
+		convey.So(w.Body.String(), convey.ShouldContainSubstring, "/pay/cashier/status?no=")
+
+		w = cashierGet(c, "/pay/cashier/pay?no=001&code=quick")
+		convey.So(w.Body.String(), convey.ShouldContainSubstring, `name="BkAcctNo"`)
+		convey.So(len(quick.reqs), convey.ShouldEqual, 0)
+		w = cashierPost(c, "/pay/cashier/pay?no=001&code=quick", url.Values{"IsCreditCard": {"true"}})
+		convey.So(w.Code, convey.ShouldEqual, http.StatusBadRequest)
+		w = cashierPost(c, "/pay/cashier/pay?no=001&code=quick", url.Values{"BkAcctNo": {"6222"}, "IsCreditCard": {"on"}})
+		convey.So(w.Code, convey.ShouldEqual, http.StatusOK)
+		convey.So(quick.reqs[0].Ext, convey.ShouldEqual, `{"BkAcctNo":"6222","IsCreditCard":true}`)
+		convey.So(w.Body.String(), convey.ShouldContainSubstring, `name="third_no" value="T001"`)
+		convey.So(len(orders.attempts), convey.ShouldEqual, 3)
+		convey.So(orders.attempts[1].TradeNo, convey.ShouldEqual, "00102")
+		convey.So(orders.attempts[2].TradeNo, convey.ShouldEqual, quick.reqs[0].No)
+		convey.So(orders.attempts[2].OrderNo, convey.ShouldEqual, "001")
+
+		w = cashierPost(c, "/pay/cashier/confirm", url.Values{"no": {"001"}, "code": {"quick"}, "third_no": {"T001"}, "verify_code": {"000000"}})
+		convey.So(w.Body.String(), convey.ShouldContainSubstring, "验证码错误")
+		w = cashierPost(c, "/pay/cashier/confirm", url.Values{"no": {"001"}, "code": {"quick"}, "third_no": {"T001"}, "verify_code": {"123456"}})
+		convey.So(w.Body.String(), convey.ShouldContainSubstring, "等待支付结果")
+		convey.So(cashierGet(c, "/pay/cashier/pay?no=001&code=app").Code, convey.ShouldEqual, http.StatusBadRequest)
+		w = cashierPost(c, "/pay/cashier/pay?no="+url.QueryEscape("A&B 1")+"&code=alipay", nil)
+		convey.So(w.Code, convey.ShouldEqual, http.StatusBadRequest)
+		convey.So(w.Body.String(), convey.ShouldContainSubstring, "单号长度限制5位")
+		convey.So(len(alipay.reqs), convey.ShouldEqual, 1)
+	})
+	convey.Convey("订单状态", t, func() {
+		w := cashierGet(c, "/pay/cashier/status?no=001")
+		convey.So(w.Body.String(), convey.ShouldContainSubstring, `"status":"DEALING"`)
+		convey.So(w.Body.String(), convey.ShouldNotContainSubstring, "returnURL")
+		orders.orders["001"].Status = payment.SUCCESS
+		orders.orders["001"].PayCode = "wxpay"
+		w = cashierGet(c, "/pay/cashier/status?no=001")
+		convey.So(w.Body.String(), convey.ShouldContainSubstring, `"returnURL":"/order/001"`)
+		convey.So(cashierGet(c, "/pay/cashier/pay?no=001&code=alipay").Code, convey.ShouldEqual, http.StatusBadRequest)
+		convey.So(cashierGet(c, "/pay/cashier/status?no=999").Code, convey.ShouldEqual, http.StatusNotFound)
+	})
+}
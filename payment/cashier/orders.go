@@ -0,0 +1,30 @@
+package cashier
+
+import (
+	"github.com/kinwyb/golang/payment"
+	"github.com/kinwyb/golang/payment/store"
+)
+
+//Orders 收银台使用的订单存储,使用支付数据存储时为store.NewOrderAdapter
+type Orders interface {
+	//CreateOrder 创建订单,状态为DEALING
+	CreateOrder(o *store.Order) error
+	//GetOrder 查询订单,不存在返回nil
+	GetOrder(no string) (*store.Order, error)
+	//CreateAttempt 记录支付请求
+	CreateAttempt(a *store.Attempt) error
+	//FailAttempt 支付请求发起失败
+	FailAttempt(id int64, errMsg string) error
+	//Attempts 订单的所有支付请求,用于生成支付请求序号
+	Attempts(no string) ([]*store.Attempt, error)
+}
+
+//StoreOrders 使用支付数据存储作为收银台订单存储
+func StoreOrders(s *store.Store) Orders {
+	return store.NewOrderAdapter(s)
+}
+
+//订单是否可以支付
+func payable(o *store.Order) bool {
+	return o != nil && o.Status == payment.DEALING
+}
@@ -0,0 +1,108 @@
+package cashier
+
+//收银台默认页面模版(artTemplate语法),可通过Config.Templates按页面名称替换
+//	所有页面都可以使用 base:收银台路径 title:页面标题
+//	order.query、channel.query为URL编码后的订单号和支付方式编码,拼接链接地址时使用
+//	index   支付方式选择 order:订单 channels:支付方式列表[code,query,name,mode]
+//	form    跳转支付 order:订单 form:支付表单(自动提交),支付方式返回跳转地址时直接重定向
+//	qrcode  扫码支付 order:订单 channel:支付方式 image:二维码图片 code:二维码内容
+//	ext     支付确认 order:订单 channel:支付方式 fields:需要填写的扩展字段[name,type,required,desc],POST提交后发起支付
+//	confirm 短信验证码确认 order:订单 channel:支付方式 thirdNo:第三方交易号 msg:确认结果
+//	error   错误提示 msg:错误消息
+//	status为轮询订单状态的公共脚本,订单支付成功后跳转到returnURL
+
+//页面名称
+const (
+	PageIndex   = "index"
+	PageForm    = "form"
+	PageQRCode  = "qrcode"
+	PageExt     = "ext"
+	PageConfirm = "confirm"
+	PageError   = "error"
+)
+
+const header = `<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width,initial-scale=1">
+<title>{{title}}</title>
+<style>
+body{font-family:sans-serif;max-width:480px;margin:20px auto;padding:0 12px;color:#333}
+.order{border-bottom:1px solid #eee;padding-bottom:12px}
+.money{font-size:24px;color:#e4393c}
+.channel{display:block;border:1px solid #ddd;padding:12px;margin:8px 0;color:#333;text-decoration:none}
+.field{margin:8px 0}.field input{width:100%;padding:6px;box-sizing:border-box}
+.msg{color:#e4393c}
+</style>
+</head>
+<body>
+`
+
+const orderInfo = `<div class="order">
+<div>订单号:{{order.no}}</div>
+<div>{{order.desc}}</div>
+<div class="money">{{order.money}} {{order.currency}}</div>
+</div>
+`
+
+const footer = `</body>
+</html>`
+
+const statusScript = `<script>
+(function(){
+  var timer = setInterval(function(){
+    var xhr = new XMLHttpRequest();
+    xhr.open("GET", "{{base}}/status?no={{order.query}}");
+    xhr.onload = function(){
+      var ret = JSON.parse(xhr.responseText);
+      if (ret.status === "SUCCESS" || ret.status === "FAIL") {
+        clearInterval(timer);
+        if (ret.returnURL) {
+          location.href = ret.returnURL;
+        } else {
+          document.getElementById("status").innerText = ret.status === "SUCCESS" ? "支付成功" : "订单已关闭";
+        }
+      }
+    };
+    xhr.send();
+  }, 2000);
+})();
+</script>
+`
+
+var defaultTemplates = map[string]string{
+	PageIndex: header + orderInfo + `{{each channels as c}}
+<a class="channel" href="{{base}}/pay?no={{order.query}}&code={{c.query}}">{{c.name}}</a>
+{{/each}}
+{{if channels.length == 0}}<div class="msg">没有可用的支付方式</div>{{/if}}
+` + footer,
+	PageForm: header + orderInfo + `<div>正在跳转到支付页面...</div>
+{{#form}}
+` + footer,
+	PageQRCode: header + orderInfo + `<div>请使用{{channel.name}}扫码支付</div>
+<img src="{{image}}" alt="{{code}}">
+<div id="status"></div>
+` + statusScript + footer,
+	PageExt: header + orderInfo + `<form method="post" action="{{base}}/pay?no={{order.query}}&code={{channel.query}}">
+{{each fields as f}}
+<div class="field"><label>{{f.desc}}{{if f.required}}*{{/if}}</label>
+{{if f.type == "bool"}}<input type="checkbox" name="{{f.name}}" value="true">{{else}}<input type="text" name="{{f.name}}">{{/if}}
+</div>
+{{/each}}
+<button type="submit">{{channel.name}}支付</button>
+</form>
+` + footer,
+	PageConfirm: header + orderInfo + `<form method="post" action="{{base}}/confirm">
+<input type="hidden" name="no" value="{{order.no}}">
+<input type="hidden" name="code" value="{{channel.code}}">
+<input type="hidden" name="third_no" value="{{thirdNo}}">
+<div class="field"><label>短信验证码</label><input type="text" name="verify_code"></div>
+<button type="submit">确认支付</button>
+</form>
+{{if msg}}<div class="msg">{{msg}}</div>{{/if}}
+<div id="status"></div>
+` + statusScript + footer,
+	PageError: header + `<div class="msg">{{msg}}</div>
+` + footer,
+}
@@ -0,0 +1,244 @@
+package chanpay
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/kinwyb/golang/payment"
+)
+
+//协议支付(鉴权绑卡后支付)
+//	1. BindCard 提交银行卡信息发起绑卡,返回绑卡请求单号和签约协议号
+//	2. PayConfirm 使用绑卡请求单号和短信验证码确认绑卡,确认成功后签约协议号生效
+//	3. Pay 支付请求Ext中只填写AgreementNo,支付短信验证码同样通过PayConfirm确认
+//	4. Unbind 解除绑定, ResendSMS 重发绑卡/支付短信验证码
+
+//绑卡请求单号前缀,用于PayConfirm区分绑卡确认和支付确认
+//	支付请求单号是小写base32编码或以数字开头的订单号,不会以大写字母加下划线开头
+const bindPrefix = "B_"
+
+//签约协议号中的卡类型
+const (
+	agreementDebit  = "D"
+	agreementCredit = "C"
+)
+
+//ErrAgreementNo 签约协议号格式错误
+var ErrAgreementNo = errors.New("签约协议号格式错误")
+
+//QuickAgreement 畅捷协议支付操作,快捷支付对象实现该接口
+//	使用方式: p.(chanpay.QuickAgreement).BindCard(req)
+type QuickAgreement interface {
+	BindCard(req *BindCardRequest) (*BindCardResult, error) //绑卡请求,发送短信验证码
+	Unbind(memberID, agreementNo string) error              //解除绑定
+	ResendSMS(no string) error                              //重发绑卡或支付短信验证码,no为绑卡请求单号或支付返回的交易单号
+}
+
+//BindCardRequest 绑卡请求
+type BindCardRequest struct {
+	QuickPayRequestExt        //银行卡信息,AgreementNo不需要填写
+	No                 string //绑卡请求单号
+	MemberID           string //用户唯一标识
+}
+
+//BindCardResult 绑卡请求结果
+type BindCardResult struct {
+	No          string            //绑卡请求单号,确认绑卡和重发短信时使用
+	AgreementNo string            //签约协议号,确认绑卡成功后生效
+	Navite      map[string]string //原始数据
+}
+
+//Agreement 签约协议信息,畅捷使用用户标识和卡号前6位、后4位识别已绑定的银行卡
+type Agreement struct {
+	CardBegin    string //卡号前6位
+	CardEnd      string //卡号后4位
+	IsCreditCard bool   //是否是信用卡
+}
+
+//AgreementNo 签约协议号
+func (a *Agreement) AgreementNo() string {
+	tp := agreementDebit
+	if a.IsCreditCard {
+		tp = agreementCredit
+	}
+	return a.CardBegin + "-" + a.CardEnd + "-" + tp
+}
+
+//NewAgreement 根据银行卡号生成签约协议信息
+func NewAgreement(cardNo string, isCreditCard bool) (*Agreement, error) {
+	if len(cardNo) < 10 {
+		return nil, errors.New("银行卡号错误")
+	}
+	return &Agreement{
+		CardBegin:    cardNo[:6],
+		CardEnd:      cardNo[len(cardNo)-4:],
+		IsCreditCard: isCreditCard,
+	}, nil
+}
+
+//ParseAgreementNo 解析签约协议号
+func ParseAgreementNo(agreementNo string) (*Agreement, error) {
+	items := strings.Split(agreementNo, "-")
+	if len(items) != 3 || len(items[0]) != 6 || len(items[1]) != 4 ||
+		(items[2] != agreementDebit && items[2] != agreementCredit) {
+		return nil, ErrAgreementNo
+	}
+	return &Agreement{
+		CardBegin:    items[0],
+		CardEnd:      items[1],
+		IsCreditCard: items[2] == agreementCredit,
+	}, nil
+}
+
+//是否是绑卡请求单号
+func isBindNo(no string) bool {
+	return len(no) > len(bindPrefix) && strings.HasPrefix(no, bindPrefix)
+}
+
+//生成请求流水号
+func newTrxID(p *payment.PayInfo) string {
+	return encodeNo(p, strconv.FormatInt(p.Now().UnixNano(), 10))
+}
+
+//公共请求参数
+func (q *quickPay) params(service string) map[string]string {
+	t := q.Now()
+	return map[string]string{
+		"Service":      service,
+		"Version":      "1.0",
+		"PartnerId":    q.config.PartnerID,
+		"InputCharset": "utf-8",
+		"TradeDate":    t.Format("20060102"),
+		"TradeTime":    t.Format("150405"),
+	}
+}
+
+//BindCard 鉴权绑卡请求,畅捷发送短信验证码到预留手机号
+func (q *quickPay) BindCard(req *BindCardRequest) (*BindCardResult, error) {
+	if req.MemberID == "" {
+		return nil, errors.New("用户唯一标识[MemberID]不能为空")
+	} else if req.No == "" {
+		return nil, errors.New("绑卡请求单号[No]不能为空")
+	} else if err := req.validate(); err != nil {
+		return nil, err
+	}
+	agreement, err := NewAgreement(req.BkAcctNo, req.IsCreditCard)
+	if err != nil {
+		return nil, err
+	}
+	params := q.params("nmg_biz_api_auth_req")
+	params["TrxId"] = bindPrefix + encodeNo(&q.PayInfo, req.No)
+	params["ExpiredTime"] = q.config.ExpiredTime
+	params["MerUserId"] = req.MemberID
+	params["BkAcctTp"] = "01"
+	params["IDTp"] = "01"
+	if req.IsCreditCard {
+		if req.CardCvn2 == "" {
+			return nil, errors.New("信用卡[CardCvn2]不能为空")
+		} else if req.CardExprDt == "" {
+			return nil, errors.New("信用卡有效期[CardExprDt]不能为空")
+		}
+		params["BkAcctTp"] = "00"
+		params["CardCvn2"] = encrypt(q.config.PublicKey, req.CardCvn2)
+		params["CardExprDt"] = encrypt(q.config.PublicKey, req.CardExprDt)
+	}
+	params["BkAcctNo"] = encrypt(q.config.PublicKey, req.BkAcctNo)
+	params["IDNo"] = encrypt(q.config.PublicKey, req.IDNo)
+	params["CstmrNm"] = encrypt(q.config.PublicKey, req.CstmrNm)
+	params["MobNo"] = encrypt(q.config.PublicKey, req.MobNo)
+	result, err := request(&q.PayInfo, q.apiURL, params, q.config.PrivateKey, q.config.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	return &BindCardResult{
+		No:          params["TrxId"],
+		AgreementNo: agreement.AgreementNo(),
+		Navite:      result,
+	}, nil
+}
+
+//确认绑卡
+func (q *quickPay) bindConfirm(req *payment.PayConfirmRequest) *payment.PayResult {
+	params := q.params("nmg_api_auth_sms")
+	params["TrxId"] = newTrxID(&q.PayInfo)
+	params["OriAuthTrxId"] = req.No
+	params["SmsCode"] = req.VerifyCode
+	result, err := request(&q.PayInfo, q.apiURL, params, q.config.PrivateKey, q.config.PublicKey)
+	if err != nil {
+		return &payment.PayResult{
+			Succ:    false,
+			No:      req.No,
+			PayCode: q.Code(),
+			ErrMsg:  err.Error(),
+			Navite:  result,
+		}
+	}
+	return &payment.PayResult{
+		Succ:         true,
+		No:           req.No,
+		TradeNo:      req.No,
+		PayCode:      q.Code(),
+		ThirdTradeNo: result["OrderTrxId"],
+		Navite:       result,
+	}
+}
+
+//协议支付
+func (q *quickPay) agreementPay(req *payment.PayRequest, agreementNo string) (string, error) {
+	agreement, err := ParseAgreementNo(agreementNo)
+	if err != nil {
+		return "", err
+	}
+	params := q.params("nmg_biz_api_quick_payment")
+	params["TrxId"] = encodeCardNo(&q.PayInfo, req.No, agreement.IsCreditCard)
+	params["OrdrName"] = req.Desc
+	params["MerUserId"] = req.MemberID
+	params["SellerId"] = q.config.MchID
+	params["ExpiredTime"] = q.config.ExpiredTime
+	params["CardBegin"] = agreement.CardBegin
+	params["CardEnd"] = agreement.CardEnd
+	params["TrxAmt"] = strconv.FormatFloat(req.Money, 'f', -1, 64)
+	params["TradeType"] = "11"
+	params["SmsFlag"] = "1"
+	params["NotifyUrl"] = q.config.NotifyURL
+	result, err := request(&q.PayInfo, q.apiURL, params, q.config.PrivateKey, q.config.PublicKey)
+	if err != nil {
+		return "", err
+	}
+	return result["TrxId"], nil
+}
+
+//Unbind 解除绑定
+func (q *quickPay) Unbind(memberID, agreementNo string) error {
+	agreement, err := ParseAgreementNo(agreementNo)
+	if err != nil {
+		return err
+	} else if memberID == "" {
+		return errors.New("用户唯一标识[MemberID]不能为空")
+	}
+	params := q.params("nmg_api_auth_unbind")
+	params["TrxId"] = newTrxID(&q.PayInfo)
+	params["MerUserId"] = memberID
+	params["UnbindType"] = "1" //物理解绑
+	params["CardBegin"] = agreement.CardBegin
+	params["CardEnd"] = agreement.CardEnd
+	_, err = request(&q.PayInfo, q.apiURL, params, q.config.PrivateKey, q.config.PublicKey)
+	return err
+}
+
+//ResendSMS 重发短信验证码,no为绑卡请求单号或支付返回的交易单号
+func (q *quickPay) ResendSMS(no string) error {
+	if no == "" {
+		return errors.New("原交易单号不能为空")
+	}
+	params := q.params("nmg_api_quick_payment_resend")
+	params["TrxId"] = newTrxID(&q.PayInfo)
+	params["OriTrxId"] = no
+	params["TradeType"] = "pay_order"
+	if isBindNo(no) {
+		params["TradeType"] = "auth_order"
+	}
+	_, err := request(&q.PayInfo, q.apiURL, params, q.config.PrivateKey, q.config.PublicKey)
+	return err
+}
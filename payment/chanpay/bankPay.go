@@ -2,11 +2,9 @@ package chanpay
 
 import (
 	"bytes"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"strconv"
-	"time"
 
 	"github.com/kinwyb/golang/payment"
 	"github.com/kinwyb/golang/utils"
@@ -22,9 +20,8 @@ type bankPay struct {
 
 //支付,返回支付代码
 func (b *bankPay) Pay(req *payment.PayRequest) (string, error) {
-	req.No = encodeNo(req.No)
-	if req.Ext == "" {
-		req.Ext = "{}"
+	if !payment.IsCNY(req.Currency) {
+		return "", payment.ErrCurrencyNotSupport
 	}
 	ext := &BankPayRequestExt{}
 	if req.IsApp {
@@ -32,17 +29,14 @@ func (b *bankPay) Pay(req *payment.PayRequest) (string, error) {
 	} else {
 		ext.ChannelType = "02"
 	}
-	err := json.Unmarshal([]byte(req.Ext), &ext)
+	err := req.DecodeExt(ext)
 	if err != nil {
-		return "", errors.New("支付扩展信息[Ext]解析错误:" + err.Error())
-	} else if ext.BankCode == "" {
-		return "", errors.New("扩展信息银行编码[BankCode]不能为空")
-	} else if ext.BizType == "" {
-		return "", errors.New("扩展信息账户类型[BizType]不能为空")
+		return "", err
 	} else if ext.ChannelType == "" {
 		ext.ChannelType = "02"
 	}
-	t := time.Now()
+	req.No = encodeNo(&b.PayInfo, req.No)
+	t := b.Now()
 	params := map[string]string{
 		"Service":      "nmg_ebank_pay",
 		"Version":      "1.0",
@@ -68,7 +62,7 @@ func (b *bankPay) Pay(req *payment.PayRequest) (string, error) {
 		"UserIp":         req.IP,
 		"NotifyUrl":      b.config.NotifyURL,
 	}
-	err = sign(params, b.config.PrivateKey)
+	err = sign(&b.PayInfo, params, b.config.PrivateKey)
 	if err != nil {
 		return "", errors.New("签名失败")
 	}
@@ -101,10 +95,10 @@ func (b *bankPay) Notify(params map[string]string) *payment.PayResult {
 	result.ThirdTradeNo = params["inner_trade_no"] //畅捷平台订单号
 	result.Money, err = strconv.ParseFloat(params["trade_amount"], 64)
 	if err != nil {
-		log(utils.LogLevelError, err.Error())
+		b.Log(utils.LogLevelError, err.Error())
 		result.Succ = false
 		result.ErrMsg = "畅捷支付回调数据错误"
-	} else if verify(params, b.config.PublicKey) {
+	} else if verify(&b.PayInfo, params, b.config.PublicKey) {
 		status := params["trade_status"]
 		if status == "TRADE_SUCCESS" || status == "TRADE_FINISHED" {
 			result.Succ = true
@@ -115,7 +109,7 @@ func (b *bankPay) Notify(params map[string]string) *payment.PayResult {
 		result.Succ = false
 		result.ErrMsg = "畅捷支付回调数据验证失败"
 	}
-	return result
+	return b.FillPayFee(result)
 }
 
 //异步通知处理结果返回内容
@@ -152,6 +146,8 @@ func (b *bankPay) GetPayment(cfg interface{}) payment.Payment {
 		config: c,
 	}
 	obj.Init(obj.config.Code, obj.config.Name, obj.config.State)
+	obj.SetFee(obj.config.Fee)
+	obj.SetOptions(obj.config.Options, &lg)
 	return obj
 }
 
@@ -159,3 +155,19 @@ func (b *bankPay) GetPayment(cfg interface{}) payment.Payment {
 func (b *bankPay) PayConfirm(req *payment.PayConfirmRequest) *payment.PayResult {
 	return payment.NoPayConfirmResult
 }
+
+//NewExt 支付请求扩展信息
+func (b *bankPay) NewExt() payment.PayExt {
+	return &BankPayRequestExt{}
+}
+
+//Capabilities 支付能力,Ext结构为BankPayRequestExt
+func (b *bankPay) Capabilities() *payment.Capabilities {
+	return &payment.Capabilities{
+		Operations: []string{payment.OpPay, payment.OpNotify},
+		TradeTypes: []string{payment.TradeBank},
+		Required:   []string{"No", "Desc", "Money", "Ext"},
+		ExtFormat:  payment.ExtJSON,
+		Ext:        payment.ExtFields(&BankPayRequestExt{}, "BizType", "BankCode"),
+	}
+}
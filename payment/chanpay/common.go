@@ -7,18 +7,17 @@ import (
 	"errors"
 	"io/ioutil"
 	"math/big"
-	"net/http"
 	"net/url"
 	"sort"
 	"strings"
-	"time"
 
 	"github.com/kinwyb/golang/crypto/rsautil"
+	"github.com/kinwyb/golang/payment"
 	"github.com/kinwyb/golang/utils"
 )
 
 //签名
-func sign(params map[string]string, privateKey []byte) error {
+func sign(p *payment.PayInfo, params map[string]string, privateKey []byte) error {
 	if params != nil && len(params) > 0 {
 		keys := make([]string, 0)
 		for k, v := range params {
@@ -37,21 +36,21 @@ func sign(params map[string]string, privateKey []byte) error {
 			bf.WriteString("&")
 		}
 		bf.Truncate(bf.Len() - 1)
-		log(utils.LogLevelTrace, "畅捷支付待签名字符串:%s", bf.String())
+		p.Log(utils.LogLevelTrace, "畅捷支付待签名字符串:%s", bf.String())
 		sign, err := rsautil.SignSHA1(privateKey, bf.Bytes(), rsautil.PKCS8)
 		if err != nil {
-			log(utils.LogLevelError, "畅捷支付签名失败:%s", err.Error())
+			p.Log(utils.LogLevelError, "畅捷支付签名失败:%s", err.Error())
 			return err
 		}
 		params["Sign"] = base64.StdEncoding.EncodeToString(sign)
-		log(utils.LogLevelTrace, "畅捷支付签名结果:%s", params["Sign"])
+		p.Log(utils.LogLevelTrace, "畅捷支付签名结果:%s", params["Sign"])
 		params["SignType"] = "RSA"
 	}
 	return nil
 }
 
 //验证签名
-func verify(params map[string]string, publicKey []byte) bool {
+func verify(p *payment.PayInfo, params map[string]string, publicKey []byte) bool {
 	var sign []byte
 	if params["Sign"] != "" {
 		sign, _ = base64.StdEncoding.DecodeString(params["Sign"])
@@ -77,7 +76,7 @@ func verify(params map[string]string, publicKey []byte) bool {
 	bf.Truncate(bf.Len() - 1)
 	ret, err := rsautil.VerifySHA1(publicKey, bf.Bytes(), sign)
 	if err != nil {
-		log(utils.LogLevelError, "畅捷支付验签错误:%s", err.Error())
+		p.Log(utils.LogLevelError, "畅捷支付验签错误:%s", err.Error())
 		return ret
 	}
 	return ret
@@ -97,39 +96,48 @@ func buildRequestQueryString(args map[string]string) string {
 }
 
 //请求
-func request(apiURL string, params map[string]string, privateKey []byte, publicKey []byte) (map[string]string, error) {
-	err := sign(params, privateKey)
+func request(p *payment.PayInfo, apiURL string, params map[string]string, privateKey []byte, publicKey []byte) (map[string]string, error) {
+	err := sign(p, params, privateKey)
 	if err != nil {
 		return nil, errors.New("签名失败")
 	}
-	resp, err := http.Post(apiURL, "application/x-www-form-urlencoded", strings.NewReader(buildRequestQueryString(params)))
+	resp, err := p.HTTPClient().Post(apiURL, "application/x-www-form-urlencoded", strings.NewReader(buildRequestQueryString(params)))
 	if err != nil {
-		return nil, errors.New("畅捷接口请求失败:" + err.Error())
+		return nil, payment.WrapRequestError(err, errors.New("畅捷接口请求失败:"+err.Error()))
 	}
 	data, err := ioutil.ReadAll(resp.Body)
 	resp.Body.Close()
 	if err != nil {
-		return nil, errors.New("畅捷接口请求失败:" + err.Error())
+		return nil, &payment.RequestFailed{Err: errors.New("畅捷接口请求失败:" + err.Error())}
 	}
-	log(utils.LogLevelInfo, "畅捷接口请求结果:%s", data)
+	p.Log(utils.LogLevelInfo, "畅捷接口请求结果:%s", data)
 	result := map[string]string{}
 	err = json.Unmarshal(data, &result)
 	if err != nil {
-		log(utils.LogLevelInfo, "畅捷接口结果解析失败:%s", data)
+		p.Log(utils.LogLevelInfo, "畅捷接口结果解析失败:%s", data)
 		return nil, errors.New("畅捷接口结果解析失败")
 	}
-	if !verify(result, publicKey) {
-		log(utils.LogLevelWarn, "畅捷接口返回结果验签失败")
+	if !verify(p, result, publicKey) {
+		p.Log(utils.LogLevelWarn, "畅捷接口返回结果验签失败")
 	} else if result["AcceptStatus"] == "S" && (result["RetCode"] == "SYSTEM_SUCCESS" || result["RetCode"] == "S001" || result["RetCode"] == "P0002") {
 		return result, nil
 	}
-	log(utils.LogLevelError, "畅捷接口请求失败:%s[%s]%s", result["AcceptStatus"], result["RetCode"], result["RetMsg"])
+	p.Log(utils.LogLevelError, "畅捷接口请求失败:%s[%s]%s", result["AcceptStatus"], result["RetCode"], result["RetMsg"])
 	return result, errors.New("畅捷接口请求失败:[" + result["RetCode"] + "]" + result["RetMsg"])
 }
 
 //编码订单号
-func encodeNo(no string) string {
-	no = "1" + no + time.Now().Format("150405.999")
+func encodeNo(p *payment.PayInfo, no string) string {
+	return encodeCardNo(p, no, false)
+}
+
+//编码快捷支付订单号,信用卡支付以2开头,异步通知时据此使用信用卡费率
+func encodeCardNo(p *payment.PayInfo, no string, credit bool) string {
+	prefix := "1"
+	if credit {
+		prefix = "2"
+	}
+	no = prefix + no + p.Now().Format("150405.999")
 	no = strings.Replace(no, ".", "", -1)
 	if bi, ok := new(big.Int).SetString(no, 10); ok {
 		return bi.Text(32)
@@ -149,6 +157,14 @@ func decodeNo(no string) string {
 	return no
 }
 
+//编码后的订单号是否是信用卡支付
+func isCreditNo(no string) bool {
+	if bi, ok := new(big.Int).SetString(no, 32); ok {
+		return strings.HasPrefix(bi.Text(10), "2")
+	}
+	return false
+}
+
 //加密字符串
 func encrypt(publicKey []byte, data string) string {
 	endata, _ := rsautil.Encrypt(publicKey, []byte(data))
@@ -50,7 +50,7 @@ enVP7XCxMuEnnmM1RwIDAQAB
 				"TradeType":      "11",
 				"Version":        "1.0",
 			}
-			sign(params, PrivateKey)
+			sign(nil, params, PrivateKey)
 			convey.So(params["Sign"], convey.ShouldEqual, "IKiWKHjVfDWHygTng1KS+kXXOTY9/adrIMx6gq+atOAyGdp4nyEkLb5SPsOeS4VkGbUJK0hVjycK3u+fZP0YTW4y7P4NLKeH6qQh8feBgkoATNb8V4KfujMW9ud6c825ogk8m68tPmj1AaAPbIQp0ajXqMqlz/3LyOuus4yEA48=")
 		})
 		convey.Convey("验签", func() {
@@ -69,7 +69,7 @@ enVP7XCxMuEnnmM1RwIDAQAB
 				"extension":      "{}",
 				"Sign":           "uERyn9W/b8I88bAVyaXUXXpyw0Ir5D3da6WiO5qrpDrvpgBmDzrYWt2wjZsu6CZdgxZ3+VSdRszrCKJM0UxUGqqKkf0gg90DFlGPMqloZHBzemXSoU2Zz/XYc7/CXWoi3+ZYk43dMhbh/S++RQFBOq+abkiGeD6QNlm4TUiJ7os=",
 			}
-			ret := verify(params, PublicKey)
+			ret := verify(nil, params, PublicKey)
 			convey.So(ret, convey.ShouldBeTrue)
 		})
 	})
@@ -79,9 +79,27 @@ enVP7XCxMuEnnmM1RwIDAQAB
 func Test_codeNo(t *testing.T) {
 	convey.Convey("订单号编码", t, func() {
 		no := "00011499222373805011324582413623123578"
-		str := encodeNo(no)
+		str := encodeNo(nil, no)
 		convey.Printf("编码前订单号:%s\n长度:%d\n后的订单号:%s\n长度:%d", no, len(no), str, len(str))
 		dno := decodeNo(str)
 		convey.So(dno, convey.ShouldEqual, no)
+		convey.So(isCreditNo(str), convey.ShouldBeFalse)
+		str = encodeCardNo(nil, no, true)
+		convey.So(decodeNo(str), convey.ShouldEqual, no)
+		convey.So(isCreditNo(str), convey.ShouldBeTrue)
+	})
+}
+
+//绑卡请求单号
+func Test_isBindNo(t *testing.T) {
+	convey.Convey("绑卡请求单号", t, func() {
+		for _, no := range []string{"00011499222373805011324582413623123578", "1503563106960768911", "9", "B001"} {
+			convey.So(isBindNo(encodeNo(nil, no)), convey.ShouldBeFalse)
+			convey.So(isBindNo(encodeCardNo(nil, no, true)), convey.ShouldBeFalse)
+			convey.So(isBindNo(bindPrefix+encodeNo(nil, no)), convey.ShouldBeTrue)
+		}
+		convey.So(isBindNo("B1q2w3e4r"), convey.ShouldBeFalse)
+		convey.So(isBindNo("b_1q2w3e4r"), convey.ShouldBeFalse)
+		convey.So(isBindNo(bindPrefix), convey.ShouldBeFalse)
 	})
 }
@@ -1,10 +1,16 @@
 package chanpay
 
-import "github.com/kinwyb/golang/payment"
+import (
+	"errors"
+
+	"github.com/kinwyb/golang/payment"
+	"github.com/kinwyb/golang/payment/validate"
+)
 
 //QRPayConfig 畅捷二维码扫码支付配置
 //	支付请求参数PayRequest中： Ext  可空  用作表示支付方式=[WXPAY:微信渠道,ALIPAY:支付宝渠道,UNIONPAY:银联渠道]
 //									   默认：ALIPAY
+//	也可以使用req.SetExt(chanpay.QRPayWXPAY)设置
 type QRPayConfig struct {
 	payment.Config
 	PartnerID  string //签约合作方的唯一用户号
@@ -17,6 +23,7 @@ type QRPayConfig struct {
 //QuickPayConfig 畅捷快捷支付配置
 //	支付请求PayRequest中：MemberID 必填
 //    					Ext      必填 结构为QuickPayRequestExt
+//	协议支付先调用BindCard绑卡并通过PayConfirm确认短信验证码,之后支付时Ext中只需要填写AgreementNo
 type QuickPayConfig struct {
 	payment.Config
 	PartnerID   string //签约合作方的唯一用户号
@@ -29,13 +36,50 @@ type QuickPayConfig struct {
 
 //QuickPayRequestExt 支付请求扩张信息
 type QuickPayRequestExt struct {
-	BkAcctNo     string //银行卡账号
-	IDNo         string //身份证号
-	CstmrNm      string //持卡人姓名
-	MobNo        string //持卡人预留手机号
-	IsCreditCard bool   //是否是信用卡
-	CardExprDt   string //有效期[当是信用卡时必填]
-	CardCvn2     string //cvv2码[信用卡时必填]
+	AgreementNo  string `description:"签约协议号[协议支付时填写,填写后其他字段可空]"`
+	BkAcctNo     string `description:"银行卡账号"`
+	IDNo         string `description:"身份证号"`
+	CstmrNm      string `description:"持卡人姓名"`
+	MobNo        string `description:"持卡人预留手机号"`
+	IsCreditCard bool   `description:"是否是信用卡"`
+	CardExprDt   string `description:"有效期[当是信用卡时必填]"`
+	CardCvn2     string `description:"cvv2码[信用卡时必填]"`
+}
+
+//Validate 校验扩展信息,填写签约协议号时只校验协议号格式
+func (q *QuickPayRequestExt) Validate() error {
+	if q.AgreementNo != "" {
+		_, err := ParseAgreementNo(q.AgreementNo)
+		return err
+	} else if q.BkAcctNo == "" {
+		return errors.New("扩展信息银行卡号[BkAcctNo]不能为空")
+	} else if q.IDNo == "" {
+		return errors.New("扩展信息身份证号[IDNo]不能为空")
+	} else if q.CstmrNm == "" {
+		return errors.New("扩展信息持卡人姓名[CstmrNm]不能为空")
+	} else if q.MobNo == "" {
+		return errors.New("扩展信息持卡人预留手机号[MobNo]不能为空")
+	} else if q.IsCreditCard && q.CardCvn2 == "" {
+		return errors.New("支付扩张信息信用卡[CardCvn2]不能为空")
+	} else if q.IsCreditCard && q.CardExprDt == "" {
+		return errors.New("支付扩张信息信用卡有效期[CardExprDt]不能为空")
+	}
+	return q.validate()
+}
+
+//校验银行卡号、身份证号和预留手机号
+func (q *QuickPayRequestExt) validate() error {
+	var errs validate.Errors
+	cardType := validate.DebitCard
+	if q.IsCreditCard {
+		cardType = validate.CreditCard
+	}
+	_, err := validate.BankCardType(q.BkAcctNo, cardType)
+	errs.Add("BkAcctNo", err)
+	_, err = validate.CertID(q.IDNo)
+	errs.Add("IDNo", err)
+	errs.Add("MobNo", validate.Mobile(q.MobNo))
+	return errs.Err()
 }
 
 type BankPayConfig struct {
@@ -55,6 +99,50 @@ type BankPayRequestExt struct {
 	BankCode    string `description:"银行编码"`
 }
 
+//Validate 校验扩展信息
+func (b *BankPayRequestExt) Validate() error {
+	if b.BankCode == "" {
+		return errors.New("扩展信息银行编码[BankCode]不能为空")
+	} else if b.BizType == "" {
+		return errors.New("扩展信息账户类型[BizType]不能为空")
+	} else if b.BizType != "01" && b.BizType != "02" {
+		return errors.New("扩展信息账户类型[BizType]错误")
+	} else if b.ChannelType != "" && b.ChannelType != "01" && b.ChannelType != "02" {
+		return errors.New("扩展信息请求渠道[ChannelType]错误")
+	}
+	return nil
+}
+
+//QRPayExt 扫码支付扩展信息,表示支付渠道,Ext中保存为文本
+type QRPayExt string
+
+//扫码支付渠道
+const (
+	QRPayWXPAY    QRPayExt = "WXPAY"    //微信渠道
+	QRPayALIPAY   QRPayExt = "ALIPAY"   //支付宝渠道
+	QRPayUNIONPAY QRPayExt = "UNIONPAY" //银联渠道
+)
+
+//Validate 校验支付渠道,为空时使用默认渠道
+func (q QRPayExt) Validate() error {
+	switch q {
+	case "", QRPayWXPAY, QRPayALIPAY, QRPayUNIONPAY:
+		return nil
+	}
+	return errors.New("扩展信息支付渠道[" + string(q) + "]错误")
+}
+
+//MarshalText 序列化为渠道编码
+func (q QRPayExt) MarshalText() ([]byte, error) {
+	return []byte(q), nil
+}
+
+//UnmarshalText 解析渠道编码
+func (q *QRPayExt) UnmarshalText(data []byte) error {
+	*q = QRPayExt(data)
+	return nil
+}
+
 //提现配置信息
 type WithdrawConfig struct {
 	payment.Config
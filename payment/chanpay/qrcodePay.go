@@ -3,12 +3,11 @@ package chanpay
 import (
 	"errors"
 	"strconv"
-	"time"
 
 	"fmt"
 
 	"github.com/kinwyb/golang/payment"
-	"github.com/kinwyb/golang/payment/wxpay"
+	"github.com/kinwyb/golang/payment/qrcode"
 	"github.com/kinwyb/golang/utils"
 )
 
@@ -20,13 +19,31 @@ type qrcodePay struct {
 	apiURL string
 }
 
-//支付,返回支付代码
+//支付,返回二维码图片(data-URI)
 func (q *qrcodePay) Pay(req *payment.PayRequest) (string, error) {
-	if req.Ext == "" {
-		req.Ext = "ALIPAY"
+	ret, err := q.QRCodePay(req, &qrcode.Options{Size: 150})
+	if err != nil {
+		if ret != nil {
+			return ret.Code, err
+		}
+		return "", err
+	}
+	return ret.Image, nil
+}
+
+//QRCodePay 扫码支付,返回二维码内容,opt不为空时同时返回生成的二维码图片
+func (q *qrcodePay) QRCodePay(req *payment.PayRequest, opt *qrcode.Options) (*payment.QRCodeResult, error) {
+	if !payment.IsCNY(req.Currency) {
+		return nil, payment.ErrCurrencyNotSupport
 	}
-	req.No = encodeNo(req.No)
-	t := time.Now()
+	var ext QRPayExt
+	if err := req.DecodeExt(&ext); err != nil {
+		return nil, err
+	} else if ext == "" {
+		ext = QRPayALIPAY
+	}
+	req.No = encodeNo(&q.PayInfo, req.No)
+	t := q.Now()
 	params := map[string]string{
 		"Service":        "mag_init_code_pay",
 		"Version":        "1.0",
@@ -37,7 +54,7 @@ func (q *qrcodePay) Pay(req *payment.PayRequest) (string, error) {
 		"OutTradeNo":     req.No,
 		"MchId":          q.config.MchID,
 		"TradeType":      "11",
-		"BankCode":       req.Ext,
+		"BankCode":       string(ext),
 		"TradeAmount":    fmt.Sprintf("%.2f", req.Money),
 		"GoodsName":      req.Desc,
 		"Subject":        req.Desc,
@@ -45,16 +62,19 @@ func (q *qrcodePay) Pay(req *payment.PayRequest) (string, error) {
 		"SpbillCreateIp": req.IP,
 		"NotifyUrl":      q.config.NotifyURL,
 	}
-	result, err := request(q.apiURL, params, q.config.PrivateKey, q.config.PublicKey)
+	result, err := request(&q.PayInfo, q.apiURL, params, q.config.PrivateKey, q.config.PublicKey)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
-	img, err := wxpay.QRCode(result["CodeUrl"], 150)
-	if err != nil {
-		log(utils.LogLevelError, "二维码创建失败:%s", err.Error())
-		return result["CodeUrl"], errors.New("二维码创建失败")
+	ret := &payment.QRCodeResult{Code: result["CodeUrl"]}
+	if opt != nil {
+		ret.Image, err = qrcode.Render(ret.Code, opt)
+		if err != nil {
+			q.Log(utils.LogLevelError, "二维码创建失败:%s", err.Error())
+			return ret, errors.New("二维码创建失败")
+		}
 	}
-	return img, nil
+	return ret, nil
 }
 
 //异步结果通知处理,返回支付结果
@@ -71,10 +91,10 @@ func (q *qrcodePay) Notify(params map[string]string) *payment.PayResult {
 	result.ThirdTradeNo = params["inner_trade_no"] //畅捷平台订单号
 	result.Money, err = strconv.ParseFloat(params["trade_amount"], 64)
 	if err != nil {
-		log(utils.LogLevelError, err.Error())
+		q.Log(utils.LogLevelError, err.Error())
 		result.Succ = false
 		result.ErrMsg = "畅捷支付回调数据错误"
-	} else if verify(params, q.config.PublicKey) {
+	} else if verify(&q.PayInfo, params, q.config.PublicKey) {
 		status := params["trade_status"]
 		if status == "TRADE_SUCCESS" || status == "TRADE_FINISHED" {
 			result.Succ = true
@@ -85,7 +105,7 @@ func (q *qrcodePay) Notify(params map[string]string) *payment.PayResult {
 		result.Succ = false
 		result.ErrMsg = "畅捷支付回调数据验证失败"
 	}
-	return result
+	return q.FillCardPayFee(result, false) //扫码支付无法获知付款卡类型,按借记卡费率计算
 }
 
 //异步通知处理结果返回内容
@@ -122,6 +142,8 @@ func (q *qrcodePay) GetPayment(cfg interface{}) payment.Payment {
 		config: c,
 	}
 	obj.Init(obj.config.Code, obj.config.Name, obj.config.State)
+	obj.SetFee(obj.config.Fee)
+	obj.SetOptions(obj.config.Options, &lg)
 	return obj
 }
 
@@ -129,3 +151,23 @@ func (q *qrcodePay) GetPayment(cfg interface{}) payment.Payment {
 func (q *qrcodePay) PayConfirm(req *payment.PayConfirmRequest) *payment.PayResult {
 	return payment.NoPayConfirmResult
 }
+
+//NewExt 支付请求扩展信息
+func (q *qrcodePay) NewExt() payment.PayExt {
+	return new(QRPayExt)
+}
+
+//Capabilities 支付能力,Ext为支付渠道编码
+func (q *qrcodePay) Capabilities() *payment.Capabilities {
+	return &payment.Capabilities{
+		Operations: []string{payment.OpPay, payment.OpNotify},
+		TradeTypes: []string{payment.TradeQRCode},
+		Required:   []string{"No", "Desc", "Money", "IP"},
+		ExtFormat:  payment.ExtString,
+		Ext: []payment.ExtField{{
+			Name: "BankCode",
+			Type: "string",
+			Desc: "支付渠道 WXPAY:微信渠道,ALIPAY:支付宝渠道,UNIONPAY:银联渠道,默认:ALIPAY",
+		}},
+	}
+}
@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"errors"
 	"strconv"
-	"time"
 
 	"github.com/kinwyb/golang/crypto/rsautil"
 	"github.com/kinwyb/golang/payment"
@@ -21,25 +20,20 @@ type quickPay struct {
 
 //支付,返回支付代码
 func (q *quickPay) Pay(req *payment.PayRequest) (string, error) {
+	if !payment.IsCNY(req.Currency) {
+		return "", payment.ErrCurrencyNotSupport
+	}
 	if req.MemberID == "" {
 		return "", errors.New("用户唯一标识[MemberID]不能为空")
-	} else if req.Ext == "" {
-		req.Ext = "{}"
 	}
 	ext := &QuickPayRequestExt{}
-	err := json.Unmarshal([]byte(req.Ext), &ext)
+	err := req.DecodeExt(ext)
 	if err != nil {
-		return "", errors.New("支付扩展信息[Ext]解析错误:" + err.Error())
-	} else if ext.BkAcctNo == "" {
-		return "", errors.New("扩展信息银行卡号[BkAcctNo]不能为空")
-	} else if ext.IDNo == "" {
-		return "", errors.New("扩展信息身份证号[IDNo]不能为空")
-	} else if ext.CstmrNm == "" {
-		return "", errors.New("扩展信息持卡人姓名[CstmrNm]不能为空")
-	} else if ext.MobNo == "" {
-		return "", errors.New("扩展信息持卡人预留手机号[MobNo]不能为空")
-	}
-	t := time.Now()
+		return "", err
+	} else if ext.AgreementNo != "" {
+		return q.agreementPay(req, ext.AgreementNo)
+	}
+	t := q.Now()
 	params := map[string]string{
 		"Service":      "nmg_zft_api_quick_payment", //直接支付接口
 		"Version":      "1.0",
@@ -47,7 +41,7 @@ func (q *quickPay) Pay(req *payment.PayRequest) (string, error) {
 		"InputCharset": "utf-8",
 		"TradeDate":    t.Format("20060102"),
 		"TradeTime":    t.Format("150405"),
-		"TrxId":        encodeNo(req.No),
+		"TrxId":        encodeCardNo(&q.PayInfo, req.No, ext.IsCreditCard),
 		"MerUserId":    req.MemberID,
 		"SellerId":     q.config.MchID,
 		"ExpiredTime":  q.config.ExpiredTime, //交易有效时间30分钟
@@ -61,11 +55,6 @@ func (q *quickPay) Pay(req *payment.PayRequest) (string, error) {
 	}
 	if ext.IsCreditCard {
 		params["BkAcctTp"] = "00"
-		if ext.CardCvn2 == "" {
-			return "", errors.New("支付扩张信息信用卡[CardCvn2]不能为空")
-		} else if ext.CardExprDt == "" {
-			return "", errors.New("支付扩张信息信用卡有效期[CardExprDt]不能为空")
-		}
 		CardCvn2, _ := rsautil.Encrypt(q.config.PublicKey, []byte(ext.CardCvn2))
 		params["CardCvn2"] = base64.StdEncoding.EncodeToString(CardCvn2)
 		CardExprDt, _ := rsautil.Encrypt(q.config.PublicKey, []byte(ext.CardExprDt))
@@ -75,7 +64,7 @@ func (q *quickPay) Pay(req *payment.PayRequest) (string, error) {
 	params["IDNo"] = encrypt(q.config.PublicKey, ext.IDNo)
 	params["CstmrNm"] = encrypt(q.config.PublicKey, ext.CstmrNm)
 	params["MobNo"] = encrypt(q.config.PublicKey, ext.MobNo)
-	result, err := request(q.apiURL, params, q.config.PrivateKey, q.config.PublicKey)
+	result, err := request(&q.PayInfo, q.apiURL, params, q.config.PrivateKey, q.config.PublicKey)
 	if err != nil {
 		return "", err
 	}
@@ -96,10 +85,10 @@ func (q *quickPay) Notify(params map[string]string) *payment.PayResult {
 	result.ThirdTradeNo = params["inner_trade_no"] //畅捷平台订单号
 	result.Money, err = strconv.ParseFloat(params["trade_amount"], 64)
 	if err != nil {
-		log(utils.LogLevelError, err.Error())
+		q.Log(utils.LogLevelError, err.Error())
 		result.Succ = false
 		result.ErrMsg = "畅捷支付回调数据错误"
-	} else if verify(params, q.config.PublicKey) {
+	} else if verify(&q.PayInfo, params, q.config.PublicKey) {
 		status := params["trade_status"]
 		if status == "TRADE_SUCCESS" || status == "TRADE_FINISHED" {
 			result.Succ = true
@@ -110,7 +99,7 @@ func (q *quickPay) Notify(params map[string]string) *payment.PayResult {
 		result.Succ = false
 		result.ErrMsg = "畅捷支付回调数据验证失败"
 	}
-	return result
+	return q.FillCardPayFee(result, isCreditNo(params["outer_trade_no"]))
 }
 
 //异步通知处理结果返回内容
@@ -147,12 +136,27 @@ func (q *quickPay) GetPayment(cfg interface{}) payment.Payment {
 		config: c,
 	}
 	obj.Init(obj.config.Code, obj.config.Name, obj.config.State)
+	obj.SetFee(obj.config.Fee)
+	obj.SetOptions(obj.config.Options, &lg)
 	return obj
 }
 
-//确认支付
+//PayFee 计算预计手续费,信用卡支付使用信用卡费率
+func (q *quickPay) PayFee(req *payment.PayRequest) float64 {
+	ext := &QuickPayRequestExt{}
+	json.Unmarshal([]byte(req.Ext), ext)
+	if a, err := ParseAgreementNo(ext.AgreementNo); err == nil {
+		return q.config.Fee.Fee(req.Money, a.IsCreditCard)
+	}
+	return q.config.Fee.Fee(req.Money, ext.IsCreditCard)
+}
+
+//确认支付,绑卡请求单号确认绑卡
 func (q *quickPay) PayConfirm(req *payment.PayConfirmRequest) *payment.PayResult {
-	t := time.Now()
+	if isBindNo(req.No) {
+		return q.bindConfirm(req)
+	}
+	t := q.Now()
 	params := map[string]string{
 		"Service":      "nmg_api_quick_payment_smsconfirm", //直接支付接口
 		"Version":      "1.0",
@@ -164,7 +168,7 @@ func (q *quickPay) PayConfirm(req *payment.PayConfirmRequest) *payment.PayResult
 		"OriPayTrxId":  req.No,
 		"SmsCode":      req.VerifyCode,
 	}
-	result, err := request(q.apiURL, params, q.config.PrivateKey, q.config.PublicKey)
+	result, err := request(&q.PayInfo, q.apiURL, params, q.config.PrivateKey, q.config.PublicKey)
 	if err != nil {
 		return &payment.PayResult{
 			Succ:   false,
@@ -180,3 +184,19 @@ func (q *quickPay) PayConfirm(req *payment.PayConfirmRequest) *payment.PayResult
 		Navite:       result,
 	}
 }
+
+//NewExt 支付请求扩展信息
+func (q *quickPay) NewExt() payment.PayExt {
+	return &QuickPayRequestExt{}
+}
+
+//Capabilities 支付能力,支付短信验证码通过PayConfirm确认,Ext结构为QuickPayRequestExt
+func (q *quickPay) Capabilities() *payment.Capabilities {
+	return &payment.Capabilities{
+		Operations: []string{payment.OpPay, payment.OpPayConfirm, payment.OpNotify},
+		TradeTypes: []string{payment.TradeQuick},
+		Required:   []string{"No", "Desc", "Money", "MemberID", "Ext"},
+		ExtFormat:  payment.ExtJSON,
+		Ext:        payment.ExtFields(&QuickPayRequestExt{}),
+	}
+}
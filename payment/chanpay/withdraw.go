@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/kinwyb/golang/payment"
+	"github.com/kinwyb/golang/payment/validate"
 	"github.com/kinwyb/golang/utils"
 )
 
@@ -41,13 +42,25 @@ func (c *chanpayWithdraw) GetWithdraw(cfg interface{}) payment.Withdraw {
 		config: conf,
 	}
 	obj.Init(obj.config.Code, obj.config.Name, obj.config.State)
+	obj.SetFee(obj.config.Fee)
+	obj.SetOptions(obj.config.Options, &lg)
 	return obj
 }
 
 //提现操作,成功返回第三方交易流水,失败返回错误
 func (c *chanpayWithdraw) Withdraw(info *payment.WithdrawInfo) *payment.WithdrawResult {
-	info.TradeNo = encodeNo(info.TradeNo)
-	t := time.Now()
+	return c.FillWithdrawFee(c.withdraw(info), info)
+}
+
+//提现请求
+func (c *chanpayWithdraw) withdraw(info *payment.WithdrawInfo) *payment.WithdrawResult {
+	if !payment.IsCNY(info.Currency) {
+		return payment.WithdrawCurrencyNotSupport
+	} else if err := validate.BankWithdraw(info); err != nil {
+		return payment.WithdrawParamsInvalid(err)
+	}
+	info.TradeNo = encodeNo(&c.PayInfo, info.TradeNo)
+	t := c.Now()
 	params := map[string]string{
 		"Service":        "cjt_dsf", //同步单笔代付
 		"Version":        "1.0",
@@ -63,14 +76,17 @@ func (c *chanpayWithdraw) Withdraw(info *payment.WithdrawInfo) *payment.Withdraw
 		"TradeAmount":    fmt.Sprintf("%.2f", info.Money),            //交易金额
 		"LiceneceType":   "01",                                       //证件类型
 		"LiceneceNo":     encrypt(c.config.PublicKey, info.CertID),   //证件号
+		"CorpPushUrl":    c.config.NotifyURL,                         //异步通知地址,为空不推送
 	}
 	if info.People { //业务类型 0=私人，1=公司
 		params["BusinessType"] = "0"
 	} else {
 		params["BusinessType"] = "1"
 	}
-	result, err := request(c.apiURL, params, c.config.PrivateKey, c.config.PublicKey)
-	if err != nil {
+	result, err := request(&c.PayInfo, c.apiURL, params, c.config.PrivateKey, c.config.PublicKey)
+	if payment.IsRequestNotSent(err) {
+		return payment.WithdrawRequestNotSent
+	} else if err != nil {
 		return payment.WithdrawResponseReadFail
 	}
 	PlatformRetCode := result["PlatformRetCode"] //平台受理码
@@ -80,12 +96,12 @@ func (c *chanpayWithdraw) Withdraw(info *payment.WithdrawInfo) *payment.Withdraw
 	case "1000", "2004", "2009":
 		//交易失败
 		return &payment.WithdrawResult{
-			TradeNo:      info.TradeNo,                  //交易流水号
-			ThridFlowNo:  result["FlowNo"],              //第三方交易流水号
-			CardNo:       info.CardNo,                   //收款账户
-			CertID:       info.CertID,                   //收款人身份证号
-			Money:        info.Money,                    //提现金额
-			PayTime:      time.Now().Format(timeFormat), //完成时间
+			TradeNo:      info.TradeNo,               //交易流水号
+			ThridFlowNo:  result["FlowNo"],           //第三方交易流水号
+			CardNo:       info.CardNo,                //收款账户
+			CertID:       info.CertID,                //收款人身份证号
+			Money:        info.Money,                 //提现金额
+			PayTime:      c.Now().Format(timeFormat), //完成时间
 			UserName:     info.UserName,
 			WithdrawCode: c.Code(),
 			WithdrawName: c.Name(),
@@ -97,12 +113,12 @@ func (c *chanpayWithdraw) Withdraw(info *payment.WithdrawInfo) *payment.Withdraw
 	if OriginalRetCode == "111111" {
 		//交易失败
 		return &payment.WithdrawResult{
-			TradeNo:      info.TradeNo,                  //交易流水号
-			ThridFlowNo:  result["FlowNo"],              //第三方交易流水号
-			CardNo:       info.CardNo,                   //收款账户
-			CertID:       info.CertID,                   //收款人身份证号
-			Money:        info.Money,                    //提现金额
-			PayTime:      time.Now().Format(timeFormat), //完成时间
+			TradeNo:      info.TradeNo,               //交易流水号
+			ThridFlowNo:  result["FlowNo"],           //第三方交易流水号
+			CardNo:       info.CardNo,                //收款账户
+			CertID:       info.CertID,                //收款人身份证号
+			Money:        info.Money,                 //提现金额
+			PayTime:      c.Now().Format(timeFormat), //完成时间
 			UserName:     info.UserName,
 			WithdrawCode: c.Code(),
 			WithdrawName: c.Name(),
@@ -113,12 +129,12 @@ func (c *chanpayWithdraw) Withdraw(info *payment.WithdrawInfo) *payment.Withdraw
 	}
 	if AppRetcode == "000000" || AppRetcode == "00019999" {
 		return &payment.WithdrawResult{
-			TradeNo:      info.TradeNo,                  //交易流水号
-			ThridFlowNo:  result["FlowNo"],              //第三方交易流水号
-			CardNo:       info.CardNo,                   //收款账户
-			CertID:       info.CertID,                   //收款人身份证号
-			Money:        info.Money,                    //提现金额
-			PayTime:      time.Now().Format(timeFormat), //完成时间
+			TradeNo:      info.TradeNo,               //交易流水号
+			ThridFlowNo:  result["FlowNo"],           //第三方交易流水号
+			CardNo:       info.CardNo,                //收款账户
+			CertID:       info.CertID,                //收款人身份证号
+			Money:        info.Money,                 //提现金额
+			PayTime:      c.Now().Format(timeFormat), //完成时间
 			UserName:     info.UserName,
 			WithdrawCode: c.Code(),
 			WithdrawName: c.Name(),
@@ -126,12 +142,12 @@ func (c *chanpayWithdraw) Withdraw(info *payment.WithdrawInfo) *payment.Withdraw
 		}
 	} else if AppRetcode == "111111" || AppRetcode == "11019999" {
 		return &payment.WithdrawResult{
-			TradeNo:      info.TradeNo,                  //交易流水号
-			ThridFlowNo:  result["FlowNo"],              //第三方交易流水号
-			CardNo:       info.CardNo,                   //收款账户
-			CertID:       info.CertID,                   //收款人身份证号
-			Money:        info.Money,                    //提现金额
-			PayTime:      time.Now().Format(timeFormat), //完成时间
+			TradeNo:      info.TradeNo,               //交易流水号
+			ThridFlowNo:  result["FlowNo"],           //第三方交易流水号
+			CardNo:       info.CardNo,                //收款账户
+			CertID:       info.CertID,                //收款人身份证号
+			Money:        info.Money,                 //提现金额
+			PayTime:      c.Now().Format(timeFormat), //完成时间
 			UserName:     info.UserName,
 			WithdrawCode: c.Code(),
 			WithdrawName: c.Name(),
@@ -142,12 +158,12 @@ func (c *chanpayWithdraw) Withdraw(info *payment.WithdrawInfo) *payment.Withdraw
 	}
 	//交易处理中
 	return &payment.WithdrawResult{
-		TradeNo:      info.TradeNo,                  //交易流水号
-		ThridFlowNo:  result["FlowNo"],              //第三方交易流水号
-		CardNo:       info.CardNo,                   //收款账户
-		CertID:       info.CertID,                   //收款人身份证号
-		Money:        info.Money,                    //提现金额
-		PayTime:      time.Now().Format(timeFormat), //完成时间
+		TradeNo:      info.TradeNo,               //交易流水号
+		ThridFlowNo:  result["FlowNo"],           //第三方交易流水号
+		CardNo:       info.CardNo,                //收款账户
+		CertID:       info.CertID,                //收款人身份证号
+		Money:        info.Money,                 //提现金额
+		PayTime:      c.Now().Format(timeFormat), //完成时间
 		UserName:     info.UserName,
 		WithdrawCode: c.Code(),
 		WithdrawName: c.Name(),
@@ -157,7 +173,7 @@ func (c *chanpayWithdraw) Withdraw(info *payment.WithdrawInfo) *payment.Withdraw
 
 //查询提现交易
 func (c *chanpayWithdraw) QueryWithdraw(tradeno string, tradeDate ...time.Time) *payment.WithdrawQueryResult {
-	t := time.Now()
+	t := c.Now()
 	params := map[string]string{
 		"Service":       "cjt_dsf", //同步单笔代付
 		"Version":       "1.0",
@@ -173,7 +189,7 @@ func (c *chanpayWithdraw) QueryWithdraw(tradeno string, tradeDate ...time.Time)
 		Status:  payment.DEALING, //提现状态
 		TradeNo: tradeno,         //交易流水号
 	}
-	result, err := request(c.apiURL, params, c.config.PrivateKey, c.config.PublicKey)
+	result, err := request(&c.PayInfo, c.apiURL, params, c.config.PrivateKey, c.config.PublicKey)
 	if err != nil {
 		return returnDealign
 	}
@@ -184,10 +200,10 @@ func (c *chanpayWithdraw) QueryWithdraw(tradeno string, tradeDate ...time.Time)
 	case "1000", "2004", "2009":
 		//交易失败
 		return &payment.WithdrawQueryResult{
-			TradeNo:     tradeno,                       //交易流水号
-			ThridFlowNo: result["FlowNo"],              //第三方交易流水号
-			PayTime:     time.Now().Format(timeFormat), //完成时间
-			Status:      payment.FAIL,                  //提现状态
+			TradeNo:     tradeno,                    //交易流水号
+			ThridFlowNo: result["FlowNo"],           //第三方交易流水号
+			PayTime:     c.Now().Format(timeFormat), //完成时间
+			Status:      payment.FAIL,               //提现状态
 			FailCode:    "Pf:" + PlatformRetCode,
 			FailMsg:     "畅捷平台未受理",
 		}
@@ -195,27 +211,27 @@ func (c *chanpayWithdraw) QueryWithdraw(tradeno string, tradeDate ...time.Time)
 	if OriginalRetCode == "111111" {
 		//交易失败
 		return &payment.WithdrawQueryResult{
-			TradeNo:     tradeno,                       //交易流水号
-			ThridFlowNo: result["FlowNo"],              //第三方交易流水号
-			PayTime:     time.Now().Format(timeFormat), //完成时间
-			Status:      payment.FAIL,                  //提现状态
+			TradeNo:     tradeno,                    //交易流水号
+			ThridFlowNo: result["FlowNo"],           //第三方交易流水号
+			PayTime:     c.Now().Format(timeFormat), //完成时间
+			Status:      payment.FAIL,               //提现状态
 			FailCode:    "Org:" + OriginalRetCode,
 			FailMsg:     "原交易返回代码失败",
 		}
 	}
 	if AppRetcode == "000000" || AppRetcode == "00019999" {
 		return &payment.WithdrawQueryResult{
-			TradeNo:     tradeno,                       //交易流水号
-			ThridFlowNo: result["FlowNo"],              //第三方交易流水号
-			PayTime:     time.Now().Format(timeFormat), //完成时间
-			Status:      payment.SUCCESS,               //提现状态
+			TradeNo:     tradeno,                    //交易流水号
+			ThridFlowNo: result["FlowNo"],           //第三方交易流水号
+			PayTime:     c.Now().Format(timeFormat), //完成时间
+			Status:      payment.SUCCESS,            //提现状态
 		}
 	} else if AppRetcode == "111111" || AppRetcode == "11019999" {
 		return &payment.WithdrawQueryResult{
-			TradeNo:     tradeno,                       //交易流水号
-			ThridFlowNo: result["FlowNo"],              //第三方交易流水号
-			PayTime:     time.Now().Format(timeFormat), //完成时间
-			Status:      payment.FAIL,                  //提现状态
+			TradeNo:     tradeno,                    //交易流水号
+			ThridFlowNo: result["FlowNo"],           //第三方交易流水号
+			PayTime:     c.Now().Format(timeFormat), //完成时间
+			Status:      payment.FAIL,               //提现状态
 			FailCode:    "AP:" + AppRetcode,
 			FailMsg:     "应用返回码失败",
 		}
@@ -223,3 +239,43 @@ func (c *chanpayWithdraw) QueryWithdraw(tradeno string, tradeDate ...time.Time)
 	//交易处理中
 	return returnDealign
 }
+
+//WithdrawNotify 提现异步通知处理,通知地址为配置中的NotifyURL
+func (c *chanpayWithdraw) WithdrawNotify(params map[string]string) *payment.WithdrawQueryResult {
+	delete(params, "request_post_body")
+	tradeno := params["outer_trade_no"]
+	if !verify(&c.PayInfo, params, c.config.PublicKey) {
+		c.Log(utils.LogLevelError, "畅捷提现回调数据验证失败:%v", params)
+		return payment.WithdrawNotifyVerifyFail(tradeno)
+	}
+	ret := &payment.WithdrawQueryResult{
+		Status:      payment.DEALING,          //提现状态
+		TradeNo:     tradeno,                  //交易流水号
+		ThridFlowNo: params["inner_trade_no"], //第三方交易流水号
+		PayTime:     params["gmt_withdrawal"], //完成时间
+	}
+	switch params["withdrawal_status"] {
+	case "WITHDRAWAL_SUCCESS":
+		ret.Status = payment.SUCCESS
+	case "WITHDRAWAL_FAIL", "RETURN_TICKET":
+		ret.Status = payment.FAIL
+		ret.FailCode = params["return_code"]
+		ret.FailMsg = params["fail_reason"]
+	}
+	return ret
+}
+
+//WithdrawNotifyResult 提现异步通知处理结果返回内容,验签失败返回fail畅捷会重新推送
+func (c *chanpayWithdraw) WithdrawNotifyResult(ret *payment.WithdrawQueryResult) string {
+	if ret == nil || ret.Status == payment.UNKNOW {
+		return "fail"
+	}
+	return "success"
+}
+
+//Capabilities 提现能力,CardNo为收款银行卡号
+func (c *chanpayWithdraw) Capabilities() *payment.Capabilities {
+	return &payment.Capabilities{
+		Required: []string{"TradeNo", "UserName", "CardNo", "OpenBank", "Money"},
+	}
+}
@@ -9,7 +9,6 @@ import (
 	"github.com/kinwyb/golang/utils"
 
 	"strconv"
-	"time"
 )
 
 type chinapay struct {
@@ -21,7 +20,10 @@ type chinapay struct {
 
 //支付,返回支付代码
 func (c *chinapay) Pay(req *payment.PayRequest) (string, error) {
-	t := time.Now()
+	if !payment.IsCNY(req.Currency) {
+		return "", payment.ErrCurrencyNotSupport
+	}
+	t := c.Now()
 	params := map[string]string{
 		"Version":    "20140728",
 		"MerId":      c.config.MerID,
@@ -80,7 +82,7 @@ func (c *chinapay) Notify(params map[string]string) *payment.PayResult {
 	} else {
 		ret.Succ = false
 	}
-	return ret
+	return c.FillPayFee(ret)
 }
 
 //异步通知处理结果返回内容
@@ -120,6 +122,8 @@ func (c *chinapay) GetPayment(cfg interface{}) payment.Payment {
 		log(utils.LogLevelError, "密钥初始化失败:%s", err.Error())
 	}
 	obj.Init(obj.config.Code, obj.config.Name, obj.config.State)
+	obj.SetFee(obj.config.Fee)
+	obj.SetOptions(obj.config.Options, &lg)
 	return obj
 }
 
@@ -132,7 +136,7 @@ func (c *chinapay) Driver() string {
 func (c *chinapay) verify(params map[string]string) bool {
 	ret, err := c.sess.Verify(params)
 	if err != nil {
-		log(utils.LogLevelError, "签名验证失败:"+err.Error())
+		c.Log(utils.LogLevelError, "签名验证失败:"+err.Error())
 	}
 	return ret
 }
@@ -151,3 +155,12 @@ func (c *chinapay) sign(params map[string]string) error {
 func (c *chinapay) PayConfirm(req *payment.PayConfirmRequest) *payment.PayResult {
 	return payment.NoPayConfirmResult
 }
+
+//Capabilities 支付能力
+func (c *chinapay) Capabilities() *payment.Capabilities {
+	return &payment.Capabilities{
+		Operations: []string{payment.OpPay, payment.OpNotify, payment.OpResult},
+		TradeTypes: []string{payment.TradeBank},
+		Required:   []string{"No", "Money"},
+	}
+}
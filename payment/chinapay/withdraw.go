@@ -15,6 +15,7 @@ import (
 	"regexp"
 
 	"github.com/kinwyb/golang/payment"
+	"github.com/kinwyb/golang/payment/validate"
 	"github.com/kinwyb/golang/utils"
 )
 
@@ -40,14 +41,25 @@ func init() {
 	regExpTradeNo, _ = regexp.Compile("\\d{1,16}")
 }
 
+//Withdraw 提现操作
 func (w *withdraw) Withdraw(info *payment.WithdrawInfo) *payment.WithdrawResult {
+	return w.FillWithdrawFee(w.withdraw(info), info)
+}
+
+//提现请求
+func (w *withdraw) withdraw(info *payment.WithdrawInfo) *payment.WithdrawResult {
+	if !payment.IsCNY(info.Currency) {
+		return payment.WithdrawCurrencyNotSupport
+	}
 	if regExpTradeNo != nil && !regExpTradeNo.MatchString(info.TradeNo) {
 		return tradeFormatError
+	} else if err := validate.BankWithdraw(info); err != nil {
+		return payment.WithdrawParamsInvalid(err)
 	}
 	args := map[string]string{
-		"merId":    w.config.MerID,                //商户号
-		"merDate":  time.Now().Format("20060102"), //商户日期
-		"merSeqId": info.TradeNo,                  //商户流水号
+		"merId":    w.config.MerID,             //商户号
+		"merDate":  w.Now().Format("20060102"), //商户日期
+		"merSeqId": info.TradeNo,               //商户流水号
 		//"certType", "01", //证件类型 01=身份证
 		//"certId":info.CertID //身份证号
 		"cardNo":   info.CardNo,   //收款账户
@@ -75,26 +87,28 @@ func (w *withdraw) Withdraw(info *payment.WithdrawInfo) *payment.WithdrawResult
 	request, err := http.NewRequest("POST", w.withdrawURL, strings.NewReader(params.Encode()))
 	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 	if err != nil {
-		log(utils.LogLevelError, "银联提现请求创建失败:%s", err.Error())
+		w.Log(utils.LogLevelError, "银联提现请求创建失败:%s", err.Error())
 		return payment.WithdrawRequestFail
 	}
-	client := http.Client{
+	client := w.HTTPClient(&http.Client{
 		Timeout: 1 * time.Minute,
-	}
+	})
 	response, err := client.Do(request)
 	if err != nil {
-		log(utils.LogLevelError, "银联提现请求失败:%s", err.Error())
+		w.Log(utils.LogLevelError, "银联提现请求失败:%s", err.Error())
+		if payment.IsDialError(err) {
+			return payment.WithdrawRequestNotSent
+		}
 		return payment.WithdrawRequestFail
 	}
 	responseData, err := ioutil.ReadAll(response.Body)
 	response.Body.Close()
 	if err != nil {
-		log(utils.LogLevelError, "银联提现请求结果读取失败:%s", err.Error())
+		w.Log(utils.LogLevelError, "银联提现请求结果读取失败:%s", err.Error())
 		return payment.WithdrawResponseReadFail
 	}
-	log(utils.LogLevelInfo, "银联提现请求结果:%s", responseData)
+	w.Log(utils.LogLevelInfo, "银联提现请求结果:%s", responseData)
 	responseString := string(responseData)
-	idex := strings.LastIndex(responseString, "&")
 	res, err := url.ParseQuery(responseString)
 	if err != nil { //要检测提现是否完成
 		return payment.WithdrawResponseUnserializeFail
@@ -104,22 +118,20 @@ func (w *withdraw) Withdraw(info *payment.WithdrawInfo) *payment.WithdrawResult
 		result[k] = v[0]
 	}
 	if result["responseCode"] == "000" { //表示请求成功 应答失败时候检测会发生异常
-		v := w.pubKey.Verify(base64.StdEncoding.EncodeToString([]byte(responseString[:idex])), responseString[idex+10:])
-		if !v {
-			log(utils.LogLevelError, "银联结果签名异常=>[%s]\n等待签名base64结果:%s\n签名:%s",
-				responseString[:idex], base64.StdEncoding.EncodeToString([]byte(responseString[:idex])), responseString[idex+10:])
+		if !w.verifyResponse(responseString) {
+			w.Log(utils.LogLevelError, "银联结果签名异常=>[%s]", responseString)
 			return payment.WithdrawResponseVerifyFail
 		}
 		switch result["stat"] {
 		case "s":
 			//交易成功
 			return &payment.WithdrawResult{
-				TradeNo:      info.TradeNo,                  //交易流水号
-				ThridFlowNo:  result["cpSeqId"],             //第三方交易流水号
-				CardNo:       info.CardNo,                   //收款账户
-				CertID:       info.CertID,                   //收款人身份证号
-				Money:        info.Money,                    //提现金额
-				PayTime:      time.Now().Format(timeFormat), //完成时间
+				TradeNo:      info.TradeNo,               //交易流水号
+				ThridFlowNo:  result["cpSeqId"],          //第三方交易流水号
+				CardNo:       info.CardNo,                //收款账户
+				CertID:       info.CertID,                //收款人身份证号
+				Money:        info.Money,                 //提现金额
+				PayTime:      w.Now().Format(timeFormat), //完成时间
 				UserName:     info.UserName,
 				WithdrawCode: w.Code(),
 				WithdrawName: w.Name(),
@@ -127,12 +139,12 @@ func (w *withdraw) Withdraw(info *payment.WithdrawInfo) *payment.WithdrawResult
 			}
 		case "2", "3", "4", "5", "7", "8":
 			return &payment.WithdrawResult{
-				TradeNo:      info.TradeNo,                  //交易流水号
-				ThridFlowNo:  result["cpSeqId"],             //第三方交易流水号
-				CardNo:       info.CardNo,                   //收款账户
-				CertID:       info.CertID,                   //收款人身份证号
-				Money:        info.Money,                    //提现金额
-				PayTime:      time.Now().Format(timeFormat), //完成时间
+				TradeNo:      info.TradeNo,               //交易流水号
+				ThridFlowNo:  result["cpSeqId"],          //第三方交易流水号
+				CardNo:       info.CardNo,                //收款账户
+				CertID:       info.CertID,                //收款人身份证号
+				Money:        info.Money,                 //提现金额
+				PayTime:      w.Now().Format(timeFormat), //完成时间
 				UserName:     info.UserName,
 				WithdrawCode: w.Code(),
 				WithdrawName: w.Name(),
@@ -142,12 +154,12 @@ func (w *withdraw) Withdraw(info *payment.WithdrawInfo) *payment.WithdrawResult
 		case "6", "9":
 			//交易失败
 			return &payment.WithdrawResult{
-				TradeNo:      info.TradeNo,                  //交易流水号
-				ThridFlowNo:  result["cpSeqId"],             //第三方交易流水号
-				CardNo:       info.CardNo,                   //收款账户
-				CertID:       info.CertID,                   //收款人身份证号
-				Money:        info.Money,                    //提现金额
-				PayTime:      time.Now().Format(timeFormat), //完成时间
+				TradeNo:      info.TradeNo,               //交易流水号
+				ThridFlowNo:  result["cpSeqId"],          //第三方交易流水号
+				CardNo:       info.CardNo,                //收款账户
+				CertID:       info.CertID,                //收款人身份证号
+				Money:        info.Money,                 //提现金额
+				PayTime:      w.Now().Format(timeFormat), //完成时间
 				UserName:     info.UserName,
 				WithdrawCode: w.Code(),
 				WithdrawName: w.Name(),
@@ -159,12 +171,12 @@ func (w *withdraw) Withdraw(info *payment.WithdrawInfo) *payment.WithdrawResult
 	//否则查询下交易状态返回查询的状态结果
 	qresult := w.QueryWithdraw(info.TradeNo)
 	return &payment.WithdrawResult{
-		TradeNo:      info.TradeNo,                  //交易流水号
-		ThridFlowNo:  result["cpSeqId"],             //第三方交易流水号
-		CardNo:       info.CardNo,                   //收款账户
-		CertID:       info.CertID,                   //收款人身份证号
-		Money:        info.Money,                    //提现金额
-		PayTime:      time.Now().Format(timeFormat), //完成时间
+		TradeNo:      info.TradeNo,               //交易流水号
+		ThridFlowNo:  result["cpSeqId"],          //第三方交易流水号
+		CardNo:       info.CardNo,                //收款账户
+		CertID:       info.CertID,                //收款人身份证号
+		Money:        info.Money,                 //提现金额
+		PayTime:      w.Now().Format(timeFormat), //完成时间
 		UserName:     info.UserName,
 		WithdrawCode: w.Code(),
 		WithdrawName: w.Name(),
@@ -187,17 +199,30 @@ func (w *withdraw) sign(params map[string]string) error {
 		params["mac"] + params["imei"] + params["ip"] +
 		params["coordinates"] + params["baseStationSn"] +
 		params["codeInputType"] + params["mobileForBank"] + params["desc"]
-	log(utils.LogLevelTrace, "待编码的签名字符串：%s", signer)
+	w.Log(utils.LogLevelTrace, "待编码的签名字符串：%s", signer)
 	signer = base64.StdEncoding.EncodeToString([]byte(signer))
-	log(utils.LogLevelTrace, "待签名字符串:%s", signer)
+	w.Log(utils.LogLevelTrace, "待签名字符串:%s", signer)
 	params[w.config.SignatureField] = w.privKey.Sign(signer)
-	log(utils.LogLevelTrace, "签名结果:%s", params[w.config.SignatureField])
+	w.Log(utils.LogLevelTrace, "签名结果:%s", params[w.config.SignatureField])
 	return nil
 }
 
+//验证提现应答签名,签名内容为最后一个参数(签名字段)之前的原始应答内容base64编码的结果
+func (w *withdraw) verifyResponse(data string) bool {
+	idex := strings.LastIndex(data, "&")
+	if idex < 0 || w.pubKey == nil {
+		return false
+	}
+	prefix := "&" + w.config.SignatureField + "="
+	if !strings.HasPrefix(data[idex:], prefix) {
+		return false
+	}
+	return w.pubKey.Verify(base64.StdEncoding.EncodeToString([]byte(data[:idex])), data[idex+len(prefix):])
+}
+
 func (w *withdraw) QueryWithdraw(tradeno string, tradeDate ...time.Time) *payment.WithdrawQueryResult {
 	if tradeDate == nil || len(tradeDate) < 1 {
-		tradeDate = []time.Time{time.Now()}
+		tradeDate = []time.Time{w.Now()}
 	}
 	returnDealign := &payment.WithdrawQueryResult{
 		Status:  payment.DEALING, //提现状态
@@ -217,29 +242,29 @@ func (w *withdraw) QueryWithdraw(tradeno string, tradeDate ...time.Time) *paymen
 	request, err := http.NewRequest("POST", w.queryWithdrawURL, strings.NewReader(args.Encode()))
 	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 	if err != nil {
-		log(utils.LogLevelError, "银联提现查询请求创建失败:%s", err.Error())
+		w.Log(utils.LogLevelError, "银联提现查询请求创建失败:%s", err.Error())
 		return returnDealign
 	}
-	client := http.Client{
+	client := w.HTTPClient(&http.Client{
 		Timeout: 1 * time.Minute,
-	}
+	})
 	response, err := client.Do(request)
 	if err != nil {
-		log(utils.LogLevelError, "银联提现查询请求失败:%s", err.Error())
+		w.Log(utils.LogLevelError, "银联提现查询请求失败:%s", err.Error())
 		return returnDealign
 	}
 	responseData, err := ioutil.ReadAll(response.Body)
 	response.Body.Close()
 	if err != nil {
-		log(utils.LogLevelError, "银联提现查询请求结果读取失败:%s", err.Error())
+		w.Log(utils.LogLevelError, "银联提现查询请求结果读取失败:%s", err.Error())
 		return returnDealign
 	}
-	log(utils.LogLevelInfo, "银联提现查询请求结果:%s", responseData)
+	w.Log(utils.LogLevelInfo, "银联提现查询请求结果:%s", responseData)
 	responseString := string(responseData)
 	result := strings.Split(responseString, "|")
 	v := w.pubKey.Verify(base64.StdEncoding.EncodeToString([]byte(strings.Join(result[:len(result)-1], "|")+"|")), result[len(result)-1])
 	if !v {
-		log(utils.LogLevelError, "银联提现查询结果验签失败:%s", responseString)
+		w.Log(utils.LogLevelError, "银联提现查询结果验签失败:%s", responseString)
 		return returnDealign
 	}
 	if result[0] == "000" {
@@ -248,10 +273,10 @@ func (w *withdraw) QueryWithdraw(tradeno string, tradeDate ...time.Time) *paymen
 			case "s":
 				//交易成功
 				return &payment.WithdrawQueryResult{
-					Status:      payment.SUCCESS,               //提现状态
-					PayTime:     time.Now().Format(timeFormat), //完成时间
-					TradeNo:     tradeno,                       //交易流水号
-					ThridFlowNo: result[5],                     //第三方交易流水号
+					Status:      payment.SUCCESS,            //提现状态
+					PayTime:     w.Now().Format(timeFormat), //完成时间
+					TradeNo:     tradeno,                    //交易流水号
+					ThridFlowNo: result[5],                  //第三方交易流水号
 				}
 			case "2", "3", "4", "5", "7", "8":
 				return returnDealign
@@ -272,6 +297,49 @@ func (w *withdraw) QueryWithdraw(tradeno string, tradeDate ...time.Time) *paymen
 	return returnDealign
 }
 
+//WithdrawNotify 提现异步通知处理,通知地址在银联商户服务平台配置
+//	通知内容与同步应答格式相同,使用与同步应答相同的方式验证原始通知内容(request_post_body)的签名
+func (w *withdraw) WithdrawNotify(params map[string]string) *payment.WithdrawQueryResult {
+	body := params["request_post_body"]
+	if !w.verifyResponse(body) {
+		w.Log(utils.LogLevelError, "银联提现回调数据验证失败:%s", body)
+		return payment.WithdrawNotifyVerifyFail(params["merSeqId"])
+	}
+	values, err := url.ParseQuery(body)
+	if err != nil || values.Get("merId") != w.config.MerID {
+		w.Log(utils.LogLevelError, "银联提现回调数据验证失败:%s", body)
+		return payment.WithdrawNotifyVerifyFail(params["merSeqId"])
+	}
+	for k := range values {
+		params[k] = values.Get(k)
+	}
+	delete(params, "request_post_body")
+	tradeno := params["merSeqId"]
+	ret := &payment.WithdrawQueryResult{
+		Status:      payment.DEALING,   //提现状态
+		TradeNo:     tradeno,           //交易流水号
+		ThridFlowNo: params["cpSeqId"], //第三方交易流水号
+	}
+	switch params["stat"] {
+	case "s":
+		ret.Status = payment.SUCCESS
+		ret.PayTime = w.Now().Format(timeFormat)
+	case "6", "9":
+		ret.Status = payment.FAIL
+		ret.FailCode = params["stat"]
+		ret.FailMsg = "银行退单"
+	}
+	return ret
+}
+
+//WithdrawNotifyResult 提现异步通知处理结果返回内容
+func (w *withdraw) WithdrawNotifyResult(ret *payment.WithdrawQueryResult) string {
+	if ret == nil || ret.Status == payment.UNKNOW {
+		return "fail"
+	}
+	return "success"
+}
+
 func (w *withdraw) Driver() string {
 	return "chinapay"
 }
@@ -305,5 +373,14 @@ func (w *withdraw) GetWithdraw(cfg interface{}) payment.Withdraw {
 		log(utils.LogLevelError, "公钥初始化失败:%s", err.Error())
 	}
 	obj.Init(obj.config.Code, obj.config.Name, obj.config.State)
+	obj.SetFee(obj.config.Fee)
+	obj.SetOptions(obj.config.Options, &lg)
 	return obj
 }
+
+//Capabilities 提现能力,CardNo为收款银行卡号
+func (w *withdraw) Capabilities() *payment.Capabilities {
+	return &payment.Capabilities{
+		Required: []string{"TradeNo", "UserName", "CardNo", "OpenBank", "Prov", "City", "Money"},
+	}
+}
@@ -1,7 +1,11 @@
 package chinapay
 
 import (
+	"crypto/rand"
+	"crypto/rsa"
 	"encoding/base64"
+	"math/big"
+	"net/url"
 	"testing"
 
 	"io/ioutil"
@@ -12,6 +16,7 @@ import (
 	"github.com/astaxie/beego/logs"
 	"github.com/kinwyb/golang/payment"
 	"github.com/kinwyb/golang/utils"
+	"github.com/smartystreets/goconvey/convey"
 )
 
 func TestWithdraw_Withdraw(t *testing.T) {
@@ -116,3 +121,66 @@ func TestWithdraw_Withdraw(t *testing.T) {
 			}
 	*/
 }
+
+//使用测试RSA密钥生成同时可签名和验签的银联密钥
+func testNetPayClient() *NetPayClient {
+	key, _ := rsa.GenerateKey(rand.Reader, 1024)
+	key.Precompute()
+	pad := func(i *big.Int, size int) string {
+		b := i.Bytes()
+		return strings.Repeat("\x00", size-len(b)) + string(b)
+	}
+	return &NetPayClient{key: &netPayKey{
+		merID:          "808080211305113",
+		pgID:           "999999999999999",
+		modulus:        pad(key.N, 128),
+		prime1:         pad(key.Primes[0], 64),
+		prime2:         pad(key.Primes[1], 64),
+		primeExponent1: pad(key.Precomputed.Dp, 64),
+		primeExponent2: pad(key.Precomputed.Dq, 64),
+		coefficient:    pad(key.Precomputed.Qinv, 64),
+	}}
+}
+
+func TestWithdraw_WithdrawNotify(t *testing.T) {
+	client := testNetPayClient()
+	cfg := &WithdrawConfig{MerID: "808080211305113", SignatureField: "chkValue"}
+	cfg.Code = "chinapay"
+	cfg.Name = "银联提现"
+	cfg.State = true
+	w := &withdraw{config: cfg, privKey: client, pubKey: client}
+	w.Init(cfg.Code, cfg.Name, cfg.State)
+	notify := func(body string) map[string]string {
+		body += "&chkValue=" + client.Sign(base64.StdEncoding.EncodeToString([]byte(body)))
+		params := map[string]string{"request_post_body": body}
+		values, _ := url.ParseQuery(body)
+		for k := range values {
+			params[k] = values.Get(k)
+		}
+		return params
+	}
+	convey.Convey("提现异步通知", t, func() {
+		body := "responseCode=0000&merId=808080211305113&merDate=20171010&merSeqId=129847382931&cpDate=20171010" +
+			"&cpSeqId=364902&transAmt=10&stat=s&cardNo=6222021208007333758"
+		ret := w.WithdrawNotify(notify(body))
+		convey.So(ret.Status, convey.ShouldEqual, payment.SUCCESS)
+		convey.So(ret.TradeNo, convey.ShouldEqual, "129847382931")
+		convey.So(ret.ThridFlowNo, convey.ShouldEqual, "364902")
+		convey.So(w.WithdrawNotifyResult(ret), convey.ShouldEqual, "success")
+
+		ret = w.WithdrawNotify(notify(strings.Replace(body, "stat=s", "stat=6", 1)))
+		convey.So(ret.Status, convey.ShouldEqual, payment.FAIL)
+		convey.So(ret.FailCode, convey.ShouldEqual, "6")
+
+		params := notify(body)
+		params["request_post_body"] = strings.Replace(params["request_post_body"], "transAmt=10", "transAmt=1000", 1)
+		ret = w.WithdrawNotify(params)
+		convey.So(ret.Status, convey.ShouldEqual, payment.UNKNOW)
+		convey.So(ret.FailCode, convey.ShouldEqual, "NOTIFY_VERIFY_FAIL")
+		convey.So(w.WithdrawNotifyResult(ret), convey.ShouldEqual, "fail")
+		ret = w.WithdrawNotify(notify(strings.Replace(body, "merId=808080211305113", "merId=808080211881045", 1)))
+		convey.So(ret.FailCode, convey.ShouldEqual, "NOTIFY_VERIFY_FAIL")
+		delete(params, "request_post_body")
+		convey.So(w.WithdrawNotify(params).FailCode, convey.ShouldEqual, "NOTIFY_VERIFY_FAIL")
+	})
+}
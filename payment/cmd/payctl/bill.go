@@ -0,0 +1,82 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/kinwyb/golang/payment"
+)
+
+var (
+	billCode string
+	billDate string
+	billOut  string
+)
+
+func init() {
+	fs := flag.NewFlagSet("bill", flag.ContinueOnError)
+	fs.StringVar(&billCode, "code", "", "支付方式编码")
+	fs.StringVar(&billDate, "date", "", "对账日期[yyyy-MM-dd],默认昨天")
+	fs.StringVar(&billOut, "out", "", "对账单保存文件,为空只输出查询结果")
+	commands = append(commands, &command{
+		Name:      "bill",
+		UsageLine: "bill -code=\"\" [-date=\"\"] [-out=\"\"]",
+		Short:     "查询交易对账单,指定-out时下载保存",
+		Long:      "支持的支付方式驱动:alipay",
+		Flag:      fs,
+		Run:       runBill,
+	})
+}
+
+func runBill(c *command, r *payment.Registry, args []string) error {
+	if err := c.required("code"); err != nil {
+		return err
+	}
+	date := time.Now().AddDate(0, 0, -1)
+	if billDate != "" {
+		v, err := parseDate(billDate)
+		if err != nil {
+			return err
+		}
+		date = v[0]
+	}
+	ret, err := r.Bill(billCode, date)
+	if err != nil {
+		return err
+	}
+	if billOut != "" {
+		if err = saveBill(ret, billOut); err != nil {
+			return err
+		}
+	}
+	return printJSON(ret)
+}
+
+//保存对账单,第三方返回下载地址时下载文件
+func saveBill(ret *payment.BillResult, filename string) error {
+	if ret.URL == "" {
+		return ioutil.WriteFile(filename, []byte(ret.Content), 0644)
+	}
+	resp, err := http.Get(ret.URL)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("对账单下载失败:%s", resp.Status)
+	}
+	f, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	if _, err = io.Copy(f, resp.Body); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
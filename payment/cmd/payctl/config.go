@@ -0,0 +1,137 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"reflect"
+
+	"github.com/kinwyb/golang/payment"
+	"github.com/kinwyb/golang/payment/alipay"
+	"github.com/kinwyb/golang/payment/appstore"
+	"github.com/kinwyb/golang/payment/chanpay"
+	"github.com/kinwyb/golang/payment/chinapay"
+	"github.com/kinwyb/golang/payment/fake"
+	"github.com/kinwyb/golang/payment/unionpay"
+	"github.com/kinwyb/golang/payment/wxpay"
+	"github.com/kinwyb/golang/utils"
+)
+
+//Config 配置文件
+//	{
+//	  "payments": [{"driver": "alipay", "config": {"Code": "alipay", "Name": "支付宝", "State": true, ...}}],
+//	  "withdraws": [{"driver": "chanpay", "config": {...}, "files": {"PrivateKey": "/etc/pay/chanpay.key"}}]
+//	}
+type Config struct {
+	Payments  []*Channel `json:"payments"`  //支付方式
+	Withdraws []*Channel `json:"withdraws"` //提现方式
+}
+
+//Channel 渠道配置
+type Channel struct {
+	Driver string            `json:"driver"` //驱动编码
+	Config json.RawMessage   `json:"config"` //驱动配置,字段与驱动包中的配置结构一致
+	Files  map[string]string `json:"files"`  //从文件读取的配置字段,字段名:文件路径,用于密钥证书等内容
+}
+
+//支付驱动对应的配置结构
+var payConfigs = map[string]func() interface{}{
+	"alipay":        func() interface{} { return &alipay.PayConfig{} },
+	"wxpay":         func() interface{} { return &wxpay.PayConfig{} },
+	"chanpayqrcode": func() interface{} { return &chanpay.QRPayConfig{} },
+	"chanpayquick":  func() interface{} { return &chanpay.QuickPayConfig{} },
+	"chanpaybank":   func() interface{} { return &chanpay.BankPayConfig{} },
+	"chinapay":      func() interface{} { return &chinapay.PayConfig{} },
+	"unionpay":      func() interface{} { return &unionpay.PayConfig{} },
+	"appstore":      func() interface{} { return &appstore.PayConfig{} },
+	"fake":          func() interface{} { return &fake.Config{} },
+}
+
+//提现驱动对应的配置结构
+var withdrawConfigs = map[string]func() interface{}{
+	"alipay":   func() interface{} { return &alipay.PayConfig{} },
+	"wxpay":    func() interface{} { return &wxpay.WithdrawConfig{} },
+	"chanpay":  func() interface{} { return &chanpay.WithdrawConfig{} },
+	"chinapay": func() interface{} { return &chinapay.WithdrawConfig{} },
+	"fake":     func() interface{} { return &fake.Config{} },
+}
+
+//loadRegistry 读取配置文件,注册驱动并生成支付/提现对象
+func loadRegistry(filename string) (*payment.Registry, error) {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	cfg := &Config{}
+	if err = json.Unmarshal(data, cfg); err != nil {
+		return nil, fmt.Errorf("配置文件解析失败:%s", err.Error())
+	}
+	var logger utils.Logger
+	if verbose {
+		logger = stderrLogger{}
+	}
+	r := payment.NewRegistry()
+	alipay.Driver(r.RegDriver, logger)
+	alipay.WithdrawDriver(r.RegWithdrawDriver, logger)
+	wxpay.Driver(r.RegDriver, logger)
+	wxpay.WithdrawDriver(r.RegWithdrawDriver, logger)
+	chanpay.DriverQrcode(r.RegDriver, logger)
+	chanpay.DriverQuick(r.RegDriver, logger)
+	chanpay.DriverBank(r.RegDriver, logger)
+	chanpay.WithdrawDriver(r.RegWithdrawDriver, logger)
+	chinapay.Driver(r.RegDriver, logger)
+	chinapay.WithdrawDriver(r.RegWithdrawDriver, logger)
+	unionpay.Driver(r.RegDriver, logger)
+	appstore.Driver(r.RegDriver, logger)
+	fake.Driver(r.RegDriver, logger)
+	fake.WithdrawDriver(r.RegWithdrawDriver, logger)
+	for i, c := range cfg.Payments {
+		v, err := c.decode(payConfigs)
+		if err != nil {
+			return nil, fmt.Errorf("payments[%d]:%s", i, err.Error())
+		} else if _, err = r.AddPayment(c.Driver, v); err != nil {
+			return nil, fmt.Errorf("payments[%d]:%s", i, err.Error())
+		}
+	}
+	for i, c := range cfg.Withdraws {
+		v, err := c.decode(withdrawConfigs)
+		if err != nil {
+			return nil, fmt.Errorf("withdraws[%d]:%s", i, err.Error())
+		} else if _, err = r.AddWithdraw(c.Driver, v); err != nil {
+			return nil, fmt.Errorf("withdraws[%d]:%s", i, err.Error())
+		}
+	}
+	return r, nil
+}
+
+//生成驱动配置
+func (c *Channel) decode(configs map[string]func() interface{}) (interface{}, error) {
+	newConfig, ok := configs[c.Driver]
+	if !ok {
+		return nil, fmt.Errorf("不支持的驱动:%s", c.Driver)
+	}
+	v := newConfig()
+	if len(c.Config) > 0 {
+		if err := json.Unmarshal(c.Config, v); err != nil {
+			return nil, fmt.Errorf("驱动配置解析失败:%s", err.Error())
+		}
+	}
+	for name, filename := range c.Files {
+		data, err := ioutil.ReadFile(filename)
+		if err != nil {
+			return nil, err
+		}
+		field := reflect.ValueOf(v).Elem().FieldByName(name)
+		switch {
+		case !field.IsValid() || !field.CanSet():
+			return nil, fmt.Errorf("配置字段不存在:%s", name)
+		case field.Kind() == reflect.String:
+			field.SetString(string(data))
+		case field.Kind() == reflect.Slice && field.Type().Elem().Kind() == reflect.Uint8:
+			field.SetBytes(data)
+		default:
+			return nil, fmt.Errorf("配置字段不支持从文件读取:%s", name)
+		}
+	}
+	return v, nil
+}
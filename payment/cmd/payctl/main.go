@@ -0,0 +1,154 @@
+package main
+
+//支付运维命令行工具,根据配置文件加载支付/提现方式后执行查询、重试等操作,结果以JSON输出
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/kinwyb/golang/payment"
+)
+
+//command 子命令
+type command struct {
+	Name      string                                                     //命令名称
+	UsageLine string                                                     //使用说明
+	Short     string                                                     //简要说明
+	Long      string                                                     //详细说明,命令帮助中输出
+	Flag      *flag.FlagSet                                              //命令参数
+	Run       func(c *command, r *payment.Registry, args []string) error //执行命令
+}
+
+//可用子命令
+var commands []*command
+
+var (
+	configFile string
+	verbose    bool
+	stdout     io.Writer = os.Stdout //结果输出
+	stderr     io.Writer = os.Stderr //帮助及错误输出
+)
+
+func usage() {
+	fmt.Fprintln(stderr, "支付运维工具.")
+	fmt.Fprintln(stderr, "USAGE")
+	fmt.Fprintln(stderr, "    payctl [-config=payctl.json] [-v] command [arguments]")
+	fmt.Fprintln(stderr)
+	fmt.Fprintln(stderr, "AVAILABLE COMMANDS")
+	for _, c := range commands {
+		fmt.Fprintf(stderr, "    %-15s %s\n", c.Name, c.Short)
+	}
+	fmt.Fprintln(stderr)
+	fmt.Fprintln(stderr, "Use payctl command -h for more information about a command.")
+}
+
+func main() {
+	flag.StringVar(&configFile, "config", "payctl.json", "支付方式配置文件")
+	flag.BoolVar(&verbose, "v", false, "输出渠道请求日志")
+	flag.Usage = usage
+	flag.Parse()
+	log.SetFlags(0)
+	os.Exit(run(flag.Args()))
+}
+
+//run 执行子命令,返回进程退出码
+func run(args []string) int {
+	if len(args) < 1 {
+		usage()
+		return 2
+	}
+	for _, c := range commands {
+		if c.Name != args[0] {
+			continue
+		}
+		c.Flag.SetOutput(stderr)
+		c.Flag.Usage = func() {
+			fmt.Fprintf(stderr, "USAGE\n    payctl %s\n\n%s\n", c.UsageLine, c.Short)
+			if c.Long != "" {
+				fmt.Fprintf(stderr, "%s\n", c.Long)
+			}
+			c.Flag.PrintDefaults()
+		}
+		if err := c.Flag.Parse(args[1:]); err != nil {
+			return 2
+		}
+		registry, err := loadRegistry(configFile)
+		if err != nil {
+			log.Printf("配置文件加载失败:%s", err.Error())
+			return 1
+		}
+		if err = c.Run(c, registry, c.Flag.Args()); err != nil {
+			log.Printf("%s失败:%s", c.Name, err.Error())
+			return 1
+		}
+		return 0
+	}
+	log.Printf("未知命令:%s", args[0])
+	usage()
+	return 2
+}
+
+//printJSON 以JSON格式输出结果
+func printJSON(v interface{}) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(stdout, string(data))
+	return nil
+}
+
+//parseDate 解析yyyy-MM-dd格式日期,为空返回nil
+func parseDate(v string) ([]time.Time, error) {
+	if v == "" {
+		return nil, nil
+	}
+	t, err := time.ParseInLocation("2006-01-02", v, time.Local)
+	if err != nil {
+		return nil, fmt.Errorf("日期格式错误[yyyy-MM-dd]:%s", v)
+	}
+	return []time.Time{t}, nil
+}
+
+//required 校验必填参数
+func (c *command) required(names ...string) error {
+	missing := []string{}
+	for _, name := range names {
+		if f := c.Flag.Lookup(name); f == nil || f.Value.String() == "" || f.Value.String() == "0" {
+			missing = append(missing, "-"+name)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("缺少参数%s", strings.Join(missing, ","))
+	}
+	return nil
+}
+
+//stderrLogger 输出到标准错误的日志,避免混入JSON结果
+type stderrLogger struct{}
+
+func (stderrLogger) Trace(format string, args ...interface{}) {
+	log.Printf("[T] "+format, args...)
+}
+
+func (stderrLogger) Debug(format string, args ...interface{}) {
+	log.Printf("[D] "+format, args...)
+}
+
+func (stderrLogger) Info(format string, args ...interface{}) {
+	log.Printf("[I] "+format, args...)
+}
+
+func (stderrLogger) Warning(format string, args ...interface{}) {
+	log.Printf("[W] "+format, args...)
+}
+
+func (stderrLogger) Error(format string, args ...interface{}) {
+	log.Printf("[E] "+format, args...)
+}
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kinwyb/golang/payment"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+//执行命令,返回退出码、结果输出和错误输出
+func testRun(args ...string) (int, string, string) {
+	out := &bytes.Buffer{}
+	errOut := &bytes.Buffer{}
+	stdout, stderr = out, errOut
+	log.SetOutput(errOut)
+	defer func() {
+		stdout, stderr = os.Stdout, os.Stderr
+		log.SetOutput(os.Stderr)
+	}()
+	*refundReq = payment.RefundRequest{}
+	refundCode = ""
+	code := run(args)
+	return code, out.String(), errOut.String()
+}
+
+func Test_Run(t *testing.T) {
+	dir, err := ioutil.TempDir("", "payctl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	configFile = filepath.Join(dir, "payctl.json")
+	err = ioutil.WriteFile(configFile, []byte(`{
+		"payments": [{"driver": "fake", "config": {"Code": "fake", "Name": "模拟支付", "State": true}}],
+		"withdraws": [{"driver": "fake", "config": {"Code": "fake", "Name": "模拟提现", "State": true}}]
+	}`), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	convey.Convey("退款", t, func() {
+		code, out, _ := testRun("refund", "-code=fake", "-no=001", "-refund-no=R001", "-money=5", "-reason=测试")
+		convey.So(code, convey.ShouldEqual, 0)
+		convey.So(refundReq.No, convey.ShouldEqual, "001")
+		convey.So(refundReq.RefundNo, convey.ShouldEqual, "R001")
+		convey.So(refundReq.Money, convey.ShouldEqual, 5)
+		convey.So(refundReq.TotalMoney, convey.ShouldEqual, 5)
+		convey.So(refundReq.Reason, convey.ShouldEqual, "测试")
+		ret := &payment.RefundResult{}
+		convey.So(json.Unmarshal([]byte(out), ret), convey.ShouldBeNil)
+		convey.So(ret.Status, convey.ShouldEqual, payment.FAIL)
+		convey.So(ret.PayCode, convey.ShouldEqual, "fake")
+		convey.So(ret.RefundNo, convey.ShouldEqual, "R001")
+		convey.So(ret.FailMsg, convey.ShouldEqual, "支付方式不支持退款")
+
+		code, _, _ = testRun("refund", "-code=fake", "-no=001", "-refund-no=R001", "-money=5", "-total=10")
+		convey.So(code, convey.ShouldEqual, 0)
+		convey.So(refundReq.TotalMoney, convey.ShouldEqual, 10)
+
+		code, out, errOut := testRun("refund", "-code=fake", "-no=001")
+		convey.So(code, convey.ShouldEqual, 1)
+		convey.So(out, convey.ShouldBeEmpty)
+		convey.So(errOut, convey.ShouldContainSubstring, "缺少参数-refund-no,-money")
+
+		code, _, errOut = testRun("refund", "-code=none", "-no=001", "-refund-no=R001", "-money=5")
+		convey.So(code, convey.ShouldEqual, 1)
+		convey.So(errOut, convey.ShouldContainSubstring, payment.ErrPaymentNotFound.Error())
+
+		code, _, _ = testRun("refund", "-money=abc")
+		convey.So(code, convey.ShouldEqual, 2)
+	})
+	convey.Convey("帮助", t, func() {
+		code, out, errOut := testRun("refund", "-h")
+		convey.So(code, convey.ShouldEqual, 2)
+		convey.So(out, convey.ShouldBeEmpty)
+		convey.So(errOut, convey.ShouldContainSubstring, "payctl refund -code=")
+		convey.So(errOut, convey.ShouldContainSubstring, "支持的支付方式驱动:alipay、unionpay(需要-third-no)")
+		convey.So(errOut, convey.ShouldContainSubstring, "-refund-no")
+
+		_, _, errOut = testRun("query", "-h")
+		convey.So(errOut, convey.ShouldContainSubstring, "unionpay(需要-date)")
+		_, _, errOut = testRun("bill", "-h")
+		convey.So(errOut, convey.ShouldContainSubstring, "支持的支付方式驱动:alipay")
+
+		code, _, errOut = testRun("unknown")
+		convey.So(code, convey.ShouldEqual, 2)
+		convey.So(errOut, convey.ShouldContainSubstring, "未知命令:unknown")
+		convey.So(errOut, convey.ShouldContainSubstring, "AVAILABLE COMMANDS")
+		code, _, _ = testRun()
+		convey.So(code, convey.ShouldEqual, 2)
+	})
+}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io/ioutil"
+	"net/url"
+	"os"
+
+	"github.com/kinwyb/golang/payment"
+)
+
+var (
+	notifyCode     string
+	notifyFile     string
+	notifyWithdraw bool
+)
+
+func init() {
+	fs := flag.NewFlagSet("verify-notify", flag.ContinueOnError)
+	fs.StringVar(&notifyCode, "code", "", "支付/提现方式编码")
+	fs.StringVar(&notifyFile, "file", "-", "抓取的通知内容文件,-表示标准输入")
+	fs.BoolVar(&notifyWithdraw, "withdraw", false, "是否是提现异步通知")
+	commands = append(commands, &command{
+		Name:      "verify-notify",
+		UsageLine: "verify-notify -code=\"\" [-file=\"-\"] [-withdraw]",
+		Short:     "校验抓取的异步通知签名并输出处理结果,验签失败时支付结果Succ为false、提现结果为UNKNOW,通知内容支持表单(a=1&b=2)、JSON对象和XML",
+		Flag:      fs,
+		Run:       runVerifyNotify,
+	})
+}
+
+func runVerifyNotify(c *command, r *payment.Registry, args []string) error {
+	if err := c.required("code"); err != nil {
+		return err
+	}
+	var data []byte
+	var err error
+	if notifyFile == "-" {
+		data, err = ioutil.ReadAll(os.Stdin)
+	} else {
+		data, err = ioutil.ReadFile(notifyFile)
+	}
+	if err != nil {
+		return err
+	}
+	params, err := parseNotify(data)
+	if err != nil {
+		return err
+	}
+	if notifyWithdraw {
+		if r.Withdraw(notifyCode) == nil {
+			return errWithdrawNotFound
+		}
+		ret, _ := r.WithdrawNotify(notifyCode, params)
+		return printJSON(ret)
+	} else if r.Payment(notifyCode) == nil {
+		return payment.ErrPaymentNotFound
+	}
+	return printJSON(r.Notify(notifyCode, params))
+}
+
+//解析通知内容,XML整体作为request_post_body传递
+func parseNotify(data []byte) (map[string]string, error) {
+	data = bytes.TrimSpace(data)
+	if len(data) < 1 {
+		return nil, errors.New("通知内容为空")
+	}
+	switch data[0] {
+	case '{':
+		params := map[string]string{}
+		if err := json.Unmarshal(data, &params); err != nil {
+			return nil, err
+		}
+		return params, nil
+	case '<':
+		return map[string]string{"request_post_body": string(data)}, nil
+	}
+	values, err := url.ParseQuery(string(data))
+	if err != nil {
+		return nil, err
+	}
+	params := map[string]string{}
+	for k := range values {
+		params[k] = values.Get(k)
+	}
+	return params, nil
+}
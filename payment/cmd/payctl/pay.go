@@ -0,0 +1,43 @@
+package main
+
+import (
+	"flag"
+
+	"github.com/kinwyb/golang/payment"
+)
+
+var payReq = &payment.PayRequest{}
+
+func init() {
+	fs := flag.NewFlagSet("pay", flag.ContinueOnError)
+	fs.StringVar(&payReq.PayCode, "code", "", "支付方式编码")
+	fs.StringVar(&payReq.No, "no", "", "交易单号")
+	fs.StringVar(&payReq.Desc, "desc", "", "交易描述")
+	fs.Float64Var(&payReq.Money, "money", 0, "交易金额")
+	fs.BoolVar(&payReq.IsApp, "app", false, "是否是APP支付")
+	fs.StringVar(&payReq.IP, "ip", "127.0.0.1", "交易发起端IP")
+	fs.StringVar(&payReq.MemberID, "member", "", "商户网站用户唯一标识")
+	fs.StringVar(&payReq.Ext, "ext", "", "支付方式扩展内容,json字符串")
+	fs.StringVar(&payReq.Currency, "currency", "", "交易币种,为空表示CNY")
+	commands = append(commands, &command{
+		Name:      "pay",
+		UsageLine: "pay -code=\"\" -no=\"\" -money=0 [-desc=\"\"] [-app] [-ip=\"\"] [-member=\"\"] [-ext=\"\"] [-currency=\"\"]",
+		Short:     "发起支付,输出支付代码(表单/跳转地址/APP参数)",
+		Flag:      fs,
+		Run:       runPay,
+	})
+}
+
+func runPay(c *command, r *payment.Registry, args []string) error {
+	if err := c.required("code", "no", "money"); err != nil {
+		return err
+	}
+	if payReq.Desc == "" {
+		payReq.Desc = payReq.No
+	}
+	ret, err := r.Pay(payReq.PayCode, payReq)
+	if err != nil {
+		return err
+	}
+	return printJSON(map[string]string{"code": payReq.PayCode, "no": payReq.No, "result": ret})
+}
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"flag"
+
+	"github.com/kinwyb/golang/payment"
+)
+
+var (
+	queryCode string
+	queryNo   string
+	queryDate string
+	closeCode string
+	closeNo   string
+)
+
+func init() {
+	fs := flag.NewFlagSet("query", flag.ContinueOnError)
+	fs.StringVar(&queryCode, "code", "", "支付方式编码")
+	fs.StringVar(&queryNo, "no", "", "交易单号")
+	fs.StringVar(&queryDate, "date", "", "交易日期[yyyy-MM-dd],部分支付方式需要")
+	commands = append(commands, &command{
+		Name:      "query",
+		UsageLine: "query -code=\"\" -no=\"\" [-date=\"\"]",
+		Short:     "查询支付结果",
+		Long:      "支持的支付方式驱动:alipay、wxpay(开启RawTradeNo时订单不存在才视为未支付)、unionpay(需要-date)",
+		Flag:      fs,
+		Run:       runQuery,
+	})
+	fs = flag.NewFlagSet("close", flag.ContinueOnError)
+	fs.StringVar(&closeCode, "code", "", "支付方式编码")
+	fs.StringVar(&closeNo, "no", "", "交易单号")
+	commands = append(commands, &command{
+		Name:      "close",
+		UsageLine: "close -code=\"\" -no=\"\"",
+		Short:     "关闭未支付的交易",
+		Long:      "支持的支付方式驱动:alipay、wxpay",
+		Flag:      fs,
+		Run:       runClose,
+	})
+}
+
+func runQuery(c *command, r *payment.Registry, args []string) error {
+	if err := c.required("code", "no"); err != nil {
+		return err
+	} else if r.Payment(queryCode) == nil {
+		return payment.ErrPaymentNotFound
+	}
+	date, err := parseDate(queryDate)
+	if err != nil {
+		return err
+	}
+	return printJSON(r.Query(queryCode, queryNo, date...))
+}
+
+func runClose(c *command, r *payment.Registry, args []string) error {
+	if err := c.required("code", "no"); err != nil {
+		return err
+	}
+	if err := r.Close(closeCode, closeNo); err != nil {
+		return err
+	}
+	return printJSON(map[string]interface{}{"code": closeCode, "no": closeNo, "closed": true})
+}
@@ -0,0 +1,43 @@
+package main
+
+import (
+	"flag"
+
+	"github.com/kinwyb/golang/payment"
+)
+
+var (
+	refundCode string
+	refundReq  = &payment.RefundRequest{}
+)
+
+func init() {
+	fs := flag.NewFlagSet("refund", flag.ContinueOnError)
+	fs.StringVar(&refundCode, "code", "", "支付方式编码")
+	fs.StringVar(&refundReq.No, "no", "", "原交易单号")
+	fs.StringVar(&refundReq.RefundNo, "refund-no", "", "退款单号")
+	fs.StringVar(&refundReq.ThirdTradeNo, "third-no", "", "原交易第三方交易流水号")
+	fs.Float64Var(&refundReq.Money, "money", 0, "退款金额")
+	fs.Float64Var(&refundReq.TotalMoney, "total", 0, "原交易金额,为空使用退款金额")
+	fs.StringVar(&refundReq.Reason, "reason", "", "退款原因")
+	commands = append(commands, &command{
+		Name:      "refund",
+		UsageLine: "refund -code=\"\" -no=\"\" -refund-no=\"\" -money=0 [-third-no=\"\"] [-total=0] [-reason=\"\"]",
+		Short:     "发起退款",
+		Long:      "支持的支付方式驱动:alipay、unionpay(需要-third-no),wxpay退款需要商户证书,暂不支持",
+		Flag:      fs,
+		Run:       runRefund,
+	})
+}
+
+func runRefund(c *command, r *payment.Registry, args []string) error {
+	if err := c.required("code", "no", "refund-no", "money"); err != nil {
+		return err
+	} else if r.Payment(refundCode) == nil {
+		return payment.ErrPaymentNotFound
+	}
+	if refundReq.TotalMoney <= 0 {
+		refundReq.TotalMoney = refundReq.Money
+	}
+	return printJSON(r.Refund(refundCode, refundReq))
+}
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"errors"
+	"flag"
+
+	"github.com/kinwyb/golang/payment"
+)
+
+//提现方式不存在
+var errWithdrawNotFound = errors.New("提现方式不存在")
+
+var (
+	withdrawCode      string
+	withdrawInfo      = &payment.WithdrawInfo{}
+	withdrawQueryCode string
+	withdrawQueryNo   string
+	withdrawQueryDate string
+)
+
+func init() {
+	fs := flag.NewFlagSet("withdraw", flag.ContinueOnError)
+	fs.StringVar(&withdrawCode, "code", "", "提现方式编码")
+	fs.StringVar(&withdrawInfo.TradeNo, "no", "", "交易流水号")
+	fs.StringVar(&withdrawInfo.UserName, "name", "", "收款人姓名")
+	fs.StringVar(&withdrawInfo.CardNo, "card", "", "收款账户")
+	fs.StringVar(&withdrawInfo.CertID, "cert", "", "收款人身份证号")
+	fs.StringVar(&withdrawInfo.OpenBank, "bank", "", "开户银行名称")
+	fs.StringVar(&withdrawInfo.Prov, "prov", "", "开户银行所在省份")
+	fs.StringVar(&withdrawInfo.City, "city", "", "开户银行所在地区")
+	fs.Float64Var(&withdrawInfo.Money, "money", 0, "提现金额")
+	fs.StringVar(&withdrawInfo.Desc, "desc", "", "描述")
+	fs.StringVar(&withdrawInfo.IP, "ip", "127.0.0.1", "提现的IP地址")
+	fs.BoolVar(&withdrawInfo.People, "people", true, "是个人,否企业")
+	fs.StringVar(&withdrawInfo.Currency, "currency", "", "提现币种,为空表示CNY")
+	commands = append(commands, &command{
+		Name:      "withdraw",
+		UsageLine: "withdraw -code=\"\" -no=\"\" -name=\"\" -card=\"\" -money=0 [-cert=\"\"] [-bank=\"\"] [-prov=\"\"] [-city=\"\"] [-desc=\"\"] [-people=true]",
+		Short:     "发起提现,同一交易流水号重试前先使用withdraw-query确认结果",
+		Flag:      fs,
+		Run:       runWithdraw,
+	})
+	fs = flag.NewFlagSet("withdraw-query", flag.ContinueOnError)
+	fs.StringVar(&withdrawQueryCode, "code", "", "提现方式编码")
+	fs.StringVar(&withdrawQueryNo, "no", "", "交易流水号")
+	fs.StringVar(&withdrawQueryDate, "date", "", "交易日期[yyyy-MM-dd],部分提现方式需要")
+	commands = append(commands, &command{
+		Name:      "withdraw-query",
+		UsageLine: "withdraw-query -code=\"\" -no=\"\" [-date=\"\"]",
+		Short:     "查询提现结果",
+		Flag:      fs,
+		Run:       runWithdrawQuery,
+	})
+}
+
+func runWithdraw(c *command, r *payment.Registry, args []string) error {
+	if err := c.required("code", "no", "name", "card", "money"); err != nil {
+		return err
+	} else if r.Withdraw(withdrawCode) == nil {
+		return errWithdrawNotFound
+	}
+	return printJSON(r.DoWithdraw(withdrawCode, withdrawInfo))
+}
+
+func runWithdrawQuery(c *command, r *payment.Registry, args []string) error {
+	if err := c.required("code", "no"); err != nil {
+		return err
+	} else if r.Withdraw(withdrawQueryCode) == nil {
+		return errWithdrawNotFound
+	}
+	date, err := parseDate(withdrawQueryDate)
+	if err != nil {
+		return err
+	}
+	return printJSON(r.QueryWithdraw(withdrawQueryCode, withdrawQueryNo, date...))
+}
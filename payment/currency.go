@@ -0,0 +1,68 @@
+package payment
+
+import (
+	"errors"
+	"math"
+	"strings"
+)
+
+//CNY 人民币,币种为空时默认为人民币
+const CNY = "CNY"
+
+//ErrCurrencyNotSupport 交易币种不支持
+var ErrCurrencyNotSupport = errors.New("交易币种不支持")
+
+//无小数位的币种,金额最小单位即为元
+var zeroDecimalCurrencies = map[string]bool{
+	"JPY": true, "KRW": true, "VND": true, "CLP": true, "ISK": true,
+}
+
+//Currencies 支持的交易币种接口,未实现该接口的支付方式只支持人民币
+type Currencies interface {
+	Currencies() []string //支持的交易币种(ISO 4217)
+}
+
+//Currency 标准化币种编码,为空时返回CNY
+func Currency(currency string) string {
+	currency = strings.ToUpper(strings.TrimSpace(currency))
+	if currency == "" {
+		return CNY
+	}
+	return currency
+}
+
+//IsCNY 是否是人民币交易
+func IsCNY(currency string) bool {
+	return Currency(currency) == CNY
+}
+
+//SupportCurrency 判断支付/提现方式是否支持指定币种
+func SupportCurrency(p interface{}, currency string) bool {
+	currency = Currency(currency)
+	c, ok := p.(Currencies)
+	if !ok {
+		return currency == CNY
+	}
+	for _, v := range c.Currencies() {
+		if Currency(v) == currency {
+			return true
+		}
+	}
+	return false
+}
+
+//ToMinorUnit 金额转换成币种最小单位(分),无小数位的币种不转换
+func ToMinorUnit(money float64, currency string) int64 {
+	if zeroDecimalCurrencies[Currency(currency)] {
+		return int64(math.Round(money))
+	}
+	return int64(math.Round(money * 100))
+}
+
+//FromMinorUnit 币种最小单位金额转换成元
+func FromMinorUnit(amount int64, currency string) float64 {
+	if zeroDecimalCurrencies[Currency(currency)] {
+		return float64(amount)
+	}
+	return float64(amount) / 100
+}
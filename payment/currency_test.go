@@ -0,0 +1,30 @@
+package payment
+
+import (
+	"testing"
+
+	"github.com/smartystreets/goconvey/convey"
+)
+
+type testCurrencies []string
+
+func (t testCurrencies) Currencies() []string {
+	return t
+}
+
+func Test_Currency(t *testing.T) {
+	convey.Convey("交易币种", t, func() {
+		convey.So(Currency(""), convey.ShouldEqual, CNY)
+		convey.So(Currency(" usd"), convey.ShouldEqual, "USD")
+		convey.So(IsCNY(""), convey.ShouldBeTrue)
+		convey.So(IsCNY("HKD"), convey.ShouldBeFalse)
+		convey.So(SupportCurrency(nil, ""), convey.ShouldBeTrue)
+		convey.So(SupportCurrency(nil, "USD"), convey.ShouldBeFalse)
+		convey.So(SupportCurrency(testCurrencies{"CNY", "usd"}, "USD"), convey.ShouldBeTrue)
+		convey.So(SupportCurrency(testCurrencies{"CNY", "usd"}, "JPY"), convey.ShouldBeFalse)
+		convey.So(ToMinorUnit(0.29, "CNY"), convey.ShouldEqual, 29)
+		convey.So(ToMinorUnit(100, "JPY"), convey.ShouldEqual, 100)
+		convey.So(FromMinorUnit(29, ""), convey.ShouldEqual, 0.29)
+		convey.So(FromMinorUnit(100, "jpy"), convey.ShouldEqual, 100)
+	})
+}
@@ -0,0 +1,271 @@
+package expire
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/kinwyb/golang/payment"
+	"github.com/kinwyb/golang/payment/store"
+	"github.com/kinwyb/golang/utils"
+)
+
+//订单超时自动关闭
+//	1. Track 订单创建后登记超时时间,任务保存到Store
+//	2. Check/Run 处理到期任务:先查询处理中的支付请求,捕获超时前最后时刻的支付;未支付时在第三方关闭交易后关闭订单
+//	3. 查询失败、关闭失败或支付方式不支持查询/关闭交易时按RetryDelay递增重试,
+//	超过MaxRetries后只关闭本地订单,之后到达的支付按重复支付处理
+//	事件在任务状态保存前发送,进程异常退出时可能重复发送,Listener需要按订单号幂等处理
+
+//事件类型
+const (
+	EventPaid    = "paid"    //订单已支付
+	EventExpired = "expired" //订单超时已关闭
+)
+
+//Event 超时处理事件
+type Event struct {
+	Type   string             //事件类型
+	Order  *store.Order       //订单
+	Result *payment.PayResult //查询到的支付结果,订单由异步通知更新为已支付时为空
+}
+
+//Config 超时处理配置
+type Config struct {
+	Registry   *payment.Registry //支付注册中心
+	Orders     Orders            //订单存储
+	Store      Store             //任务存储
+	Listener   func(e *Event)    //事件监听
+	Logger     utils.Logger      //日志
+	Expire     time.Duration     //默认超时时间,默认30分钟
+	RetryDelay time.Duration     //重试间隔,第n次重试间隔n*RetryDelay,默认1分钟
+	MaxRetries int               //第三方交易状态无法确认时最大重试次数,默认5
+	BatchSize  int               //每次处理数量,默认100
+	Clock      func() time.Time  //时钟,默认time.Now
+}
+
+//默认值
+func (c *Config) defaults() {
+	if c.Expire <= 0 {
+		c.Expire = 30 * time.Minute
+	}
+	if c.RetryDelay <= 0 {
+		c.RetryDelay = time.Minute
+	}
+	if c.MaxRetries < 1 {
+		c.MaxRetries = 5
+	}
+	if c.BatchSize < 1 {
+		c.BatchSize = 100
+	}
+	if c.Clock == nil {
+		c.Clock = time.Now
+	}
+}
+
+//Scheduler 订单超时处理
+type Scheduler struct {
+	cfg  *Config
+	lock sync.Mutex
+}
+
+//New 创建订单超时处理
+func New(c *Config) *Scheduler {
+	c.defaults()
+	return &Scheduler{cfg: c}
+}
+
+//Track 登记订单超时时间,expireAt为零值时使用默认超时时间
+func (s *Scheduler) Track(no string, expireAt time.Time) error {
+	if no == "" {
+		return errors.New("订单号不能为空")
+	}
+	now := s.cfg.Clock()
+	if expireAt.IsZero() {
+		expireAt = now.Add(s.cfg.Expire)
+	}
+	return s.cfg.Store.Track(&Task{
+		No:         no,
+		ExpireTime: expireAt,
+		Status:     TaskWaiting,
+		NextTime:   expireAt,
+		Created:    now,
+		Updated:    now,
+	})
+}
+
+//Check 处理到期任务,返回处理完成的数量,同一时间只有一个Check执行
+func (s *Scheduler) Check() (int, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	tasks, err := s.cfg.Store.Due(s.cfg.Clock(), s.cfg.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+	done := 0
+	for _, t := range tasks {
+		s.process(t)
+		t.Updated = s.cfg.Clock()
+		if err := s.cfg.Store.Update(t); err != nil {
+			return done, err
+		} else if t.Status != TaskWaiting {
+			done++
+		}
+	}
+	return done, nil
+}
+
+//Run 每隔interval处理一次到期任务,stop关闭后退出
+func (s *Scheduler) Run(interval time.Duration, stop <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if _, err := s.Check(); err != nil {
+			s.log(utils.LogLevelError, "订单超时处理失败:%s", err.Error())
+		}
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+//处理任务,结果写入任务状态
+func (s *Scheduler) process(t *Task) {
+	o, err := s.cfg.Orders.GetOrder(t.No)
+	if err != nil {
+		s.retry(t, err)
+		return
+	} else if o == nil {
+		s.finish(t, TaskCanceled, "订单不存在")
+		return
+	}
+	switch o.Status {
+	case payment.SUCCESS:
+		s.paid(t, o, nil)
+		return
+	case payment.FAIL:
+		s.finish(t, TaskCanceled, "订单已关闭")
+		return
+	}
+	attempts, err := s.cfg.Orders.Attempts(t.No)
+	if err != nil {
+		s.retry(t, err)
+		return
+	}
+	var pending error            //第三方交易状态无法确认的原因
+	for _, a := range attempts { //查询超时前最后时刻的支付
+		if a.Status != payment.DEALING {
+			continue
+		} else if _, ok := s.cfg.Registry.Payment(a.PayCode).(payment.Query); !ok {
+			pending = fmt.Errorf("%s不支持查询交易", a.PayCode)
+			continue
+		}
+		ret := s.cfg.Registry.Query(a.PayCode, a.TradeNo, a.Created)
+		if ret == nil || (!ret.Succ && ret.Navite == nil) { //查询失败,支付状态未知
+			pending = fmt.Errorf("%s查询交易[%s]失败:%s", a.PayCode, a.TradeNo, queryErrMsg(ret))
+			s.log(utils.LogLevelError, "订单[%s]超时%s", t.No, pending.Error())
+			continue
+		} else if !ret.Succ {
+			continue
+		}
+		ret.PayCode = a.PayCode
+		if ret.TradeNo == "" {
+			ret.TradeNo = a.TradeNo
+		}
+		if ret.No == "" {
+			ret.No = a.OrderNo
+		}
+		order, err := s.cfg.Orders.ApplyPayResult(ret)
+		if order == nil {
+			s.retry(t, err)
+			return
+		} else if err != nil {
+			s.log(utils.LogLevelError, "订单[%s]超时查询支付结果更新异常:%s", t.No, err.Error())
+		}
+		s.paid(t, order, ret)
+		return
+	}
+	if pending != nil && t.Retries < s.cfg.MaxRetries { //支付状态未知时不关闭交易
+		s.retry(t, pending)
+		return
+	}
+	for _, a := range attempts { //第三方关闭交易
+		if a.Status != payment.DEALING {
+			continue
+		} else if _, ok := s.cfg.Registry.Payment(a.PayCode).(payment.Close); !ok {
+			pending = fmt.Errorf("%s不支持关闭交易", a.PayCode)
+			continue
+		}
+		if err := s.cfg.Registry.Close(a.PayCode, a.TradeNo); err != nil {
+			s.log(utils.LogLevelError, "订单[%s]超时关闭%s交易[%s]失败:%s", t.No, a.PayCode, a.TradeNo, err.Error())
+			pending = err
+		} else if err = s.cfg.Orders.FailAttempt(a.ID, "订单超时,交易已关闭"); err != nil {
+			s.log(utils.LogLevelError, "订单[%s]超时关闭后更新%s交易[%s]状态失败:%s", t.No, a.PayCode, a.TradeNo, err.Error())
+		}
+	}
+	if pending != nil {
+		if t.Retries < s.cfg.MaxRetries {
+			s.retry(t, pending)
+			return
+		}
+		s.log(utils.LogLevelError, "订单[%s]第三方交易状态无法确认且超过重试次数,关闭本地订单:%s", t.No, pending.Error())
+	}
+	if err = s.cfg.Orders.CloseOrder(t.No); err != nil {
+		if o, e := s.cfg.Orders.GetOrder(t.No); e == nil && o != nil && o.Status == payment.SUCCESS { //关闭时收到支付通知
+			s.paid(t, o, nil)
+			return
+		}
+		s.retry(t, err)
+		return
+	}
+	o.Status = payment.FAIL
+	s.emit(&Event{Type: EventExpired, Order: o})
+	s.finish(t, TaskExpired, "")
+	if pending != nil {
+		t.LastError = pending.Error()
+	}
+}
+
+//查询失败原因
+func queryErrMsg(ret *payment.PayResult) string {
+	if ret == nil {
+		return "查询结果为空"
+	}
+	return ret.ErrMsg
+}
+
+//订单已支付
+func (s *Scheduler) paid(t *Task, o *store.Order, ret *payment.PayResult) {
+	s.emit(&Event{Type: EventPaid, Order: o, Result: ret})
+	s.finish(t, TaskPaid, "")
+}
+
+//任务完成
+func (s *Scheduler) finish(t *Task, status TaskStatus, msg string) {
+	t.Status = status
+	t.LastError = msg
+}
+
+//任务重试
+func (s *Scheduler) retry(t *Task, err error) {
+	t.Retries++
+	t.NextTime = s.cfg.Clock().Add(time.Duration(t.Retries) * s.cfg.RetryDelay)
+	if err != nil {
+		t.LastError = err.Error()
+	}
+}
+
+//发送事件
+func (s *Scheduler) emit(e *Event) {
+	if s.cfg.Listener != nil {
+		s.cfg.Listener(e)
+	}
+}
+
+//日志输出
+func (s *Scheduler) log(level utils.LoggerLevel, format string, args ...interface{}) {
+	utils.WriteLog(s.cfg.Logger, level, format, args...)
+}
@@ -0,0 +1,232 @@
+package expire
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kinwyb/golang/payment"
+	"github.com/kinwyb/golang/payment/store"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+type expireTestPay struct {
+	code     string
+	paid     map[string]bool
+	queryErr int
+	closeErr int
+	closed   []string
+}
+
+func (p *expireTestPay) Pay(req *payment.PayRequest) (string, error)                  { return "", nil }
+func (p *expireTestPay) PayConfirm(req *payment.PayConfirmRequest) *payment.PayResult { return nil }
+func (p *expireTestPay) Notify(params map[string]string) *payment.PayResult           { return nil }
+func (p *expireTestPay) NotifyResult(ret *payment.PayResult) string                   { return "" }
+func (p *expireTestPay) Result(params map[string]string) *payment.PayResult           { return nil }
+func (p *expireTestPay) Code() string                                                 { return p.code }
+func (p *expireTestPay) Name() string                                                 { return p.code }
+func (p *expireTestPay) Start() bool                                                  { return true }
+
+func (p *expireTestPay) Query(tradeno string, tradeDate ...time.Time) *payment.PayResult {
+	if p.queryErr > 0 {
+		p.queryErr--
+		return &payment.PayResult{TradeNo: tradeno, ErrMsg: "请求超时"}
+	}
+	return &payment.PayResult{Succ: p.paid[tradeno], TradeNo: tradeno, ThirdTradeNo: "T" + tradeno, Money: 10,
+		Navite: map[string]string{"out_trade_no": tradeno}}
+}
+
+//不支持查询和关闭交易的支付方式
+type expireTestNoQueryPay struct {
+	expireTestPay
+}
+
+func (p *expireTestNoQueryPay) Query() {}
+func (p *expireTestNoQueryPay) Close() {}
+
+func (p *expireTestPay) Close(tradeno string) error {
+	if p.closeErr > 0 {
+		p.closeErr--
+		return &payment.RequestFailed{Err: errors.New("请求超时")}
+	}
+	p.closed = append(p.closed, tradeno)
+	return nil
+}
+
+type expireTestOrders struct {
+	orders   map[string]*store.Order
+	attempts map[string][]*store.Attempt
+}
+
+func (o *expireTestOrders) GetOrder(no string) (*store.Order, error) {
+	if v, ok := o.orders[no]; ok {
+		ret := *v
+		return &ret, nil
+	}
+	return nil, nil
+}
+func (o *expireTestOrders) Attempts(no string) ([]*store.Attempt, error) { return o.attempts[no], nil }
+func (o *expireTestOrders) ApplyPayResult(ret *payment.PayResult) (*store.Order, error) {
+	order := o.orders[ret.No]
+	order.Status = payment.SUCCESS
+	order.PayCode = ret.PayCode
+	order.TradeNo = ret.TradeNo
+	v := *order
+	return &v, nil
+}
+func (o *expireTestOrders) FailAttempt(id int64, errMsg string) error {
+	for _, v := range o.attempts {
+		for _, a := range v {
+			if a.ID == id && a.Status == payment.DEALING {
+				a.Status = payment.FAIL
+				a.ErrMsg = errMsg
+			}
+		}
+	}
+	return nil
+}
+func (o *expireTestOrders) CloseOrder(no string) error {
+	if o.orders[no].Status != payment.DEALING {
+		return store.ErrStatusTransition
+	}
+	o.orders[no].Status = payment.FAIL
+	return nil
+}
+
+func Test_Scheduler(t *testing.T) {
+	convey.Convey("订单超时", t, func() {
+		registry := payment.NewRegistry()
+		p := &expireTestPay{code: "test", paid: map[string]bool{"002": true}, closeErr: 2}
+		registry.SetPayment(p)
+		orders := &expireTestOrders{orders: map[string]*store.Order{}, attempts: map[string][]*store.Attempt{}}
+		for i, no := range []string{"001", "002", "003", "004"} {
+			orders.orders[no] = &store.Order{No: no, Money: 10, Status: payment.DEALING}
+			orders.attempts[no] = []*store.Attempt{{ID: int64(i + 1), OrderNo: no, PayCode: "test", TradeNo: no, Status: payment.DEALING}}
+		}
+		orders.orders["003"].Status = payment.SUCCESS
+		now := time.Date(2017, 10, 19, 10, 0, 0, 0, time.Local)
+		tasks := NewMemoryStore()
+		var events []*Event
+		s := New(&Config{
+			Registry:   registry,
+			Orders:     orders,
+			Store:      tasks,
+			Listener:   func(e *Event) { events = append(events, e) },
+			RetryDelay: time.Minute,
+			MaxRetries: 3,
+			Clock:      func() time.Time { return now },
+		})
+		convey.So(s.Track("", time.Time{}), convey.ShouldNotBeNil)
+		for _, no := range []string{"001", "002", "003"} {
+			convey.So(s.Track(no, time.Time{}), convey.ShouldBeNil)
+		}
+		convey.So(s.Track("004", now.Add(time.Hour)), convey.ShouldBeNil)
+		convey.So(s.Track("001", now.Add(10*time.Minute)), convey.ShouldBeNil)
+		task, _ := tasks.Get("001")
+		convey.So(task.ExpireTime, convey.ShouldResemble, now.Add(10*time.Minute))
+
+		n, err := s.Check()
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(n, convey.ShouldEqual, 0)
+
+		now = now.Add(30 * time.Minute)
+		n, err = s.Check()
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(n, convey.ShouldEqual, 2)
+		convey.So(len(events), convey.ShouldEqual, 2)
+		convey.So(events[0].Type, convey.ShouldEqual, EventPaid)
+		convey.So(events[0].Order.No, convey.ShouldEqual, "002")
+		convey.So(events[0].Result.ThirdTradeNo, convey.ShouldEqual, "T002")
+		convey.So(events[1].Type, convey.ShouldEqual, EventPaid)
+		convey.So(events[1].Order.No, convey.ShouldEqual, "003")
+		convey.So(events[1].Result, convey.ShouldBeNil)
+		task, _ = tasks.Get("001")
+		convey.So(task.Status, convey.ShouldEqual, TaskWaiting)
+		convey.So(task.Retries, convey.ShouldEqual, 1)
+		convey.So(task.NextTime, convey.ShouldResemble, now.Add(time.Minute))
+		convey.So(orders.orders["001"].Status, convey.ShouldEqual, payment.DEALING)
+
+		now = now.Add(time.Minute)
+		n, _ = s.Check()
+		convey.So(n, convey.ShouldEqual, 0)
+		task, _ = tasks.Get("001")
+		convey.So(task.Retries, convey.ShouldEqual, 2)
+		now = now.Add(2 * time.Minute)
+		n, _ = s.Check()
+		convey.So(n, convey.ShouldEqual, 1)
+		convey.So(p.closed, convey.ShouldResemble, []string{"001"})
+		convey.So(orders.orders["001"].Status, convey.ShouldEqual, payment.FAIL)
+		convey.So(orders.attempts["001"][0].Status, convey.ShouldEqual, payment.FAIL)
+		convey.So(orders.attempts["004"][0].Status, convey.ShouldEqual, payment.DEALING)
+		convey.So(events[2].Type, convey.ShouldEqual, EventExpired)
+		convey.So(events[2].Order.Status, convey.ShouldEqual, payment.FAIL)
+		task, _ = tasks.Get("001")
+		convey.So(task.Status, convey.ShouldEqual, TaskExpired)
+
+		delete(orders.orders, "004")
+		now = now.Add(time.Hour)
+		n, _ = s.Check()
+		convey.So(n, convey.ShouldEqual, 1)
+		task, _ = tasks.Get("004")
+		convey.So(task.Status, convey.ShouldEqual, TaskCanceled)
+		convey.So(len(events), convey.ShouldEqual, 3)
+		convey.So(s.Track("001", time.Time{}), convey.ShouldBeNil)
+		task, _ = tasks.Get("001")
+		convey.So(task.Status, convey.ShouldEqual, TaskExpired)
+	})
+	convey.Convey("超过重试次数关闭本地订单", t, func() {
+		registry := payment.NewRegistry()
+		p := &expireTestPay{code: "test", closeErr: 10}
+		registry.SetPayment(p)
+		orders := &expireTestOrders{
+			orders:   map[string]*store.Order{"001": {No: "001", Status: payment.DEALING}},
+			attempts: map[string][]*store.Attempt{"001": {{ID: 1, OrderNo: "001", PayCode: "test", TradeNo: "001", Status: payment.DEALING}}},
+		}
+		now := time.Now()
+		s := New(&Config{Registry: registry, Orders: orders, Store: NewMemoryStore(), MaxRetries: 1, Clock: func() time.Time { return now }})
+		s.Track("001", now)
+		n, _ := s.Check()
+		convey.So(n, convey.ShouldEqual, 0)
+		now = now.Add(time.Minute)
+		n, _ = s.Check()
+		convey.So(n, convey.ShouldEqual, 1)
+		convey.So(orders.orders["001"].Status, convey.ShouldEqual, payment.FAIL)
+		convey.So(orders.attempts["001"][0].Status, convey.ShouldEqual, payment.DEALING) //第三方交易未关闭,等待渠道结果
+	})
+	convey.Convey("查询失败或不支持查询时重试", t, func() {
+		registry := payment.NewRegistry()
+		p := &expireTestPay{code: "test", queryErr: 1}
+		registry.SetPayment(p)
+		registry.SetPayment(&expireTestNoQueryPay{expireTestPay{code: "noquery"}})
+		orders := &expireTestOrders{
+			orders: map[string]*store.Order{"001": {No: "001", Status: payment.DEALING}, "002": {No: "002", Status: payment.DEALING}},
+			attempts: map[string][]*store.Attempt{
+				"001": {{ID: 1, OrderNo: "001", PayCode: "test", TradeNo: "001", Status: payment.DEALING}},
+				"002": {{ID: 2, OrderNo: "002", PayCode: "noquery", TradeNo: "002", Status: payment.DEALING}},
+			},
+		}
+		now := time.Now()
+		tasks := NewMemoryStore()
+		s := New(&Config{Registry: registry, Orders: orders, Store: tasks, MaxRetries: 2, Clock: func() time.Time { return now }})
+		s.Track("001", now)
+		s.Track("002", now)
+		n, _ := s.Check()
+		convey.So(n, convey.ShouldEqual, 0)
+		convey.So(p.closed, convey.ShouldBeEmpty)
+		convey.So(orders.orders["001"].Status, convey.ShouldEqual, payment.DEALING)
+		task, _ := tasks.Get("002")
+		convey.So(task.LastError, convey.ShouldEqual, "noquery不支持查询交易")
+		now = now.Add(time.Minute)
+		n, _ = s.Check()
+		convey.So(n, convey.ShouldEqual, 1)
+		convey.So(p.closed, convey.ShouldResemble, []string{"001"})
+		convey.So(orders.orders["001"].Status, convey.ShouldEqual, payment.FAIL)
+		convey.So(orders.orders["002"].Status, convey.ShouldEqual, payment.DEALING)
+		now = now.Add(2 * time.Minute)
+		n, _ = s.Check()
+		convey.So(n, convey.ShouldEqual, 1)
+		convey.So(orders.orders["002"].Status, convey.ShouldEqual, payment.FAIL)
+		task, _ = tasks.Get("002")
+		convey.So(task.Status, convey.ShouldEqual, TaskExpired)
+	})
+}
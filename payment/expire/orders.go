@@ -0,0 +1,25 @@
+package expire
+
+import (
+	"github.com/kinwyb/golang/payment"
+	"github.com/kinwyb/golang/payment/store"
+)
+
+//Orders 超时处理使用的订单存储,使用支付数据存储时为store.NewOrderAdapter
+type Orders interface {
+	//GetOrder 查询订单,不存在返回nil
+	GetOrder(no string) (*store.Order, error)
+	//Attempts 订单的所有支付请求
+	Attempts(no string) ([]*store.Attempt, error)
+	//ApplyPayResult 根据支付结果更新订单,订单重复支付时同时返回订单和错误
+	ApplyPayResult(ret *payment.PayResult) (*store.Order, error)
+	//CloseOrder 关闭未支付的订单
+	CloseOrder(no string) error
+	//FailAttempt 第三方交易关闭后标记支付请求失败
+	FailAttempt(id int64, errMsg string) error
+}
+
+//StoreOrders 使用支付数据存储作为超时处理订单存储
+func StoreOrders(s *store.Store) Orders {
+	return store.NewOrderAdapter(s)
+}
@@ -0,0 +1,130 @@
+package expire
+
+import (
+	"strings"
+	"time"
+
+	"github.com/kinwyb/golang/gosql"
+)
+
+const timeFormat = "2006-01-02 15:04:05"
+
+//Schema 超时任务表结构(MySQL),{expire}替换为表名
+const Schema = `CREATE TABLE IF NOT EXISTS {expire} (
+  no VARCHAR(64) NOT NULL COMMENT '订单号',
+  expire_time DATETIME NOT NULL COMMENT '超时时间',
+  status VARCHAR(16) NOT NULL COMMENT '状态 WAITING:等待 PAID:已支付 EXPIRED:已超时关闭 CANCELED:已取消',
+  retries INT NOT NULL DEFAULT 0 COMMENT '处理失败重试次数',
+  next_time DATETIME NOT NULL COMMENT '下次处理时间',
+  last_error VARCHAR(255) NOT NULL DEFAULT '' COMMENT '最后一次处理错误',
+  created DATETIME NOT NULL COMMENT '创建时间',
+  updated DATETIME NOT NULL COMMENT '更新时间',
+  PRIMARY KEY (no),
+  KEY idx_due (status, next_time)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='订单超时任务';`
+
+const taskColumns = "no,expire_time,status,retries,next_time,last_error,created,updated"
+
+//SQLStore 数据库任务存储
+type SQLStore struct {
+	db    gosql.SQL
+	table string
+}
+
+//NewSQLStore 创建数据库任务存储,table为空时使用pay_expire
+func NewSQLStore(db gosql.SQL, table string) *SQLStore {
+	if table == "" {
+		table = "pay_expire"
+	}
+	return &SQLStore{db: db, table: table}
+}
+
+//CreateTable 创建任务表
+func (s *SQLStore) CreateTable() error {
+	if _, err := s.db.Exec(strings.Replace(Schema, "{expire}", s.table, -1)); err != nil {
+		return err
+	}
+	return nil
+}
+
+//Track 新增任务,订单号已存在且等待中时更新超时时间
+func (s *SQLStore) Track(t *Task) error {
+	_, err := s.db.Exec("INSERT INTO "+s.table+"(no,expire_time,status,retries,next_time,last_error,created,updated) "+
+		"VALUES(?,?,?,?,?,?,?,?) ON DUPLICATE KEY UPDATE "+
+		"expire_time = IF(status = ?,VALUES(expire_time),expire_time),next_time = IF(status = ?,VALUES(next_time),next_time)",
+		t.No, t.ExpireTime, string(t.Status), t.Retries, t.NextTime, cut(t.LastError, 255), t.Created, t.Updated,
+		string(TaskWaiting), string(TaskWaiting))
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+//Due 查询到期任务
+func (s *SQLStore) Due(now time.Time, limit int) ([]*Task, error) {
+	query := "SELECT " + taskColumns + " FROM " + s.table + " WHERE status = ? AND next_time <= ? ORDER BY next_time,no"
+	args := []interface{}{string(TaskWaiting), now}
+	if limit > 0 {
+		query += " LIMIT ?"
+		args = append(args, limit)
+	}
+	return s.list(query, args...)
+}
+
+//Update 更新任务
+func (s *SQLStore) Update(t *Task) error {
+	_, err := s.db.Exec("UPDATE "+s.table+" SET status = ?,retries = ?,next_time = ?,last_error = ?,updated = ? WHERE no = ?",
+		string(t.Status), t.Retries, t.NextTime, cut(t.LastError, 255), t.Updated, t.No)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+//Get 查询任务
+func (s *SQLStore) Get(no string) (*Task, error) {
+	ret, err := s.list("SELECT "+taskColumns+" FROM "+s.table+" WHERE no = ?", no)
+	if err != nil {
+		return nil, err
+	} else if len(ret) < 1 {
+		return nil, ErrTaskNotExists
+	}
+	return ret[0], nil
+}
+
+//查询任务
+func (s *SQLStore) list(query string, args ...interface{}) ([]*Task, error) {
+	rows, err := s.db.Rows(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*Task, 0, len(rows))
+	for _, v := range rows {
+		ret = append(ret, &Task{
+			No:         gosql.StringDefault(v["no"]),
+			ExpireTime: parseTime(v["expire_time"]),
+			Status:     TaskStatus(gosql.StringDefault(v["status"])),
+			Retries:    gosql.IntDefault(v["retries"]),
+			NextTime:   parseTime(v["next_time"]),
+			LastError:  gosql.StringDefault(v["last_error"]),
+			Created:    parseTime(v["created"]),
+			Updated:    parseTime(v["updated"]),
+		})
+	}
+	return ret, nil
+}
+
+//数据库时间
+func parseTime(v interface{}) time.Time {
+	ret, _ := time.ParseInLocation(timeFormat, gosql.StringDefault(v), time.Local)
+	return ret
+}
+
+//截取字符串,避免超出字段长度
+func cut(s string, n int) string {
+	r := []rune(s)
+	if len(r) > n {
+		return string(r[:n])
+	}
+	return s
+}
@@ -0,0 +1,121 @@
+package expire
+
+import (
+	"errors"
+	"sort"
+	"sync"
+	"time"
+)
+
+//TaskStatus 超时任务状态
+type TaskStatus string
+
+const (
+	TaskWaiting  TaskStatus = "WAITING"  //等待超时/重试
+	TaskPaid     TaskStatus = "PAID"     //订单已支付
+	TaskExpired  TaskStatus = "EXPIRED"  //订单超时已关闭
+	TaskCanceled TaskStatus = "CANCELED" //订单不存在或已由其他方式关闭
+)
+
+//ErrTaskNotExists 超时任务不存在
+var ErrTaskNotExists = errors.New("超时任务不存在")
+
+//Task 订单超时任务
+type Task struct {
+	No         string     //订单号
+	ExpireTime time.Time  //超时时间
+	Status     TaskStatus //任务状态
+	Retries    int        //处理失败重试次数
+	NextTime   time.Time  //下次处理时间
+	LastError  string     //最后一次处理错误
+	Created    time.Time  //创建时间
+	Updated    time.Time  //更新时间
+}
+
+//Store 超时任务存储,任务持久化后进程重启可以继续处理
+type Store interface {
+	//Track 新增任务,订单号已存在且未完成时更新超时时间
+	Track(t *Task) error
+	//Due 查询NextTime不晚于now的等待中任务,按NextTime排序
+	Due(now time.Time, limit int) ([]*Task, error)
+	//Update 更新任务状态、重试次数、下次处理时间和错误信息
+	Update(t *Task) error
+	//Get 查询任务,不存在返回ErrTaskNotExists
+	Get(no string) (*Task, error)
+}
+
+//MemoryStore 内存任务存储,只适用于单进程和测试
+type MemoryStore struct {
+	lock  sync.Mutex
+	tasks map[string]*Task
+}
+
+//NewMemoryStore 创建内存任务存储
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{tasks: map[string]*Task{}}
+}
+
+//Track 新增任务
+func (m *MemoryStore) Track(t *Task) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if old, ok := m.tasks[t.No]; ok {
+		if old.Status != TaskWaiting {
+			return nil
+		}
+		old.ExpireTime = t.ExpireTime
+		old.NextTime = t.NextTime
+		old.Updated = t.Updated
+		return nil
+	}
+	v := *t
+	m.tasks[t.No] = &v
+	return nil
+}
+
+//Due 查询到期任务
+func (m *MemoryStore) Due(now time.Time, limit int) ([]*Task, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	ret := []*Task{}
+	for _, t := range m.tasks {
+		if t.Status == TaskWaiting && !t.NextTime.After(now) {
+			v := *t
+			ret = append(ret, &v)
+		}
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		if ret[i].NextTime.Equal(ret[j].NextTime) {
+			return ret[i].No < ret[j].No
+		}
+		return ret[i].NextTime.Before(ret[j].NextTime)
+	})
+	if limit > 0 && len(ret) > limit {
+		ret = ret[:limit]
+	}
+	return ret, nil
+}
+
+//Update 更新任务
+func (m *MemoryStore) Update(t *Task) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.tasks[t.No]; !ok {
+		return ErrTaskNotExists
+	}
+	v := *t
+	m.tasks[t.No] = &v
+	return nil
+}
+
+//Get 查询任务
+func (m *MemoryStore) Get(no string) (*Task, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	t, ok := m.tasks[no]
+	if !ok {
+		return nil, ErrTaskNotExists
+	}
+	v := *t
+	return &v, nil
+}
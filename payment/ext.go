@@ -0,0 +1,78 @@
+package payment
+
+import (
+	"encoding"
+	"encoding/json"
+	"errors"
+	"reflect"
+)
+
+//PayExt 类型化的支付请求扩展信息,各支付方式定义自己的扩展结构
+//	扩展结构实现encoding.TextMarshaler/TextUnmarshaler时Ext保存为文本,否则保存为json字符串
+type PayExt interface {
+	Validate() error //校验扩展信息,支付请求发送前调用
+}
+
+//ExtType 支付方式的扩展信息类型,实现该接口的支付方式在请求发送前校验Ext
+type ExtType interface {
+	NewExt() PayExt //生成一个空的扩展信息对象
+}
+
+//SetExt 设置类型化扩展信息,校验通过后序列化保存到Ext
+func (p *PayRequest) SetExt(ext PayExt) error {
+	if ext == nil {
+		p.Ext = ""
+		return nil
+	} else if err := ext.Validate(); err != nil {
+		return err
+	}
+	if m, ok := ext.(encoding.TextMarshaler); ok {
+		data, err := m.MarshalText()
+		if err != nil {
+			return errors.New("支付扩展信息[Ext]序列化错误:" + err.Error())
+		}
+		p.Ext = string(data)
+		return nil
+	}
+	data, err := json.Marshal(ext)
+	if err != nil {
+		return errors.New("支付扩展信息[Ext]序列化错误:" + err.Error())
+	}
+	p.Ext = string(data)
+	return nil
+}
+
+//DecodeExt 解析Ext到类型化扩展信息并校验,Ext为空时保留ext中的默认值
+func (p *PayRequest) DecodeExt(ext PayExt) error {
+	if u, ok := ext.(encoding.TextUnmarshaler); ok {
+		if err := u.UnmarshalText([]byte(p.Ext)); err != nil {
+			return errors.New("支付扩展信息[Ext]解析错误:" + err.Error())
+		}
+	} else if p.Ext != "" {
+		if err := json.Unmarshal([]byte(p.Ext), ext); err != nil {
+			return errors.New("支付扩展信息[Ext]解析错误:" + err.Error())
+		}
+	}
+	return ext.Validate()
+}
+
+//ValidateRequest 按支付方式能力校验支付请求:必填字段不能为空,实现ExtType时校验扩展信息
+func ValidateRequest(p Payment, req *PayRequest) error {
+	if req == nil {
+		return errors.New("支付请求不能为空")
+	}
+	v := reflect.ValueOf(req).Elem()
+	for _, name := range PaymentCapabilities(p).Required {
+		f := v.FieldByName(name)
+		if f.IsValid() && reflect.DeepEqual(f.Interface(), reflect.Zero(f.Type()).Interface()) {
+			return errors.New("支付请求[" + name + "]不能为空")
+		}
+	}
+	if t, ok := p.(ExtType); ok {
+		ext := t.NewExt()
+		if ext != nil {
+			return req.DecodeExt(ext)
+		}
+	}
+	return nil
+}
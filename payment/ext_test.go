@@ -0,0 +1,88 @@
+package payment
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/smartystreets/goconvey/convey"
+)
+
+type extTestCard struct {
+	CardNo string
+	Credit bool
+}
+
+func (e *extTestCard) Validate() error {
+	if e.CardNo == "" {
+		return errors.New("CardNo不能为空")
+	}
+	return nil
+}
+
+type extTestChannel string
+
+func (e extTestChannel) Validate() error {
+	if e != "" && e != "A" && e != "B" {
+		return errors.New("渠道错误")
+	}
+	return nil
+}
+
+func (e extTestChannel) MarshalText() ([]byte, error) { return []byte(e), nil }
+
+func (e *extTestChannel) UnmarshalText(data []byte) error {
+	*e = extTestChannel(data)
+	return nil
+}
+
+type extTestPay struct {
+	capabilityTestPay
+}
+
+func (t *extTestPay) NewExt() PayExt { return &extTestCard{} }
+
+func Test_PayExt(t *testing.T) {
+	convey.Convey("json扩展信息", t, func() {
+		req := &PayRequest{}
+		convey.So(req.SetExt(&extTestCard{}), convey.ShouldNotBeNil)
+		convey.So(req.Ext, convey.ShouldEqual, "")
+		convey.So(req.SetExt(&extTestCard{CardNo: "6222", Credit: true}), convey.ShouldBeNil)
+		convey.So(req.Ext, convey.ShouldEqual, `{"CardNo":"6222","Credit":true}`)
+		ext := &extTestCard{}
+		convey.So(req.DecodeExt(ext), convey.ShouldBeNil)
+		convey.So(ext, convey.ShouldResemble, &extTestCard{CardNo: "6222", Credit: true})
+		req.Ext = "{"
+		convey.So(req.DecodeExt(&extTestCard{}), convey.ShouldNotBeNil)
+		req.Ext = ""
+		convey.So(req.DecodeExt(&extTestCard{}), convey.ShouldNotBeNil)
+		convey.So(req.DecodeExt(&extTestCard{CardNo: "default"}), convey.ShouldBeNil)
+		convey.So(req.SetExt(nil), convey.ShouldBeNil)
+	})
+	convey.Convey("文本扩展信息", t, func() {
+		req := &PayRequest{}
+		convey.So(req.SetExt(extTestChannel("B")), convey.ShouldBeNil)
+		convey.So(req.Ext, convey.ShouldEqual, "B")
+		var ext extTestChannel
+		convey.So(req.DecodeExt(&ext), convey.ShouldBeNil)
+		convey.So(ext, convey.ShouldEqual, "B")
+		req.Ext = "C"
+		convey.So(req.DecodeExt(&ext), convey.ShouldNotBeNil)
+	})
+	convey.Convey("请求校验", t, func() {
+		p := &extTestPay{}
+		p.Init("c", "C", true)
+		req := &PayRequest{Ext: `{"CardNo":"6222"}`}
+		err := ValidateRequest(p, req)
+		convey.So(err, convey.ShouldNotBeNil)
+		convey.So(err.Error(), convey.ShouldContainSubstring, "MemberID")
+		req.MemberID = "m1"
+		convey.So(ValidateRequest(p, req), convey.ShouldBeNil)
+		req.Ext = `{"Credit":true}`
+		convey.So(ValidateRequest(p, req), convey.ShouldNotBeNil)
+		r := NewRegistry()
+		r.SetPayment(p)
+		_, err = r.Pay("c", req)
+		convey.So(err, convey.ShouldNotBeNil)
+		convey.So(r.PaymentHealth("c").Requests, convey.ShouldEqual, 0)
+	})
+}
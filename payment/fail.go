@@ -1,5 +1,10 @@
 package payment
 
+import (
+	"net"
+	"net/url"
+)
+
 var (
 	//提现参数序列化错误
 	WithdrawParamsSerializeFail = &WithdrawResult{
@@ -31,4 +36,90 @@ var (
 		FailCode: "RESPONSE_UNSERIALIZE_FAIL",
 		FailMsg:  "请求结果签名验证失败",
 	}
+	//提现请求未发送，连接第三方网关失败，请求没有到达第三方，可以安全的重试或切换其他提现方式
+	WithdrawRequestNotSent = &WithdrawResult{
+		Status:   FAIL,
+		FailCode: "REQUEST_NOT_SENT",
+		FailMsg:  "请求未发送",
+	}
+	//提现币种不支持，提现方式只支持人民币
+	WithdrawCurrencyNotSupport = &WithdrawResult{
+		Status:   FAIL,
+		FailCode: "CURRENCY_NOT_SUPPORT",
+		FailMsg:  "提现币种不支持",
+	}
 )
+
+//WithdrawParamsInvalid 提现参数校验失败,请求没有发送到第三方
+func WithdrawParamsInvalid(err error) *WithdrawResult {
+	return &WithdrawResult{
+		Status:   FAIL,
+		FailCode: "PARAMS_INVALID",
+		FailMsg:  err.Error(),
+	}
+}
+
+//WithdrawNotifyVerifyFail 提现异步通知签名验证失败,通知内容不可信,状态未知,不能据此更新提现状态
+func WithdrawNotifyVerifyFail(tradeno string) *WithdrawQueryResult {
+	return &WithdrawQueryResult{
+		Status:   UNKNOW,
+		TradeNo:  tradeno,
+		FailCode: "NOTIFY_VERIFY_FAIL",
+		FailMsg:  "异步通知签名验证失败",
+	}
+}
+
+//RequestNotSent 请求未发送到第三方网关的错误,可以安全的重试或切换其他支付方式
+type RequestNotSent struct {
+	Err error
+}
+
+func (r *RequestNotSent) Error() string {
+	return r.Err.Error()
+}
+
+//IsRequestNotSent 判断错误是否为请求未发送到第三方网关
+func IsRequestNotSent(err error) bool {
+	_, ok := err.(*RequestNotSent)
+	return ok
+}
+
+//IsDialError 判断http请求错误是否发生在建立连接阶段(请求未发送)
+func IsDialError(err error) bool {
+	if e, ok := err.(*url.Error); ok {
+		err = e.Err
+	}
+	if e, ok := err.(*net.OpError); ok {
+		return e.Op == "dial"
+	}
+	_, ok := err.(*net.DNSError)
+	return ok
+}
+
+//RequestFailed 请求已发出但通讯失败的错误(超时、连接中断等),交易结果未知
+type RequestFailed struct {
+	Err error
+}
+
+func (r *RequestFailed) Error() string {
+	return r.Err.Error()
+}
+
+//IsRequestFailed 判断错误是否为请求通讯失败
+func IsRequestFailed(err error) bool {
+	_, ok := err.(*RequestFailed)
+	return ok
+}
+
+//IsGatewayError 判断错误是否为第三方网关通讯错误,用于渠道健康检测
+func IsGatewayError(err error) bool {
+	return IsRequestNotSent(err) || IsRequestFailed(err)
+}
+
+//WrapRequestError http请求错误包装,连接阶段的错误包装成RequestNotSent,其他包装成RequestFailed
+func WrapRequestError(err error, wrap error) error {
+	if IsDialError(err) {
+		return &RequestNotSent{Err: wrap}
+	}
+	return &RequestFailed{Err: wrap}
+}
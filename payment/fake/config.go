@@ -0,0 +1,11 @@
+package fake
+
+import "github.com/kinwyb/golang/payment"
+
+//Config 模拟支付/提现配置信息
+//	不请求任何第三方,交易结果由Gateway中按单号预设的Script决定,用于应用的支付和提现流程测试
+type Config struct {
+	payment.Config
+	//Gateway 模拟网关,为空时自动创建,未预设结果的交易全部成功
+	Gateway *Gateway `json:"-"`
+}
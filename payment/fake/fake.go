@@ -0,0 +1,132 @@
+package fake
+
+import (
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/kinwyb/golang/payment"
+	"github.com/kinwyb/golang/utils"
+)
+
+type fake struct {
+	payment.PayInfo
+	config *Config
+}
+
+//支付,返回模拟的支付跳转地址,预设PayError时返回该错误
+func (f *fake) Pay(req *payment.PayRequest) (string, error) {
+	if err := f.config.Gateway.pay(req); err != nil {
+		f.Log(utils.LogLevelWarn, "模拟支付[%s]请求失败:%s", req.No, err.Error())
+		return "", err
+	}
+	args := url.Values{}
+	args.Set("out_trade_no", req.No)
+	args.Set("total_amount", strconv.FormatFloat(req.Money, 'f', 2, 64))
+	return "fake://pay?" + args.Encode(), nil
+}
+
+//模拟支付无需确认支付
+func (f *fake) PayConfirm(req *payment.PayConfirmRequest) *payment.PayResult {
+	return payment.NoPayConfirmResult
+}
+
+//异步结果通知处理,params为Gateway.PayNotifications生成的通知
+func (f *fake) Notify(params map[string]string) *payment.PayResult {
+	if !f.config.Gateway.verify(params) {
+		return &payment.PayResult{
+			Succ:    false,
+			ErrMsg:  "模拟支付通知签名验证失败",
+			No:      params["out_trade_no"],
+			TradeNo: params["out_trade_no"],
+			PayCode: f.Code(),
+			Navite:  params,
+		}
+	}
+	return f.result(params)
+}
+
+//异步通知处理结果返回内容
+func (f *fake) NotifyResult(payResult *payment.PayResult) string {
+	if payResult.Succ {
+		return "success"
+	}
+	return "fail"
+}
+
+//同步结果跳转处理,参数同异步通知
+func (f *fake) Result(params map[string]string) *payment.PayResult {
+	return f.Notify(params)
+}
+
+//Query 根据订单号查询支付结果,预设Pending次数内返回处理中
+func (f *fake) Query(tradeno string, tradeDate ...time.Time) *payment.PayResult {
+	params, ok := f.config.Gateway.queryPay(tradeno)
+	if !ok {
+		return &payment.PayResult{
+			Succ:    false,
+			ErrMsg:  ErrTradeNotExists.Error(),
+			No:      tradeno,
+			TradeNo: tradeno,
+			PayCode: f.Code(),
+		}
+	}
+	return f.result(params)
+}
+
+//通知内容转换为支付结果
+func (f *fake) result(params map[string]string) *payment.PayResult {
+	ret := &payment.PayResult{
+		No:           params["out_trade_no"],
+		TradeNo:      params["out_trade_no"],
+		Currency:     params["currency"],
+		PayCode:      f.Code(),
+		ThirdTradeNo: params["trade_no"],
+		Navite:       params,
+	}
+	ret.Money, _ = strconv.ParseFloat(params["total_amount"], 64)
+	switch payment.Status(params["trade_status"]) {
+	case payment.SUCCESS:
+		ret.Succ = true
+	case payment.FAIL:
+		ret.ErrMsg = "[" + params["fail_code"] + "]" + params["fail_msg"]
+	default:
+		ret.ErrMsg = "交易" + payment.StatusMsg(payment.Status(params["trade_status"]))
+	}
+	return f.FillPayFee(ret)
+}
+
+//GetPayment 生成一个支付对象
+func (f *fake) GetPayment(cfg interface{}) payment.Payment {
+	var c *Config
+	ok := false
+	if c, ok = cfg.(*Config); !ok || c == nil {
+		log(utils.LogLevelWarn, "传递的配置信息不是一个有效的模拟支付配置")
+		return nil
+	}
+	if c.Name == "" || c.Code == "" {
+		return nil
+	}
+	if c.Gateway == nil {
+		c.Gateway = NewGateway("")
+	}
+	obj := &fake{config: c}
+	obj.Init(obj.config.Code, obj.config.Name, obj.config.State)
+	obj.SetFee(obj.config.Fee)
+	obj.SetOptions(obj.config.Options, &lg)
+	return obj
+}
+
+//Driver 驱动编码
+func (f *fake) Driver() string {
+	return "fake"
+}
+
+//Capabilities 支付能力,PayConfirm无需调用
+func (f *fake) Capabilities() *payment.Capabilities {
+	return &payment.Capabilities{
+		Operations: []string{payment.OpPay, payment.OpNotify, payment.OpResult},
+		TradeTypes: []string{payment.TradeWeb},
+		Required:   []string{"No", "Money"},
+	}
+}
@@ -0,0 +1,95 @@
+package fake
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/kinwyb/golang/payment"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func Test_Fake(t *testing.T) {
+	gw := NewGateway("test")
+	registry := payment.NewRegistry()
+	Driver(registry.RegDriver, nil)
+	WithdrawDriver(registry.RegWithdrawDriver, nil)
+	cfg := &Config{Gateway: gw}
+	cfg.Code = "fake"
+	cfg.Name = "模拟支付"
+	cfg.State = true
+	_, err := registry.AddPayment("fake", cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = registry.AddWithdraw("fake", cfg); err != nil {
+		t.Fatal(err)
+	}
+	convey.Convey("支付", t, func() {
+		gw.Script("002", &Script{Status: payment.FAIL, FailCode: "NOT_ENOUGH", FailMsg: "余额不足"})
+		gw.Script("003", &Script{Pending: 2, Duplicate: 1})
+		gw.Script("004", &Script{PayError: &payment.RequestNotSent{Err: errors.New("连接失败")}})
+		code, err := registry.Pay("fake", &payment.PayRequest{No: "001", Money: 10})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(code, convey.ShouldEqual, "fake://pay?out_trade_no=001&total_amount=10.00")
+		notifies, err := gw.PayNotifications("001")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(len(notifies), convey.ShouldEqual, 1)
+		ret := registry.Notify("fake", notifies[0])
+		convey.So(ret.Succ, convey.ShouldBeTrue)
+		convey.So(ret.No, convey.ShouldEqual, "001")
+		convey.So(ret.Money, convey.ShouldEqual, 10)
+		convey.So(ret.ThirdTradeNo, convey.ShouldEqual, "FP001")
+		notifies[0]["total_amount"] = "0.01"
+		convey.So(registry.Notify("fake", notifies[0]).Succ, convey.ShouldBeFalse)
+
+		registry.Pay("fake", &payment.PayRequest{No: "002", Money: 10})
+		notifies, _ = gw.PayNotifications("002")
+		ret = registry.Notify("fake", notifies[0])
+		convey.So(ret.Succ, convey.ShouldBeFalse)
+		convey.So(ret.ErrMsg, convey.ShouldEqual, "[NOT_ENOUGH]余额不足")
+		convey.So(registry.Query("fake", "002").Succ, convey.ShouldBeFalse)
+
+		registry.Pay("fake", &payment.PayRequest{No: "003", Money: 5})
+		notifies, _ = gw.PayNotifications("003")
+		convey.So(len(notifies), convey.ShouldEqual, 4)
+		convey.So(registry.Notify("fake", notifies[0]).Succ, convey.ShouldBeFalse)
+		convey.So(registry.Notify("fake", notifies[2]).Succ, convey.ShouldBeTrue)
+		convey.So(notifies[3]["trade_status"], convey.ShouldEqual, "SUCCESS")
+		convey.So(registry.Query("fake", "003").ErrMsg, convey.ShouldEqual, "交易处理中")
+		convey.So(registry.Query("fake", "003").Succ, convey.ShouldBeFalse)
+		convey.So(registry.Query("fake", "003").Succ, convey.ShouldBeTrue)
+
+		_, err = registry.Pay("fake", &payment.PayRequest{No: "004", Money: 5})
+		convey.So(payment.IsRequestNotSent(err), convey.ShouldBeTrue)
+		_, err = gw.PayNotifications("004")
+		convey.So(err, convey.ShouldEqual, ErrTradeNotExists)
+		convey.So(registry.PaymentCapabilities("fake").Has(payment.OpQuery), convey.ShouldBeTrue)
+	})
+	convey.Convey("提现", t, func() {
+		gw.Script("W002", &Script{Status: payment.FAIL, FailCode: "CARD_ERROR", FailMsg: "卡号错误"})
+		gw.Script("W003", &Script{Pending: 1})
+		ret := registry.DoWithdraw("fake", &payment.WithdrawInfo{TradeNo: "W001", CardNo: "6222", UserName: "张三", Money: 10})
+		convey.So(ret.Status, convey.ShouldEqual, payment.SUCCESS)
+		convey.So(ret.ThridFlowNo, convey.ShouldEqual, "FWW001")
+		ret = registry.DoWithdraw("fake", &payment.WithdrawInfo{TradeNo: "W002", CardNo: "6222", UserName: "张三", Money: 10})
+		convey.So(ret.Status, convey.ShouldEqual, payment.FAIL)
+		convey.So(ret.FailCode, convey.ShouldEqual, "CARD_ERROR")
+		ret = registry.DoWithdraw("fake", &payment.WithdrawInfo{TradeNo: "W003", CardNo: "6222", UserName: "张三", Money: 10})
+		convey.So(ret.Status, convey.ShouldEqual, payment.DEALING)
+		convey.So(registry.QueryWithdraw("fake", "W003").Status, convey.ShouldEqual, payment.DEALING)
+		q := registry.QueryWithdraw("fake", "W003")
+		convey.So(q.Status, convey.ShouldEqual, payment.SUCCESS)
+		convey.So(q.ThridFlowNo, convey.ShouldEqual, "FWW003")
+		convey.So(registry.QueryWithdraw("fake", "W004").Status, convey.ShouldEqual, payment.FAIL)
+
+		notifies, _ := gw.WithdrawNotifications("W002")
+		q, reply := registry.WithdrawNotify("fake", notifies[0])
+		convey.So(q.Status, convey.ShouldEqual, payment.FAIL)
+		convey.So(q.FailMsg, convey.ShouldEqual, "卡号错误")
+		convey.So(reply, convey.ShouldEqual, "success")
+		notifies[0]["sign"] = "x"
+		q, reply = registry.WithdrawNotify("fake", notifies[0])
+		convey.So(q.Status, convey.ShouldEqual, payment.UNKNOW)
+		convey.So(reply, convey.ShouldEqual, "fail")
+	})
+}
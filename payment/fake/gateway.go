@@ -0,0 +1,244 @@
+package fake
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kinwyb/golang/crypto"
+	"github.com/kinwyb/golang/payment"
+)
+
+const timeFormat = "2006-01-02 15:04:05"
+
+//ErrTradeNotExists 交易不存在,生成通知前需要先发起支付/提现
+var ErrTradeNotExists = errors.New("模拟交易不存在")
+
+//Script 预设的交易结果
+type Script struct {
+	Status    payment.Status //最终结果,为空表示SUCCESS
+	FailCode  string         //失败编码,Status为FAIL时返回
+	FailMsg   string         //失败原因,Status为FAIL时返回
+	Pending   int            //得到最终结果前查询返回处理中的次数,大于0时提现请求返回处理中
+	Duplicate int            //生成通知时最终结果通知额外重复的次数
+	PayError  error          //Pay返回的错误,不为空时不登记交易,可使用payment.RequestNotSent等模拟网关错误
+}
+
+//最终结果
+func (s *Script) status() payment.Status {
+	if s.Status == "" {
+		return payment.SUCCESS
+	}
+	return s.Status
+}
+
+//模拟交易
+type trade struct {
+	no       string
+	money    float64
+	currency string
+	script   *Script
+	pending  int //剩余处理中次数
+}
+
+//Gateway 模拟网关,保存按单号预设的结果和已发起的交易,并发安全
+//	同一个Gateway可以同时用于多个模拟支付/提现方式,支付和提现单号分别登记
+type Gateway struct {
+	key       string
+	lock      sync.Mutex
+	scripts   map[string]*Script
+	pays      map[string]*trade
+	withdraws map[string]*trade
+}
+
+//NewGateway 创建模拟网关,key为通知签名密钥,为空使用fake
+func NewGateway(key string) *Gateway {
+	if key == "" {
+		key = "fake"
+	}
+	return &Gateway{
+		key:       key,
+		scripts:   map[string]*Script{},
+		pays:      map[string]*trade{},
+		withdraws: map[string]*trade{},
+	}
+}
+
+//Script 预设单号的交易结果,需在发起支付/提现前设置,支付使用订单号,提现使用交易流水号
+func (g *Gateway) Script(no string, s *Script) {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	g.scripts[no] = s
+}
+
+//PayNotifications 生成支付异步通知,可直接作为Notify/Result的参数
+//	依次为Pending条处理中通知、最终结果通知以及Duplicate条重复的最终结果通知,生成通知不改变交易状态
+func (g *Gateway) PayNotifications(no string) ([]map[string]string, error) {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	t, ok := g.pays[no]
+	if !ok {
+		return nil, ErrTradeNotExists
+	}
+	return g.notifications(t, g.payNotification), nil
+}
+
+//WithdrawNotifications 生成提现异步通知,可直接作为WithdrawNotify的参数,顺序同PayNotifications
+func (g *Gateway) WithdrawNotifications(no string) ([]map[string]string, error) {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	t, ok := g.withdraws[no]
+	if !ok {
+		return nil, ErrTradeNotExists
+	}
+	return g.notifications(t, g.withdrawNotification), nil
+}
+
+//Sign 通知签名,除sign外的非空参数按参数名排序拼接后加上&key=密钥计算MD5
+func (g *Gateway) Sign(params map[string]string) string {
+	keys := make([]string, 0, len(params))
+	for k, v := range params {
+		if k != "sign" && v != "" {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	buf := make([]string, 0, len(keys)+1)
+	for _, k := range keys {
+		buf = append(buf, k+"="+params[k])
+	}
+	buf = append(buf, "key="+g.key)
+	return strings.ToUpper(fmt.Sprintf("%x", crypto.MD5([]byte(strings.Join(buf, "&")))))
+}
+
+//校验通知签名
+func (g *Gateway) verify(params map[string]string) bool {
+	return params["sign"] != "" && params["sign"] == g.Sign(params)
+}
+
+//单号对应的预设结果
+func (g *Gateway) script(no string) *Script {
+	if s, ok := g.scripts[no]; ok && s != nil {
+		return s
+	}
+	return &Script{}
+}
+
+//登记支付交易,重复支付时保留原交易状态
+func (g *Gateway) pay(req *payment.PayRequest) error {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	s := g.script(req.No)
+	if s.PayError != nil {
+		return s.PayError
+	}
+	if t, ok := g.pays[req.No]; ok {
+		t.money = req.Money
+		t.currency = req.Currency
+		return nil
+	}
+	g.pays[req.No] = &trade{no: req.No, money: req.Money, currency: req.Currency, script: s, pending: s.Pending}
+	return nil
+}
+
+//查询支付交易
+func (g *Gateway) queryPay(no string) (map[string]string, bool) {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	t, ok := g.pays[no]
+	if !ok {
+		return nil, false
+	}
+	return g.payNotification(t, g.next(t)), true
+}
+
+//登记提现交易,返回请求结果状态,重复提现时返回当前状态
+func (g *Gateway) withdraw(info *payment.WithdrawInfo) (*trade, payment.Status) {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	t, ok := g.withdraws[info.TradeNo]
+	if !ok {
+		s := g.script(info.TradeNo)
+		t = &trade{no: info.TradeNo, money: info.Money, currency: info.Currency, script: s, pending: s.Pending}
+		g.withdraws[info.TradeNo] = t
+	}
+	if t.pending > 0 {
+		return t, payment.DEALING
+	}
+	return t, t.script.status()
+}
+
+//查询提现交易
+func (g *Gateway) queryWithdraw(no string) (map[string]string, bool) {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	t, ok := g.withdraws[no]
+	if !ok {
+		return nil, false
+	}
+	return g.withdrawNotification(t, g.next(t)), true
+}
+
+//查询时的交易状态,处理中次数未用完时返回处理中
+func (g *Gateway) next(t *trade) payment.Status {
+	if t.pending > 0 {
+		t.pending--
+		return payment.DEALING
+	}
+	return t.script.status()
+}
+
+//按预设结果生成通知
+func (g *Gateway) notifications(t *trade, build func(*trade, payment.Status) map[string]string) []map[string]string {
+	ret := []map[string]string{}
+	for i := 0; i < t.script.Pending; i++ {
+		ret = append(ret, build(t, payment.DEALING))
+	}
+	for i := 0; i <= t.script.Duplicate; i++ {
+		ret = append(ret, build(t, t.script.status()))
+	}
+	return ret
+}
+
+//支付通知内容
+func (g *Gateway) payNotification(t *trade, status payment.Status) map[string]string {
+	ret := map[string]string{
+		"out_trade_no": t.no,
+		"trade_no":     "FP" + t.no,
+		"total_amount": strconv.FormatFloat(t.money, 'f', 2, 64),
+		"currency":     t.currency,
+		"trade_status": string(status),
+		"notify_time":  time.Now().Format(timeFormat),
+	}
+	if status == payment.FAIL {
+		ret["fail_code"] = t.script.FailCode
+		ret["fail_msg"] = t.script.FailMsg
+	}
+	ret["sign"] = g.Sign(ret)
+	return ret
+}
+
+//提现通知内容
+func (g *Gateway) withdrawNotification(t *trade, status payment.Status) map[string]string {
+	ret := map[string]string{
+		"out_trade_no": t.no,
+		"trade_no":     "FW" + t.no,
+		"amount":       strconv.FormatFloat(t.money, 'f', 2, 64),
+		"currency":     t.currency,
+		"status":       string(status),
+		"notify_time":  time.Now().Format(timeFormat),
+	}
+	switch status {
+	case payment.SUCCESS:
+		ret["pay_time"] = ret["notify_time"]
+	case payment.FAIL:
+		ret["fail_code"] = t.script.FailCode
+		ret["fail_msg"] = t.script.FailMsg
+	}
+	ret["sign"] = g.Sign(ret)
+	return ret
+}
@@ -0,0 +1,41 @@
+package fake
+
+import (
+	"github.com/kinwyb/golang/payment"
+
+	"github.com/kinwyb/golang/utils"
+)
+
+var lg utils.Logger
+
+//Driver 模拟支付驱动
+func Driver(fun payment.RegDriverFun, logger utils.Logger) {
+	lg = logger
+	err := fun(&fake{})
+	if err != nil {
+		log(utils.LogLevelError, "模拟支付驱动注入......[失败]:%s", err.Error())
+	} else {
+		log(utils.LogLevelInfo, "模拟支付驱动注入......[成功]")
+	}
+}
+
+//WithdrawDriver 模拟提现驱动
+func WithdrawDriver(fun payment.RegWithdrawDriverFun, logger utils.Logger) {
+	lg = logger
+	err := fun(&withdraw{})
+	if err != nil {
+		log(utils.LogLevelError, "模拟提现驱动注入......[失败]:%s", err.Error())
+	} else {
+		log(utils.LogLevelInfo, "模拟提现驱动注入......[成功]")
+	}
+}
+
+//SetLogger 设置日志
+func SetLogger(log utils.Logger) {
+	lg = log
+}
+
+//日志输出
+func log(level utils.LoggerLevel, format string, args ...interface{}) {
+	utils.WriteLog(lg, level, format, args...)
+}
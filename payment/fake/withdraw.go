@@ -0,0 +1,116 @@
+package fake
+
+import (
+	"time"
+
+	"github.com/kinwyb/golang/payment"
+	"github.com/kinwyb/golang/utils"
+)
+
+type withdraw struct {
+	payment.PayInfo
+	config *Config
+}
+
+//提现操作,预设Pending大于0时返回处理中,否则返回预设的最终结果
+func (w *withdraw) Withdraw(info *payment.WithdrawInfo) *payment.WithdrawResult {
+	ret := &payment.WithdrawResult{
+		TradeNo:      info.TradeNo,
+		CardNo:       info.CardNo,
+		UserName:     info.UserName,
+		CertID:       info.CertID,
+		Money:        info.Money,
+		Currency:     info.Currency,
+		WithdrawCode: w.Code(),
+		WithdrawName: w.Name(),
+	}
+	t, status := w.config.Gateway.withdraw(info)
+	ret.Status = status
+	switch status {
+	case payment.SUCCESS:
+		ret.ThridFlowNo = "FW" + t.no
+		ret.PayTime = w.Now().Format(timeFormat)
+	case payment.FAIL:
+		ret.FailCode = t.script.FailCode
+		ret.FailMsg = t.script.FailMsg
+		w.Log(utils.LogLevelWarn, "模拟提现[%s]失败:[%s]%s", info.TradeNo, ret.FailCode, ret.FailMsg)
+	case payment.DEALING:
+		ret.ThridFlowNo = "FW" + t.no
+	}
+	return w.FillWithdrawFee(ret, info)
+}
+
+//查询提现交易,预设Pending次数内返回处理中
+func (w *withdraw) QueryWithdraw(tradeno string, tradeDate ...time.Time) *payment.WithdrawQueryResult {
+	params, ok := w.config.Gateway.queryWithdraw(tradeno)
+	if !ok {
+		return &payment.WithdrawQueryResult{
+			Status:   payment.FAIL,
+			TradeNo:  tradeno,
+			FailCode: "TRADE_NOT_EXISTS",
+			FailMsg:  ErrTradeNotExists.Error(),
+		}
+	}
+	return w.result(params)
+}
+
+//WithdrawNotify 提现异步通知处理,params为Gateway.WithdrawNotifications生成的通知
+func (w *withdraw) WithdrawNotify(params map[string]string) *payment.WithdrawQueryResult {
+	if !w.config.Gateway.verify(params) {
+		return payment.WithdrawNotifyVerifyFail(params["out_trade_no"])
+	}
+	return w.result(params)
+}
+
+//WithdrawNotifyResult 提现异步通知处理结果返回内容
+func (w *withdraw) WithdrawNotifyResult(ret *payment.WithdrawQueryResult) string {
+	if ret == nil || ret.Status == payment.UNKNOW {
+		return "fail"
+	}
+	return "success"
+}
+
+//通知内容转换为提现结果
+func (w *withdraw) result(params map[string]string) *payment.WithdrawQueryResult {
+	return &payment.WithdrawQueryResult{
+		Status:      payment.Status(params["status"]),
+		PayTime:     params["pay_time"],
+		TradeNo:     params["out_trade_no"],
+		ThridFlowNo: params["trade_no"],
+		FailCode:    params["fail_code"],
+		FailMsg:     params["fail_msg"],
+	}
+}
+
+//GetWithdraw 生成一个提现对象
+func (w *withdraw) GetWithdraw(cfg interface{}) payment.Withdraw {
+	var c *Config
+	ok := false
+	if c, ok = cfg.(*Config); !ok || c == nil {
+		log(utils.LogLevelWarn, "传递的配置信息不是一个有效的模拟提现配置")
+		return nil
+	}
+	if c.Name == "" || c.Code == "" {
+		return nil
+	}
+	if c.Gateway == nil {
+		c.Gateway = NewGateway("")
+	}
+	obj := &withdraw{config: c}
+	obj.Init(obj.config.Code, obj.config.Name, obj.config.State)
+	obj.SetFee(obj.config.Fee)
+	obj.SetOptions(obj.config.Options, &lg)
+	return obj
+}
+
+//Driver 驱动编码
+func (w *withdraw) Driver() string {
+	return "fake"
+}
+
+//Capabilities 提现能力,CardNo为任意收款账户
+func (w *withdraw) Capabilities() *payment.Capabilities {
+	return &payment.Capabilities{
+		Required: []string{"TradeNo", "CardNo", "Money"},
+	}
+}
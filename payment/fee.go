@@ -0,0 +1,104 @@
+package payment
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+//FeeTier 阶梯费率
+type FeeTier struct {
+	Max   float64 //阶梯金额上限[含],0表示无上限
+	Rate  float64 //百分比费率,0.006表示0.6%
+	Fixed float64 //每笔固定费用
+}
+
+//FeeSchedule 手续费配置
+type FeeSchedule struct {
+	Rate   float64      //百分比费率,0.006表示0.6%
+	Fixed  float64      //每笔固定费用
+	Tiers  []FeeTier    //阶梯费率,按交易金额所在阶梯计算,设置后忽略Rate和Fixed
+	Min    float64      //最低手续费,0不限制
+	Max    float64      //最高手续费(封顶),0不限制
+	Credit *FeeSchedule //信用卡费率,为空时信用卡使用本费率
+}
+
+//Fee 计算手续费,结果四舍五入到分
+func (f *FeeSchedule) Fee(money float64, credit bool) float64 {
+	if f == nil {
+		return 0
+	} else if credit && f.Credit != nil {
+		return f.Credit.Fee(money, false)
+	}
+	rate, fixed := f.Rate, f.Fixed
+	if len(f.Tiers) > 0 {
+		tier := f.Tiers[len(f.Tiers)-1]
+		for _, v := range f.Tiers {
+			if v.Max <= 0 || math.Round(money*100) <= math.Round(v.Max*100) {
+				tier = v
+				break
+			}
+		}
+		rate, fixed = tier.Rate, tier.Fixed
+	}
+	fee := money*rate + fixed
+	if f.Min > 0 && fee < f.Min {
+		fee = f.Min
+	}
+	if f.Max > 0 && fee > f.Max {
+		fee = f.Max
+	}
+	return math.Round(fee*100) / 100
+}
+
+//PayFee 支付手续费接口,交易创建时计算预计手续费
+type PayFee interface {
+	PayFee(req *PayRequest) float64
+}
+
+//WithdrawFee 提现手续费接口,交易创建时计算预计手续费
+type WithdrawFee interface {
+	WithdrawFee(info *WithdrawInfo) float64
+}
+
+//FeeRecord 手续费记录
+type FeeRecord struct {
+	Code  string    //支付/提现方式编码
+	Time  time.Time //交易时间
+	Money float64   //交易金额
+	Fee   float64   //手续费
+}
+
+//FeeSummary 手续费汇总
+type FeeSummary struct {
+	Code  string  //支付/提现方式编码
+	Day   string  //日期[2006-01-02]
+	Count int     //交易笔数
+	Money float64 //交易金额合计
+	Fee   float64 //手续费合计
+}
+
+//SummarizeFees 按支付方式和日期汇总手续费,结果按日期、编码排序
+func SummarizeFees(records []FeeRecord) []*FeeSummary {
+	idx := map[string]*FeeSummary{}
+	var ret []*FeeSummary
+	for _, v := range records {
+		day := v.Time.Format("2006-01-02")
+		s, ok := idx[v.Code+"\x00"+day]
+		if !ok {
+			s = &FeeSummary{Code: v.Code, Day: day}
+			idx[v.Code+"\x00"+day] = s
+			ret = append(ret, s)
+		}
+		s.Count++
+		s.Money = math.Round((s.Money+v.Money)*100) / 100
+		s.Fee = math.Round((s.Fee+v.Fee)*100) / 100
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		if ret[i].Day != ret[j].Day {
+			return ret[i].Day < ret[j].Day
+		}
+		return ret[i].Code < ret[j].Code
+	})
+	return ret
+}
@@ -0,0 +1,53 @@
+package payment
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func Test_FeeSchedule(t *testing.T) {
+	convey.Convey("手续费计算", t, func() {
+		var f *FeeSchedule
+		convey.So(f.Fee(100, false), convey.ShouldEqual, 0)
+		f = &FeeSchedule{Rate: 0.006, Fixed: 0.1, Min: 0.5, Max: 20}
+		convey.So(f.Fee(100, false), convey.ShouldEqual, 0.7)
+		convey.So(f.Fee(10, false), convey.ShouldEqual, 0.5)
+		convey.So(f.Fee(10000, false), convey.ShouldEqual, 20)
+		f.Credit = &FeeSchedule{Rate: 0.01}
+		convey.So(f.Fee(100, true), convey.ShouldEqual, 1)
+		convey.So(f.Fee(100, false), convey.ShouldEqual, 0.7)
+		tier := &FeeSchedule{Tiers: []FeeTier{
+			{Max: 1000, Fixed: 2},
+			{Max: 50000, Rate: 0.001},
+			{Fixed: 60},
+		}}
+		convey.So(tier.Fee(1000, false), convey.ShouldEqual, 2)
+		convey.So(tier.Fee(1000.01, false), convey.ShouldEqual, 1)
+		convey.So(tier.Fee(100000, false), convey.ShouldEqual, 60)
+	})
+	convey.Convey("手续费结果", t, func() {
+		p := &PayInfo{}
+		p.SetFee(&FeeSchedule{Rate: 0.01})
+		convey.So(p.FillPayFee(&PayResult{Succ: true, Money: 50}).Fee, convey.ShouldEqual, 0.5)
+		convey.So(p.FillPayFee(&PayResult{Succ: false, Money: 50}).Fee, convey.ShouldEqual, 0)
+		convey.So(p.PayFee(&PayRequest{Money: 10}), convey.ShouldEqual, 0.1)
+		ret := p.FillWithdrawFee(WithdrawResponseReadFail, &WithdrawInfo{Money: 100})
+		convey.So(ret.Fee, convey.ShouldEqual, 1)
+		convey.So(WithdrawResponseReadFail.Fee, convey.ShouldEqual, 0)
+		convey.So(p.FillWithdrawFee(WithdrawRequestFail, &WithdrawInfo{Money: 100}).Fee, convey.ShouldEqual, 0)
+	})
+	convey.Convey("手续费汇总", t, func() {
+		day1 := time.Date(2017, 10, 19, 10, 0, 0, 0, time.Local)
+		day2 := day1.AddDate(0, 0, 1)
+		ret := SummarizeFees([]FeeRecord{
+			{Code: "wxpay", Time: day2, Money: 10, Fee: 0.06},
+			{Code: "alipay", Time: day1, Money: 10, Fee: 0.06},
+			{Code: "alipay", Time: day1.Add(time.Hour), Money: 20.1, Fee: 0.12},
+		})
+		convey.So(len(ret), convey.ShouldEqual, 2)
+		convey.So(*ret[0], convey.ShouldResemble, FeeSummary{Code: "alipay", Day: "2017-10-19", Count: 2, Money: 30.1, Fee: 0.18})
+		convey.So(ret[1].Code, convey.ShouldEqual, "wxpay")
+	})
+}
@@ -0,0 +1,243 @@
+package payment
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+//CircuitState 熔断状态
+type CircuitState string
+
+const (
+	CircuitClosed   CircuitState = "CLOSED"    //正常
+	CircuitOpen     CircuitState = "OPEN"      //熔断中,渠道不可用
+	CircuitHalfOpen CircuitState = "HALF_OPEN" //半开,允许试探请求
+)
+
+//统计窗口分桶数
+const healthBuckets = 10
+
+//HealthConfig 渠道健康检测配置
+type HealthConfig struct {
+	Window      time.Duration //统计窗口,默认1分钟
+	MinRequests int           //窗口内请求数达到该值后才判断是否熔断,默认10
+	MaxFailRate float64       //失败率阈值,超过后熔断,默认0.5
+	MaxLatency  time.Duration //平均耗时阈值,超过后熔断,0不限制
+	OpenTime    time.Duration //熔断持续时间,到期后进入半开状态,默认30秒
+}
+
+//默认值
+func (c HealthConfig) defaults() HealthConfig {
+	if c.Window <= 0 {
+		c.Window = time.Minute
+	}
+	if c.MinRequests <= 0 {
+		c.MinRequests = 10
+	}
+	if c.MaxFailRate <= 0 {
+		c.MaxFailRate = 0.5
+	}
+	if c.OpenTime <= 0 {
+		c.OpenTime = 30 * time.Second
+	}
+	return c
+}
+
+//Health 渠道健康状态
+type Health struct {
+	Code        string        //支付/提现方式编码
+	State       CircuitState  //熔断状态
+	Requests    int           //窗口内请求数
+	Failures    int           //窗口内失败数
+	SuccessRate float64       //窗口内成功率,无请求时为1
+	AvgLatency  time.Duration //窗口内平均耗时
+	OpenedAt    time.Time     //最近一次熔断时间
+}
+
+//统计分桶
+type healthBucket struct {
+	start    time.Time
+	requests int
+	failures int
+	latency  time.Duration
+}
+
+//单个渠道的熔断器
+type breaker struct {
+	buckets  [healthBuckets]healthBucket
+	state    CircuitState
+	openedAt time.Time
+	probeAt  time.Time //半开状态下试探请求时间,试探超过熔断时间未返回结果时允许重新试探
+}
+
+//HealthTracker 渠道健康检测及熔断
+type HealthTracker struct {
+	lock     sync.Mutex
+	config   HealthConfig
+	breakers map[string]*breaker
+	now      func() time.Time
+}
+
+//NewHealthTracker 创建渠道健康检测
+func NewHealthTracker(config HealthConfig) *HealthTracker {
+	return &HealthTracker{
+		config:   config.defaults(),
+		breakers: map[string]*breaker{},
+		now:      time.Now,
+	}
+}
+
+//SetConfig 修改健康检测配置
+func (h *HealthTracker) SetConfig(config HealthConfig) {
+	h.lock.Lock()
+	h.config = config.defaults()
+	h.lock.Unlock()
+}
+
+//Record 记录一次请求结果,fail表示渠道请求失败(通讯异常等),业务失败不应计入
+func (h *HealthTracker) Record(code string, fail bool, latency time.Duration) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	now := h.now()
+	b := h.breaker(code)
+	h.refresh(b, now)
+	if b.state == CircuitHalfOpen { //试探请求结果决定是否恢复
+		b.probeAt = time.Time{}
+		if fail {
+			b.state = CircuitOpen
+			b.openedAt = now
+		} else {
+			b.state = CircuitClosed
+			b.buckets = [healthBuckets]healthBucket{}
+		}
+	}
+	size := h.config.Window / healthBuckets
+	start := now.Truncate(size)
+	bucket := &b.buckets[start.UnixNano()/int64(size)%healthBuckets]
+	if !bucket.start.Equal(start) {
+		*bucket = healthBucket{start: start}
+	}
+	bucket.requests++
+	bucket.latency += latency
+	if fail {
+		bucket.failures++
+	}
+	if b.state != CircuitClosed {
+		return
+	}
+	health := h.stat(code, b, now)
+	if health.Requests < h.config.MinRequests {
+		return
+	}
+	if 1-health.SuccessRate > h.config.MaxFailRate ||
+		(h.config.MaxLatency > 0 && health.AvgLatency > h.config.MaxLatency) {
+		b.state = CircuitOpen
+		b.openedAt = now
+	}
+}
+
+//Available 渠道是否可以发起请求,熔断中不可用,半开状态只允许一个试探请求
+//	半开状态返回true时占用试探请求,只在实际发起请求前调用,列出可用渠道使用State
+func (h *HealthTracker) Available(code string) bool {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	b, ok := h.breakers[code]
+	if !ok {
+		return true
+	}
+	now := h.now()
+	h.refresh(b, now)
+	switch b.state {
+	case CircuitOpen:
+		return false
+	case CircuitHalfOpen:
+		if !b.probeAt.IsZero() && now.Sub(b.probeAt) < h.config.OpenTime {
+			return false
+		}
+		b.probeAt = now
+	}
+	return true
+}
+
+//State 渠道熔断状态,只读取状态,不占用半开状态的试探请求
+func (h *HealthTracker) State(code string) CircuitState {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	b, ok := h.breakers[code]
+	if !ok {
+		return CircuitClosed
+	} else if b.state == CircuitOpen && h.now().Sub(b.openedAt) >= h.config.OpenTime {
+		return CircuitHalfOpen
+	}
+	return b.state
+}
+
+//Health 渠道健康状态
+func (h *HealthTracker) Health(code string) *Health {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	now := h.now()
+	b := h.breaker(code)
+	h.refresh(b, now)
+	return h.stat(code, b, now)
+}
+
+//Healths 所有渠道健康状态,按编码排序
+func (h *HealthTracker) Healths() []*Health {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	now := h.now()
+	ret := make([]*Health, 0, len(h.breakers))
+	for code, b := range h.breakers {
+		h.refresh(b, now)
+		ret = append(ret, h.stat(code, b, now))
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].Code < ret[j].Code
+	})
+	return ret
+}
+
+//Reset 重置渠道状态
+func (h *HealthTracker) Reset(code string) {
+	h.lock.Lock()
+	delete(h.breakers, code)
+	h.lock.Unlock()
+}
+
+func (h *HealthTracker) breaker(code string) *breaker {
+	b, ok := h.breakers[code]
+	if !ok {
+		b = &breaker{state: CircuitClosed}
+		h.breakers[code] = b
+	}
+	return b
+}
+
+//熔断到期进入半开状态
+func (h *HealthTracker) refresh(b *breaker, now time.Time) {
+	if b.state == CircuitOpen && now.Sub(b.openedAt) >= h.config.OpenTime {
+		b.state = CircuitHalfOpen
+		b.probeAt = time.Time{}
+	}
+}
+
+//统计窗口内的请求情况
+func (h *HealthTracker) stat(code string, b *breaker, now time.Time) *Health {
+	ret := &Health{Code: code, State: b.state, OpenedAt: b.openedAt, SuccessRate: 1}
+	var latency time.Duration
+	for _, v := range b.buckets {
+		if v.requests < 1 || now.Sub(v.start) >= h.config.Window {
+			continue
+		}
+		ret.Requests += v.requests
+		ret.Failures += v.failures
+		latency += v.latency
+	}
+	if ret.Requests > 0 {
+		ret.SuccessRate = float64(ret.Requests-ret.Failures) / float64(ret.Requests)
+		ret.AvgLatency = latency / time.Duration(ret.Requests)
+	}
+	return ret
+}
@@ -0,0 +1,162 @@
+package payment
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kinwyb/golang/payment/qrcode"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+type healthTestPay struct {
+	PayInfo
+	err error
+}
+
+func (t *healthTestPay) Pay(req *PayRequest) (string, error)          { return "", t.err }
+func (t *healthTestPay) PayConfirm(req *PayConfirmRequest) *PayResult { return nil }
+func (t *healthTestPay) Notify(params map[string]string) *PayResult   { return nil }
+func (t *healthTestPay) NotifyResult(payResult *PayResult) string     { return "" }
+func (t *healthTestPay) Result(params map[string]string) *PayResult   { return nil }
+
+type healthTestQRPay struct {
+	healthTestPay
+}
+
+func (t *healthTestQRPay) QRCodePay(req *PayRequest, opt *qrcode.Options) (*QRCodeResult, error) {
+	if t.err != nil {
+		return nil, t.err
+	}
+	return &QRCodeResult{Code: "weixin://wxpay/" + req.No}, nil
+}
+
+type healthTestQueryPay struct {
+	healthTestPay
+	ret *PayResult
+}
+
+func (t *healthTestQueryPay) Query(tradeno string, tradeDate ...time.Time) *PayResult { return t.ret }
+
+func Test_HealthTracker(t *testing.T) {
+	convey.Convey("熔断", t, func() {
+		now := time.Date(2017, 10, 19, 10, 0, 0, 0, time.Local)
+		h := NewHealthTracker(HealthConfig{MinRequests: 4, MaxFailRate: 0.5, OpenTime: 10 * time.Second})
+		h.now = func() time.Time { return now }
+		convey.So(h.Available("a"), convey.ShouldBeTrue)
+		h.Record("a", false, time.Millisecond)
+		h.Record("a", true, time.Millisecond)
+		h.Record("a", true, time.Millisecond)
+		convey.So(h.Health("a").State, convey.ShouldEqual, CircuitClosed)
+		h.Record("a", true, time.Millisecond)
+		health := h.Health("a")
+		convey.So(health.State, convey.ShouldEqual, CircuitOpen)
+		convey.So(health.Requests, convey.ShouldEqual, 4)
+		convey.So(health.SuccessRate, convey.ShouldEqual, 0.25)
+		convey.So(h.Available("a"), convey.ShouldBeFalse)
+		convey.So(h.State("a"), convey.ShouldEqual, CircuitOpen)
+		now = now.Add(10 * time.Second)
+		convey.So(h.State("a"), convey.ShouldEqual, CircuitHalfOpen)
+		convey.So(h.State("a"), convey.ShouldEqual, CircuitHalfOpen)
+		convey.So(h.Available("a"), convey.ShouldBeTrue)
+		convey.So(h.Health("a").State, convey.ShouldEqual, CircuitHalfOpen)
+		convey.So(h.Available("a"), convey.ShouldBeFalse)
+		h.Record("a", true, time.Millisecond)
+		convey.So(h.Health("a").State, convey.ShouldEqual, CircuitOpen)
+		now = now.Add(10 * time.Second)
+		convey.So(h.Available("a"), convey.ShouldBeTrue)
+		h.Record("a", false, time.Millisecond)
+		convey.So(h.Health("a").State, convey.ShouldEqual, CircuitClosed)
+		convey.So(h.Available("a"), convey.ShouldBeTrue)
+		convey.So(len(h.Healths()), convey.ShouldEqual, 1)
+	})
+	convey.Convey("统计窗口", t, func() {
+		now := time.Date(2017, 10, 19, 10, 0, 0, 0, time.Local)
+		h := NewHealthTracker(HealthConfig{MinRequests: 2, MaxLatency: time.Second})
+		h.now = func() time.Time { return now }
+		h.Record("a", true, time.Millisecond)
+		now = now.Add(time.Minute)
+		h.Record("a", false, time.Millisecond)
+		convey.So(h.Health("a").Requests, convey.ShouldEqual, 1)
+		h.Record("a", false, 3*time.Second)
+		health := h.Health("a")
+		convey.So(health.AvgLatency, convey.ShouldEqual, 1500500*time.Microsecond)
+		convey.So(health.State, convey.ShouldEqual, CircuitOpen)
+		h.Reset("a")
+		convey.So(h.Available("a"), convey.ShouldBeTrue)
+	})
+	convey.Convey("注册中心记录渠道健康状态", t, func() {
+		r := NewRegistry()
+		r.SetHealthConfig(HealthConfig{MinRequests: 2})
+		p := &healthTestPay{err: &RequestNotSent{Err: errors.New("connect refused")}}
+		p.Init("down", "down", true)
+		r.SetPayment(p)
+		convey.So(r.PaymentAvailable("down"), convey.ShouldBeTrue)
+		r.Pay("down", &PayRequest{})
+		r.Pay("down", &PayRequest{})
+		convey.So(r.PaymentAvailable("down"), convey.ShouldBeFalse)
+		convey.So(r.PaymentHealth("down").Failures, convey.ShouldEqual, 2)
+		_, err := r.Pay("down", &PayRequest{})
+		convey.So(IsRequestNotSent(err), convey.ShouldBeTrue)
+		convey.So(err.Error(), convey.ShouldEqual, ErrCircuitOpen.Error())
+		convey.So(r.PaymentHealth("down").Requests, convey.ShouldEqual, 2)
+		_, err = r.Pay("none", &PayRequest{})
+		convey.So(err, convey.ShouldEqual, ErrPaymentNotFound)
+		convey.So(r.DoWithdraw("none", &WithdrawInfo{}).Status, convey.ShouldEqual, FAIL)
+	})
+	convey.Convey("查询", t, func() {
+		r := NewRegistry()
+		r.SetHealthConfig(HealthConfig{MinRequests: 2})
+		p := &healthTestQueryPay{ret: &PayResult{ErrMsg: "参数错误", Err: &RequestNotSent{Err: errors.New("参数错误")}}}
+		p.Init("q", "q", true)
+		r.SetPayment(p)
+		var ops []string
+		r.SetObserver(ObserverFunc(func(op, code, outcome, failCode string, latency time.Duration) {
+			ops = append(ops, op+":"+outcome+":"+failCode)
+		}))
+		r.Query("q", "001")
+		r.Query("q", "001")
+		convey.So(r.PaymentHealth("q").Requests, convey.ShouldEqual, 0)
+		p.ret = &PayResult{ErrMsg: "连接超时"}
+		r.Query("q", "001")
+		r.Query("q", "001")
+		convey.So(r.PaymentHealth("q").Failures, convey.ShouldEqual, 2)
+		convey.So(r.PaymentAvailable("q"), convey.ShouldBeFalse)
+		p.ret = &PayResult{Succ: true}
+		convey.So(r.Query("q", "001").Succ, convey.ShouldBeTrue)
+		convey.So(ops[0], convey.ShouldEqual, OpQuery+":"+OutcomeFail+":"+WithdrawRequestNotSent.FailCode)
+		convey.So(ops[2], convey.ShouldEqual, OpQuery+":"+OutcomeFail+":")
+		convey.So(ops[4], convey.ShouldEqual, OpQuery+":"+OutcomeSuccess+":")
+	})
+	convey.Convey("扫码支付", t, func() {
+		r := NewRegistry()
+		r.SetHealthConfig(HealthConfig{MinRequests: 2, MaxFailRate: 0.4})
+		var ops []string
+		r.SetObserver(ObserverFunc(func(op, code, outcome, failCode string, latency time.Duration) {
+			ops = append(ops, op+":"+outcome+":"+failCode)
+		}))
+		p := &healthTestQRPay{}
+		p.Init("qr", "qr", true)
+		r.SetPayment(p)
+		ret, err := r.QRCodePay("qr", &PayRequest{No: "001", Money: 1}, nil)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ret.Code, convey.ShouldEqual, "weixin://wxpay/001")
+		p.err = &RequestNotSent{Err: errors.New("connect refused")}
+		_, err = r.QRCodePay("qr", &PayRequest{No: "002", Money: 1}, nil)
+		convey.So(IsRequestNotSent(err), convey.ShouldBeTrue)
+		convey.So(r.PaymentAvailable("qr"), convey.ShouldBeFalse)
+		_, err = r.QRCodePay("qr", &PayRequest{No: "003", Money: 1}, nil)
+		convey.So(err.Error(), convey.ShouldEqual, ErrCircuitOpen.Error())
+		convey.So(ops, convey.ShouldResemble, []string{
+			OpQRCodePay + ":" + OutcomeSuccess + ":",
+			OpQRCodePay + ":" + OutcomeFail + ":" + WithdrawRequestNotSent.FailCode,
+		})
+		d := &healthTestPay{}
+		d.Init("down", "down", true)
+		r.SetPayment(d)
+		_, err = r.QRCodePay("down", &PayRequest{No: "001", Money: 1}, nil)
+		convey.So(err, convey.ShouldNotBeNil)
+		_, err = r.QRCodePay("none", &PayRequest{}, nil)
+		convey.So(err, convey.ShouldEqual, ErrPaymentNotFound)
+	})
+}
@@ -0,0 +1,213 @@
+package metrics
+
+import (
+	"bytes"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kinwyb/golang/payment"
+)
+
+//渠道调用指标,输出Prometheus文本格式,不依赖Prometheus客户端库
+//	collector := metrics.New()
+//	registry.SetObserver(collector)
+//	http.Handle("/metrics", collector)
+
+//DefaultBuckets 默认耗时分布区间(秒)
+var DefaultBuckets = []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30}
+
+//计数标签
+type counterKey struct {
+	op, code, outcome, failCode string
+}
+
+//耗时标签
+type histogramKey struct {
+	op, code, outcome string
+}
+
+//耗时分布
+type histogram struct {
+	counts []uint64 //各区间数量(非累计)
+	count  uint64
+	sum    float64
+}
+
+//Collector 渠道调用指标收集,实现payment.Observer和http.Handler
+type Collector struct {
+	lock       sync.Mutex
+	namespace  string
+	buckets    []float64
+	counters   map[counterKey]uint64
+	histograms map[histogramKey]*histogram
+	registry   *payment.Registry
+}
+
+//New 创建指标收集,buckets为空时使用DefaultBuckets
+func New(buckets ...float64) *Collector {
+	if len(buckets) < 1 {
+		buckets = DefaultBuckets
+	}
+	b := append([]float64{}, buckets...)
+	sort.Float64s(b)
+	return &Collector{
+		namespace:  "payment",
+		buckets:    b,
+		counters:   map[counterKey]uint64{},
+		histograms: map[histogramKey]*histogram{},
+	}
+}
+
+//SetNamespace 设置指标名称前缀,默认payment
+func (c *Collector) SetNamespace(namespace string) {
+	c.lock.Lock()
+	c.namespace = namespace
+	c.lock.Unlock()
+}
+
+//SetRegistry 设置注册中心,输出渠道熔断状态
+func (c *Collector) SetRegistry(r *payment.Registry) {
+	c.lock.Lock()
+	c.registry = r
+	c.lock.Unlock()
+}
+
+//Observe 记录一次渠道调用
+func (c *Collector) Observe(op, code, outcome, failCode string, latency time.Duration) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.counters[counterKey{op: op, code: code, outcome: outcome, failCode: failCode}]++
+	hk := histogramKey{op: op, code: code, outcome: outcome}
+	h, ok := c.histograms[hk]
+	if !ok {
+		h = &histogram{counts: make([]uint64, len(c.buckets))}
+		c.histograms[hk] = h
+	}
+	seconds := latency.Seconds()
+	for i, v := range c.buckets {
+		if seconds <= v {
+			h.counts[i]++
+			break
+		}
+	}
+	h.count++
+	h.sum += seconds
+}
+
+//ServeHTTP 输出Prometheus文本格式指标
+func (c *Collector) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	w.Write(c.Bytes())
+}
+
+//Bytes Prometheus文本格式指标
+func (c *Collector) Bytes() []byte {
+	c.lock.Lock()
+	buf := &bytes.Buffer{}
+	name := c.namespace + "_requests_total"
+	writeHeader(buf, name, "counter", "渠道调用次数")
+	ckeys := make([]counterKey, 0, len(c.counters))
+	for k := range c.counters {
+		ckeys = append(ckeys, k)
+	}
+	sort.Slice(ckeys, func(i, j int) bool {
+		a, b := ckeys[i], ckeys[j]
+		if a.op != b.op {
+			return a.op < b.op
+		} else if a.code != b.code {
+			return a.code < b.code
+		} else if a.outcome != b.outcome {
+			return a.outcome < b.outcome
+		}
+		return a.failCode < b.failCode
+	})
+	for _, k := range ckeys {
+		writeSample(buf, name, labels("op", k.op, "code", k.code, "outcome", k.outcome, "fail_code", k.failCode), float64(c.counters[k]))
+	}
+	name = c.namespace + "_request_duration_seconds"
+	writeHeader(buf, name, "histogram", "渠道调用耗时")
+	hkeys := make([]histogramKey, 0, len(c.histograms))
+	for k := range c.histograms {
+		hkeys = append(hkeys, k)
+	}
+	sort.Slice(hkeys, func(i, j int) bool {
+		a, b := hkeys[i], hkeys[j]
+		if a.op != b.op {
+			return a.op < b.op
+		} else if a.code != b.code {
+			return a.code < b.code
+		}
+		return a.outcome < b.outcome
+	})
+	for _, k := range hkeys {
+		h := c.histograms[k]
+		var total uint64
+		for i, v := range c.buckets {
+			total += h.counts[i]
+			writeSample(buf, name+"_bucket", labels("op", k.op, "code", k.code, "outcome", k.outcome, "le", formatFloat(v)), float64(total))
+		}
+		writeSample(buf, name+"_bucket", labels("op", k.op, "code", k.code, "outcome", k.outcome, "le", "+Inf"), float64(h.count))
+		writeSample(buf, name+"_sum", labels("op", k.op, "code", k.code, "outcome", k.outcome), h.sum)
+		writeSample(buf, name+"_count", labels("op", k.op, "code", k.code, "outcome", k.outcome), float64(h.count))
+	}
+	registry, namespace := c.registry, c.namespace
+	c.lock.Unlock()
+	if registry != nil {
+		writeHealth(buf, namespace, "payment", registry.PaymentHealths())
+		writeHealth(buf, namespace, "withdraw", registry.WithdrawHealths())
+	}
+	return buf.Bytes()
+}
+
+//输出渠道熔断状态,0:正常 1:熔断 2:半开
+func writeHealth(buf *bytes.Buffer, namespace, kind string, healths []*payment.Health) {
+	name := namespace + "_" + kind + "_circuit_state"
+	writeHeader(buf, name, "gauge", "渠道熔断状态 0:正常 1:熔断 2:半开")
+	for _, h := range healths {
+		state := 0.0
+		switch h.State {
+		case payment.CircuitOpen:
+			state = 1
+		case payment.CircuitHalfOpen:
+			state = 2
+		}
+		writeSample(buf, name, labels("code", h.Code), state)
+	}
+}
+
+func writeHeader(buf *bytes.Buffer, name, tp, help string) {
+	buf.WriteString("# HELP " + name + " " + help + "\n")
+	buf.WriteString("# TYPE " + name + " " + tp + "\n")
+}
+
+func writeSample(buf *bytes.Buffer, name, labels string, value float64) {
+	buf.WriteString(name)
+	buf.WriteString(labels)
+	buf.WriteString(" ")
+	buf.WriteString(formatFloat(value))
+	buf.WriteString("\n")
+}
+
+//标签,参数为名称和值交替
+func labels(kv ...string) string {
+	items := make([]string, 0, len(kv)/2)
+	for i := 0; i+1 < len(kv); i += 2 {
+		items = append(items, kv[i]+"=\""+escape(kv[i+1])+"\"")
+	}
+	return "{" + strings.Join(items, ",") + "}"
+}
+
+var labelEscaper = strings.NewReplacer("\\", "\\\\", "\"", "\\\"", "\n", "\\n")
+
+//标签值转义
+func escape(v string) string {
+	return labelEscaper.Replace(v)
+}
+
+func formatFloat(v float64) string {
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
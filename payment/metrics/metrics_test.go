@@ -0,0 +1,37 @@
+package metrics
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kinwyb/golang/payment"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func Test_Collector(t *testing.T) {
+	convey.Convey("指标输出", t, func() {
+		c := New(0.1, 1)
+		c.Observe(payment.OpPay, "wxpay", payment.OutcomeSuccess, "", 50*time.Millisecond)
+		c.Observe(payment.OpPay, "wxpay", payment.OutcomeSuccess, "", 500*time.Millisecond)
+		c.Observe(payment.OpWithdraw, "ali\"pay", payment.OutcomeFail, "REQUEST_FAIL", 2*time.Second)
+		r := payment.NewRegistry()
+		r.SetObserver(c)
+		c.SetRegistry(r)
+		r.DoWithdraw("none", &payment.WithdrawInfo{})
+		w := httptest.NewRecorder()
+		c.ServeHTTP(w, httptest.NewRequest("GET", "/metrics", nil))
+		body := w.Body.String()
+		convey.So(w.Header().Get("Content-Type"), convey.ShouldStartWith, "text/plain")
+		convey.So(body, convey.ShouldContainSubstring, "# TYPE payment_requests_total counter\n")
+		convey.So(body, convey.ShouldContainSubstring, `payment_requests_total{op="pay",code="wxpay",outcome="success",fail_code=""} 2`)
+		convey.So(body, convey.ShouldContainSubstring, `payment_requests_total{op="withdraw",code="ali\"pay",outcome="fail",fail_code="REQUEST_FAIL"} 1`)
+		convey.So(body, convey.ShouldContainSubstring, `payment_request_duration_seconds_bucket{op="pay",code="wxpay",outcome="success",le="0.1"} 1`)
+		convey.So(body, convey.ShouldContainSubstring, `payment_request_duration_seconds_bucket{op="pay",code="wxpay",outcome="success",le="1"} 2`)
+		convey.So(body, convey.ShouldContainSubstring, `payment_request_duration_seconds_bucket{op="withdraw",code="ali\"pay",outcome="fail",le="+Inf"} 1`)
+		convey.So(body, convey.ShouldContainSubstring, `payment_request_duration_seconds_count{op="pay",code="wxpay",outcome="success"} 2`)
+		convey.So(body, convey.ShouldContainSubstring, "# TYPE payment_payment_circuit_state gauge\n")
+		convey.So(strings.HasSuffix(body, "\n"), convey.ShouldBeTrue)
+	})
+}
@@ -0,0 +1,78 @@
+package payment
+
+import (
+	"time"
+)
+
+//调用操作
+const (
+	OpPay            = "pay"             //支付
+	OpPayConfirm     = "pay_confirm"     //支付确认
+	OpNotify         = "notify"          //异步通知
+	OpQuery          = "query"           //支付查询
+	OpWithdraw       = "withdraw"        //提现
+	OpQueryWithdraw  = "query_withdraw"  //提现查询
+	OpNotifyWithdraw = "notify_withdraw" //提现异步通知
+)
+
+//调用结果
+const (
+	OutcomeSuccess = "success" //成功
+	OutcomeFail    = "fail"    //失败
+	OutcomeDealing = "dealing" //处理中
+	OutcomeUnknow  = "unknow"  //未知
+)
+
+//Observer 渠道调用观察者,注册中心每次调用渠道后通知,用于统计指标
+//	只统计经过注册中心方法(Pay/Query/DoWithdraw等)的调用,收银台、超时处理和路由都通过注册中心调用渠道
+//	通过Registry.Payment/Withdraw取得支付对象后直接调用(如代扣、协议支付、风控包装内部的提现)时不通知
+//	failCode 为失败原因编码,支付类操作使用提现失败编码中的REQUEST_NOT_SENT/REQUEST_FAIL表示网关错误
+//	支付结果(PayResult)的failCode取自PayResult.Err,没有设置Err的失败结果failCode为空
+type Observer interface {
+	Observe(op, code, outcome, failCode string, latency time.Duration)
+}
+
+//ObserverFunc 函数形式的观察者
+type ObserverFunc func(op, code, outcome, failCode string, latency time.Duration)
+
+//Observe 通知调用结果
+func (f ObserverFunc) Observe(op, code, outcome, failCode string, latency time.Duration) {
+	f(op, code, outcome, failCode, latency)
+}
+
+//支付错误的结果
+func errOutcome(err error) (string, string) {
+	if err == nil {
+		return OutcomeSuccess, ""
+	} else if IsRequestNotSent(err) {
+		return OutcomeFail, WithdrawRequestNotSent.FailCode
+	} else if IsRequestFailed(err) {
+		return OutcomeFail, WithdrawRequestFail.FailCode
+	}
+	return OutcomeFail, ""
+}
+
+//支付结果的结果,失败原因编码取自结果中的请求错误
+func payOutcome(ret *PayResult) (string, string) {
+	if ret == nil {
+		return OutcomeUnknow, ""
+	} else if ret.Succ {
+		return OutcomeSuccess, ""
+	} else if ret.Err != nil {
+		return errOutcome(ret.Err)
+	}
+	return OutcomeFail, ""
+}
+
+//提现状态的结果
+func statusOutcome(status Status, failCode string) (string, string) {
+	switch status {
+	case SUCCESS:
+		return OutcomeSuccess, ""
+	case FAIL:
+		return OutcomeFail, failCode
+	case DEALING:
+		return OutcomeDealing, failCode
+	}
+	return OutcomeUnknow, failCode
+}
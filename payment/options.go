@@ -0,0 +1,79 @@
+package payment
+
+import (
+	"crypto/rand"
+	"net/http"
+	"time"
+
+	"github.com/kinwyb/golang/utils"
+)
+
+//Options 支付/提现对象运行参数,通过Config.Options在GetPayment/GetWithdraw时传入
+//	每个对象独立设置,未设置的参数使用默认值:驱动注入时的日志、http.DefaultClient、time.Now、32位随机字符串
+//	同一驱动的多个商户可以使用不同日志和HTTP客户端,测试时可以固定时钟和随机字符串
+type Options struct {
+	Logger     utils.Logger     //日志
+	HTTPClient *http.Client     //HTTP客户端,需要客户端证书的请求设置后不再使用证书创建客户端
+	Clock      func() time.Time //时钟
+	Nonce      func() string    //随机字符串
+}
+
+//SetOptions 设置运行参数,logger为驱动包的日志变量,Options中未设置日志时使用
+func (p *PayInfo) SetOptions(opts *Options, logger *utils.Logger) {
+	p.opts = opts
+	p.logger = logger
+}
+
+//Log 日志输出
+func (p *PayInfo) Log(level utils.LoggerLevel, format string, args ...interface{}) {
+	if opts := p.options(); opts.Logger != nil {
+		utils.WriteLog(opts.Logger, level, format, args...)
+	} else if p != nil && p.logger != nil {
+		utils.WriteLog(*p.logger, level, format, args...)
+	}
+}
+
+//HTTPClient HTTP客户端,未设置时返回def,def为空返回http.DefaultClient
+func (p *PayInfo) HTTPClient(def ...*http.Client) *http.Client {
+	if opts := p.options(); opts.HTTPClient != nil {
+		return opts.HTTPClient
+	} else if len(def) > 0 && def[0] != nil {
+		return def[0]
+	}
+	return http.DefaultClient
+}
+
+//Now 当前时间
+func (p *PayInfo) Now() time.Time {
+	if opts := p.options(); opts.Clock != nil {
+		return opts.Clock()
+	}
+	return time.Now()
+}
+
+//Nonce 随机字符串
+func (p *PayInfo) Nonce() string {
+	if opts := p.options(); opts.Nonce != nil {
+		return opts.Nonce()
+	}
+	return NonceStr()
+}
+
+//运行参数,未设置时返回空参数
+func (p *PayInfo) options() *Options {
+	if p == nil || p.opts == nil {
+		return &Options{}
+	}
+	return p.opts
+}
+
+//NonceStr 32位随机字符串,由小写字母和数字组成
+func NonceStr() string {
+	chars := []byte("abcdefghijklmnopqrstuvwxyz0123456789")
+	buf := make([]byte, 32)
+	rand.Read(buf)
+	for i, v := range buf {
+		buf[i] = chars[int(v)%len(chars)]
+	}
+	return string(buf)
+}
@@ -0,0 +1,63 @@
+package payment
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/kinwyb/golang/utils"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+type optionsTestLogger []string
+
+func (l *optionsTestLogger) add(format string, args ...interface{}) {
+	*l = append(*l, fmt.Sprintf(format, args...))
+}
+
+func (l *optionsTestLogger) Trace(format string, args ...interface{})   { l.add(format, args...) }
+func (l *optionsTestLogger) Debug(format string, args ...interface{})   { l.add(format, args...) }
+func (l *optionsTestLogger) Info(format string, args ...interface{})    { l.add(format, args...) }
+func (l *optionsTestLogger) Warning(format string, args ...interface{}) { l.add(format, args...) }
+func (l *optionsTestLogger) Error(format string, args ...interface{})   { l.add(format, args...) }
+
+func Test_Options(t *testing.T) {
+	convey.Convey("默认参数", t, func() {
+		var p *PayInfo
+		p.Log(utils.LogLevelInfo, "nil")
+		convey.So(p.HTTPClient(), convey.ShouldEqual, http.DefaultClient)
+		convey.So(len(p.Nonce()), convey.ShouldEqual, 32)
+		convey.So(NonceStr(), convey.ShouldNotEqual, NonceStr())
+		def := &http.Client{Timeout: time.Second}
+		p = &PayInfo{}
+		convey.So(p.HTTPClient(def), convey.ShouldEqual, def)
+		convey.So(time.Since(p.Now()), convey.ShouldBeLessThan, time.Second)
+	})
+	convey.Convey("对象参数", t, func() {
+		pkg := &optionsTestLogger{}
+		var lg utils.Logger = pkg
+		a, b := &PayInfo{}, &PayInfo{}
+		own := &optionsTestLogger{}
+		now := time.Date(2017, 10, 19, 10, 0, 0, 0, time.Local)
+		client := &http.Client{}
+		a.SetOptions(&Options{
+			Logger:     own,
+			HTTPClient: client,
+			Clock:      func() time.Time { return now },
+			Nonce:      func() string { return "nonce" },
+		}, &lg)
+		b.SetOptions(nil, &lg)
+		a.Log(utils.LogLevelInfo, "a%d", 1)
+		b.Log(utils.LogLevelInfo, "b%d", 1)
+		convey.So(*own, convey.ShouldResemble, optionsTestLogger{"a1"})
+		convey.So(*pkg, convey.ShouldResemble, optionsTestLogger{"b1"})
+		other := &optionsTestLogger{}
+		lg = other
+		b.Log(utils.LogLevelError, "b2")
+		convey.So(*other, convey.ShouldResemble, optionsTestLogger{"b2"})
+		convey.So(a.HTTPClient(&http.Client{}), convey.ShouldEqual, client)
+		convey.So(a.Now(), convey.ShouldResemble, now)
+		convey.So(a.Nonce(), convey.ShouldEqual, "nonce")
+	})
+}
@@ -1,6 +1,10 @@
 package payment
 
-import "time"
+import (
+	"time"
+
+	"github.com/kinwyb/golang/payment/qrcode"
+)
 
 //NoPayConfirmResult 无需确认支付步骤结果提示
 var NoPayConfirmResult = &PayResult{
@@ -26,6 +30,36 @@ type Driver interface {
 	GetPayment(interface{}) Payment //生成一个支付对象
 }
 
+//Query 支付查询接口,支持主动查询交易结果的支付方式实现
+type Query interface {
+	Query(tradeno string, tradeDate ...time.Time) *PayResult //根据交易单号查询支付结果
+}
+
+//Refund 退款接口,支持退款的支付方式实现
+type Refund interface {
+	Refund(req *RefundRequest) *RefundResult //退款操作
+}
+
+//RefundNotify 退款异步通知接口,第三方主动推送退款结果的支付方式实现
+type RefundNotify interface {
+	RefundNotify(params map[string]string) *RefundResult //退款异步通知处理,验签失败或非退款通知返回UNKNOW状态
+}
+
+//Close 关闭交易接口,支持关闭未支付交易的支付方式实现,交易关闭后用户无法继续支付
+type Close interface {
+	Close(tradeno string) error //根据交易单号关闭交易,交易已支付时返回错误
+}
+
+//Bill 对账单下载接口,支持下载交易对账单的支付方式实现
+type Bill interface {
+	Bill(date time.Time) (*BillResult, error) //查询指定日期的交易对账单
+}
+
+//QRCodePay 扫码支付接口,支持返回二维码的支付方式实现
+type QRCodePay interface {
+	QRCodePay(req *PayRequest, opt *qrcode.Options) (*QRCodeResult, error) //扫码支付,opt不为空时同时返回生成的二维码图片
+}
+
 //Withdraw 提现接口
 type Withdraw interface {
 	Withdraw(info *WithdrawInfo) *WithdrawResult                               //提现操作,成功返回第三方交易流水,失败返回错误
@@ -35,6 +69,17 @@ type Withdraw interface {
 	Start() bool                                                               //启用状态
 }
 
+//WithdrawNotify 提现异步通知接口,第三方主动推送提现结果的提现方式实现
+type WithdrawNotify interface {
+	WithdrawNotify(params map[string]string) *WithdrawQueryResult //提现异步结果通知处理,验签失败返回UNKNOW状态
+	WithdrawNotifyResult(ret *WithdrawQueryResult) string         //提现异步通知处理结果返回内容
+}
+
+//WithdrawBalance 出款账户余额查询接口,支持查询余额的提现方式实现
+type WithdrawBalance interface {
+	Balance() (*BalanceResult, error) //查询出款账户余额
+}
+
 //WithdrawDriver 提现方式驱动接口
 type WithdrawDriver interface {
 	Driver() string                   //获取驱动编码
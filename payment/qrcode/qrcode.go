@@ -0,0 +1,238 @@
+package qrcode
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"strings"
+
+	"github.com/boombuler/barcode"
+	"github.com/boombuler/barcode/qr"
+)
+
+//二维码生成,支付二维码统一使用本包渲染
+
+//Format 图片格式
+type Format string
+
+const (
+	PNG     Format = "png"     //PNG图片数据
+	SVG     Format = "svg"     //SVG图片
+	DataURI Format = "datauri" //base64编码的PNG图片data-URI
+)
+
+//Level 容错级别
+type Level string
+
+const (
+	L Level = "L" //可恢复7%
+	M Level = "M" //可恢复15%
+	Q Level = "Q" //可恢复25%
+	H Level = "H" //可恢复30%
+)
+
+//Options 二维码生成参数
+type Options struct {
+	Format Format      //图片格式,默认DataURI
+	Size   int         //图片宽高(像素),默认256
+	Level  Level       //容错级别,默认M,设置Logo时自动提升为H
+	Margin int         //边距(模块数),默认0
+	Logo   image.Image //中间Logo,为空时不添加
+}
+
+//默认值
+func (o *Options) defaults() *Options {
+	ret := &Options{}
+	if o != nil {
+		*ret = *o
+	}
+	if ret.Format == "" {
+		ret.Format = DataURI
+	}
+	if ret.Size <= 0 {
+		ret.Size = 256
+	}
+	if ret.Level == "" {
+		ret.Level = M
+	}
+	if ret.Logo != nil {
+		ret.Level = H
+	}
+	if ret.Margin < 0 {
+		ret.Margin = 0
+	}
+	return ret
+}
+
+func (l Level) qr() qr.ErrorCorrectionLevel {
+	switch strings.ToUpper(string(l)) {
+	case "L":
+		return qr.L
+	case "Q":
+		return qr.Q
+	case "H":
+		return qr.H
+	}
+	return qr.M
+}
+
+//Render 根据参数中的图片格式生成二维码
+//	PNG格式返回PNG图片数据,SVG格式返回SVG内容,DataURI格式返回data:image/png;base64,...
+func Render(content string, opt *Options) (string, error) {
+	opt = opt.defaults()
+	switch opt.Format {
+	case PNG:
+		data, err := EncodePNG(content, opt)
+		return string(data), err
+	case SVG:
+		return EncodeSVG(content, opt)
+	case DataURI:
+		return EncodeDataURI(content, opt)
+	}
+	return "", errors.New("不支持的二维码图片格式:" + string(opt.Format))
+}
+
+//Encode 生成二维码图片
+func Encode(content string, opt *Options) (image.Image, error) {
+	opt = opt.defaults()
+	code, err := encode(content, opt)
+	if err != nil {
+		return nil, err
+	}
+	total := code.Bounds().Dx() + opt.Margin*2
+	size := opt.Size
+	if size < total {
+		size = total
+	}
+	scale := size / total
+	offset := (size-scale*total)/2 + opt.Margin*scale
+	img := image.NewRGBA(image.Rect(0, 0, size, size))
+	draw.Draw(img, img.Bounds(), image.White, image.ZP, draw.Src)
+	eachModule(code, func(x, y int) {
+		rect := image.Rect(offset+x*scale, offset+y*scale, offset+(x+1)*scale, offset+(y+1)*scale)
+		draw.Draw(img, rect, image.Black, image.ZP, draw.Src)
+	})
+	if opt.Logo != nil {
+		drawLogo(img, opt.Logo)
+	}
+	return img, nil
+}
+
+//EncodePNG 生成PNG格式二维码
+func EncodePNG(content string, opt *Options) ([]byte, error) {
+	img, err := Encode(content, opt)
+	if err != nil {
+		return nil, err
+	}
+	buf := &bytes.Buffer{}
+	err = png.Encode(buf, img)
+	if err != nil {
+		return nil, errors.New("图像编码失败:" + err.Error())
+	}
+	return buf.Bytes(), nil
+}
+
+//EncodeDataURI 生成base64编码的PNG图片data-URI
+func EncodeDataURI(content string, opt *Options) (string, error) {
+	data, err := EncodePNG(content, opt)
+	if err != nil {
+		return "", err
+	}
+	return "data:image/png;base64," + base64.StdEncoding.EncodeToString(data), nil
+}
+
+//EncodeSVG 生成SVG格式二维码
+func EncodeSVG(content string, opt *Options) (string, error) {
+	opt = opt.defaults()
+	code, err := encode(content, opt)
+	if err != nil {
+		return "", err
+	}
+	n := code.Bounds().Dx()
+	total := n + opt.Margin*2
+	buf := bytes.NewBufferString("")
+	fmt.Fprintf(buf, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" shape-rendering="crispEdges">`,
+		opt.Size, opt.Size, total, total)
+	fmt.Fprintf(buf, `<rect width="%d" height="%d" fill="#fff"/><path fill="#000" d="`, total, total)
+	eachModule(code, func(x, y int) {
+		fmt.Fprintf(buf, "M%d %dh1v1h-1z", x+opt.Margin, y+opt.Margin)
+	})
+	buf.WriteString(`"/>`)
+	if opt.Logo != nil {
+		logo := &bytes.Buffer{}
+		if err = png.Encode(logo, opt.Logo); err != nil {
+			return "", errors.New("Logo编码失败:" + err.Error())
+		}
+		w := float64(n) / 5
+		pos := float64(opt.Margin) + (float64(n)-w)/2
+		fmt.Fprintf(buf, `<rect x="%.2f" y="%.2f" width="%.2f" height="%.2f" fill="#fff"/>`, pos-0.5, pos-0.5, w+1, w+1)
+		fmt.Fprintf(buf, `<image x="%.2f" y="%.2f" width="%.2f" height="%.2f" href="data:image/png;base64,%s"/>`,
+			pos, pos, w, w, base64.StdEncoding.EncodeToString(logo.Bytes()))
+	}
+	buf.WriteString("</svg>")
+	return buf.String(), nil
+}
+
+//编码二维码
+func encode(content string, opt *Options) (barcode.Barcode, error) {
+	if content == "" {
+		return nil, errors.New("二维码内容不能为空")
+	}
+	code, err := qr.Encode(content, opt.Level.qr(), qr.Auto)
+	if err != nil {
+		return nil, errors.New("二维码编码失败:" + err.Error())
+	}
+	return code, nil
+}
+
+//遍历二维码中的黑色模块
+func eachModule(code barcode.Barcode, fun func(x, y int)) {
+	bounds := code.Bounds()
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			if r, _, _, _ := code.At(x, y).RGBA(); r == 0 {
+				fun(x-bounds.Min.X, y-bounds.Min.Y)
+			}
+		}
+	}
+}
+
+//在二维码中间绘制Logo,Logo宽高为二维码的1/5并带白色底框
+func drawLogo(img *image.RGBA, logo image.Image) {
+	size := img.Bounds().Dx()
+	w := size / 5
+	if w < 1 {
+		return
+	}
+	pos := (size - w) / 2
+	pad := w / 10
+	draw.Draw(img, image.Rect(pos-pad, pos-pad, pos+w+pad, pos+w+pad), image.White, image.ZP, draw.Src)
+	lb := logo.Bounds()
+	for y := 0; y < w; y++ {
+		for x := 0; x < w; x++ { //最近邻缩放
+			c := logo.At(lb.Min.X+x*lb.Dx()/w, lb.Min.Y+y*lb.Dy()/w)
+			img.Set(pos+x, pos+y, over(img.At(pos+x, pos+y), c))
+		}
+	}
+}
+
+//半透明颜色叠加
+func over(dst, src color.Color) color.Color {
+	sr, sg, sb, sa := src.RGBA()
+	if sa == 0xffff {
+		return src
+	}
+	dr, dg, db, _ := dst.RGBA()
+	a := 0xffff - sa
+	return color.RGBA64{
+		R: uint16(sr + dr*a/0xffff),
+		G: uint16(sg + dg*a/0xffff),
+		B: uint16(sb + db*a/0xffff),
+		A: 0xffff,
+	}
+}
@@ -0,0 +1,59 @@
+package qrcode
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"strings"
+	"testing"
+
+	"github.com/smartystreets/goconvey/convey"
+)
+
+const content = "weixin://wxpay/bizpayurl?pr=abcdefg"
+
+func Test_Encode(t *testing.T) {
+	convey.Convey("二维码生成", t, func() {
+		convey.Convey("PNG", func() {
+			data, err := EncodePNG(content, &Options{Size: 200, Margin: 2})
+			convey.So(err, convey.ShouldBeNil)
+			img, err := png.Decode(bytes.NewReader(data))
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(img.Bounds().Dx(), convey.ShouldEqual, 200)
+			r, _, _, _ := img.At(0, 0).RGBA()
+			convey.So(r, convey.ShouldEqual, 0xffff)
+		})
+		convey.Convey("DataURI", func() {
+			uri, err := Render(content, nil)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(strings.HasPrefix(uri, "data:image/png;base64,"), convey.ShouldBeTrue)
+		})
+		convey.Convey("SVG", func() {
+			svg, err := Render(content, &Options{Format: SVG, Size: 120, Level: Q})
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(strings.HasPrefix(svg, "<svg"), convey.ShouldBeTrue)
+			convey.So(svg, convey.ShouldContainSubstring, `width="120"`)
+		})
+		convey.Convey("Logo", func() {
+			logo := image.NewRGBA(image.Rect(0, 0, 10, 10))
+			for x := 0; x < 10; x++ {
+				for y := 0; y < 10; y++ {
+					logo.Set(x, y, color.RGBA{R: 255, A: 255})
+				}
+			}
+			img, err := Encode(content, &Options{Size: 250, Logo: logo})
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(img.At(125, 125), convey.ShouldResemble, color.RGBA{R: 255, A: 255})
+			svg, err := EncodeSVG(content, &Options{Logo: logo})
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(svg, convey.ShouldContainSubstring, "<image")
+		})
+		convey.Convey("异常", func() {
+			_, err := Render("", nil)
+			convey.So(err, convey.ShouldNotBeNil)
+			_, err = Render(content, &Options{Format: "gif"})
+			convey.So(err, convey.ShouldNotBeNil)
+		})
+	})
+}
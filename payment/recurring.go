@@ -0,0 +1,100 @@
+package payment
+
+import (
+	"errors"
+	"time"
+)
+
+//AgreementStatus 代扣协议状态
+type AgreementStatus string
+
+const (
+	AgreementSigning AgreementStatus = "SIGNING" //签约中
+	AgreementNormal  AgreementStatus = "NORMAL"  //已签约,可以扣款
+	AgreementStop    AgreementStatus = "STOP"    //已解约
+	AgreementUnknow  AgreementStatus = "UNKNOW"  //未知
+)
+
+//扣款周期类型
+const (
+	PeriodDay   = "DAY"   //按天
+	PeriodMonth = "MONTH" //按月
+)
+
+//Recurring 周期扣款接口,支持签约代扣协议后按协议扣款的支付方式实现
+//	1. Sign 返回签约页面或签约参数,用户确认后第三方通过SignNotify推送签约结果
+//	2. Charge 按协议号扣款,扣款结果与普通支付相同通过Notify异步通知
+//	3. Unsign 商户解约,用户在第三方解约同样通过SignNotify推送
+//	使用方式: p.(payment.Recurring).Sign(req)
+type Recurring interface {
+	Sign(req *SignRequest) (string, error)                         //发起签约,返回签约页面/跳转地址/APP签约参数
+	SignNotify(params map[string]string) *AgreementResult          //签约/解约异步通知处理
+	SignNotifyResult(ret *AgreementResult) string                  //签约/解约异步通知处理结果返回内容
+	QueryAgreement(agreementNo string, no string) *AgreementResult //查询协议,agreementNo为空时使用商户签约号查询
+	Unsign(agreementNo string, remark string) error                //解约
+	Charge(req *ChargeRequest) (*PayResult, error)                 //按协议扣款,返回nil时扣款处理中,结果通过Notify通知
+}
+
+//SignRequest 代扣签约请求
+type SignRequest struct {
+	No            string    `description:"商户签约号,商户侧唯一"`
+	MemberID      string    `description:"商户网站用户唯一标识"`
+	Desc          string    `description:"签约展示名称"`
+	IsApp         bool      `description:"是否是APP签约"`
+	IP            string    `description:"签约发起端IP"`
+	PeriodType    string    `description:"扣款周期类型 DAY/MONTH[支付宝必填]"`
+	Period        int       `description:"扣款周期数[支付宝必填]"`
+	ExecuteTime   time.Time `description:"首次扣款时间[支付宝必填]"`
+	SingleMoney   float64   `description:"单次扣款最大金额[支付宝必填]"`
+	TotalMoney    float64   `description:"累计扣款最大金额,0表示不限制"`
+	TotalPayments int       `description:"总扣款次数,0表示不限制"`
+}
+
+//Validate 校验签约请求基本信息,周期规则由各支付方式按需校验
+func (s *SignRequest) Validate() error {
+	if s == nil {
+		return errors.New("签约请求不能为空")
+	} else if s.No == "" {
+		return errors.New("签约请求[No]不能为空")
+	} else if s.SingleMoney < 0 || s.TotalMoney < 0 || s.TotalPayments < 0 {
+		return errors.New("签约请求扣款金额/次数不能小于0")
+	} else if s.PeriodType != "" && s.PeriodType != PeriodDay && s.PeriodType != PeriodMonth {
+		return errors.New("签约请求[PeriodType]只能是DAY/MONTH")
+	}
+	return nil
+}
+
+//AgreementResult 代扣协议签约/解约通知及查询结果
+type AgreementResult struct {
+	Succ         bool              //通知验签/查询是否成功
+	ErrMsg       string            //错误消息
+	PayCode      string            //支付方式编码
+	No           string            //商户签约号
+	AgreementNo  string            //第三方协议号,扣款和解约时使用
+	ThirdAccount string            //第三方用户标识
+	Status       AgreementStatus   //协议状态
+	SignTime     string            //签约时间
+	InvalidTime  string            //协议失效/解约时间
+	Navite       map[string]string //原始数据
+}
+
+//ChargeRequest 协议扣款请求
+type ChargeRequest struct {
+	No          string  `description:"交易单号"`
+	AgreementNo string  `description:"第三方协议号"`
+	Desc        string  `description:"交易描述"`
+	Money       float64 `description:"扣款金额"`
+	IP          string  `description:"交易发起端IP"`
+}
+
+//Validate 校验扣款请求
+func (c *ChargeRequest) Validate() error {
+	if c == nil {
+		return errors.New("扣款请求不能为空")
+	} else if c.No == "" || c.AgreementNo == "" {
+		return errors.New("扣款请求[No/AgreementNo]不能为空")
+	} else if c.Money <= 0 {
+		return errors.New("扣款请求[Money]必须大于0")
+	}
+	return nil
+}
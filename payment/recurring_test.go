@@ -0,0 +1,50 @@
+package payment
+
+import (
+	"testing"
+
+	"github.com/smartystreets/goconvey/convey"
+)
+
+type recurringTestPay struct {
+	healthTestPay
+}
+
+func (t *recurringTestPay) Sign(req *SignRequest) (string, error)                  { return "", nil }
+func (t *recurringTestPay) SignNotify(params map[string]string) *AgreementResult   { return nil }
+func (t *recurringTestPay) SignNotifyResult(ret *AgreementResult) string           { return "" }
+func (t *recurringTestPay) QueryAgreement(agreementNo, no string) *AgreementResult { return nil }
+func (t *recurringTestPay) Unsign(agreementNo, remark string) error                { return nil }
+func (t *recurringTestPay) Charge(req *ChargeRequest) (*PayResult, error)          { return nil, nil }
+
+func Test_Recurring(t *testing.T) {
+	convey.Convey("签约请求校验", t, func() {
+		var req *SignRequest
+		convey.So(req.Validate(), convey.ShouldNotBeNil)
+		req = &SignRequest{}
+		convey.So(req.Validate(), convey.ShouldNotBeNil)
+		req.No = "s1"
+		convey.So(req.Validate(), convey.ShouldBeNil)
+		req.PeriodType = "WEEK"
+		convey.So(req.Validate(), convey.ShouldNotBeNil)
+		req.PeriodType = PeriodMonth
+		req.SingleMoney = -1
+		convey.So(req.Validate(), convey.ShouldNotBeNil)
+	})
+	convey.Convey("扣款请求校验", t, func() {
+		req := &ChargeRequest{No: "1", Money: 10}
+		convey.So(req.Validate(), convey.ShouldNotBeNil)
+		req.AgreementNo = "a1"
+		convey.So(req.Validate(), convey.ShouldBeNil)
+		req.Money = 0
+		convey.So(req.Validate(), convey.ShouldNotBeNil)
+	})
+	convey.Convey("能力识别", t, func() {
+		p := &recurringTestPay{}
+		p.Init("r", "R", true)
+		convey.So(PaymentCapabilities(p).Has(OpRecurring), convey.ShouldBeTrue)
+		q := &healthTestPay{}
+		q.Init("a", "A", true)
+		convey.So(PaymentCapabilities(q).Has(OpRecurring), convey.ShouldBeFalse)
+	})
+}
@@ -0,0 +1,528 @@
+package payment
+
+import (
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/kinwyb/golang/payment/qrcode"
+)
+
+var (
+	//ErrDriverExists 驱动已注册
+	ErrDriverExists = errors.New("驱动已存在")
+	//ErrDriverNotFound 驱动不存在
+	ErrDriverNotFound = errors.New("驱动不存在")
+	//ErrConfigInvalid 配置信息无效
+	ErrConfigInvalid = errors.New("配置信息无效")
+	//ErrPaymentNotFound 支付/提现方式不存在
+	ErrPaymentNotFound = errors.New("支付方式不存在")
+	//ErrCircuitOpen 渠道熔断中,请求未发送
+	ErrCircuitOpen = errors.New("渠道熔断中")
+)
+
+//Registry 支付方式注册中心,管理支付/提现驱动以及按编码生成的支付/提现对象
+//	驱动注入: alipay.Driver(registry.RegDriver, logger)
+//	通过注册中心的Pay/QRCodePay/DoWithdraw/Query/Close调用渠道时记录渠道健康状态
+//	熔断只拦截Pay/QRCodePay/DoWithdraw等资金类请求,Query/Close/QueryWithdraw在熔断中仍然发送,用于确认交易结果
+//	QueryWithdraw的结果无法区分渠道请求失败和处理中,不记录渠道健康状态
+//	通过注册中心调用渠道时通知观察者,用于统计指标
+type Registry struct {
+	lock            sync.RWMutex
+	drivers         map[string]Driver
+	withdrawDrivers map[string]WithdrawDriver
+	payments        map[string]Payment
+	withdraws       map[string]Withdraw
+	payHealth       *HealthTracker
+	withdrawHealth  *HealthTracker
+	observer        Observer
+}
+
+//NewRegistry 创建注册中心
+func NewRegistry() *Registry {
+	return &Registry{
+		drivers:         map[string]Driver{},
+		withdrawDrivers: map[string]WithdrawDriver{},
+		payments:        map[string]Payment{},
+		withdraws:       map[string]Withdraw{},
+		payHealth:       NewHealthTracker(HealthConfig{}),
+		withdrawHealth:  NewHealthTracker(HealthConfig{}),
+	}
+}
+
+//RegDriver 注册支付驱动,符合RegDriverFun
+func (r *Registry) RegDriver(d Driver) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if _, ok := r.drivers[d.Driver()]; ok {
+		return ErrDriverExists
+	}
+	r.drivers[d.Driver()] = d
+	return nil
+}
+
+//RegWithdrawDriver 注册提现驱动,符合RegWithdrawDriverFun
+func (r *Registry) RegWithdrawDriver(d WithdrawDriver) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if _, ok := r.withdrawDrivers[d.Driver()]; ok {
+		return ErrDriverExists
+	}
+	r.withdrawDrivers[d.Driver()] = d
+	return nil
+}
+
+//AddPayment 根据驱动编码和配置生成支付对象并注册,相同编码的支付对象会被替换
+func (r *Registry) AddPayment(driver string, cfg interface{}) (Payment, error) {
+	r.lock.RLock()
+	d, ok := r.drivers[driver]
+	r.lock.RUnlock()
+	if !ok {
+		return nil, ErrDriverNotFound
+	}
+	p := d.GetPayment(cfg)
+	if p == nil {
+		return nil, ErrConfigInvalid
+	}
+	r.lock.Lock()
+	r.payments[p.Code()] = p
+	r.lock.Unlock()
+	return p, nil
+}
+
+//AddWithdraw 根据驱动编码和配置生成提现对象并注册,相同编码的提现对象会被替换
+func (r *Registry) AddWithdraw(driver string, cfg interface{}) (Withdraw, error) {
+	r.lock.RLock()
+	d, ok := r.withdrawDrivers[driver]
+	r.lock.RUnlock()
+	if !ok {
+		return nil, ErrDriverNotFound
+	}
+	w := d.GetWithdraw(cfg)
+	if w == nil {
+		return nil, ErrConfigInvalid
+	}
+	r.lock.Lock()
+	r.withdraws[w.Code()] = w
+	r.lock.Unlock()
+	return w, nil
+}
+
+//SetPayment 直接注册支付对象
+func (r *Registry) SetPayment(p Payment) {
+	r.lock.Lock()
+	r.payments[p.Code()] = p
+	r.lock.Unlock()
+}
+
+//SetWithdraw 直接注册提现对象
+func (r *Registry) SetWithdraw(w Withdraw) {
+	r.lock.Lock()
+	r.withdraws[w.Code()] = w
+	r.lock.Unlock()
+}
+
+//RemovePayment 删除支付对象
+func (r *Registry) RemovePayment(code string) {
+	r.lock.Lock()
+	delete(r.payments, code)
+	r.lock.Unlock()
+}
+
+//RemoveWithdraw 删除提现对象
+func (r *Registry) RemoveWithdraw(code string) {
+	r.lock.Lock()
+	delete(r.withdraws, code)
+	r.lock.Unlock()
+}
+
+//Payment 根据编码获取支付对象,不存在返回nil
+func (r *Registry) Payment(code string) Payment {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	return r.payments[code]
+}
+
+//Withdraw 根据编码获取提现对象,不存在返回nil
+func (r *Registry) Withdraw(code string) Withdraw {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	return r.withdraws[code]
+}
+
+//Payments 所有支付对象,按编码排序
+func (r *Registry) Payments() []Payment {
+	r.lock.RLock()
+	ret := make([]Payment, 0, len(r.payments))
+	for _, p := range r.payments {
+		ret = append(ret, p)
+	}
+	r.lock.RUnlock()
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].Code() < ret[j].Code()
+	})
+	return ret
+}
+
+//Withdraws 所有提现对象,按编码排序
+func (r *Registry) Withdraws() []Withdraw {
+	r.lock.RLock()
+	ret := make([]Withdraw, 0, len(r.withdraws))
+	for _, w := range r.withdraws {
+		ret = append(ret, w)
+	}
+	r.lock.RUnlock()
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].Code() < ret[j].Code()
+	})
+	return ret
+}
+
+//PaymentCapabilities 支付方式能力,不存在返回nil
+func (r *Registry) PaymentCapabilities(code string) *Capabilities {
+	p := r.Payment(code)
+	if p == nil {
+		return nil
+	}
+	return PaymentCapabilities(p)
+}
+
+//WithdrawCapabilities 提现方式能力,不存在返回nil
+func (r *Registry) WithdrawCapabilities(code string) *Capabilities {
+	w := r.Withdraw(code)
+	if w == nil {
+		return nil
+	}
+	return WithdrawCapabilities(w)
+}
+
+//PaymentsCapabilities 所有已启用支付方式的能力,按编码排序
+func (r *Registry) PaymentsCapabilities() []*Capabilities {
+	var ret []*Capabilities
+	for _, p := range r.Payments() {
+		if p.Start() {
+			ret = append(ret, PaymentCapabilities(p))
+		}
+	}
+	return ret
+}
+
+//WithdrawsCapabilities 所有已启用提现方式的能力,按编码排序
+func (r *Registry) WithdrawsCapabilities() []*Capabilities {
+	var ret []*Capabilities
+	for _, w := range r.Withdraws() {
+		if w.Start() {
+			ret = append(ret, WithdrawCapabilities(w))
+		}
+	}
+	return ret
+}
+
+//SetObserver 设置渠道调用观察者,只有通过注册中心方法发起的渠道调用会通知观察者
+func (r *Registry) SetObserver(o Observer) {
+	r.lock.Lock()
+	r.observer = o
+	r.lock.Unlock()
+}
+
+//通知观察者
+func (r *Registry) observe(op, code string, start time.Time, outcome, failCode string) {
+	r.lock.RLock()
+	o := r.observer
+	r.lock.RUnlock()
+	if o != nil {
+		o.Observe(op, code, outcome, failCode, time.Since(start))
+	}
+}
+
+//SetHealthConfig 设置渠道健康检测配置
+func (r *Registry) SetHealthConfig(config HealthConfig) {
+	r.payHealth.SetConfig(config)
+	r.withdrawHealth.SetConfig(config)
+}
+
+//PaymentHealth 支付方式健康状态
+func (r *Registry) PaymentHealth(code string) *Health {
+	return r.payHealth.Health(code)
+}
+
+//WithdrawHealth 提现方式健康状态
+func (r *Registry) WithdrawHealth(code string) *Health {
+	return r.withdrawHealth.Health(code)
+}
+
+//PaymentHealths 所有支付方式健康状态
+func (r *Registry) PaymentHealths() []*Health {
+	return r.payHealth.Healths()
+}
+
+//WithdrawHealths 所有提现方式健康状态
+func (r *Registry) WithdrawHealths() []*Health {
+	return r.withdrawHealth.Healths()
+}
+
+//PaymentAvailable 支付方式是否可用:已注册、已启用且未熔断,半开状态视为可用
+//	用于列出可用渠道,不占用半开状态的试探请求,试探请求在Pay发起时占用
+func (r *Registry) PaymentAvailable(code string) bool {
+	p := r.Payment(code)
+	return p != nil && p.Start() && r.payHealth.State(code) != CircuitOpen
+}
+
+//WithdrawAvailable 提现方式是否可用:已注册、已启用且未熔断,半开状态视为可用
+//	用于列出可用渠道,不占用半开状态的试探请求,试探请求在DoWithdraw发起时占用
+func (r *Registry) WithdrawAvailable(code string) bool {
+	w := r.Withdraw(code)
+	return w != nil && w.Start() && r.withdrawHealth.State(code) != CircuitOpen
+}
+
+//Pay 校验支付请求后使用指定支付方式支付并记录渠道健康状态,校验失败不发送请求
+//	渠道熔断中(半开状态已有试探请求)时返回RequestNotSent,可以切换其他支付方式
+func (r *Registry) Pay(code string, req *PayRequest) (string, error) {
+	p := r.Payment(code)
+	if p == nil {
+		return "", ErrPaymentNotFound
+	} else if err := ValidateRequest(p, req); err != nil {
+		return "", err
+	} else if !r.payHealth.Available(code) {
+		return "", &RequestNotSent{Err: ErrCircuitOpen}
+	}
+	start := time.Now()
+	ret, err := p.Pay(req)
+	r.payHealth.Record(code, IsGatewayError(err), time.Since(start))
+	outcome, failCode := errOutcome(err)
+	r.observe(OpPay, code, start, outcome, failCode)
+	return ret, err
+}
+
+//QRCodePay 校验支付请求后使用指定支付方式扫码支付并记录渠道健康状态,opt不为空时同时返回生成的二维码图片
+//	渠道熔断中(半开状态已有试探请求)时返回RequestNotSent,可以切换其他支付方式
+func (r *Registry) QRCodePay(code string, req *PayRequest, opt *qrcode.Options) (*QRCodeResult, error) {
+	p := r.Payment(code)
+	if p == nil {
+		return nil, ErrPaymentNotFound
+	}
+	q, ok := p.(QRCodePay)
+	if !ok {
+		return nil, errors.New("支付方式不支持扫码支付")
+	} else if err := ValidateRequest(p, req); err != nil {
+		return nil, err
+	} else if !r.payHealth.Available(code) {
+		return nil, &RequestNotSent{Err: ErrCircuitOpen}
+	}
+	start := time.Now()
+	ret, err := q.QRCodePay(req, opt)
+	r.payHealth.Record(code, IsGatewayError(err), time.Since(start))
+	outcome, failCode := errOutcome(err)
+	r.observe(OpQRCodePay, code, start, outcome, failCode)
+	return ret, err
+}
+
+//PayConfirm 使用指定支付方式确认支付
+func (r *Registry) PayConfirm(code string, req *PayConfirmRequest) *PayResult {
+	p := r.Payment(code)
+	if p == nil {
+		return &PayResult{PayCode: code, No: req.No, ErrMsg: ErrPaymentNotFound.Error()}
+	}
+	start := time.Now()
+	ret := p.PayConfirm(req)
+	outcome, failCode := payOutcome(ret)
+	r.observe(OpPayConfirm, code, start, outcome, failCode)
+	return ret
+}
+
+//Notify 使用指定支付方式处理异步通知
+func (r *Registry) Notify(code string, params map[string]string) *PayResult {
+	p := r.Payment(code)
+	if p == nil {
+		return &PayResult{PayCode: code, ErrMsg: ErrPaymentNotFound.Error()}
+	}
+	start := time.Now()
+	ret := p.Notify(params)
+	outcome, failCode := payOutcome(ret)
+	r.observe(OpNotify, code, start, outcome, failCode)
+	return ret
+}
+
+//Query 使用指定支付方式查询支付结果并记录渠道健康状态,熔断中仍然发送查询
+//	查询失败且没有返回原始数据时视为渠道请求失败,请求未发送(Err为RequestNotSent)时不记录
+func (r *Registry) Query(code string, tradeno string, tradeDate ...time.Time) *PayResult {
+	p := r.Payment(code)
+	q, ok := p.(Query)
+	if !ok {
+		return &PayResult{PayCode: code, TradeNo: tradeno, ErrMsg: "支付方式不支持查询"}
+	}
+	start := time.Now()
+	ret := q.Query(tradeno, tradeDate...)
+	if ret == nil || !IsRequestNotSent(ret.Err) {
+		r.payHealth.Record(code, ret == nil || (!ret.Succ && ret.Navite == nil), time.Since(start))
+	}
+	outcome, failCode := payOutcome(ret)
+	r.observe(OpQuery, code, start, outcome, failCode)
+	return ret
+}
+
+//Close 使用指定支付方式关闭交易并记录渠道健康状态,熔断中仍然发送关闭请求
+func (r *Registry) Close(code string, tradeno string) error {
+	p := r.Payment(code)
+	if p == nil {
+		return ErrPaymentNotFound
+	}
+	c, ok := p.(Close)
+	if !ok {
+		return errors.New("支付方式不支持关闭交易")
+	}
+	start := time.Now()
+	err := c.Close(tradeno)
+	r.payHealth.Record(code, IsGatewayError(err), time.Since(start))
+	outcome, failCode := errOutcome(err)
+	r.observe(OpClose, code, start, outcome, failCode)
+	return err
+}
+
+//Refund 使用指定支付方式退款
+func (r *Registry) Refund(code string, req *RefundRequest) *RefundResult {
+	p := r.Payment(code)
+	f, ok := p.(Refund)
+	if !ok {
+		return &RefundResult{Status: FAIL, No: req.No, RefundNo: req.RefundNo, PayCode: code, FailMsg: "支付方式不支持退款"}
+	}
+	start := time.Now()
+	ret := f.Refund(req)
+	if ret == nil {
+		r.observe(OpRefund, code, start, OutcomeUnknow, "")
+	} else {
+		outcome, failCode := statusOutcome(ret.Status, ret.FailCode)
+		r.observe(OpRefund, code, start, outcome, failCode)
+	}
+	return ret
+}
+
+//RefundNotify 使用指定支付方式处理退款异步通知
+func (r *Registry) RefundNotify(code string, params map[string]string) *RefundResult {
+	n, ok := r.Payment(code).(RefundNotify)
+	if !ok {
+		return &RefundResult{Status: UNKNOW, PayCode: code, FailMsg: "支付方式不支持退款通知"}
+	}
+	start := time.Now()
+	ret := n.RefundNotify(params)
+	if ret == nil {
+		r.observe(OpRefundNotify, code, start, OutcomeUnknow, "")
+	} else {
+		outcome, failCode := statusOutcome(ret.Status, ret.FailCode)
+		r.observe(OpRefundNotify, code, start, outcome, failCode)
+	}
+	return ret
+}
+
+//Bill 使用指定支付方式查询对账单
+func (r *Registry) Bill(code string, date time.Time) (*BillResult, error) {
+	p := r.Payment(code)
+	if p == nil {
+		return nil, ErrPaymentNotFound
+	}
+	b, ok := p.(Bill)
+	if !ok {
+		return nil, errors.New("支付方式不支持对账单下载")
+	}
+	start := time.Now()
+	ret, err := b.Bill(date)
+	outcome, failCode := errOutcome(err)
+	r.observe(OpBill, code, start, outcome, failCode)
+	return ret, err
+}
+
+//DoWithdraw 使用指定提现方式提现并记录渠道健康状态
+//	渠道熔断中(半开状态已有试探请求)时返回请求未发送的失败结果,可以切换其他提现方式
+func (r *Registry) DoWithdraw(code string, info *WithdrawInfo) *WithdrawResult {
+	w := r.Withdraw(code)
+	if w == nil {
+		return &WithdrawResult{
+			TradeNo:  info.TradeNo,
+			Status:   FAIL,
+			FailCode: "NO_CHANNEL",
+			FailMsg:  ErrPaymentNotFound.Error(),
+		}
+	} else if !r.withdrawHealth.Available(code) {
+		return &WithdrawResult{
+			TradeNo:  info.TradeNo,
+			Status:   FAIL,
+			FailCode: WithdrawRequestNotSent.FailCode,
+			FailMsg:  ErrCircuitOpen.Error(),
+		}
+	}
+	start := time.Now()
+	ret := w.Withdraw(info)
+	r.withdrawHealth.Record(code, withdrawGatewayFail(ret), time.Since(start))
+	if ret == nil {
+		r.observe(OpWithdraw, code, start, OutcomeUnknow, "")
+	} else {
+		outcome, failCode := statusOutcome(ret.Status, ret.FailCode)
+		r.observe(OpWithdraw, code, start, outcome, failCode)
+	}
+	return ret
+}
+
+//QueryWithdraw 使用指定提现方式查询提现结果,不检查熔断也不记录渠道健康状态
+func (r *Registry) QueryWithdraw(code string, tradeno string, tradeDate ...time.Time) *WithdrawQueryResult {
+	w := r.Withdraw(code)
+	if w == nil {
+		return &WithdrawQueryResult{TradeNo: tradeno, Status: UNKNOW, FailMsg: ErrPaymentNotFound.Error()}
+	}
+	start := time.Now()
+	ret := w.QueryWithdraw(tradeno, tradeDate...)
+	if ret == nil {
+		r.observe(OpQueryWithdraw, code, start, OutcomeUnknow, "")
+	} else {
+		outcome, failCode := statusOutcome(ret.Status, ret.FailCode)
+		r.observe(OpQueryWithdraw, code, start, outcome, failCode)
+	}
+	return ret
+}
+
+//WithdrawNotify 使用指定提现方式处理提现异步通知,返回处理结果和需要返回给第三方的内容
+func (r *Registry) WithdrawNotify(code string, params map[string]string) (*WithdrawQueryResult, string) {
+	w, ok := r.Withdraw(code).(WithdrawNotify)
+	if !ok {
+		return &WithdrawQueryResult{Status: UNKNOW, FailMsg: "提现方式不支持异步通知"}, ""
+	}
+	start := time.Now()
+	ret := w.WithdrawNotify(params)
+	if ret == nil {
+		r.observe(OpNotifyWithdraw, code, start, OutcomeUnknow, "")
+	} else {
+		outcome, failCode := statusOutcome(ret.Status, ret.FailCode)
+		r.observe(OpNotifyWithdraw, code, start, outcome, failCode)
+	}
+	return ret, w.WithdrawNotifyResult(ret)
+}
+
+//WithdrawBalance 查询指定提现方式的出款账户余额
+func (r *Registry) WithdrawBalance(code string) (*BalanceResult, error) {
+	w := r.Withdraw(code)
+	if w == nil {
+		return nil, ErrPaymentNotFound
+	}
+	b, ok := w.(WithdrawBalance)
+	if !ok {
+		return nil, errors.New("提现方式不支持余额查询")
+	}
+	start := time.Now()
+	ret, err := b.Balance()
+	outcome, failCode := errOutcome(err)
+	r.observe(OpBalance, code, start, outcome, failCode)
+	return ret, err
+}
+
+//提现结果是否为渠道请求失败
+func withdrawGatewayFail(ret *WithdrawResult) bool {
+	if ret == nil {
+		return true
+	}
+	switch ret.FailCode {
+	case WithdrawRequestFail.FailCode, WithdrawRequestNotSent.FailCode,
+		WithdrawResponseReadFail.FailCode, WithdrawResponseUnserializeFail.FailCode:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,219 @@
+package risk
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/kinwyb/golang/payment"
+)
+
+//Checker 提现风控检查
+//	检查通过的提现在发送期间计入处理中额度,防止并发提现绕过限额
+type Checker struct {
+	lock       sync.Mutex
+	policy     Policy
+	store      Store
+	queue      ReviewQueue
+	blockCards map[string]bool
+	blockCerts map[string]bool
+	pending    map[string]*Usage //发送中的提现
+	now        func() time.Time
+}
+
+//NewChecker 创建风控检查,store为nil时使用内存存储,queue为nil时使用内存审核队列
+func NewChecker(policy Policy, store Store, queue ReviewQueue) *Checker {
+	if store == nil {
+		store = NewMemoryStore()
+	}
+	if queue == nil {
+		queue = NewMemoryReviewQueue()
+	}
+	c := &Checker{
+		store:   store,
+		queue:   queue,
+		pending: map[string]*Usage{},
+		now:     time.Now,
+	}
+	c.SetPolicy(policy)
+	return c
+}
+
+//SetPolicy 修改风控策略,黑名单使用策略中的数据重置
+func (c *Checker) SetPolicy(policy Policy) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.policy = policy
+	c.blockCards = map[string]bool{}
+	c.blockCerts = map[string]bool{}
+	for _, v := range policy.BlockCards {
+		c.blockCards[v] = true
+	}
+	for _, v := range policy.BlockCertIDs {
+		c.blockCerts[v] = true
+	}
+}
+
+//BlockCard 收款账户加入/移出黑名单
+func (c *Checker) BlockCard(cardNo string, block bool) {
+	c.lock.Lock()
+	if block {
+		c.blockCards[cardNo] = true
+	} else {
+		delete(c.blockCards, cardNo)
+	}
+	c.lock.Unlock()
+}
+
+//BlockCertID 身份证号加入/移出黑名单
+func (c *Checker) BlockCertID(certID string, block bool) {
+	c.lock.Lock()
+	if block {
+		c.blockCerts[certID] = true
+	} else {
+		delete(c.blockCerts, certID)
+	}
+	c.lock.Unlock()
+}
+
+//Queue 人工审核队列
+func (c *Checker) Queue() ReviewQueue {
+	return c.queue
+}
+
+//Check 检查提现请求,通过返回nil
+func (c *Checker) Check(code string, info *payment.WithdrawInfo) (*Violation, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.check(code, info)
+}
+
+func (c *Checker) check(code string, info *payment.WithdrawInfo) (*Violation, error) {
+	if c.blockCards[info.CardNo] {
+		return &Violation{Rule: RuleBlockCard, Msg: "收款账户已被禁止提现"}, nil
+	} else if info.CertID != "" && c.blockCerts[info.CertID] {
+		return &Violation{Rule: RuleBlockCertID, Msg: "收款人已被禁止提现"}, nil
+	}
+	if max := c.policy.singleMax(code); max > 0 && cent(info.Money) > cent(max) {
+		return &Violation{Rule: RuleSingleMax, Msg: fmt.Sprintf("单笔提现金额超过限额%.2f", max), Review: true}, nil
+	}
+	now := c.now()
+	day := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	month := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+	if user := c.policy.userKey(info); user != "" {
+		if v, err := c.checkLimit("user:"+user, info.Money, day, c.policy.User.DailyMoney, c.policy.User.DailyCount, RuleUserDaily, "用户日"); v != nil || err != nil {
+			return v, err
+		} else if v, err = c.checkLimit("user:"+user, info.Money, month, c.policy.User.MonthlyMoney, c.policy.User.MonthlyCount, RuleUserMonthly, "用户月"); v != nil || err != nil {
+			return v, err
+		}
+	}
+	if v, err := c.checkLimit("card:"+info.CardNo, info.Money, day, c.policy.Card.DailyMoney, c.policy.Card.DailyCount, RuleCardDaily, "收款账户日"); v != nil || err != nil {
+		return v, err
+	} else if v, err = c.checkLimit("card:"+info.CardNo, info.Money, month, c.policy.Card.MonthlyMoney, c.policy.Card.MonthlyCount, RuleCardMonthly, "收款账户月"); v != nil || err != nil {
+		return v, err
+	}
+	if c.policy.DuplicateWindow > 0 {
+		usage, err := c.usage(duplicateKey(info), now.Add(-c.policy.DuplicateWindow))
+		if err != nil {
+			return nil, err
+		} else if usage.Count > 0 {
+			return &Violation{Rule: RuleDuplicate, Msg: "相同收款账户和金额重复提现", Review: true}, nil
+		}
+	}
+	return nil, nil
+}
+
+//检查累计限额
+func (c *Checker) checkLimit(key string, money float64, since time.Time, maxMoney float64, maxCount int, rule, name string) (*Violation, error) {
+	if maxMoney <= 0 && maxCount <= 0 {
+		return nil, nil
+	}
+	usage, err := c.usage(key, since)
+	if err != nil {
+		return nil, err
+	}
+	if maxMoney > 0 && cent(usage.Money)+cent(money) > cent(maxMoney) {
+		return &Violation{Rule: rule, Msg: fmt.Sprintf("%s累计提现金额超过限额%.2f", name, maxMoney), Review: true}, nil
+	} else if maxCount > 0 && usage.Count+1 > maxCount {
+		return &Violation{Rule: rule, Msg: name + "累计提现笔数超过限制" + strconv.Itoa(maxCount), Review: true}, nil
+	}
+	return nil, nil
+}
+
+//累计使用情况,包含发送中的提现
+func (c *Checker) usage(key string, since time.Time) (*Usage, error) {
+	ret, err := c.store.Usage(key, since)
+	if err != nil {
+		return nil, err
+	}
+	if p, ok := c.pending[key]; ok {
+		ret.Money += p.Money
+		ret.Count += p.Count
+	}
+	return ret, nil
+}
+
+//提现相关的统计key
+func (c *Checker) keys(info *payment.WithdrawInfo) []string {
+	keys := []string{"card:" + info.CardNo, duplicateKey(info)}
+	if user := c.policy.userKey(info); user != "" {
+		keys = append(keys, "user:"+user)
+	}
+	return keys
+}
+
+//检查通过后计入发送中额度,force为true时跳过检查(审核通过)
+func (c *Checker) reserve(code string, info *payment.WithdrawInfo, force bool) (*Violation, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if !force {
+		if v, err := c.check(code, info); v != nil || err != nil {
+			return v, err
+		}
+	}
+	for _, key := range c.keys(info) {
+		p, ok := c.pending[key]
+		if !ok {
+			p = &Usage{}
+			c.pending[key] = p
+		}
+		p.Money += info.Money
+		p.Count++
+	}
+	return nil, nil
+}
+
+//提现请求完成,释放发送中额度,提现未失败时记录累计额度
+func (c *Checker) release(info *payment.WithdrawInfo, record bool) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	var ret error
+	now := c.now()
+	for _, key := range c.keys(info) {
+		if p, ok := c.pending[key]; ok {
+			p.Money -= info.Money
+			p.Count--
+			if p.Count < 1 {
+				delete(c.pending, key)
+			}
+		}
+		if record {
+			if err := c.store.Record(key, info.Money, now); err != nil {
+				ret = err
+			}
+		}
+	}
+	return ret
+}
+
+//重复提现检查key
+func duplicateKey(info *payment.WithdrawInfo) string {
+	return "dup:" + info.CardNo + ":" + strconv.FormatInt(cent(info.Money), 10)
+}
+
+//金额转换成分,用于比较
+func cent(money float64) int64 {
+	return int64(math.Round(money * 100))
+}
@@ -0,0 +1,73 @@
+package risk
+
+import (
+	"time"
+
+	"github.com/kinwyb/golang/payment"
+)
+
+//提现风控,提现请求发送到渠道之前按策略检查
+//	黑名单命中直接失败,限额、重复提现等规则命中时进入人工审核队列
+
+//风控规则
+const (
+	RuleBlockCard      = "BLOCK_CARD"       //收款账户黑名单
+	RuleBlockCertID    = "BLOCK_CERT_ID"    //身份证号黑名单
+	RuleSingleMax      = "SINGLE_MAX"       //单笔限额
+	RuleUserDaily      = "USER_DAILY"       //用户日限额/笔数
+	RuleUserMonthly    = "USER_MONTHLY"     //用户月限额/笔数
+	RuleCardDaily      = "CARD_DAILY"       //收款账户日限额/笔数
+	RuleCardMonthly    = "CARD_MONTHLY"     //收款账户月限额/笔数
+	RuleDuplicate      = "DUPLICATE"        //重复提现
+	FailCodeBlocked    = "RISK_BLOCKED"     //黑名单错误编码
+	FailCodeReview     = "RISK_REVIEW"      //人工审核中错误编码
+	FailCodeRejected   = "RISK_REJECTED"    //人工审核拒绝错误编码
+	FailCodeQueueError = "RISK_QUEUE_ERROR" //风控数据读写失败错误编码
+)
+
+//Limit 限额,0表示不限制
+type Limit struct {
+	DailyMoney   float64 //日累计金额
+	DailyCount   int     //日累计笔数
+	MonthlyMoney float64 //月累计金额
+	MonthlyCount int     //月累计笔数
+}
+
+//Policy 风控策略
+type Policy struct {
+	SingleMax       float64                                 //单笔限额,0不限制
+	ChannelMax      map[string]float64                      //各提现方式单笔限额,优先于SingleMax
+	User            Limit                                   //用户限额
+	Card            Limit                                   //收款账户限额
+	BlockCards      []string                                //收款账户黑名单
+	BlockCertIDs    []string                                //身份证号黑名单
+	DuplicateWindow time.Duration                           //同一收款账户相同金额在该时间内重复提现时需要审核,0不检查
+	UserKey         func(info *payment.WithdrawInfo) string //用户标识,默认使用身份证号
+}
+
+//Violation 风控规则命中信息
+type Violation struct {
+	Rule   string //规则
+	Msg    string //描述
+	Review bool   //是否可以人工审核,false表示直接拒绝
+}
+
+func (v *Violation) Error() string {
+	return v.Msg
+}
+
+//用户标识
+func (p *Policy) userKey(info *payment.WithdrawInfo) string {
+	if p.UserKey != nil {
+		return p.UserKey(info)
+	}
+	return info.CertID
+}
+
+//单笔限额
+func (p *Policy) singleMax(code string) float64 {
+	if v, ok := p.ChannelMax[code]; ok {
+		return v
+	}
+	return p.SingleMax
+}
@@ -0,0 +1,113 @@
+package risk
+
+import (
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/kinwyb/golang/payment"
+)
+
+var (
+	//ErrReviewNotFound 审核记录不存在
+	ErrReviewNotFound = errors.New("审核记录不存在")
+	//ErrReviewExists 交易流水号已在审核队列中
+	ErrReviewExists = errors.New("审核记录已存在")
+	//ErrReviewConflict 审核记录状态已被其他操作变更
+	ErrReviewConflict = errors.New("审核记录状态已变更")
+)
+
+//ReviewItem 人工审核记录,TradeNo唯一
+type ReviewItem struct {
+	TradeNo  string                //交易流水号
+	Code     string                //提现方式编码
+	Info     *payment.WithdrawInfo //提现信息
+	Rule     string                //命中的规则
+	Reason   string                //原因
+	Status   payment.Status        //审核状态 DEALING:待审核 SUCCESS:通过 FAIL:拒绝
+	Reviewer string                //审核人
+	Remark   string                //审核备注
+	Created  time.Time             //创建时间
+	Reviewed time.Time             //审核时间
+}
+
+//ReviewQueue 人工审核队列,可使用数据库等实现
+type ReviewQueue interface {
+	//Push 加入审核队列,交易流水号已存在时返回ErrReviewExists
+	Push(item *ReviewItem) error
+	//Get 获取审核记录,不存在返回ErrReviewNotFound
+	Get(tradeNo string) (*ReviewItem, error)
+	//Update 更新审核记录,当前状态为from时才更新,否则返回ErrReviewConflict
+	//	并发审核同一记录时只有一个成功,数据库实现可使用 UPDATE ... WHERE trade_no = ? AND status = ?
+	Update(item *ReviewItem, from payment.Status) error
+	//Pending 待审核记录,按创建时间排序
+	Pending() ([]*ReviewItem, error)
+}
+
+//MemoryReviewQueue 内存审核队列,只适用于单进程
+type MemoryReviewQueue struct {
+	lock  sync.RWMutex
+	items map[string]*ReviewItem
+}
+
+//NewMemoryReviewQueue 创建内存审核队列
+func NewMemoryReviewQueue() *MemoryReviewQueue {
+	return &MemoryReviewQueue{items: map[string]*ReviewItem{}}
+}
+
+//Push 加入审核队列
+func (m *MemoryReviewQueue) Push(item *ReviewItem) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.items[item.TradeNo]; ok {
+		return ErrReviewExists
+	}
+	v := *item
+	m.items[item.TradeNo] = &v
+	return nil
+}
+
+//Get 获取审核记录
+func (m *MemoryReviewQueue) Get(tradeNo string) (*ReviewItem, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	v, ok := m.items[tradeNo]
+	if !ok {
+		return nil, ErrReviewNotFound
+	}
+	ret := *v
+	return &ret, nil
+}
+
+//Update 更新审核记录,当前状态为from时才更新
+func (m *MemoryReviewQueue) Update(item *ReviewItem, from payment.Status) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	old, ok := m.items[item.TradeNo]
+	if !ok {
+		return ErrReviewNotFound
+	} else if old.Status != from {
+		return ErrReviewConflict
+	}
+	v := *item
+	m.items[item.TradeNo] = &v
+	return nil
+}
+
+//Pending 待审核记录
+func (m *MemoryReviewQueue) Pending() ([]*ReviewItem, error) {
+	m.lock.RLock()
+	var ret []*ReviewItem
+	for _, v := range m.items {
+		if v.Status == payment.DEALING {
+			item := *v
+			ret = append(ret, &item)
+		}
+	}
+	m.lock.RUnlock()
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].Created.Before(ret[j].Created)
+	})
+	return ret, nil
+}
@@ -0,0 +1,127 @@
+package risk
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kinwyb/golang/payment"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+type testWithdraw struct {
+	payment.PayInfo
+	count int
+}
+
+func (t *testWithdraw) Withdraw(info *payment.WithdrawInfo) *payment.WithdrawResult {
+	t.count++
+	return &payment.WithdrawResult{TradeNo: info.TradeNo, Status: payment.SUCCESS}
+}
+
+func (t *testWithdraw) QueryWithdraw(tradeno string, tradeDate ...time.Time) *payment.WithdrawQueryResult {
+	return &payment.WithdrawQueryResult{TradeNo: tradeno, Status: payment.SUCCESS}
+}
+
+func Test_Risk(t *testing.T) {
+	convey.Convey("提现风控", t, func() {
+		now := time.Date(2017, 10, 19, 10, 0, 0, 0, time.Local)
+		checker := NewChecker(Policy{
+			SingleMax:       1000,
+			ChannelMax:      map[string]float64{"test": 500},
+			User:            Limit{DailyMoney: 600, MonthlyCount: 3},
+			Card:            Limit{DailyCount: 2},
+			BlockCards:      []string{"black"},
+			DuplicateWindow: time.Minute,
+		}, nil, nil)
+		checker.now = func() time.Time { return now }
+		tw := &testWithdraw{}
+		tw.Init("test", "test", true)
+		w := Wrap(tw, checker)
+		info := func(no, card string, money float64) *payment.WithdrawInfo {
+			return &payment.WithdrawInfo{TradeNo: no, CardNo: card, CertID: "user1", Money: money}
+		}
+		convey.Convey("异步通知", func() {
+			convey.So(payment.WithdrawCapabilities(w).Has(payment.OpNotifyWithdraw), convey.ShouldBeFalse)
+			convey.So(w.WithdrawNotify(nil).Status, convey.ShouldEqual, payment.UNKNOW)
+		})
+		convey.Convey("黑名单", func() {
+			ret := w.Withdraw(info("001", "black", 1))
+			convey.So(ret.Status, convey.ShouldEqual, payment.FAIL)
+			convey.So(ret.FailCode, convey.ShouldEqual, FailCodeBlocked)
+			checker.BlockCertID("user1", true)
+			convey.So(w.Withdraw(info("002", "card1", 1)).FailCode, convey.ShouldEqual, FailCodeBlocked)
+			convey.So(tw.count, convey.ShouldEqual, 0)
+		})
+		convey.Convey("限额及审核", func() {
+			ret := w.Withdraw(info("001", "card1", 600))
+			convey.So(ret.Status, convey.ShouldEqual, payment.DEALING)
+			convey.So(ret.FailCode, convey.ShouldEqual, FailCodeReview)
+			convey.So(w.QueryWithdraw("001").Status, convey.ShouldEqual, payment.DEALING)
+			convey.So(w.Withdraw(info("002", "card1", 400)).Status, convey.ShouldEqual, payment.SUCCESS)
+			dup := info("003", "card1", 400)
+			dup.CertID = "user2"
+			v, _ := checker.Check("test", dup)
+			convey.So(v.Rule, convey.ShouldEqual, RuleDuplicate)
+			v, _ = checker.Check("test", info("003", "card2", 300))
+			convey.So(v.Rule, convey.ShouldEqual, RuleUserDaily)
+			convey.So(w.Withdraw(info("003", "card1", 100)).Status, convey.ShouldEqual, payment.SUCCESS)
+			v, _ = checker.Check("test", info("004", "card1", 1))
+			convey.So(v.Rule, convey.ShouldEqual, RuleCardDaily)
+			items, _ := checker.Queue().Pending()
+			convey.So(len(items), convey.ShouldEqual, 1)
+			convey.So(items[0].Rule, convey.ShouldEqual, RuleSingleMax)
+			ret, err := w.Approve("001", "admin", "ok")
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(ret.Status, convey.ShouldEqual, payment.SUCCESS)
+			convey.So(w.QueryWithdraw("001").Status, convey.ShouldEqual, payment.SUCCESS)
+			_, err = w.Approve("001", "admin", "ok")
+			convey.So(err, convey.ShouldEqual, ErrReviewNotFound)
+			now = now.AddDate(0, 0, 1)
+			v, _ = checker.Check("test", info("005", "card3", 1))
+			convey.So(v.Rule, convey.ShouldEqual, RuleUserMonthly)
+		})
+		convey.Convey("审核拒绝", func() {
+			w.Withdraw(info("001", "card1", 501))
+			convey.So(w.Reject("001", "admin", "金额异常"), convey.ShouldBeNil)
+			ret := w.QueryWithdraw("001")
+			convey.So(ret.Status, convey.ShouldEqual, payment.FAIL)
+			convey.So(ret.FailCode, convey.ShouldEqual, FailCodeRejected)
+			convey.So(tw.count, convey.ShouldEqual, 0)
+		})
+		convey.Convey("重复加入审核队列", func() {
+			convey.So(w.Withdraw(info("001", "card1", 501)).Status, convey.ShouldEqual, payment.DEALING)
+			ret := w.Withdraw(info("001", "card1", 501))
+			convey.So(ret.Status, convey.ShouldEqual, payment.DEALING)
+			convey.So(ret.FailCode, convey.ShouldEqual, FailCodeReview)
+			convey.So(checker.Queue().Push(&ReviewItem{TradeNo: "001"}), convey.ShouldEqual, ErrReviewExists)
+			items, _ := checker.Queue().Pending()
+			convey.So(len(items), convey.ShouldEqual, 1)
+		})
+		convey.Convey("并发审核", func() {
+			w.Withdraw(info("001", "card1", 501))
+			var wg sync.WaitGroup
+			var lock sync.Mutex
+			succ, conflict := 0, 0
+			for i := 0; i < 20; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					_, err := w.Approve("001", "admin", "ok")
+					lock.Lock()
+					if err == nil {
+						succ++
+					} else if err == ErrReviewConflict || err == ErrReviewNotFound {
+						conflict++
+					}
+					lock.Unlock()
+				}()
+			}
+			wg.Wait()
+			convey.So(succ, convey.ShouldEqual, 1)
+			convey.So(conflict, convey.ShouldEqual, 19)
+			convey.So(tw.count, convey.ShouldEqual, 1)
+			convey.So(w.Reject("001", "admin", "晚了"), convey.ShouldEqual, ErrReviewNotFound)
+		})
+	})
+}
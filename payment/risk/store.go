@@ -0,0 +1,71 @@
+package risk
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+//Usage 累计使用情况
+type Usage struct {
+	Money float64 //累计金额
+	Count int     //累计笔数
+}
+
+//Store 限额数据存储,可使用数据库/redis等实现
+type Store interface {
+	//Usage 查询key在since之后的累计使用情况
+	Usage(key string, since time.Time) (*Usage, error)
+	//Record 记录一笔使用
+	Record(key string, money float64, at time.Time) error
+}
+
+type usageRecord struct {
+	money float64
+	at    time.Time
+}
+
+//MemoryStore 内存限额存储,数据保留Keep时间(默认32天),只适用于单进程
+type MemoryStore struct {
+	Keep    time.Duration
+	lock    sync.Mutex
+	records map[string][]usageRecord
+}
+
+//NewMemoryStore 创建内存限额存储
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		Keep:    32 * 24 * time.Hour,
+		records: map[string][]usageRecord{},
+	}
+}
+
+//Usage 查询key在since之后的累计使用情况
+func (m *MemoryStore) Usage(key string, since time.Time) (*Usage, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	ret := &Usage{}
+	for _, v := range m.records[key] {
+		if !v.at.Before(since) {
+			ret.Money += v.money
+			ret.Count++
+		}
+	}
+	ret.Money = math.Round(ret.Money*100) / 100
+	return ret, nil
+}
+
+//Record 记录一笔使用,同时清理过期数据
+func (m *MemoryStore) Record(key string, money float64, at time.Time) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	expire := at.Add(-m.Keep)
+	records := m.records[key][:0]
+	for _, v := range m.records[key] {
+		if v.at.After(expire) {
+			records = append(records, v)
+		}
+	}
+	m.records[key] = append(records, usageRecord{money: money, at: at})
+	return nil
+}
@@ -0,0 +1,169 @@
+package risk
+
+import (
+	"errors"
+	"time"
+
+	"github.com/kinwyb/golang/payment"
+)
+
+//Guard 带风控检查的提现方式,实现payment.Withdraw
+//	黑名单命中时返回FAIL,其他规则命中时加入人工审核队列并返回DEALING,审核通过后调用Approve发送提现请求
+type Guard struct {
+	withdraw payment.Withdraw
+	checker  *Checker
+}
+
+//Wrap 为提现方式增加风控检查
+func Wrap(w payment.Withdraw, checker *Checker) *Guard {
+	return &Guard{withdraw: w, checker: checker}
+}
+
+//Code 提现方式编码
+func (w *Guard) Code() string {
+	return w.withdraw.Code()
+}
+
+//Name 提现方式名称
+func (w *Guard) Name() string {
+	return w.withdraw.Name()
+}
+
+//Start 启用状态
+func (w *Guard) Start() bool {
+	return w.withdraw.Start()
+}
+
+//Capabilities 被包装提现方式的能力
+func (w *Guard) Capabilities() *payment.Capabilities {
+	return payment.WithdrawCapabilities(w.withdraw)
+}
+
+//Withdraw 风控检查通过后提现
+func (w *Guard) Withdraw(info *payment.WithdrawInfo) *payment.WithdrawResult {
+	v, err := w.checker.reserve(w.Code(), info, false)
+	if err != nil {
+		return w.result(info, payment.FAIL, FailCodeQueueError, "风控检查失败:"+err.Error())
+	} else if v != nil && !v.Review {
+		return w.result(info, payment.FAIL, FailCodeBlocked, v.Msg)
+	} else if v != nil {
+		args := *info
+		err = w.checker.queue.Push(&ReviewItem{
+			TradeNo: info.TradeNo,
+			Code:    w.Code(),
+			Info:    &args,
+			Rule:    v.Rule,
+			Reason:  v.Msg,
+			Status:  payment.DEALING,
+			Created: w.checker.now(),
+		})
+		if err == ErrReviewExists { //重复提交的提现以已有审核记录为准
+			return w.result(info, payment.DEALING, FailCodeReview, "提现已在审核队列中")
+		} else if err != nil {
+			return w.result(info, payment.FAIL, FailCodeQueueError, "加入审核队列失败:"+err.Error())
+		}
+		return w.result(info, payment.DEALING, FailCodeReview, v.Msg)
+	}
+	return w.send(info)
+}
+
+//QueryWithdraw 查询提现结果,审核中或审核拒绝的提现不查询渠道
+func (w *Guard) QueryWithdraw(tradeno string, tradeDate ...time.Time) *payment.WithdrawQueryResult {
+	item, err := w.checker.queue.Get(tradeno)
+	if err == nil && item.Status != payment.SUCCESS {
+		ret := &payment.WithdrawQueryResult{
+			Status:   item.Status,
+			TradeNo:  tradeno,
+			FailCode: FailCodeReview,
+			FailMsg:  item.Reason,
+		}
+		if item.Status == payment.FAIL {
+			ret.FailCode = FailCodeRejected
+			ret.FailMsg = item.Remark
+		}
+		return ret
+	}
+	return w.withdraw.QueryWithdraw(tradeno, tradeDate...)
+}
+
+//WithdrawNotify 被包装提现方式的异步通知处理,不支持异步通知时返回UNKNOW状态
+func (w *Guard) WithdrawNotify(params map[string]string) *payment.WithdrawQueryResult {
+	if n, ok := w.withdraw.(payment.WithdrawNotify); ok {
+		return n.WithdrawNotify(params)
+	}
+	return &payment.WithdrawQueryResult{Status: payment.UNKNOW, FailMsg: "提现方式不支持异步通知"}
+}
+
+//WithdrawNotifyResult 被包装提现方式的异步通知处理结果返回内容
+func (w *Guard) WithdrawNotifyResult(ret *payment.WithdrawQueryResult) string {
+	if n, ok := w.withdraw.(payment.WithdrawNotify); ok {
+		return n.WithdrawNotifyResult(ret)
+	}
+	return ""
+}
+
+//Balance 被包装提现方式的出款账户余额
+func (w *Guard) Balance() (*payment.BalanceResult, error) {
+	if b, ok := w.withdraw.(payment.WithdrawBalance); ok {
+		return b.Balance()
+	}
+	return nil, errors.New("提现方式不支持余额查询")
+}
+
+//Approve 审核通过并发送提现请求
+//	记录已审核或被并发审核抢先更新时返回错误,提现请求只发送一次
+func (w *Guard) Approve(tradeNo, reviewer, remark string) (*payment.WithdrawResult, error) {
+	item, err := w.review(tradeNo, reviewer, remark, payment.SUCCESS)
+	if err != nil {
+		return nil, err
+	}
+	w.checker.reserve(w.Code(), item.Info, true)
+	return w.send(item.Info), nil
+}
+
+//Reject 审核拒绝
+func (w *Guard) Reject(tradeNo, reviewer, remark string) error {
+	_, err := w.review(tradeNo, reviewer, remark, payment.FAIL)
+	return err
+}
+
+//更新审核状态,只有待审核的记录可以更新,并发审核时只有一个成功
+func (w *Guard) review(tradeNo, reviewer, remark string, status payment.Status) (*ReviewItem, error) {
+	item, err := w.checker.queue.Get(tradeNo)
+	if err != nil {
+		return nil, err
+	} else if item.Code != w.Code() || item.Status != payment.DEALING {
+		return nil, ErrReviewNotFound
+	}
+	item.Status = status
+	item.Reviewer = reviewer
+	item.Remark = remark
+	item.Reviewed = w.checker.now()
+	if err = w.checker.queue.Update(item, payment.DEALING); err != nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+//发送提现请求并记录累计额度
+func (w *Guard) send(info *payment.WithdrawInfo) *payment.WithdrawResult {
+	ret := w.withdraw.Withdraw(info)
+	w.checker.release(info, ret != nil && ret.Status != payment.FAIL)
+	return ret
+}
+
+func (w *Guard) result(info *payment.WithdrawInfo, status payment.Status, failCode, failMsg string) *payment.WithdrawResult {
+	return &payment.WithdrawResult{
+		WithdrawCode: w.Code(),
+		WithdrawName: w.Name(),
+		TradeNo:      info.TradeNo,
+		CardNo:       info.CardNo,
+		UserName:     info.UserName,
+		CertID:       info.CertID,
+		Money:        info.Money,
+		Currency:     info.Currency,
+		Status:       status,
+		FailCode:     failCode,
+		FailMsg:      failMsg,
+	}
+}
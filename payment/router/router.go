@@ -0,0 +1,241 @@
+package router
+
+import (
+	"errors"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/kinwyb/golang/payment"
+)
+
+//支付/提现路由,根据规则从注册中心中选择渠道
+//	渠道在请求到达第三方网关之前失败时自动切换到下一个可用渠道
+
+//ErrNoChannel 没有可用的渠道
+var ErrNoChannel = errors.New("没有可用的支付渠道")
+
+//Attempt 渠道尝试记录
+type Attempt struct {
+	Code     string //支付/提现方式编码
+	Failover bool   //是否切换到了下一个渠道
+	Msg      string //失败原因
+}
+
+//PayRoute 支付路由结果
+type PayRoute struct {
+	Rule     string    //匹配的规则名称
+	Code     string    //最终使用的支付方式编码
+	Result   string    //支付代码
+	Err      error     //支付错误
+	Attempts []Attempt //渠道尝试记录
+}
+
+//WithdrawRoute 提现路由结果
+type WithdrawRoute struct {
+	Rule     string                  //匹配的规则名称
+	Code     string                  //最终使用的提现方式编码
+	Result   *payment.WithdrawResult //提现结果
+	Attempts []Attempt               //渠道尝试记录
+}
+
+//Router 路由
+type Router struct {
+	registry      *payment.Registry
+	lock          sync.RWMutex
+	payRules      []*Rule
+	withdrawRules []*Rule
+	rand          *rand.Rand
+	randLock      sync.Mutex
+	now           func() time.Time
+}
+
+//New 创建路由
+func New(registry *payment.Registry) *Router {
+	return &Router{
+		registry: registry,
+		rand:     rand.New(rand.NewSource(time.Now().UnixNano())),
+		now:      time.Now,
+	}
+}
+
+//SetPayRules 设置支付路由规则,规则复制后使用,之后修改传入的规则不影响路由
+func (r *Router) SetPayRules(rules ...*Rule) error {
+	list, err := loadRules(rules)
+	if err != nil {
+		return err
+	}
+	r.lock.Lock()
+	r.payRules = list
+	r.lock.Unlock()
+	return nil
+}
+
+//SetWithdrawRules 设置提现路由规则,规则复制后使用,之后修改传入的规则不影响路由
+func (r *Router) SetWithdrawRules(rules ...*Rule) error {
+	list, err := loadRules(rules)
+	if err != nil {
+		return err
+	}
+	r.lock.Lock()
+	r.withdrawRules = list
+	r.lock.Unlock()
+	return nil
+}
+
+//PayChannels 根据规则获取支付请求可用的支付方式,按尝试顺序排列
+func (r *Router) PayChannels(req *payment.PayRequest, cardType string) (string, []payment.Payment) {
+	r.lock.RLock()
+	rules := r.payRules
+	r.lock.RUnlock()
+	c := &condition{money: req.Money, isApp: &req.IsApp, cardType: cardType, now: r.now()}
+	for _, rule := range rules {
+		if !rule.match(c) {
+			continue
+		}
+		var ret []payment.Payment
+		for _, code := range r.order(rule.Channels) {
+			p := r.registry.Payment(code)
+			if r.registry.PaymentAvailable(code) && payment.SupportCurrency(p, req.Currency) {
+				ret = append(ret, p)
+			}
+		}
+		if len(ret) > 0 {
+			return rule.Name, ret
+		}
+	}
+	return "", nil
+}
+
+//WithdrawChannels 根据规则获取提现请求可用的提现方式,按尝试顺序排列
+func (r *Router) WithdrawChannels(info *payment.WithdrawInfo, cardType string) (string, []payment.Withdraw) {
+	r.lock.RLock()
+	rules := r.withdrawRules
+	r.lock.RUnlock()
+	c := &condition{money: info.Money, cardType: cardType, now: r.now()}
+	for _, rule := range rules {
+		if !rule.match(c) {
+			continue
+		}
+		var ret []payment.Withdraw
+		for _, code := range r.order(rule.Channels) {
+			w := r.registry.Withdraw(code)
+			if r.registry.WithdrawAvailable(code) && payment.SupportCurrency(w, info.Currency) {
+				ret = append(ret, w)
+			}
+		}
+		if len(ret) > 0 {
+			return rule.Name, ret
+		}
+	}
+	return "", nil
+}
+
+//Pay 路由支付,请求未到达第三方网关时切换下一个渠道
+//	成功后req.PayCode设置为实际使用的支付方式编码
+func (r *Router) Pay(req *payment.PayRequest, cardType string) *PayRoute {
+	ret := &PayRoute{}
+	var channels []payment.Payment
+	ret.Rule, channels = r.PayChannels(req, cardType)
+	if len(channels) < 1 {
+		ret.Err = ErrNoChannel
+		return ret
+	}
+	for i, p := range channels {
+		args := *req //驱动可能修改请求参数,每个渠道使用副本
+		args.PayCode = p.Code()
+		ret.Code = p.Code()
+		ret.Result, ret.Err = r.registry.Pay(p.Code(), &args)
+		if ret.Err == nil {
+			req.PayCode = p.Code()
+			ret.Attempts = append(ret.Attempts, Attempt{Code: p.Code()})
+			return ret
+		}
+		failover := payment.IsRequestNotSent(ret.Err) && i < len(channels)-1
+		ret.Attempts = append(ret.Attempts, Attempt{Code: p.Code(), Failover: failover, Msg: ret.Err.Error()})
+		if !failover {
+			break
+		}
+	}
+	req.PayCode = ret.Code
+	return ret
+}
+
+//Withdraw 路由提现,请求未到达第三方网关时切换下一个渠道
+func (r *Router) Withdraw(info *payment.WithdrawInfo, cardType string) *WithdrawRoute {
+	ret := &WithdrawRoute{}
+	var channels []payment.Withdraw
+	ret.Rule, channels = r.WithdrawChannels(info, cardType)
+	if len(channels) < 1 {
+		ret.Result = &payment.WithdrawResult{
+			TradeNo:  info.TradeNo,
+			CardNo:   info.CardNo,
+			Money:    info.Money,
+			Status:   payment.FAIL,
+			FailCode: "NO_CHANNEL",
+			FailMsg:  ErrNoChannel.Error(),
+		}
+		return ret
+	}
+	for i, w := range channels {
+		args := *info //驱动可能修改请求参数,每个渠道使用副本
+		ret.Code = w.Code()
+		ret.Result = r.registry.DoWithdraw(w.Code(), &args)
+		if ret.Result == nil {
+			ret.Result = &payment.WithdrawResult{Status: payment.UNKNOW}
+		}
+		failover := failoverWithdraw(ret.Result) && i < len(channels)-1
+		ret.Attempts = append(ret.Attempts, Attempt{Code: w.Code(), Failover: failover, Msg: ret.Result.FailMsg})
+		if !failover {
+			break
+		}
+	}
+	return ret
+}
+
+//提现结果是否可以切换渠道,只有确定请求没有到达第三方网关时才可以切换
+func failoverWithdraw(ret *payment.WithdrawResult) bool {
+	if ret.Status != payment.FAIL {
+		return false
+	}
+	switch ret.FailCode {
+	case payment.WithdrawRequestNotSent.FailCode,
+		payment.WithdrawParamsSerializeFail.FailCode,
+		payment.WithdrawCurrencyNotSupport.FailCode:
+		return true
+	}
+	return false
+}
+
+//渠道排序,有权重的渠道按权重随机排序,无权重的渠道按顺序排在后面
+func (r *Router) order(channels []Channel) []string {
+	var weighted []Channel
+	var ret []string
+	total := 0
+	for _, v := range channels {
+		if v.Weight > 0 {
+			weighted = append(weighted, v)
+			total += v.Weight
+		}
+	}
+	r.randLock.Lock()
+	for len(weighted) > 0 {
+		n := r.rand.Intn(total)
+		for i, v := range weighted {
+			if n < v.Weight {
+				ret = append(ret, v.Code)
+				total -= v.Weight
+				weighted = append(weighted[:i], weighted[i+1:]...)
+				break
+			}
+			n -= v.Weight
+		}
+	}
+	r.randLock.Unlock()
+	for _, v := range channels {
+		if v.Weight <= 0 {
+			ret = append(ret, v.Code)
+		}
+	}
+	return ret
+}
@@ -0,0 +1,142 @@
+package router
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kinwyb/golang/payment"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+type testPay struct {
+	payment.PayInfo
+	err error
+}
+
+func (t *testPay) Pay(req *payment.PayRequest) (string, error) {
+	req.No = "changed"
+	if t.err != nil {
+		return "", t.err
+	}
+	return t.Code() + ":" + req.No, nil
+}
+func (t *testPay) PayConfirm(req *payment.PayConfirmRequest) *payment.PayResult { return nil }
+func (t *testPay) Notify(params map[string]string) *payment.PayResult           { return nil }
+func (t *testPay) NotifyResult(payResult *payment.PayResult) string             { return "" }
+func (t *testPay) Result(params map[string]string) *payment.PayResult           { return nil }
+
+type testWithdraw struct {
+	payment.PayInfo
+	ret *payment.WithdrawResult
+}
+
+func (t *testWithdraw) Withdraw(info *payment.WithdrawInfo) *payment.WithdrawResult {
+	return t.ret
+}
+func (t *testWithdraw) QueryWithdraw(tradeno string, tradeDate ...time.Time) *payment.WithdrawQueryResult {
+	return nil
+}
+
+func newPay(code string, start bool, err error) *testPay {
+	p := &testPay{err: err}
+	p.Init(code, code, start)
+	return p
+}
+
+func newWithdraw(code string, ret *payment.WithdrawResult) *testWithdraw {
+	w := &testWithdraw{ret: ret}
+	w.Init(code, code, true)
+	return w
+}
+
+func Test_Router(t *testing.T) {
+	convey.Convey("支付路由", t, func() {
+		registry := payment.NewRegistry()
+		registry.SetPayment(newPay("down", true, &payment.RequestNotSent{Err: errors.New("connect refused")}))
+		registry.SetPayment(newPay("ok", true, nil))
+		registry.SetPayment(newPay("stop", false, nil))
+		registry.SetPayment(newPay("fail", true, errors.New("timeout")))
+		r := New(registry)
+		r.now = func() time.Time {
+			return time.Date(2017, 10, 19, 23, 30, 0, 0, time.Local)
+		}
+		app := true
+		err := r.SetPayRules(
+			&Rule{Name: "app", IsApp: &app, Channels: []Channel{{Code: "fail"}}},
+			&Rule{Name: "big", MinMoney: 1000, Channels: []Channel{{Code: "ok"}}},
+			&Rule{Name: "credit", CardTypes: []string{CreditCard}, Channels: []Channel{{Code: "fail"}, {Code: "ok"}}},
+			&Rule{Name: "night", TimeStart: "22:00", TimeEnd: "06:00", Channels: []Channel{{Code: "stop"}, {Code: "down"}, {Code: "ok"}}},
+			&Rule{Name: "default", Channels: []Channel{{Code: "fail"}}},
+		)
+		convey.So(err, convey.ShouldBeNil)
+		convey.Convey("故障切换", func() {
+			req := &payment.PayRequest{No: "001", Money: 10}
+			ret := r.Pay(req, DebitCard)
+			convey.So(ret.Rule, convey.ShouldEqual, "night")
+			convey.So(ret.Err, convey.ShouldBeNil)
+			convey.So(ret.Code, convey.ShouldEqual, "ok")
+			convey.So(ret.Result, convey.ShouldEqual, "ok:changed")
+			convey.So(req.No, convey.ShouldEqual, "001")
+			convey.So(req.PayCode, convey.ShouldEqual, "ok")
+			convey.So(len(ret.Attempts), convey.ShouldEqual, 2)
+			convey.So(ret.Attempts[0].Failover, convey.ShouldBeTrue)
+		})
+		convey.Convey("请求可能已到达网关不切换", func() {
+			ret := r.Pay(&payment.PayRequest{No: "001", Money: 10}, CreditCard)
+			convey.So(ret.Rule, convey.ShouldEqual, "credit")
+			convey.So(ret.Err, convey.ShouldNotBeNil)
+			convey.So(ret.Code, convey.ShouldEqual, "fail")
+			convey.So(len(ret.Attempts), convey.ShouldEqual, 1)
+		})
+		convey.Convey("规则匹配", func() {
+			convey.So(r.Pay(&payment.PayRequest{Money: 10, IsApp: true}, "").Rule, convey.ShouldEqual, "app")
+			convey.So(r.Pay(&payment.PayRequest{Money: 1000}, "").Rule, convey.ShouldEqual, "big")
+			r.now = func() time.Time {
+				return time.Date(2017, 10, 19, 12, 0, 0, 0, time.Local)
+			}
+			convey.So(r.Pay(&payment.PayRequest{Money: 10}, "").Rule, convey.ShouldEqual, "default")
+			ret := r.Pay(&payment.PayRequest{Money: 10, Currency: "USD"}, "")
+			convey.So(ret.Err, convey.ShouldEqual, ErrNoChannel)
+		})
+		convey.Convey("规则格式错误", func() {
+			convey.So(r.SetPayRules(&Rule{Name: "err"}), convey.ShouldNotBeNil)
+			convey.So(r.SetPayRules(&Rule{Name: "err", TimeStart: "25", Channels: []Channel{{Code: "ok"}}}), convey.ShouldNotBeNil)
+			convey.So(r.SetPayRules(&Rule{Name: "err", TimeStart: "08:00", TimeEnd: "08:00", Channels: []Channel{{Code: "ok"}}}), convey.ShouldNotBeNil)
+		})
+		convey.Convey("规则复制后使用", func() {
+			rule := &Rule{Name: "copy", TimeStart: "22:00", TimeEnd: "06:00", Channels: []Channel{{Code: "ok"}}}
+			convey.So(r.SetPayRules(rule), convey.ShouldBeNil)
+			convey.So(rule.start, convey.ShouldEqual, 0)
+			convey.So(rule.end, convey.ShouldEqual, 0)
+			rule.Channels[0].Code = "fail"
+			rule.TimeStart = "00:00"
+			ret := r.Pay(&payment.PayRequest{Money: 10}, "")
+			convey.So(ret.Rule, convey.ShouldEqual, "copy")
+			convey.So(ret.Code, convey.ShouldEqual, "ok")
+		})
+	})
+	convey.Convey("提现路由", t, func() {
+		registry := payment.NewRegistry()
+		registry.SetWithdraw(newWithdraw("down", payment.WithdrawRequestNotSent))
+		registry.SetWithdraw(newWithdraw("dealing", payment.WithdrawResponseReadFail))
+		registry.SetWithdraw(newWithdraw("ok", &payment.WithdrawResult{Status: payment.SUCCESS}))
+		r := New(registry)
+		convey.So(r.SetWithdrawRules(&Rule{Channels: []Channel{{Code: "down"}, {Code: "dealing"}, {Code: "ok"}}}), convey.ShouldBeNil)
+		ret := r.Withdraw(&payment.WithdrawInfo{TradeNo: "001", Money: 1}, "")
+		convey.So(ret.Code, convey.ShouldEqual, "dealing")
+		convey.So(ret.Result.Status, convey.ShouldEqual, payment.DEALING)
+		convey.So(len(ret.Attempts), convey.ShouldEqual, 2)
+	})
+	convey.Convey("权重排序", t, func() {
+		r := New(payment.NewRegistry())
+		count := map[string]int{}
+		for i := 0; i < 1000; i++ {
+			codes := r.order([]Channel{{Code: "a", Weight: 9}, {Code: "b", Weight: 1}, {Code: "c"}})
+			convey.So(len(codes), convey.ShouldEqual, 3)
+			convey.So(codes[2], convey.ShouldEqual, "c")
+			count[codes[0]]++
+		}
+		convey.So(count["a"], convey.ShouldBeGreaterThan, count["b"])
+	})
+}
@@ -0,0 +1,125 @@
+package router
+
+import (
+	"errors"
+	"math"
+	"time"
+)
+
+//卡类型
+const (
+	DebitCard  = "DEBIT"  //借记卡
+	CreditCard = "CREDIT" //信用卡
+)
+
+//Channel 候选渠道
+type Channel struct {
+	Code   string //支付/提现方式编码
+	Weight int    //权重,大于0时按权重随机排序,等于0时按配置顺序排在有权重的渠道之后
+}
+
+//Rule 路由规则,按顺序匹配,匹配成功且存在可用渠道时使用该规则
+type Rule struct {
+	Name      string    //规则名称
+	MinMoney  float64   //最小金额[含],0不限制
+	MaxMoney  float64   //最大金额[含],0不限制
+	IsApp     *bool     //是否是APP支付[提现规则忽略],nil不限制
+	CardTypes []string  //卡类型[DEBIT,CREDIT],空不限制
+	TimeStart string    //生效开始时间[15:04],空不限制
+	TimeEnd   string    //生效结束时间[15:04],小于开始时间时表示跨天,不能等于开始时间
+	Channels  []Channel //候选渠道
+	start     int       //生效开始时间[分钟]
+	end       int       //生效结束时间[分钟]
+}
+
+//路由条件
+type condition struct {
+	money    float64
+	isApp    *bool
+	cardType string
+	now      time.Time
+}
+
+//复制并初始化规则
+func loadRules(rules []*Rule) ([]*Rule, error) {
+	ret := make([]*Rule, 0, len(rules))
+	for _, v := range rules {
+		rule := *v
+		rule.Channels = append([]Channel(nil), v.Channels...)
+		rule.CardTypes = append([]string(nil), v.CardTypes...)
+		if v.IsApp != nil {
+			isApp := *v.IsApp
+			rule.IsApp = &isApp
+		}
+		if err := rule.init(); err != nil {
+			return nil, err
+		}
+		ret = append(ret, &rule)
+	}
+	return ret, nil
+}
+
+//初始化规则
+func (r *Rule) init() error {
+	if len(r.Channels) < 1 {
+		return errors.New("路由规则[" + r.Name + "]没有候选渠道")
+	}
+	r.start, r.end = -1, -1
+	if r.TimeStart == "" && r.TimeEnd == "" {
+		return nil
+	}
+	var err error
+	if r.start, err = minutes(r.TimeStart, 0); err != nil {
+		return errors.New("路由规则[" + r.Name + "]开始时间格式错误")
+	}
+	if r.end, err = minutes(r.TimeEnd, 24*60); err != nil {
+		return errors.New("路由规则[" + r.Name + "]结束时间格式错误")
+	} else if r.start == r.end {
+		return errors.New("路由规则[" + r.Name + "]开始时间和结束时间相同,全天生效请不设置时间")
+	}
+	return nil
+}
+
+//规则是否匹配
+func (r *Rule) match(c *condition) bool {
+	money := math.Round(c.money * 100)
+	if r.MinMoney > 0 && money < math.Round(r.MinMoney*100) {
+		return false
+	} else if r.MaxMoney > 0 && money > math.Round(r.MaxMoney*100) {
+		return false
+	} else if r.IsApp != nil && c.isApp != nil && *r.IsApp != *c.isApp {
+		return false
+	}
+	if len(r.CardTypes) > 0 {
+		ok := false
+		for _, v := range r.CardTypes {
+			if v == c.cardType {
+				ok = true
+				break
+			}
+		}
+		if !ok {
+			return false
+		}
+	}
+	if r.start >= 0 {
+		now := c.now.Hour()*60 + c.now.Minute()
+		if r.start <= r.end {
+			return now >= r.start && now < r.end
+		}
+		return now >= r.start || now < r.end //跨天
+	}
+	return true
+}
+
+//时间转换成分钟
+func minutes(t string, def int) (int, error) {
+	if t == "" {
+		return def, nil
+	}
+	tm, err := time.Parse("15:04", t)
+	if err != nil {
+		return 0, err
+	}
+	return tm.Hour()*60 + tm.Minute(), nil
+}
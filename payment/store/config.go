@@ -0,0 +1,132 @@
+package store
+
+import (
+	"strings"
+
+	"github.com/kinwyb/golang/gosql"
+)
+
+//Config 支付数据存储配置
+type Config struct {
+	DB            gosql.SQL //数据库
+	OrderTable    string    //支付订单表名称,默认:pay_order
+	AttemptTable  string    //支付请求表名称,默认:pay_attempt
+	NotifyTable   string    //支付通知表名称,默认:pay_notify
+	RefundTable   string    //退款表名称,默认:pay_refund
+	WithdrawTable string    //提现表名称,默认:pay_withdraw
+}
+
+//Schema 数据表结构(MySQL),{order}、{attempt}、{notify}、{refund}、{withdraw}替换为配置中的表名
+const Schema = `CREATE TABLE IF NOT EXISTS {order} (
+  no VARCHAR(64) NOT NULL COMMENT '订单号',
+  member_id VARCHAR(64) NOT NULL DEFAULT '' COMMENT '付款用户',
+  money DECIMAL(18,2) NOT NULL COMMENT '订单金额',
+  currency VARCHAR(8) NOT NULL DEFAULT '' COMMENT '币种,空表示CNY',
+  description VARCHAR(255) NOT NULL DEFAULT '' COMMENT '描述',
+  status VARCHAR(16) NOT NULL COMMENT '状态 DEALING:待支付 SUCCESS:已支付 FAIL:已关闭',
+  pay_code VARCHAR(32) NOT NULL DEFAULT '' COMMENT '实际支付方式编码',
+  trade_no VARCHAR(64) NOT NULL DEFAULT '' COMMENT '实际支付交易单号',
+  third_trade_no VARCHAR(64) NOT NULL DEFAULT '' COMMENT '第三方交易流水号',
+  fee DECIMAL(18,2) NOT NULL DEFAULT 0 COMMENT '预计手续费',
+  pay_time DATETIME NULL COMMENT '支付时间',
+  created DATETIME NOT NULL COMMENT '创建时间',
+  updated DATETIME NOT NULL COMMENT '更新时间',
+  PRIMARY KEY (no)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='支付订单';
+CREATE TABLE IF NOT EXISTS {attempt} (
+  id BIGINT NOT NULL AUTO_INCREMENT,
+  order_no VARCHAR(64) NOT NULL COMMENT '订单号',
+  pay_code VARCHAR(32) NOT NULL COMMENT '支付方式编码',
+  trade_no VARCHAR(64) NOT NULL DEFAULT '' COMMENT '提交给渠道的交易单号,渠道改写单号时在通知后更新',
+  money DECIMAL(18,2) NOT NULL COMMENT '支付金额',
+  status VARCHAR(16) NOT NULL COMMENT '状态 DEALING:处理中 SUCCESS:成功 FAIL:失败',
+  third_trade_no VARCHAR(64) NOT NULL DEFAULT '' COMMENT '第三方交易流水号',
+  err_msg VARCHAR(255) NOT NULL DEFAULT '' COMMENT '错误消息',
+  created DATETIME NOT NULL COMMENT '创建时间',
+  updated DATETIME NOT NULL COMMENT '更新时间',
+  PRIMARY KEY (id),
+  KEY idx_order (order_no),
+  KEY idx_trade (pay_code, trade_no)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='支付请求';
+CREATE TABLE IF NOT EXISTS {notify} (
+  id BIGINT NOT NULL AUTO_INCREMENT,
+  pay_code VARCHAR(32) NOT NULL COMMENT '支付方式编码',
+  order_no VARCHAR(64) NOT NULL DEFAULT '' COMMENT '订单号',
+  trade_no VARCHAR(64) NOT NULL DEFAULT '' COMMENT '交易单号',
+  third_trade_no VARCHAR(64) NOT NULL DEFAULT '' COMMENT '第三方交易流水号',
+  succ TINYINT NOT NULL COMMENT '是否成功',
+  money DECIMAL(18,2) NOT NULL DEFAULT 0 COMMENT '金额',
+  err_msg VARCHAR(255) NOT NULL DEFAULT '' COMMENT '错误消息',
+  raw TEXT NOT NULL COMMENT '原始数据[json]',
+  created DATETIME NOT NULL COMMENT '接收时间',
+  PRIMARY KEY (id),
+  KEY idx_trade (trade_no)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='支付通知';
+CREATE TABLE IF NOT EXISTS {refund} (
+  refund_no VARCHAR(64) NOT NULL COMMENT '退款单号',
+  order_no VARCHAR(64) NOT NULL COMMENT '原订单号',
+  pay_code VARCHAR(32) NOT NULL COMMENT '支付方式编码',
+  money DECIMAL(18,2) NOT NULL COMMENT '退款金额',
+  reason VARCHAR(255) NOT NULL DEFAULT '' COMMENT '退款原因',
+  status VARCHAR(16) NOT NULL COMMENT '状态 DEALING:处理中 SUCCESS:成功 FAIL:失败',
+  third_refund_no VARCHAR(64) NOT NULL DEFAULT '' COMMENT '第三方退款流水号',
+  fail_code VARCHAR(64) NOT NULL DEFAULT '' COMMENT '错误代码',
+  fail_msg VARCHAR(255) NOT NULL DEFAULT '' COMMENT '错误原因',
+  created DATETIME NOT NULL COMMENT '创建时间',
+  updated DATETIME NOT NULL COMMENT '更新时间',
+  PRIMARY KEY (refund_no),
+  KEY idx_order (order_no)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='退款';
+CREATE TABLE IF NOT EXISTS {withdraw} (
+  trade_no VARCHAR(64) NOT NULL COMMENT '交易流水号',
+  code VARCHAR(32) NOT NULL COMMENT '提现方式编码',
+  card_no VARCHAR(64) NOT NULL COMMENT '收款账户',
+  user_name VARCHAR(64) NOT NULL DEFAULT '' COMMENT '收款人姓名',
+  money DECIMAL(18,2) NOT NULL COMMENT '提现金额',
+  currency VARCHAR(8) NOT NULL DEFAULT '' COMMENT '币种,空表示CNY',
+  fee DECIMAL(18,2) NOT NULL DEFAULT 0 COMMENT '预计手续费',
+  status VARCHAR(16) NOT NULL COMMENT '状态 DEALING:处理中 SUCCESS:成功 FAIL:失败',
+  third_trade_no VARCHAR(64) NOT NULL DEFAULT '' COMMENT '第三方交易流水号',
+  fail_code VARCHAR(64) NOT NULL DEFAULT '' COMMENT '错误代码',
+  fail_msg VARCHAR(255) NOT NULL DEFAULT '' COMMENT '错误原因',
+  pay_time VARCHAR(32) NOT NULL DEFAULT '' COMMENT '完成时间',
+  created DATETIME NOT NULL COMMENT '创建时间',
+  updated DATETIME NOT NULL COMMENT '更新时间',
+  PRIMARY KEY (trade_no),
+  KEY idx_status (status)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='提现';`
+
+//CreateTable 创建数据表
+func CreateTable(c *Config) gosql.Error {
+	c.defaults()
+	sqls := strings.NewReplacer("{order}", c.OrderTable, "{attempt}", c.AttemptTable,
+		"{notify}", c.NotifyTable, "{refund}", c.RefundTable, "{withdraw}", c.WithdrawTable).Replace(Schema)
+	for _, s := range strings.Split(sqls, ";") {
+		if strings.TrimSpace(s) == "" {
+			continue
+		}
+		if _, err := c.DB.Exec(s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//默认值
+func (c *Config) defaults() {
+	if c.OrderTable == "" {
+		c.OrderTable = "pay_order"
+	}
+	if c.AttemptTable == "" {
+		c.AttemptTable = "pay_attempt"
+	}
+	if c.NotifyTable == "" {
+		c.NotifyTable = "pay_notify"
+	}
+	if c.RefundTable == "" {
+		c.RefundTable = "pay_refund"
+	}
+	if c.WithdrawTable == "" {
+		c.WithdrawTable = "pay_withdraw"
+	}
+}
@@ -0,0 +1,75 @@
+package store
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/kinwyb/golang/gosql"
+	"github.com/kinwyb/golang/payment"
+)
+
+//Notification 支付通知记录
+type Notification struct {
+	ID           int64             //编号
+	PayCode      string            //支付方式编码
+	OrderNo      string            //订单号
+	TradeNo      string            //交易单号
+	ThirdTradeNo string            //第三方交易流水号
+	Succ         bool              //是否成功
+	Money        float64           //金额
+	ErrMsg       string            //错误消息
+	Raw          map[string]string //原始数据
+	Created      time.Time         //接收时间
+}
+
+//SaveNotification 保存支付通知原始数据
+func (s *Store) SaveNotification(result *payment.PayResult) (*Notification, gosql.Error) {
+	raw, e := json.Marshal(result.Navite)
+	if e != nil {
+		return nil, gosql.NewError(1, "通知数据序列化失败", e)
+	}
+	n := &Notification{
+		PayCode:      result.PayCode,
+		OrderNo:      result.No,
+		TradeNo:      result.TradeNo,
+		ThirdTradeNo: result.ThirdTradeNo,
+		Succ:         result.Succ,
+		Money:        result.Money,
+		ErrMsg:       varchar(result.ErrMsg),
+		Raw:          result.Navite,
+		Created:      time.Now(),
+	}
+	ret, err := s.cfg.DB.Exec("INSERT INTO "+s.cfg.NotifyTable+"(pay_code,order_no,trade_no,third_trade_no,succ,money,err_msg,raw,created) "+
+		"VALUES(?,?,?,?,?,?,?,?,?)", n.PayCode, n.OrderNo, n.TradeNo, n.ThirdTradeNo, n.Succ, round(n.Money), n.ErrMsg, string(raw), n.Created)
+	if err != nil {
+		return nil, err
+	}
+	n.ID, _ = ret.LastInsertId()
+	return n, nil
+}
+
+//Notifications 交易单号的所有通知,按接收时间排序
+func (s *Store) Notifications(tradeNo string) ([]*Notification, gosql.Error) {
+	rows, err := s.cfg.DB.Rows("SELECT id,pay_code,order_no,trade_no,third_trade_no,succ,money,err_msg,raw,created FROM "+
+		s.cfg.NotifyTable+" WHERE trade_no = ? ORDER BY id", tradeNo)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*Notification, 0, len(rows))
+	for _, r := range rows {
+		n := &Notification{
+			ID:           gosql.Int64Default(r["id"]),
+			PayCode:      gosql.StringDefault(r["pay_code"]),
+			OrderNo:      gosql.StringDefault(r["order_no"]),
+			TradeNo:      gosql.StringDefault(r["trade_no"]),
+			ThirdTradeNo: gosql.StringDefault(r["third_trade_no"]),
+			Succ:         gosql.IntDefault(r["succ"]) == 1,
+			Money:        gosql.Float64Default(r["money"]),
+			ErrMsg:       gosql.StringDefault(r["err_msg"]),
+			Created:      parseTime(r["created"]),
+		}
+		json.Unmarshal(gosql.BytesDefault(r["raw"]), &n.Raw)
+		ret = append(ret, n)
+	}
+	return ret, nil
+}
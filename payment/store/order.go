@@ -0,0 +1,385 @@
+package store
+
+import (
+	"time"
+
+	"github.com/kinwyb/golang/gosql"
+	"github.com/kinwyb/golang/payment"
+)
+
+//Order 支付订单
+type Order struct {
+	No           string         //订单号
+	MemberID     string         //付款用户
+	Money        float64        //订单金额
+	Currency     string         //币种,空表示CNY
+	Desc         string         //描述
+	Status       payment.Status //状态 DEALING:待支付 SUCCESS:已支付 FAIL:已关闭
+	PayCode      string         //实际支付方式编码
+	TradeNo      string         //实际支付交易单号
+	ThirdTradeNo string         //第三方交易流水号
+	Fee          float64        //预计手续费
+	PayTime      time.Time      //支付时间
+	Created      time.Time      //创建时间
+	Updated      time.Time      //更新时间
+}
+
+//Attempt 支付请求,一个订单可以通过多个支付方式多次发起支付
+//	TradeNo为提交给渠道的交易单号,渠道改写单号(如微信在订单号前增加时间)时在收到通知后更新
+type Attempt struct {
+	ID           int64          //编号
+	OrderNo      string         //订单号
+	PayCode      string         //支付方式编码
+	TradeNo      string         //交易单号
+	Money        float64        //支付金额
+	Status       payment.Status //状态
+	ThirdTradeNo string         //第三方交易流水号
+	ErrMsg       string         //错误消息
+	Created      time.Time      //创建时间
+	Updated      time.Time      //更新时间
+}
+
+//PayRequest 根据订单生成支付请求
+func (o *Order) PayRequest(payCode string) *payment.PayRequest {
+	return &payment.PayRequest{
+		No:       o.No,
+		Desc:     o.Desc,
+		Money:    o.Money,
+		PayCode:  payCode,
+		MemberID: o.MemberID,
+		Currency: o.Currency,
+	}
+}
+
+const orderColumns = "no,member_id,money,currency,description,status,pay_code,trade_no,third_trade_no,fee,pay_time,created,updated"
+
+const attemptColumns = "id,order_no,pay_code,trade_no,money,status,third_trade_no,err_msg,created,updated"
+
+//按支付方式和交易单号查询支付请求,优先处理中的请求,其次最新的请求
+const attemptByTrade = "pay_code = ? AND trade_no = ? ORDER BY status = ? DESC,id DESC LIMIT 1"
+
+//CreateOrder 创建订单,状态为DEALING
+func (s *Store) CreateOrder(o *Order) gosql.Error {
+	return s.cfg.DB.Transaction(func(tx gosql.TxSQL) gosql.Error {
+		old, err := s.order(tx, o.No, true)
+		if err != nil {
+			return err
+		} else if old != nil {
+			return ErrExists
+		}
+		now := time.Now()
+		_, err = tx.Exec("INSERT INTO "+s.cfg.OrderTable+"(no,member_id,money,currency,description,status,created,updated) "+
+			"VALUES(?,?,?,?,?,?,?,?)", o.No, o.MemberID, round(o.Money), o.Currency, varchar(o.Desc), string(payment.DEALING), now, now)
+		if err == nil {
+			o.Status = payment.DEALING
+			o.Created = now
+			o.Updated = now
+		}
+		return err
+	})
+}
+
+//GetOrder 查询订单,不存在返回nil
+func (s *Store) GetOrder(no string) (*Order, gosql.Error) {
+	return s.order(s.cfg.DB, no, false)
+}
+
+//CloseOrder 关闭未支付的订单,已支付的订单返回ErrStatusTransition
+func (s *Store) CloseOrder(no string) gosql.Error {
+	return s.cfg.DB.Transaction(func(tx gosql.TxSQL) gosql.Error {
+		o, err := s.order(tx, no, true)
+		if err != nil {
+			return err
+		} else if o == nil {
+			return ErrNotExists
+		} else if err = Transit(o.Status, payment.FAIL); err != nil {
+			return err
+		}
+		_, err = tx.Exec("UPDATE "+s.cfg.OrderTable+" SET status = ?,updated = ? WHERE no = ?", string(payment.FAIL), time.Now(), no)
+		return err
+	})
+}
+
+//Orders 按状态查询订单,创建时间早于before,按创建时间排序,limit<=0不限制数量
+func (s *Store) Orders(status payment.Status, before time.Time, limit int) ([]*Order, gosql.Error) {
+	query := "SELECT " + orderColumns + " FROM " + s.cfg.OrderTable + " WHERE status = ? AND created < ? ORDER BY created"
+	args := []interface{}{string(status), before}
+	if limit > 0 {
+		query += " LIMIT ?"
+		args = append(args, limit)
+	}
+	rows, err := s.cfg.DB.Rows(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*Order, 0, len(rows))
+	for _, v := range rows {
+		ret = append(ret, orderFromRow(v))
+	}
+	return ret, nil
+}
+
+//CreateAttempt 记录支付请求,状态为DEALING.渠道返回的交易单号未知时TradeNo使用订单号
+func (s *Store) CreateAttempt(a *Attempt) gosql.Error {
+	return s.cfg.DB.Transaction(func(tx gosql.TxSQL) gosql.Error {
+		o, err := s.order(tx, a.OrderNo, true)
+		if err != nil {
+			return err
+		} else if o == nil {
+			return ErrNotExists
+		} else if o.Status != payment.DEALING {
+			return Transit(o.Status, payment.DEALING)
+		}
+		if a.TradeNo == "" {
+			a.TradeNo = a.OrderNo
+		}
+		if a.Money == 0 {
+			a.Money = o.Money
+		}
+		now := time.Now()
+		ret, err := tx.Exec("INSERT INTO "+s.cfg.AttemptTable+"(order_no,pay_code,trade_no,money,status,err_msg,created,updated) "+
+			"VALUES(?,?,?,?,?,?,?,?)", a.OrderNo, a.PayCode, a.TradeNo, round(a.Money), string(payment.DEALING), varchar(a.ErrMsg), now, now)
+		if err != nil {
+			return err
+		}
+		a.ID, _ = ret.LastInsertId()
+		a.Status = payment.DEALING
+		a.Created = now
+		a.Updated = now
+		return nil
+	})
+}
+
+//FailAttempt 支付请求发起失败
+func (s *Store) FailAttempt(id int64, errMsg string) gosql.Error {
+	return s.cfg.DB.Transaction(func(tx gosql.TxSQL) gosql.Error {
+		a, err := s.attempt(tx, "id = ?", []interface{}{id}, true)
+		if err != nil {
+			return err
+		} else if a == nil {
+			return ErrNotExists
+		}
+		return s.updateAttempt(tx, a, payment.FAIL, "", errMsg)
+	})
+}
+
+//GetAttempt 根据支付方式和交易单号查询支付请求,不存在返回nil
+//	相同交易单号有多个支付请求时优先返回处理中的请求,其次返回最新的请求
+func (s *Store) GetAttempt(payCode, tradeNo string) (*Attempt, gosql.Error) {
+	return s.attempt(s.cfg.DB, attemptByTrade, []interface{}{payCode, tradeNo, string(payment.DEALING)}, false)
+}
+
+//Attempts 订单的所有支付请求
+func (s *Store) Attempts(orderNo string) ([]*Attempt, gosql.Error) {
+	rows, err := s.cfg.DB.Rows("SELECT "+attemptColumns+" FROM "+s.cfg.AttemptTable+" WHERE order_no = ? ORDER BY id", orderNo)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*Attempt, 0, len(rows))
+	for _, v := range rows {
+		ret = append(ret, attemptFromRow(v))
+	}
+	return ret, nil
+}
+
+//ApplyPayResult 根据支付结果(通知/同步返回/查询)更新支付请求和订单状态,返回更新后的订单
+//	先按支付方式和交易单号查找支付请求,找不到时按订单号查找该支付方式处理中的请求并更新交易单号
+//	只处理支付成功的结果,未成功的结果(签名验证失败、业务校验失败、状态未知等)返回ErrPayNotSucc,支付请求保持处理中
+//	渠道明确返回支付失败(如查询到交易已关闭)时使用FailAttempt关闭对应的支付请求,订单保持待支付以便使用其他方式支付
+//	已失败的支付请求收到支付成功结果时改为成功,按正常支付处理订单
+//	订单已由其他交易支付成功时返回ErrDuplicatePaid,当前支付请求仍记为成功以便退款
+func (s *Store) ApplyPayResult(result *payment.PayResult) (*Order, gosql.Error) {
+	if !result.Succ {
+		if result.ErrMsg != "" {
+			return nil, gosql.NewError(ErrPayNotSucc.Code(), ErrPayNotSucc.Msg()+":"+result.ErrMsg)
+		}
+		return nil, ErrPayNotSucc
+	}
+	var ret *Order
+	var dup gosql.Error
+	err := s.cfg.DB.Transaction(func(tx gosql.TxSQL) gosql.Error {
+		a, err := s.attempt(tx, attemptByTrade, []interface{}{result.PayCode, result.TradeNo, string(payment.DEALING)}, true)
+		if err != nil {
+			return err
+		} else if a == nil && result.No != "" {
+			a, err = s.attempt(tx, "pay_code = ? AND order_no = ? AND status = ? ORDER BY id DESC LIMIT 1",
+				[]interface{}{result.PayCode, result.No, string(payment.DEALING)}, true)
+			if err != nil {
+				return err
+			} else if a != nil && result.TradeNo != "" {
+				a.TradeNo = result.TradeNo
+			}
+		}
+		if a == nil {
+			return ErrNotExists
+		}
+		ret, err = s.order(tx, a.OrderNo, true)
+		if err != nil {
+			return err
+		} else if ret == nil {
+			return ErrNotExists
+		}
+		if result.Money != 0 && cent(result.Money) != cent(a.Money) {
+			return ErrMoneyMismatch
+		}
+		if err = s.updateAttempt(tx, a, payment.SUCCESS, result.ThirdTradeNo, ""); err != nil {
+			return err
+		}
+		if ret.Status == payment.SUCCESS {
+			if ret.PayCode != a.PayCode || ret.TradeNo != a.TradeNo {
+				dup = ErrDuplicatePaid
+			}
+			return nil
+		} else if err = Transit(ret.Status, payment.SUCCESS); err != nil {
+			dup = ErrDuplicatePaid //订单已关闭,当前交易需要退款
+			return nil
+		}
+		now := time.Now()
+		_, err = tx.Exec("UPDATE "+s.cfg.OrderTable+" SET status = ?,pay_code = ?,trade_no = ?,third_trade_no = ?,fee = ?,pay_time = ?,updated = ? WHERE no = ?",
+			string(payment.SUCCESS), a.PayCode, a.TradeNo, result.ThirdTradeNo, round(result.Fee), now, now, ret.No)
+		if err != nil {
+			return err
+		}
+		ret.Status = payment.SUCCESS
+		ret.PayCode = a.PayCode
+		ret.TradeNo = a.TradeNo
+		ret.ThirdTradeNo = result.ThirdTradeNo
+		ret.Fee = round(result.Fee)
+		ret.PayTime = now
+		ret.Updated = now
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ret, dup
+}
+
+//查询订单,lock为true时锁定订单行(事务中使用)
+func (s *Store) order(db executor, no string, lock bool) (*Order, gosql.Error) {
+	r, err := row(db, forUpdate("SELECT "+orderColumns+" FROM "+s.cfg.OrderTable+" WHERE no = ?", lock), no)
+	if err != nil || r == nil {
+		return nil, err
+	}
+	return orderFromRow(r), nil
+}
+
+func orderFromRow(r map[string]interface{}) *Order {
+	return &Order{
+		No:           gosql.StringDefault(r["no"]),
+		MemberID:     gosql.StringDefault(r["member_id"]),
+		Money:        gosql.Float64Default(r["money"]),
+		Currency:     gosql.StringDefault(r["currency"]),
+		Desc:         gosql.StringDefault(r["description"]),
+		Status:       payment.Status(gosql.StringDefault(r["status"])),
+		PayCode:      gosql.StringDefault(r["pay_code"]),
+		TradeNo:      gosql.StringDefault(r["trade_no"]),
+		ThirdTradeNo: gosql.StringDefault(r["third_trade_no"]),
+		Fee:          gosql.Float64Default(r["fee"]),
+		PayTime:      parseTime(r["pay_time"]),
+		Created:      parseTime(r["created"]),
+		Updated:      parseTime(r["updated"]),
+	}
+}
+
+//查询支付请求,lock为true时锁定行(事务中使用)
+func (s *Store) attempt(db executor, where string, args []interface{}, lock bool) (*Attempt, gosql.Error) {
+	r, err := row(db, forUpdate("SELECT "+attemptColumns+" FROM "+s.cfg.AttemptTable+" WHERE "+where, lock), args...)
+	if err != nil || r == nil {
+		return nil, err
+	}
+	return attemptFromRow(r), nil
+}
+
+func attemptFromRow(r map[string]interface{}) *Attempt {
+	return &Attempt{
+		ID:           gosql.Int64Default(r["id"]),
+		OrderNo:      gosql.StringDefault(r["order_no"]),
+		PayCode:      gosql.StringDefault(r["pay_code"]),
+		TradeNo:      gosql.StringDefault(r["trade_no"]),
+		Money:        gosql.Float64Default(r["money"]),
+		Status:       payment.Status(gosql.StringDefault(r["status"])),
+		ThirdTradeNo: gosql.StringDefault(r["third_trade_no"]),
+		ErrMsg:       gosql.StringDefault(r["err_msg"]),
+		Created:      parseTime(r["created"]),
+		Updated:      parseTime(r["updated"]),
+	}
+}
+
+//更新支付请求状态
+func (s *Store) updateAttempt(tx gosql.TxSQL, a *Attempt, status payment.Status, thirdTradeNo, errMsg string) gosql.Error {
+	if !canTransitAttempt(a.Status, status) {
+		return Transit(a.Status, status)
+	}
+	if thirdTradeNo == "" {
+		thirdTradeNo = a.ThirdTradeNo
+	}
+	errMsg = varchar(errMsg)
+	now := time.Now()
+	_, err := tx.Exec("UPDATE "+s.cfg.AttemptTable+" SET trade_no = ?,status = ?,third_trade_no = ?,err_msg = ?,updated = ? WHERE id = ?",
+		a.TradeNo, string(status), thirdTradeNo, errMsg, now, a.ID)
+	if err == nil {
+		a.Status = status
+		a.ThirdTradeNo = thirdTradeNo
+		a.ErrMsg = errMsg
+		a.Updated = now
+	}
+	return err
+}
+
+//OrderAdapter 订单存储适配,方法返回error,用于收银台、超时处理等按接口使用订单存储
+//	Store的方法返回gosql.Error,nil值直接赋给error接口时不等于nil,通过适配器转换
+type OrderAdapter struct {
+	s *Store
+}
+
+//NewOrderAdapter 创建订单存储适配
+func NewOrderAdapter(s *Store) *OrderAdapter {
+	return &OrderAdapter{s: s}
+}
+
+//CreateOrder 创建订单,状态为DEALING
+func (o *OrderAdapter) CreateOrder(order *Order) error {
+	return wrapError(o.s.CreateOrder(order))
+}
+
+//GetOrder 查询订单,不存在返回nil
+func (o *OrderAdapter) GetOrder(no string) (*Order, error) {
+	ret, err := o.s.GetOrder(no)
+	return ret, wrapError(err)
+}
+
+//CloseOrder 关闭未支付的订单
+func (o *OrderAdapter) CloseOrder(no string) error {
+	return wrapError(o.s.CloseOrder(no))
+}
+
+//CreateAttempt 记录支付请求
+func (o *OrderAdapter) CreateAttempt(a *Attempt) error {
+	return wrapError(o.s.CreateAttempt(a))
+}
+
+//FailAttempt 支付请求发起失败
+func (o *OrderAdapter) FailAttempt(id int64, errMsg string) error {
+	return wrapError(o.s.FailAttempt(id, errMsg))
+}
+
+//Attempts 订单的所有支付请求
+func (o *OrderAdapter) Attempts(no string) ([]*Attempt, error) {
+	ret, err := o.s.Attempts(no)
+	return ret, wrapError(err)
+}
+
+//ApplyPayResult 根据支付结果更新订单,订单重复支付时同时返回订单和错误
+func (o *OrderAdapter) ApplyPayResult(ret *payment.PayResult) (*Order, error) {
+	order, err := o.s.ApplyPayResult(ret)
+	return order, wrapError(err)
+}
+
+//gosql.Error转换成error,nil时返回nil
+func wrapError(err gosql.Error) error {
+	if err == nil {
+		return nil
+	}
+	return err
+}
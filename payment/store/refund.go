@@ -0,0 +1,154 @@
+package store
+
+import (
+	"time"
+
+	"github.com/kinwyb/golang/gosql"
+	"github.com/kinwyb/golang/payment"
+)
+
+//Refund 退款记录
+type Refund struct {
+	RefundNo      string         //退款单号
+	OrderNo       string         //原订单号
+	PayCode       string         //支付方式编码
+	Money         float64        //退款金额
+	Reason        string         //退款原因
+	Status        payment.Status //状态
+	ThirdRefundNo string         //第三方退款流水号
+	FailCode      string         //错误代码
+	FailMsg       string         //错误原因
+	Created       time.Time      //创建时间
+	Updated       time.Time      //更新时间
+}
+
+const refundColumns = "refund_no,order_no,pay_code,money,reason,status,third_refund_no,fail_code,fail_msg,created,updated"
+
+//CreateRefund 创建退款,状态为DEALING,返回对应的退款请求
+//	只有已支付的订单可以退款,处理中和成功的退款金额合计不能超过订单金额
+func (s *Store) CreateRefund(r *Refund) (*payment.RefundRequest, gosql.Error) {
+	var ret *payment.RefundRequest
+	err := s.cfg.DB.Transaction(func(tx gosql.TxSQL) gosql.Error {
+		o, err := s.order(tx, r.OrderNo, true)
+		if err != nil {
+			return err
+		} else if o == nil {
+			return ErrNotExists
+		} else if o.Status != payment.SUCCESS {
+			return ErrStatusTransition
+		}
+		old, err := s.refund(tx, r.RefundNo, true)
+		if err != nil {
+			return err
+		} else if old != nil {
+			return ErrExists
+		}
+		rows, err := tx.Rows("SELECT IFNULL(SUM(money),0) AS money FROM "+s.cfg.RefundTable+" WHERE order_no = ? AND status <> ?",
+			r.OrderNo, string(payment.FAIL))
+		if err != nil {
+			return err
+		} else if len(rows) > 0 && cent(gosql.Float64Default(rows[0]["money"]))+cent(r.Money) > cent(o.Money) {
+			return ErrRefundOverflow
+		}
+		now := time.Now()
+		r.PayCode = o.PayCode
+		_, err = tx.Exec("INSERT INTO "+s.cfg.RefundTable+"(refund_no,order_no,pay_code,money,reason,status,created,updated) "+
+			"VALUES(?,?,?,?,?,?,?,?)", r.RefundNo, r.OrderNo, r.PayCode, round(r.Money), varchar(r.Reason), string(payment.DEALING), now, now)
+		if err != nil {
+			return err
+		}
+		r.Status = payment.DEALING
+		r.Created = now
+		r.Updated = now
+		ret = &payment.RefundRequest{
+			No:           o.TradeNo,
+			RefundNo:     r.RefundNo,
+			ThirdTradeNo: o.ThirdTradeNo,
+			Money:        r.Money,
+			TotalMoney:   o.Money,
+			Reason:       r.Reason,
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+//GetRefund 查询退款,不存在返回nil
+func (s *Store) GetRefund(refundNo string) (*Refund, gosql.Error) {
+	return s.refund(s.cfg.DB, refundNo, false)
+}
+
+//Refunds 订单的所有退款
+func (s *Store) Refunds(orderNo string) ([]*Refund, gosql.Error) {
+	rows, err := s.cfg.DB.Rows("SELECT "+refundColumns+" FROM "+s.cfg.RefundTable+" WHERE order_no = ? ORDER BY created", orderNo)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*Refund, 0, len(rows))
+	for _, v := range rows {
+		ret = append(ret, refundFromRow(v))
+	}
+	return ret, nil
+}
+
+//ApplyRefundResult 根据退款结果更新退款状态,UNKNOW和DEALING结果不变更状态
+func (s *Store) ApplyRefundResult(result *payment.RefundResult) (*Refund, gosql.Error) {
+	var ret *Refund
+	err := s.cfg.DB.Transaction(func(tx gosql.TxSQL) gosql.Error {
+		var err gosql.Error
+		ret, err = s.refund(tx, result.RefundNo, true)
+		if err != nil {
+			return err
+		} else if ret == nil {
+			return ErrNotExists
+		} else if !IsFinal(result.Status) {
+			return nil
+		} else if err = Transit(ret.Status, result.Status); err != nil {
+			return err
+		}
+		now := time.Now()
+		_, err = tx.Exec("UPDATE "+s.cfg.RefundTable+" SET status = ?,third_refund_no = ?,fail_code = ?,fail_msg = ?,updated = ? WHERE refund_no = ?",
+			string(result.Status), result.ThirdTradeNo, result.FailCode, varchar(result.FailMsg), now, ret.RefundNo)
+		if err != nil {
+			return err
+		}
+		ret.Status = result.Status
+		ret.ThirdRefundNo = result.ThirdTradeNo
+		ret.FailCode = result.FailCode
+		ret.FailMsg = result.FailMsg
+		ret.Updated = now
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+//查询退款,lock为true时锁定行(事务中使用)
+func (s *Store) refund(db executor, refundNo string, lock bool) (*Refund, gosql.Error) {
+	r, err := row(db, forUpdate("SELECT "+refundColumns+" FROM "+s.cfg.RefundTable+" WHERE refund_no = ?", lock), refundNo)
+	if err != nil || r == nil {
+		return nil, err
+	}
+	return refundFromRow(r), nil
+}
+
+func refundFromRow(r map[string]interface{}) *Refund {
+	return &Refund{
+		RefundNo:      gosql.StringDefault(r["refund_no"]),
+		OrderNo:       gosql.StringDefault(r["order_no"]),
+		PayCode:       gosql.StringDefault(r["pay_code"]),
+		Money:         gosql.Float64Default(r["money"]),
+		Reason:        gosql.StringDefault(r["reason"]),
+		Status:        payment.Status(gosql.StringDefault(r["status"])),
+		ThirdRefundNo: gosql.StringDefault(r["third_refund_no"]),
+		FailCode:      gosql.StringDefault(r["fail_code"]),
+		FailMsg:       gosql.StringDefault(r["fail_msg"]),
+		Created:       parseTime(r["created"]),
+		Updated:       parseTime(r["updated"]),
+	}
+}
@@ -0,0 +1,130 @@
+package store
+
+import (
+	"database/sql"
+	"math"
+	"time"
+	"unicode/utf8"
+
+	"github.com/kinwyb/golang/gosql"
+	"github.com/kinwyb/golang/payment"
+)
+
+//支付订单、支付请求、通知、退款、提现的数据存储
+//	所有状态变更都经过状态机校验,只允许 DEALING→SUCCESS/FAIL 等有效转换,终态不可变更
+
+const timeFormat = "2006-01-02 15:04:05"
+
+var (
+	//ErrExists 数据已存在
+	ErrExists = gosql.NewError(200, "单号已存在")
+	//ErrNotExists 数据不存在
+	ErrNotExists = gosql.NewError(201, "数据不存在")
+	//ErrStatusTransition 状态转换无效
+	ErrStatusTransition = gosql.NewError(202, "状态转换无效")
+	//ErrDuplicatePaid 订单已通过其他交易支付成功,当前交易需要退款处理
+	ErrDuplicatePaid = gosql.NewError(203, "订单重复支付")
+	//ErrRefundOverflow 退款金额超出可退金额
+	ErrRefundOverflow = gosql.NewError(204, "退款金额超出可退金额")
+	//ErrMoneyMismatch 支付金额与订单金额不一致
+	ErrMoneyMismatch = gosql.NewError(205, "支付金额与订单金额不一致")
+	//ErrPayNotSucc 支付结果未成功,不更新支付请求和订单
+	ErrPayNotSucc = gosql.NewError(206, "支付未成功")
+)
+
+//有效的状态转换,相同状态视为幂等操作
+var transitions = map[payment.Status][]payment.Status{
+	"":              {payment.DEALING, payment.SUCCESS, payment.FAIL},
+	payment.UNKNOW:  {payment.DEALING, payment.SUCCESS, payment.FAIL},
+	payment.DEALING: {payment.SUCCESS, payment.FAIL},
+}
+
+//CanTransit 状态是否可以从from转换成to,SUCCESS和FAIL为终态
+func CanTransit(from, to payment.Status) bool {
+	if from == to {
+		return true
+	}
+	for _, v := range transitions[from] {
+		if v == to {
+			return true
+		}
+	}
+	return false
+}
+
+//Transit 校验状态转换,无效时返回ErrStatusTransition
+func Transit(from, to payment.Status) gosql.Error {
+	if !CanTransit(from, to) {
+		return gosql.NewError(ErrStatusTransition.Code(), ErrStatusTransition.Msg()+":"+string(from)+"→"+string(to))
+	}
+	return nil
+}
+
+//支付请求状态是否可以从from转换成to
+//	支付请求发起失败或已关闭后仍可能收到渠道的支付成功结果,此时渠道已扣款,以渠道结果为准允许FAIL→SUCCESS
+func canTransitAttempt(from, to payment.Status) bool {
+	return CanTransit(from, to) || (from == payment.FAIL && to == payment.SUCCESS)
+}
+
+//IsFinal 是否是终态
+func IsFinal(status payment.Status) bool {
+	return status == payment.SUCCESS || status == payment.FAIL
+}
+
+//executor 数据库和事务的公共操作
+type executor interface {
+	Rows(sql string, args ...interface{}) ([]map[string]interface{}, gosql.Error)
+	Exec(sql string, args ...interface{}) (sql.Result, gosql.Error)
+}
+
+//Store 支付数据存储
+type Store struct {
+	cfg *Config
+}
+
+//New 创建支付数据存储
+func New(c *Config) *Store {
+	c.defaults()
+	return &Store{cfg: c}
+}
+
+//查询单行数据
+func row(db executor, query string, args ...interface{}) (map[string]interface{}, gosql.Error) {
+	rows, err := db.Rows(query, args...)
+	if err != nil || len(rows) < 1 {
+		return nil, err
+	}
+	return rows[0], nil
+}
+
+//数据库时间
+func parseTime(v interface{}) time.Time {
+	ret, _ := time.ParseInLocation(timeFormat, gosql.StringDefault(v), time.Local)
+	return ret
+}
+
+//加锁查询
+func forUpdate(query string, lock bool) string {
+	if lock {
+		return query + " FOR UPDATE"
+	}
+	return query
+}
+
+//截断为VARCHAR(255)字段可保存的长度,渠道错误消息等外部内容写入前使用
+func varchar(s string) string {
+	if utf8.RuneCountInString(s) <= 255 {
+		return s
+	}
+	return string([]rune(s)[:255])
+}
+
+//金额保留两位小数
+func round(money float64) float64 {
+	return math.Round(money*100) / 100
+}
+
+//金额转换成分,用于比较
+func cent(money float64) int64 {
+	return int64(math.Round(money * 100))
+}
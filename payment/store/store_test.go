@@ -0,0 +1,236 @@
+package store
+
+import (
+	"database/sql"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kinwyb/golang/gosql"
+	"github.com/kinwyb/golang/payment"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+//内存模拟的支付数据库,只支持订单和支付请求使用的SQL,事务失败时回滚
+type storeTestDB struct {
+	gosql.SQL
+	orders   []map[string]interface{}
+	attempts []map[string]interface{}
+}
+
+type storeTestTx struct {
+	gosql.TxSQL
+	db *storeTestDB
+}
+
+type storeTestResult int64
+
+func (r storeTestResult) LastInsertId() (int64, error) { return int64(r), nil }
+func (r storeTestResult) RowsAffected() (int64, error) { return 1, nil }
+
+func (d *storeTestDB) Transaction(t gosql.TransactionFunc) gosql.Error {
+	orders, attempts := copyRows(d.orders), copyRows(d.attempts)
+	if err := t(&storeTestTx{db: d}); err != nil {
+		d.orders, d.attempts = orders, attempts
+		return err
+	}
+	return nil
+}
+
+func (t *storeTestTx) Rows(query string, args ...interface{}) ([]map[string]interface{}, gosql.Error) {
+	return t.db.Rows(query, args...)
+}
+
+func (t *storeTestTx) Exec(query string, args ...interface{}) (sql.Result, gosql.Error) {
+	return t.db.Exec(query, args...)
+}
+
+func (d *storeTestDB) Rows(query string, args ...interface{}) ([]map[string]interface{}, gosql.Error) {
+	var ret []map[string]interface{}
+	switch {
+	case strings.Contains(query, "FROM pay_order WHERE no = ?"):
+		ret = find(d.orders, func(r map[string]interface{}) bool { return r["no"] == args[0] })
+	case strings.Contains(query, "WHERE id = ?"):
+		ret = find(d.attempts, func(r map[string]interface{}) bool { return r["id"] == args[0] })
+	case strings.Contains(query, "WHERE pay_code = ? AND trade_no = ?"): //优先处理中的请求,其次最新的请求
+		ret = find(d.attempts, func(r map[string]interface{}) bool { return r["pay_code"] == args[0] && r["trade_no"] == args[1] })
+		for i := len(ret) - 1; i >= 0; i-- {
+			if ret[i]["status"] == args[2] {
+				return ret[i : i+1], nil
+			}
+		}
+		if len(ret) > 0 {
+			ret = ret[len(ret)-1:]
+		}
+	case strings.Contains(query, "WHERE pay_code = ? AND order_no = ? AND status = ?"):
+		ret = find(d.attempts, func(r map[string]interface{}) bool {
+			return r["pay_code"] == args[0] && r["order_no"] == args[1] && r["status"] == args[2]
+		})
+		if len(ret) > 0 {
+			ret = ret[len(ret)-1:]
+		}
+	}
+	return ret, nil
+}
+
+func (d *storeTestDB) Exec(query string, args ...interface{}) (sql.Result, gosql.Error) {
+	switch {
+	case strings.HasPrefix(query, "INSERT INTO pay_order"):
+		d.orders = append(d.orders, map[string]interface{}{
+			"no": args[0], "member_id": args[1], "money": args[2], "currency": args[3], "description": args[4],
+			"status": args[5], "created": dbTime(args[6]), "updated": dbTime(args[7]),
+		})
+	case strings.HasPrefix(query, "UPDATE pay_order SET status = ?,updated = ?"):
+		for _, r := range d.orders {
+			if r["no"] == args[2] {
+				r["status"], r["updated"] = args[0], dbTime(args[1])
+			}
+		}
+	case strings.HasPrefix(query, "UPDATE pay_order"):
+		for _, r := range d.orders {
+			if r["no"] == args[7] {
+				r["status"], r["pay_code"], r["trade_no"], r["third_trade_no"] = args[0], args[1], args[2], args[3]
+				r["fee"], r["pay_time"], r["updated"] = args[4], dbTime(args[5]), dbTime(args[6])
+			}
+		}
+	case strings.HasPrefix(query, "INSERT INTO pay_attempt"):
+		id := int64(len(d.attempts) + 1)
+		d.attempts = append(d.attempts, map[string]interface{}{
+			"id": id, "order_no": args[0], "pay_code": args[1], "trade_no": args[2], "money": args[3],
+			"status": args[4], "err_msg": args[5], "created": dbTime(args[6]), "updated": dbTime(args[7]),
+		})
+		return storeTestResult(id), nil
+	case strings.HasPrefix(query, "UPDATE pay_attempt"):
+		for _, r := range d.attempts {
+			if r["id"] == args[5] {
+				r["trade_no"], r["status"], r["third_trade_no"], r["err_msg"], r["updated"] = args[0], args[1], args[2], args[3], dbTime(args[4])
+			}
+		}
+	}
+	return storeTestResult(0), nil
+}
+
+func find(rows []map[string]interface{}, match func(r map[string]interface{}) bool) []map[string]interface{} {
+	var ret []map[string]interface{}
+	for _, r := range rows {
+		if match(r) {
+			ret = append(ret, copyRow(r))
+		}
+	}
+	return ret
+}
+
+func copyRows(rows []map[string]interface{}) []map[string]interface{} {
+	ret := make([]map[string]interface{}, 0, len(rows))
+	for _, r := range rows {
+		ret = append(ret, copyRow(r))
+	}
+	return ret
+}
+
+func copyRow(r map[string]interface{}) map[string]interface{} {
+	ret := map[string]interface{}{}
+	for k, v := range r {
+		ret[k] = v
+	}
+	return ret
+}
+
+func dbTime(v interface{}) string {
+	return v.(time.Time).Format(timeFormat)
+}
+
+func Test_Transit(t *testing.T) {
+	convey.Convey("状态机", t, func() {
+		convey.So(CanTransit("", payment.DEALING), convey.ShouldBeTrue)
+		convey.So(CanTransit(payment.DEALING, payment.SUCCESS), convey.ShouldBeTrue)
+		convey.So(CanTransit(payment.DEALING, payment.FAIL), convey.ShouldBeTrue)
+		convey.So(CanTransit(payment.UNKNOW, payment.DEALING), convey.ShouldBeTrue)
+		convey.So(CanTransit(payment.SUCCESS, payment.SUCCESS), convey.ShouldBeTrue)
+		convey.So(CanTransit(payment.SUCCESS, payment.FAIL), convey.ShouldBeFalse)
+		convey.So(CanTransit(payment.FAIL, payment.SUCCESS), convey.ShouldBeFalse)
+		convey.So(CanTransit(payment.SUCCESS, payment.DEALING), convey.ShouldBeFalse)
+		convey.So(CanTransit(payment.DEALING, payment.UNKNOW), convey.ShouldBeFalse)
+		err := Transit(payment.SUCCESS, payment.FAIL)
+		convey.So(err, convey.ShouldNotBeNil)
+		convey.So(err.Code(), convey.ShouldEqual, ErrStatusTransition.Code())
+		convey.So(Transit(payment.DEALING, payment.SUCCESS), convey.ShouldBeNil)
+		convey.So(IsFinal(payment.FAIL), convey.ShouldBeTrue)
+		convey.So(IsFinal(payment.DEALING), convey.ShouldBeFalse)
+	})
+	convey.Convey("默认表名", t, func() {
+		s := New(&Config{})
+		convey.So(s.cfg.OrderTable, convey.ShouldEqual, "pay_order")
+		convey.So(s.cfg.WithdrawTable, convey.ShouldEqual, "pay_withdraw")
+		o := &Order{No: "001", Money: 10, Desc: "test"}
+		req := o.PayRequest("wxpay")
+		convey.So(req.PayCode, convey.ShouldEqual, "wxpay")
+		convey.So(req.Money, convey.ShouldEqual, 10)
+		_, err := s.ApplyPayResult(&payment.PayResult{PayCode: "wxpay", TradeNo: "001", ErrMsg: "签名验证失败"})
+		convey.So(err.Code(), convey.ShouldEqual, ErrPayNotSucc.Code())
+		_, e := NewOrderAdapter(s).ApplyPayResult(&payment.PayResult{PayCode: "wxpay", TradeNo: "001"})
+		convey.So(e, convey.ShouldEqual, ErrPayNotSucc)
+		convey.So(wrapError(nil) == nil, convey.ShouldBeTrue)
+	})
+}
+
+func Test_ApplyPayResult(t *testing.T) {
+	db := &storeTestDB{}
+	s := New(&Config{DB: db})
+	convey.Convey("支付结果更新订单", t, func() {
+		convey.So(s.CreateOrder(&Order{No: "001", Money: 10, Desc: strings.Repeat("描", 300)}), convey.ShouldBeNil)
+		convey.So(len([]rune(db.orders[0]["description"].(string))), convey.ShouldEqual, 255)
+		a := &Attempt{OrderNo: "001", PayCode: "alipay", TradeNo: "00101"}
+		convey.So(s.CreateAttempt(a), convey.ShouldBeNil)
+		convey.So(a.Money, convey.ShouldEqual, 10)
+		_, err := s.ApplyPayResult(&payment.PayResult{Succ: true, PayCode: "alipay", TradeNo: "00101", Money: 9})
+		convey.So(err, convey.ShouldEqual, ErrMoneyMismatch)
+		o, err := s.ApplyPayResult(&payment.PayResult{Succ: true, PayCode: "alipay", TradeNo: "00101", ThirdTradeNo: "T1", Money: 10, Fee: 0.06})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(o.Status, convey.ShouldEqual, payment.SUCCESS)
+		convey.So(o.TradeNo, convey.ShouldEqual, "00101")
+		convey.So(o.Fee, convey.ShouldEqual, 0.06)
+		convey.So(db.attempts[0]["status"], convey.ShouldEqual, string(payment.SUCCESS))
+		convey.So(db.attempts[0]["third_trade_no"], convey.ShouldEqual, "T1")
+	})
+	convey.Convey("重复通知幂等", t, func() {
+		o, err := s.ApplyPayResult(&payment.PayResult{Succ: true, PayCode: "alipay", TradeNo: "00101", ThirdTradeNo: "T1", Money: 10})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(o.Status, convey.ShouldEqual, payment.SUCCESS)
+		convey.So(len(db.attempts), convey.ShouldEqual, 1)
+	})
+	convey.Convey("重复支付", t, func() {
+		convey.So(s.CreateAttempt(&Attempt{OrderNo: "001", PayCode: "wxpay", TradeNo: "00102"}).Code(), convey.ShouldEqual, ErrStatusTransition.Code())
+		db.attempts = append(db.attempts, map[string]interface{}{"id": int64(2), "order_no": "001", "pay_code": "wxpay", "trade_no": "00102",
+			"money": 10.0, "status": string(payment.DEALING), "created": "2017-10-19 10:00:00"})
+		o, err := s.ApplyPayResult(&payment.PayResult{Succ: true, PayCode: "wxpay", TradeNo: "00102", ThirdTradeNo: "W1", Money: 10})
+		convey.So(err, convey.ShouldEqual, ErrDuplicatePaid)
+		convey.So(o.PayCode, convey.ShouldEqual, "alipay")
+		convey.So(db.attempts[1]["status"], convey.ShouldEqual, string(payment.SUCCESS))
+	})
+	convey.Convey("失败的支付请求收到支付成功", t, func() {
+		convey.So(s.CreateOrder(&Order{No: "002", Money: 5}), convey.ShouldBeNil)
+		a := &Attempt{OrderNo: "002", PayCode: "alipay", TradeNo: "00201"}
+		convey.So(s.CreateAttempt(a), convey.ShouldBeNil)
+		convey.So(s.FailAttempt(a.ID, strings.Repeat("错", 300)), convey.ShouldBeNil)
+		convey.So(len([]rune(db.attempts[2]["err_msg"].(string))), convey.ShouldEqual, 255)
+		_, err := s.ApplyPayResult(&payment.PayResult{PayCode: "alipay", TradeNo: "00201", ErrMsg: "交易已关闭"})
+		convey.So(err.Code(), convey.ShouldEqual, ErrPayNotSucc.Code())
+		o, err := s.ApplyPayResult(&payment.PayResult{Succ: true, PayCode: "alipay", TradeNo: "00201", Money: 5})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(o.Status, convey.ShouldEqual, payment.SUCCESS)
+		convey.So(db.attempts[2]["status"], convey.ShouldEqual, string(payment.SUCCESS))
+		convey.So(db.attempts[2]["err_msg"], convey.ShouldEqual, "")
+		convey.So(s.FailAttempt(a.ID, "关闭").Code(), convey.ShouldEqual, ErrStatusTransition.Code())
+	})
+	convey.Convey("订单关闭后收到支付成功", t, func() {
+		convey.So(s.CreateOrder(&Order{No: "003", Money: 5}), convey.ShouldBeNil)
+		convey.So(s.CreateAttempt(&Attempt{OrderNo: "003", PayCode: "alipay", TradeNo: "00301"}), convey.ShouldBeNil)
+		convey.So(s.CloseOrder("003"), convey.ShouldBeNil)
+		o, err := s.ApplyPayResult(&payment.PayResult{Succ: true, PayCode: "alipay", TradeNo: "00301", Money: 5})
+		convey.So(err, convey.ShouldEqual, ErrDuplicatePaid)
+		convey.So(o.Status, convey.ShouldEqual, payment.FAIL)
+		_, err = s.ApplyPayResult(&payment.PayResult{Succ: true, PayCode: "alipay", TradeNo: "404"})
+		convey.So(err, convey.ShouldEqual, ErrNotExists)
+	})
+}
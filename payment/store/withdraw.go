@@ -0,0 +1,164 @@
+package store
+
+import (
+	"time"
+
+	"github.com/kinwyb/golang/gosql"
+	"github.com/kinwyb/golang/payment"
+)
+
+//Withdrawal 提现记录
+type Withdrawal struct {
+	TradeNo      string         //交易流水号
+	Code         string         //提现方式编码
+	CardNo       string         //收款账户
+	UserName     string         //收款人姓名
+	Money        float64        //提现金额
+	Currency     string         //币种,空表示CNY
+	Fee          float64        //预计手续费
+	Status       payment.Status //状态
+	ThirdTradeNo string         //第三方交易流水号
+	FailCode     string         //错误代码
+	FailMsg      string         //错误原因
+	PayTime      string         //完成时间
+	Created      time.Time      //创建时间
+	Updated      time.Time      //更新时间
+}
+
+const withdrawColumns = "trade_no,code,card_no,user_name,money,currency,fee,status,third_trade_no,fail_code,fail_msg,pay_time,created,updated"
+
+//CreateWithdrawal 提现请求发起前记录提现,状态为DEALING
+func (s *Store) CreateWithdrawal(code string, info *payment.WithdrawInfo) (*Withdrawal, gosql.Error) {
+	w := &Withdrawal{
+		TradeNo:  info.TradeNo,
+		Code:     code,
+		CardNo:   info.CardNo,
+		UserName: info.UserName,
+		Money:    info.Money,
+		Currency: info.Currency,
+		Status:   payment.DEALING,
+	}
+	err := s.cfg.DB.Transaction(func(tx gosql.TxSQL) gosql.Error {
+		old, err := s.withdrawal(tx, info.TradeNo, true)
+		if err != nil {
+			return err
+		} else if old != nil {
+			return ErrExists
+		}
+		w.Created = time.Now()
+		w.Updated = w.Created
+		_, err = tx.Exec("INSERT INTO "+s.cfg.WithdrawTable+"(trade_no,code,card_no,user_name,money,currency,status,created,updated) "+
+			"VALUES(?,?,?,?,?,?,?,?,?)", w.TradeNo, w.Code, w.CardNo, w.UserName, round(w.Money), w.Currency, string(w.Status), w.Created, w.Updated)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+//GetWithdrawal 查询提现,不存在返回nil
+func (s *Store) GetWithdrawal(tradeNo string) (*Withdrawal, gosql.Error) {
+	return s.withdrawal(s.cfg.DB, tradeNo, false)
+}
+
+//Withdrawals 按状态查询提现,创建时间早于before,按创建时间排序,limit<=0不限制数量
+func (s *Store) Withdrawals(status payment.Status, before time.Time, limit int) ([]*Withdrawal, gosql.Error) {
+	query := "SELECT " + withdrawColumns + " FROM " + s.cfg.WithdrawTable + " WHERE status = ? AND created < ? ORDER BY created"
+	args := []interface{}{string(status), before}
+	if limit > 0 {
+		query += " LIMIT ?"
+		args = append(args, limit)
+	}
+	rows, err := s.cfg.DB.Rows(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*Withdrawal, 0, len(rows))
+	for _, v := range rows {
+		ret = append(ret, withdrawalFromRow(v))
+	}
+	return ret, nil
+}
+
+//ApplyWithdrawResult 根据提现结果更新提现状态,UNKNOW和DEALING结果只更新第三方流水号
+func (s *Store) ApplyWithdrawResult(result *payment.WithdrawResult) (*Withdrawal, gosql.Error) {
+	return s.applyWithdraw(result.TradeNo, result.Status, result.ThridFlowNo, result.FailCode, result.FailMsg, result.PayTime, result.Fee)
+}
+
+//ApplyWithdrawQuery 根据提现查询结果更新提现状态
+func (s *Store) ApplyWithdrawQuery(result *payment.WithdrawQueryResult) (*Withdrawal, gosql.Error) {
+	return s.applyWithdraw(result.TradeNo, result.Status, result.ThridFlowNo, result.FailCode, result.FailMsg, result.PayTime, -1)
+}
+
+//更新提现状态,fee小于0时不更新手续费
+func (s *Store) applyWithdraw(tradeNo string, status payment.Status, thirdTradeNo, failCode, failMsg, payTime string, fee float64) (*Withdrawal, gosql.Error) {
+	var ret *Withdrawal
+	err := s.cfg.DB.Transaction(func(tx gosql.TxSQL) gosql.Error {
+		var err gosql.Error
+		ret, err = s.withdrawal(tx, tradeNo, true)
+		if err != nil {
+			return err
+		} else if ret == nil {
+			return ErrNotExists
+		}
+		if !IsFinal(status) {
+			status = ret.Status
+			failCode, failMsg, payTime = ret.FailCode, ret.FailMsg, ret.PayTime
+		} else if err = Transit(ret.Status, status); err != nil {
+			return err
+		}
+		if thirdTradeNo == "" {
+			thirdTradeNo = ret.ThirdTradeNo
+		}
+		if fee < 0 {
+			fee = ret.Fee
+		}
+		now := time.Now()
+		_, err = tx.Exec("UPDATE "+s.cfg.WithdrawTable+" SET status = ?,third_trade_no = ?,fail_code = ?,fail_msg = ?,pay_time = ?,fee = ?,updated = ? WHERE trade_no = ?",
+			string(status), thirdTradeNo, failCode, varchar(failMsg), payTime, round(fee), now, tradeNo)
+		if err != nil {
+			return err
+		}
+		ret.Status = status
+		ret.ThirdTradeNo = thirdTradeNo
+		ret.FailCode = failCode
+		ret.FailMsg = failMsg
+		ret.PayTime = payTime
+		ret.Fee = round(fee)
+		ret.Updated = now
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+//查询提现,lock为true时锁定行(事务中使用)
+func (s *Store) withdrawal(db executor, tradeNo string, lock bool) (*Withdrawal, gosql.Error) {
+	r, err := row(db, forUpdate("SELECT "+withdrawColumns+" FROM "+s.cfg.WithdrawTable+" WHERE trade_no = ?", lock), tradeNo)
+	if err != nil || r == nil {
+		return nil, err
+	}
+	return withdrawalFromRow(r), nil
+}
+
+func withdrawalFromRow(r map[string]interface{}) *Withdrawal {
+	return &Withdrawal{
+		TradeNo:      gosql.StringDefault(r["trade_no"]),
+		Code:         gosql.StringDefault(r["code"]),
+		CardNo:       gosql.StringDefault(r["card_no"]),
+		UserName:     gosql.StringDefault(r["user_name"]),
+		Money:        gosql.Float64Default(r["money"]),
+		Currency:     gosql.StringDefault(r["currency"]),
+		Fee:          gosql.Float64Default(r["fee"]),
+		Status:       payment.Status(gosql.StringDefault(r["status"])),
+		ThirdTradeNo: gosql.StringDefault(r["third_trade_no"]),
+		FailCode:     gosql.StringDefault(r["fail_code"]),
+		FailMsg:      gosql.StringDefault(r["fail_msg"]),
+		PayTime:      gosql.StringDefault(r["pay_time"]),
+		Created:      parseTime(r["created"]),
+		Updated:      parseTime(r["updated"]),
+	}
+}
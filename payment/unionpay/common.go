@@ -0,0 +1,295 @@
+package unionpay
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"html"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kinwyb/golang/crypto/rsautil"
+	"github.com/kinwyb/golang/payment"
+	"github.com/kinwyb/golang/utils"
+	"golang.org/x/crypto/pkcs12"
+)
+
+const (
+	unionpayCNName     = "中国银联股份有限公司"    //生产环境验签证书CN名称
+	unionpayTestCNName = "00040000:SIGN" //测试环境验签证书CN名称
+	verifyCacheSize    = 8               //验签证书缓存数量上限,银联同时在用的验签证书只有一两张
+)
+
+//certs 银联全渠道证书信息
+type certs struct {
+	signKey       *rsa.PrivateKey              //签名私钥
+	signCertID    string                       //签名证书编号
+	encryptKey    *rsa.PublicKey               //敏感信息加密公钥
+	encryptCertID string                       //敏感信息加密证书编号
+	middle        *x509.CertPool               //验签中级证书
+	root          *x509.CertPool               //验签根证书
+	verifyCache   map[string]*x509.Certificate //已验证通过的验签证书
+	lock          sync.RWMutex
+	testMode      bool
+	info          *payment.PayInfo //日志和时钟,为空使用默认值
+}
+
+//初始化证书
+func loadCerts(c *PayConfig) (*certs, error) {
+	ret := &certs{
+		middle:      x509.NewCertPool(),
+		root:        x509.NewCertPool(),
+		verifyCache: map[string]*x509.Certificate{},
+		testMode:    c.TestMode,
+	}
+	priv, cert, err := pkcs12.Decode(c.SignCert, c.SignCertPassword)
+	if err != nil {
+		return nil, errors.New("签名证书解析失败:" + err.Error())
+	}
+	var ok bool
+	if ret.signKey, ok = priv.(*rsa.PrivateKey); !ok {
+		return nil, errors.New("签名证书私钥不是RSA私钥")
+	}
+	ret.signCertID = cert.SerialNumber.String()
+	if len(c.EncryptCert) > 0 {
+		cert, err = parseCert(c.EncryptCert)
+		if err != nil {
+			return nil, errors.New("敏感信息加密证书解析失败:" + err.Error())
+		}
+		if ret.encryptKey, ok = cert.PublicKey.(*rsa.PublicKey); !ok {
+			return nil, errors.New("敏感信息加密证书公钥不是RSA公钥")
+		}
+		ret.encryptCertID = cert.SerialNumber.String()
+	}
+	if !ret.middle.AppendCertsFromPEM(c.MiddleCert) {
+		return nil, errors.New("验签中级证书解析失败")
+	}
+	if !ret.root.AppendCertsFromPEM(c.RootCert) {
+		return nil, errors.New("验签根证书解析失败")
+	}
+	return ret, nil
+}
+
+//解析PEM证书
+func parseCert(data []byte) (*x509.Certificate, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("证书格式错误")
+	}
+	return x509.ParseCertificate(block.Bytes)
+}
+
+//签名 signMethod=01: 待签名字符串SHA256摘要的十六进制字符串再进行SHA256withRSA签名
+func (c *certs) sign(params map[string]string) error {
+	params["certId"] = c.signCertID
+	delete(params, "signature")
+	signStr := createLinkString(params)
+	c.info.Log(utils.LogLevelTrace, "银联全渠道待签名字符串:%s", signStr)
+	digest := sha256.Sum256([]byte(signStr))
+	signer, err := rsautil.SignSHA256WithKey(c.signKey, []byte(hex.EncodeToString(digest[:])))
+	if err != nil {
+		c.info.Log(utils.LogLevelError, "银联全渠道签名失败:%s", err.Error())
+		return err
+	}
+	params["signature"] = base64.StdEncoding.EncodeToString(signer)
+	return nil
+}
+
+//验证签名,验签公钥取报文中的signPubKeyCert并校验证书链
+func (c *certs) verify(params map[string]string) bool {
+	signature, err := base64.StdEncoding.DecodeString(params["signature"])
+	if err != nil || len(signature) == 0 {
+		c.info.Log(utils.LogLevelError, "银联全渠道报文签名为空或格式错误")
+		return false
+	}
+	cert, err := c.verifyCert(params["signPubKeyCert"])
+	if err != nil {
+		c.info.Log(utils.LogLevelError, "银联全渠道验签证书校验失败:%s", err.Error())
+		return false
+	}
+	args := map[string]string{}
+	for k, v := range params {
+		if k != "signature" {
+			args[k] = v
+		}
+	}
+	digest := sha256.Sum256([]byte(createLinkString(args)))
+	ret, err := rsautil.VerifySHA256WithKey(cert.PublicKey.(*rsa.PublicKey), []byte(hex.EncodeToString(digest[:])), signature)
+	if err != nil {
+		c.info.Log(utils.LogLevelError, "银联全渠道验签失败:%s", err.Error())
+	}
+	return ret
+}
+
+//校验验签证书:证书链需由中级证书和根证书签发,且证书CN为银联的签名证书
+func (c *certs) verifyCert(certPEM string) (*x509.Certificate, error) {
+	if certPEM == "" {
+		return nil, errors.New("报文中验签证书为空")
+	}
+	c.lock.RLock()
+	cert, ok := c.verifyCache[certPEM]
+	c.lock.RUnlock()
+	if ok && c.info.Now().Before(cert.NotAfter) {
+		return cert, nil
+	}
+	cert, err := parseCert([]byte(certPEM))
+	if err != nil {
+		return nil, err
+	}
+	if _, ok = cert.PublicKey.(*rsa.PublicKey); !ok {
+		return nil, errors.New("验签证书公钥不是RSA公钥")
+	}
+	_, err = cert.Verify(x509.VerifyOptions{
+		Intermediates: c.middle,
+		Roots:         c.root,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	})
+	if err != nil {
+		return nil, errors.New("证书链验证失败:" + err.Error())
+	}
+	cnName := unionpayCNName
+	if c.testMode {
+		cnName = unionpayTestCNName
+	}
+	cn := strings.Split(cert.Subject.CommonName, "@")
+	if len(cn) < 3 || cn[2] != cnName {
+		return nil, errors.New("验签证书不是银联签名证书:" + cert.Subject.CommonName)
+	}
+	c.lock.Lock()
+	if len(c.verifyCache) >= verifyCacheSize {
+		c.verifyCache = map[string]*x509.Certificate{}
+	}
+	c.verifyCache[certPEM] = cert
+	c.lock.Unlock()
+	return cert, nil
+}
+
+//敏感信息加密,同时设置报文中的encryptCertId
+func (c *certs) encrypt(params map[string]string, data string) (string, error) {
+	if c.encryptKey == nil {
+		return "", errors.New("未配置敏感信息加密证书")
+	}
+	endata, err := rsa.EncryptPKCS1v15(rand.Reader, c.encryptKey, []byte(data))
+	if err != nil {
+		return "", errors.New("敏感信息加密失败:" + err.Error())
+	}
+	params["encryptCertId"] = c.encryptCertID
+	return base64.StdEncoding.EncodeToString(endata), nil
+}
+
+//拼接字符串 按照“参数=参数值”的模式用“&”字符拼接成字符串
+func createLinkString(params map[string]string) string {
+	keys := make([]string, 0, len(params))
+	for k := range params {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	buf := bytes.NewBufferString("")
+	for _, k := range keys {
+		buf.WriteString(k)
+		buf.WriteString("=")
+		buf.WriteString(params[k])
+		buf.WriteString("&")
+	}
+	if buf.Len() > 0 {
+		buf.Truncate(buf.Len() - 1)
+	}
+	return buf.String()
+}
+
+//解析应答报文,报文值中可能包含由{}或[]包裹的&和=
+func parseResponse(data string) map[string]string {
+	ret := map[string]string{}
+	var key string
+	depth := 0
+	isKey := true
+	buf := &bytes.Buffer{}
+	for _, ch := range data {
+		switch {
+		case isKey && ch == '=':
+			key = buf.String()
+			buf.Reset()
+			isKey = false
+		case !isKey && depth == 0 && ch == '&':
+			ret[key] = buf.String()
+			buf.Reset()
+			isKey = true
+		default:
+			if !isKey {
+				if ch == '{' || ch == '[' {
+					depth++
+				} else if (ch == '}' || ch == ']') && depth > 0 {
+					depth--
+				}
+			}
+			buf.WriteRune(ch)
+		}
+	}
+	if !isKey {
+		ret[key] = buf.String()
+	}
+	return ret
+}
+
+//后台请求
+func request(apiURL string, params map[string]string, c *certs) (map[string]string, error) {
+	err := c.sign(params)
+	if err != nil {
+		return nil, errors.New("签名失败")
+	}
+	args := url.Values{}
+	for k, v := range params {
+		args.Add(k, v)
+	}
+	c.info.Log(utils.LogLevelDebug, "银联全渠道接口请求参数:%s", args.Encode())
+	client := c.info.HTTPClient(&http.Client{
+		Timeout: 1 * time.Minute,
+	})
+	resp, err := client.Post(apiURL, "application/x-www-form-urlencoded;charset=utf-8", strings.NewReader(args.Encode()))
+	if err != nil {
+		c.info.Log(utils.LogLevelError, "银联全渠道接口请求异常:%s", err.Error())
+		return nil, payment.WrapRequestError(err, errors.New("银联全渠道接口请求失败:"+err.Error()))
+	}
+	data, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		c.info.Log(utils.LogLevelError, "银联全渠道接口结果读取异常:%s", err.Error())
+		return nil, &payment.RequestFailed{Err: errors.New("银联全渠道接口结果读取失败")}
+	}
+	c.info.Log(utils.LogLevelInfo, "银联全渠道接口请求结果:%s", data)
+	result := parseResponse(string(data))
+	if len(result) < 1 {
+		return nil, errors.New("银联全渠道接口结果解析失败")
+	} else if !c.verify(result) {
+		return result, errors.New("银联全渠道接口返回结果验签失败")
+	}
+	return result, nil
+}
+
+//生成自动提交的表单
+func buildForm(apiURL string, params map[string]string) string {
+	buf := bytes.NewBufferString("<form id=\"unionpaysubmit\" name=\"unionpaysubmit\" action=\"")
+	buf.WriteString(html.EscapeString(apiURL))
+	buf.WriteString("\" method=\"POST\">")
+	for k, v := range params {
+		buf.WriteString("<input type=\"hidden\" name=\"")
+		buf.WriteString(html.EscapeString(k))
+		buf.WriteString("\" value=\"")
+		buf.WriteString(html.EscapeString(v))
+		buf.WriteString("\"/>")
+	}
+	buf.WriteString("<input type=\"submit\" value=\"提交\" style=\"display:none;\"></form>")
+	buf.WriteString("<script>document.forms['unionpaysubmit'].submit();</script>")
+	return buf.String()
+}
@@ -0,0 +1,173 @@
+package unionpay
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/kinwyb/golang/payment"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+//生成测试证书
+func testCert(cn string, serial int64, parent *x509.Certificate, parentKey *rsa.PrivateKey, ca bool) (*x509.Certificate, *rsa.PrivateKey) {
+	key, _ := rsa.GenerateKey(rand.Reader, 1024)
+	tpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(serial),
+		Subject:               pkix.Name{CommonName: cn},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  ca,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+	}
+	if parent == nil {
+		parent, parentKey = tpl, key
+	}
+	der, _ := x509.CreateCertificate(rand.Reader, tpl, parent, &key.PublicKey, parentKey)
+	cert, _ := x509.ParseCertificate(der)
+	return cert, key
+}
+
+func Test_SignAndVerify(t *testing.T) {
+	convey.Convey("银联全渠道签名验签", t, func() {
+		root, rootKey := testCert("root", 1, nil, nil, true)
+		middle, middleKey := testCert("middle", 2, root, rootKey, true)
+		leaf, leafKey := testCert("041@Z12@00040000:SIGN@00000001", 3, middle, middleKey, false)
+		other, otherKey := testCert("041@Z12@OTHER@00000001", 4, middle, middleKey, false)
+		c := &certs{
+			signKey:     leafKey,
+			signCertID:  leaf.SerialNumber.String(),
+			middle:      x509.NewCertPool(),
+			root:        x509.NewCertPool(),
+			verifyCache: map[string]*x509.Certificate{},
+			testMode:    true,
+		}
+		c.middle.AddCert(middle)
+		c.root.AddCert(root)
+		leafPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: leaf.Raw}))
+		params := map[string]string{
+			"orderId":        "20171019101010123456",
+			"txnAmt":         "100",
+			"respCode":       "00",
+			"signPubKeyCert": leafPEM,
+		}
+		convey.So(c.sign(params), convey.ShouldBeNil)
+		convey.So(params["certId"], convey.ShouldEqual, "3")
+		convey.So(c.verify(params), convey.ShouldBeTrue)
+		convey.Convey("篡改金额验签失败", func() {
+			params["txnAmt"] = "1"
+			convey.So(c.verify(params), convey.ShouldBeFalse)
+		})
+		convey.Convey("非银联证书验签失败", func() {
+			c.signKey = otherKey
+			params["signPubKeyCert"] = string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: other.Raw}))
+			convey.So(c.sign(params), convey.ShouldBeNil)
+			convey.So(c.verify(params), convey.ShouldBeFalse)
+		})
+		convey.Convey("验签证书缓存数量有上限", func() {
+			for i := 0; i < verifyCacheSize+2; i++ {
+				cert, key := testCert("041@Z12@00040000:SIGN@00000001", int64(10+i), middle, middleKey, false)
+				c.signKey = key
+				params["signPubKeyCert"] = string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw}))
+				convey.So(c.sign(params), convey.ShouldBeNil)
+				convey.So(c.verify(params), convey.ShouldBeTrue)
+			}
+			convey.So(len(c.verifyCache), convey.ShouldBeLessThanOrEqualTo, verifyCacheSize)
+		})
+	})
+}
+
+func Test_buildForm(t *testing.T) {
+	convey.Convey("自动提交表单转义参数", t, func() {
+		form := buildForm("https://gateway.test.95516.com/gateway/api/frontTransReq.do", map[string]string{"reqReserved": `"/><script>alert(1)</script>`})
+		convey.So(form, convey.ShouldContainSubstring, `value="&#34;/&gt;&lt;script&gt;alert(1)&lt;/script&gt;"`)
+		convey.So(form, convey.ShouldNotContainSubstring, "<script>alert(1)")
+	})
+}
+
+func Test_parseResponse(t *testing.T) {
+	convey.Convey("应答报文解析", t, func() {
+		ret := parseResponse("respCode=00&reqReserved={a=1&b=2}&respMsg=成功[0000000]")
+		convey.So(ret["respCode"], convey.ShouldEqual, "00")
+		convey.So(ret["reqReserved"], convey.ShouldEqual, "{a=1&b=2}")
+		convey.So(ret["respMsg"], convey.ShouldEqual, "成功[0000000]")
+	})
+}
+
+func Test_codeNo(t *testing.T) {
+	convey.Convey("订单号编码", t, func() {
+		tm := time.Date(2017, 10, 19, 10, 10, 10, 0, time.Local)
+		orderID := encodeNo("123456", tm)
+		convey.So(orderID, convey.ShouldEqual, "20171019101010123456")
+		convey.So(decodeNo(orderID), convey.ShouldEqual, "123456")
+		txnTime, ok := decodeTxnTime(orderID)
+		convey.So(ok, convey.ShouldBeTrue)
+		convey.So(txnTime.Equal(tm), convey.ShouldBeTrue)
+		convey.So(decodeNo("123"), convey.ShouldEqual, "123")
+		convey.So(checkOrderID(orderID), convey.ShouldBeNil)
+		convey.So(checkOrderID(encodeNo("A-001", tm)), convey.ShouldNotBeNil)
+		convey.So(checkOrderID(encodeNo("123456789012345678901234567", tm)), convey.ShouldNotBeNil)
+		convey.So(checkOrderID("1234567"), convey.ShouldNotBeNil)
+	})
+}
+
+func Test_notify(t *testing.T) {
+	convey.Convey("消费与退货通知", t, func() {
+		root, rootKey := testCert("root", 1, nil, nil, true)
+		middle, middleKey := testCert("middle", 2, root, rootKey, true)
+		leaf, leafKey := testCert("041@Z12@00040000:SIGN@00000001", 3, middle, middleKey, false)
+		c := &certs{
+			signKey:     leafKey,
+			signCertID:  leaf.SerialNumber.String(),
+			middle:      x509.NewCertPool(),
+			root:        x509.NewCertPool(),
+			verifyCache: map[string]*x509.Certificate{},
+			testMode:    true,
+		}
+		c.middle.AddCert(middle)
+		c.root.AddCert(root)
+		u := &unionpay{config: &PayConfig{}, certs: c}
+		u.Init("unionpay", "银联全渠道", true)
+		notify := func(txnType string) map[string]string {
+			params := map[string]string{
+				"txnType":        txnType,
+				"orderId":        "20171019101010R001",
+				"reqReserved":    "R001",
+				"queryId":        "Q001",
+				"txnAmt":         "100",
+				"respCode":       "00",
+				"signPubKeyCert": string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: leaf.Raw})),
+			}
+			c.sign(params)
+			return params
+		}
+		ret := u.Notify(notify("04"))
+		convey.So(ret.Succ, convey.ShouldBeFalse)
+		convey.So(ret.ErrMsg, convey.ShouldEqual, "非消费交易通知:04")
+		convey.So(u.Notify(notify("01")).Succ, convey.ShouldBeTrue)
+		refund := u.RefundNotify(notify("31"))
+		convey.So(refund.Status, convey.ShouldEqual, payment.SUCCESS)
+		convey.So(refund.RefundNo, convey.ShouldEqual, "R001")
+		convey.So(refund.Money, convey.ShouldEqual, 1)
+		convey.So(u.RefundNotify(notify("01")).Status, convey.ShouldEqual, payment.UNKNOW)
+		params := notify("04")
+		params["txnAmt"] = "1"
+		convey.So(u.RefundNotify(params).FailCode, convey.ShouldEqual, "NOTIFY_VERIFY_FAIL")
+		query := u.Query("R001")
+		convey.So(query.Succ, convey.ShouldBeFalse)
+		convey.So(query.ErrMsg, convey.ShouldStartWith, "参数错误")
+		convey.So(payment.IsRequestNotSent(query.Err), convey.ShouldBeTrue)
+		query = u.Query("R001", time.Date(2017, 10, 19, 0, 0, 0, 0, time.Local))
+		convey.So(query.ErrMsg, convey.ShouldEqual, "参数错误:交易时间需要精确到秒,不能只指定交易日期")
+		convey.So(payment.IsRequestNotSent(query.Err), convey.ShouldBeTrue)
+		refund = u.Refund(&payment.RefundRequest{No: "P001", RefundNo: "R_001", Money: 1})
+		convey.So(refund.Status, convey.ShouldEqual, payment.FAIL)
+		convey.So(refund.FailCode, convey.ShouldEqual, "PARAMS_INVALID")
+	})
+}
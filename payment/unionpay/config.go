@@ -0,0 +1,41 @@
+package unionpay
+
+import (
+	"errors"
+
+	"github.com/kinwyb/golang/payment"
+	"github.com/kinwyb/golang/payment/validate"
+)
+
+//PayConfig 银联全渠道(ACP 5.1.0)支付配置信息
+//	支付请求参数PayRequest中： Ext  可空  结构为PayRequestExt
+type PayConfig struct {
+	payment.Config
+	MerID            string //商户号
+	SignCert         []byte //签名证书(PFX格式)
+	SignCertPassword string //签名证书密码
+	EncryptCert      []byte //敏感信息加密证书(PEM格式)
+	MiddleCert       []byte //验签中级证书(PEM格式)
+	RootCert         []byte //验签根证书(PEM格式)
+	FrontURL         string //前台通知地址(同步跳转地址)
+	BackURL          string //后台通知地址
+	TestMode         bool   //是否测试环境
+}
+
+//PayRequestExt 支付请求扩展信息
+type PayRequestExt struct {
+	AccNo       string `description:"支付卡号[可空,填写后收银台直接使用该卡号,使用敏感信息加密证书加密]"`
+	ChannelType string `description:"渠道类型 07:PC 08:手机,默认根据IsApp判断"`
+}
+
+//Validate 校验扩展信息
+func (p *PayRequestExt) Validate() error {
+	if p.ChannelType != "" && p.ChannelType != "07" && p.ChannelType != "08" {
+		return errors.New("扩展信息渠道类型[ChannelType]错误")
+	} else if p.AccNo != "" {
+		if _, err := validate.BankCard(p.AccNo); err != nil {
+			return errors.New("扩展信息支付卡号[AccNo]错误:" + err.Error())
+		}
+	}
+	return nil
+}
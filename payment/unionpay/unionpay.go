@@ -0,0 +1,379 @@
+package unionpay
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/kinwyb/golang/payment"
+	"github.com/kinwyb/golang/utils"
+)
+
+//银联全渠道(ACP 5.1.0)支付
+
+const txnTimeFormat = "20060102150405"
+
+type unionpay struct {
+	payment.PayInfo
+	config   *PayConfig
+	certs    *certs
+	frontURL string //前台交易请求地址
+	backURL  string //后台交易请求地址
+	appURL   string //APP交易请求地址
+	queryURL string //单笔查询请求地址
+}
+
+//支付,返回支付代码
+//	PC/WAP支付返回自动提交的表单,APP支付返回银联受理订单号(tn)
+func (u *unionpay) Pay(req *payment.PayRequest) (string, error) {
+	if !payment.IsCNY(req.Currency) {
+		return "", payment.ErrCurrencyNotSupport
+	}
+	ext := &PayRequestExt{}
+	err := req.DecodeExt(ext)
+	if err != nil {
+		return "", err
+	} else if ext.ChannelType == "" {
+		if req.IsApp {
+			ext.ChannelType = "08"
+		} else {
+			ext.ChannelType = "07"
+		}
+	}
+	t := u.Now()
+	orderID := encodeNo(req.No, t)
+	if err = checkOrderID(orderID); err != nil {
+		return "", err
+	}
+	params := u.baseParams("01", "01", t)
+	params["channelType"] = ext.ChannelType
+	params["orderId"] = orderID
+	params["txnAmt"] = strconv.FormatInt(int64(req.Money*100+0.5), 10)
+	params["currencyCode"] = "156"
+	params["reqReserved"] = req.No
+	params["backUrl"] = u.config.BackURL
+	if ext.AccNo != "" {
+		params["accNo"], err = u.certs.encrypt(params, ext.AccNo)
+		if err != nil {
+			return "", err
+		}
+	}
+	if req.IsApp {
+		result, err := request(u.appURL, params, u.certs)
+		if err != nil {
+			return "", err
+		} else if result["respCode"] != "00" {
+			return "", errors.New("银联全渠道支付请求失败:[" + result["respCode"] + "]" + result["respMsg"])
+		}
+		return result["tn"], nil
+	}
+	if u.config.FrontURL != "" {
+		params["frontUrl"] = u.config.FrontURL
+	}
+	err = u.certs.sign(params)
+	if err != nil {
+		return "", errors.New("签名失败")
+	}
+	return buildForm(u.frontURL, params), nil
+}
+
+//异步结果通知处理,返回支付结果
+//	退货/撤销与消费使用相同的后台通知地址,非消费交易(txnType不为01)的通知返回失败,由RefundNotify处理
+func (u *unionpay) Notify(params map[string]string) *payment.PayResult {
+	delete(params, "request_post_body")
+	result := &payment.PayResult{
+		PayCode:      u.Code(),
+		Navite:       params,
+		TradeNo:      params["orderId"],     //商户订单号
+		No:           params["reqReserved"], //原始订单号
+		ThirdTradeNo: params["queryId"],     //银联交易流水号
+		ThirdAccount: params["accNo"],       //支付卡号(脱敏)
+	}
+	if result.No == "" {
+		result.No = decodeNo(params["orderId"])
+	}
+	if !u.certs.verify(params) {
+		result.Succ = false
+		result.ErrMsg = "银联全渠道回调数据验证失败"
+		return result
+	} else if params["txnType"] != "01" {
+		result.Succ = false
+		result.ErrMsg = "非消费交易通知:" + params["txnType"]
+		return result
+	}
+	money, err := strconv.ParseFloat(params["txnAmt"], 64)
+	if err != nil {
+		result.Succ = false
+		result.ErrMsg = "交易金额异常"
+		return result
+	}
+	result.Money = money / 100
+	if params["respCode"] == "00" || params["respCode"] == "A6" {
+		result.Succ = true
+	} else {
+		result.Succ = false
+		result.ErrMsg = "[" + params["respCode"] + "]" + params["respMsg"]
+	}
+	return u.FillPayFee(result)
+}
+
+//异步通知处理结果返回内容
+func (u *unionpay) NotifyResult(payResult *payment.PayResult) string {
+	if payResult.Succ {
+		return "ok"
+	}
+	return "fail"
+}
+
+//同步结果跳转处理,返回支付结果
+func (u *unionpay) Result(params map[string]string) *payment.PayResult {
+	return u.Notify(params)
+}
+
+//RefundNotify 退货/撤销异步通知处理,验签失败或非退货/撤销通知返回UNKNOW状态
+//	通知中没有原交易单号,No为空,RefundNo为退款单号
+func (u *unionpay) RefundNotify(params map[string]string) *payment.RefundResult {
+	delete(params, "request_post_body")
+	ret := &payment.RefundResult{
+		Status:       payment.UNKNOW,
+		RefundNo:     params["reqReserved"],
+		ThirdTradeNo: params["queryId"],
+		PayCode:      u.Code(),
+		Navite:       params,
+	}
+	if ret.RefundNo == "" {
+		ret.RefundNo = decodeNo(params["orderId"])
+	}
+	if !u.certs.verify(params) {
+		ret.FailCode = "NOTIFY_VERIFY_FAIL"
+		ret.FailMsg = "银联全渠道回调数据验证失败"
+		return ret
+	} else if params["txnType"] != "04" && params["txnType"] != "31" {
+		ret.FailMsg = "非退货/撤销交易通知:" + params["txnType"]
+		return ret
+	}
+	money, _ := strconv.ParseFloat(params["txnAmt"], 64)
+	ret.Money = money / 100
+	if params["respCode"] == "00" || params["respCode"] == "A6" {
+		ret.Status = payment.SUCCESS
+	} else {
+		ret.Status = payment.FAIL
+		ret.FailCode = params["respCode"]
+		ret.FailMsg = params["respMsg"]
+	}
+	return ret
+}
+
+//Query 根据交易单号(银联orderId)查询支付结果
+//	银联查询需要原交易的txnTime,交易单号中没有交易时间时必须传入精确到秒的原交易时间tradeDate
+//	tradeDate为空或只有日期(00:00:00)时不发送请求,返回Err为RequestNotSent的结果
+func (u *unionpay) Query(tradeno string, tradeDate ...time.Time) *payment.PayResult {
+	ret := &payment.PayResult{
+		PayCode: u.Code(),
+		TradeNo: tradeno,
+		No:      decodeNo(tradeno),
+	}
+	t, ok := decodeTxnTime(tradeno)
+	if !ok && len(tradeDate) > 0 {
+		t = tradeDate[0]
+	}
+	if t.IsZero() {
+		ret.Err = &payment.RequestNotSent{Err: errors.New("参数错误:交易单号中没有交易时间,需要指定交易时间")}
+		ret.ErrMsg = ret.Err.Error()
+		return ret
+	} else if !ok && t.Equal(time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())) {
+		ret.Err = &payment.RequestNotSent{Err: errors.New("参数错误:交易时间需要精确到秒,不能只指定交易日期")}
+		ret.ErrMsg = ret.Err.Error()
+		return ret
+	}
+	params := u.baseParams("00", "00", u.Now())
+	params["orderId"] = tradeno
+	params["txnTime"] = t.Format(txnTimeFormat)
+	result, err := request(u.queryURL, params, u.certs)
+	if err != nil {
+		ret.ErrMsg = err.Error()
+		return ret
+	}
+	ret.Navite = result
+	if result["respCode"] != "00" {
+		ret.ErrMsg = "[" + result["respCode"] + "]" + result["respMsg"]
+		return ret
+	}
+	ret.ThirdTradeNo = result["queryId"]
+	ret.ThirdAccount = result["accNo"]
+	money, _ := strconv.ParseFloat(result["txnAmt"], 64)
+	ret.Money = money / 100
+	if result["origRespCode"] == "00" || result["origRespCode"] == "A6" {
+		ret.Succ = true
+	} else {
+		ret.ErrMsg = "[" + result["origRespCode"] + "]" + result["origRespMsg"]
+	}
+	return u.FillPayFee(ret)
+}
+
+//Refund 退货,退款结果以后台通知为准,受理成功返回处理中
+func (u *unionpay) Refund(req *payment.RefundRequest) *payment.RefundResult {
+	return u.back("04", req)
+}
+
+//Revoke 消费撤销,仅限当日交易,撤销结果以后台通知为准,受理成功返回处理中
+func (u *unionpay) Revoke(req *payment.RefundRequest) *payment.RefundResult {
+	return u.back("31", req)
+}
+
+//后台退货/撤销类交易
+func (u *unionpay) back(txnType string, req *payment.RefundRequest) *payment.RefundResult {
+	t := u.Now()
+	ret := &payment.RefundResult{
+		Status:   payment.FAIL,
+		No:       req.No,
+		RefundNo: req.RefundNo,
+		Money:    req.Money,
+		PayCode:  u.Code(),
+	}
+	orderID := encodeNo(req.RefundNo, t)
+	if err := checkOrderID(orderID); err != nil {
+		ret.FailCode = "PARAMS_INVALID"
+		ret.FailMsg = err.Error()
+		return ret
+	}
+	params := u.baseParams(txnType, "00", t)
+	params["channelType"] = "07"
+	params["orderId"] = orderID
+	params["origQryId"] = req.ThirdTradeNo
+	params["txnAmt"] = strconv.FormatInt(int64(req.Money*100+0.5), 10)
+	params["reqReserved"] = req.RefundNo
+	params["backUrl"] = u.config.BackURL
+	result, err := request(u.backURL, params, u.certs)
+	if err != nil {
+		if result == nil { //请求未完成,状态未知
+			ret.Status = payment.UNKNOW
+		}
+		ret.FailCode = "REQUEST_FAIL"
+		ret.FailMsg = err.Error()
+		return ret
+	}
+	ret.Navite = result
+	ret.ThirdTradeNo = result["queryId"]
+	switch result["respCode"] {
+	case "00", "03", "04", "05": //受理成功或处理中
+		ret.Status = payment.DEALING
+	default:
+		ret.FailCode = result["respCode"]
+		ret.FailMsg = result["respMsg"]
+	}
+	return ret
+}
+
+//公共请求参数
+func (u *unionpay) baseParams(txnType, txnSubType string, t time.Time) map[string]string {
+	return map[string]string{
+		"version":     "5.1.0",
+		"encoding":    "UTF-8",
+		"signMethod":  "01",
+		"txnType":     txnType,
+		"txnSubType":  txnSubType,
+		"bizType":     "000201",
+		"channelType": "07",
+		"accessType":  "0",
+		"merId":       u.config.MerID,
+		"txnTime":     t.Format(txnTimeFormat),
+	}
+}
+
+//GetPayment 生成一个支付对象
+func (u *unionpay) GetPayment(cfg interface{}) payment.Payment {
+	var c *PayConfig
+	ok := false
+	if c, ok = cfg.(*PayConfig); !ok || c == nil {
+		log(utils.LogLevelWarn, "传递的配置信息不是一个有效的银联全渠道配置")
+		return nil
+	}
+	if c.Name == "" || c.Code == "" {
+		return nil
+	}
+	crts, err := loadCerts(c)
+	if err != nil {
+		log(utils.LogLevelError, "银联全渠道证书初始化失败:%s", err.Error())
+		return nil
+	}
+	gateway := "https://gateway.95516.com"
+	if c.TestMode {
+		gateway = "https://gateway.test.95516.com"
+	}
+	obj := &unionpay{
+		config:   c,
+		certs:    crts,
+		frontURL: gateway + "/gateway/api/frontTransReq.do",
+		backURL:  gateway + "/gateway/api/backTransReq.do",
+		appURL:   gateway + "/gateway/api/appTransReq.do",
+		queryURL: gateway + "/gateway/api/queryTrans.do",
+	}
+	obj.Init(obj.config.Code, obj.config.Name, obj.config.State)
+	obj.SetFee(obj.config.Fee)
+	obj.SetOptions(obj.config.Options, &lg)
+	obj.certs.info = &obj.PayInfo
+	return obj
+}
+
+//Driver 驱动编码
+func (u *unionpay) Driver() string {
+	return "unionpay"
+}
+
+//无需确认支付
+func (u *unionpay) PayConfirm(req *payment.PayConfirmRequest) *payment.PayResult {
+	return payment.NoPayConfirmResult
+}
+
+//编码订单号,银联查询需要原交易的txnTime,所以将交易时间拼接在订单号前
+func encodeNo(no string, t time.Time) string {
+	return t.Format(txnTimeFormat) + no
+}
+
+//校验银联订单号,orderId为8-40位字母或数字
+func checkOrderID(orderID string) error {
+	if len(orderID) < 8 || len(orderID) > 40 {
+		return errors.New("银联订单号[orderId]长度需为8-40位:" + orderID)
+	}
+	for _, c := range orderID {
+		if (c < '0' || c > '9') && (c < 'a' || c > 'z') && (c < 'A' || c > 'Z') {
+			return errors.New("银联订单号[orderId]只能包含字母和数字:" + orderID)
+		}
+	}
+	return nil
+}
+
+//解码订单号
+func decodeNo(orderID string) string {
+	if _, ok := decodeTxnTime(orderID); ok {
+		return orderID[len(txnTimeFormat):]
+	}
+	return orderID
+}
+
+//从订单号中解析交易时间
+func decodeTxnTime(orderID string) (time.Time, bool) {
+	if len(orderID) <= len(txnTimeFormat) {
+		return time.Time{}, false
+	}
+	t, err := time.ParseInLocation(txnTimeFormat, orderID[:len(txnTimeFormat)], time.Local)
+	return t, err == nil
+}
+
+//NewExt 支付请求扩展信息
+func (u *unionpay) NewExt() payment.PayExt {
+	return &PayRequestExt{}
+}
+
+//Capabilities 支付能力,撤销通过Revoke调用,Ext结构为PayRequestExt
+func (u *unionpay) Capabilities() *payment.Capabilities {
+	return &payment.Capabilities{
+		Operations: []string{payment.OpPay, payment.OpNotify, payment.OpResult},
+		TradeTypes: []string{payment.TradeWeb, payment.TradeApp},
+		Required:   []string{"No", "Money"},
+		ExtFormat:  payment.ExtJSON,
+		Ext:        payment.ExtFields(&PayRequestExt{}),
+		MaxTradeNo: 40 - len(txnTimeFormat), //orderId最长40位,前面会增加交易时间
+	}
+}
@@ -0,0 +1,128 @@
+package validate
+
+import (
+	"errors"
+	"sort"
+	"sync"
+)
+
+//卡类型,与router.DebitCard/router.CreditCard一致
+const (
+	DebitCard  = "DEBIT"  //借记卡
+	CreditCard = "CREDIT" //信用卡
+)
+
+var (
+	//ErrCardFormat 银行卡号格式错误
+	ErrCardFormat = errors.New("银行卡号必须是12到19位数字")
+	//ErrCardChecksum 银行卡号校验位错误
+	ErrCardChecksum = errors.New("银行卡号校验失败")
+	//ErrCardType 银行卡类型不符
+	ErrCardType = errors.New("银行卡类型不符")
+)
+
+//CardBIN 发卡行识别码
+type CardBIN struct {
+	BIN      string //卡号前缀
+	Bank     string //发卡行名称
+	BankCode string //发卡行编码
+	CardType string //卡类型 DEBIT:借记卡 CREDIT:信用卡
+	Length   int    //卡号长度,0不限制
+}
+
+var binLock sync.RWMutex
+
+//按BIN长度倒序,优先匹配最长前缀
+var bins = []CardBIN{
+	{BIN: "622202", Bank: "中国工商银行", BankCode: "ICBC", CardType: DebitCard, Length: 19},
+	{BIN: "621226", Bank: "中国工商银行", BankCode: "ICBC", CardType: DebitCard, Length: 19},
+	{BIN: "622848", Bank: "中国农业银行", BankCode: "ABC", CardType: DebitCard, Length: 19},
+	{BIN: "621700", Bank: "中国建设银行", BankCode: "CCB", CardType: DebitCard, Length: 19},
+	{BIN: "622700", Bank: "中国建设银行", BankCode: "CCB", CardType: DebitCard, Length: 19},
+	{BIN: "436742", Bank: "中国建设银行", BankCode: "CCB", CardType: DebitCard, Length: 19},
+	{BIN: "621661", Bank: "中国银行", BankCode: "BOC", CardType: DebitCard, Length: 19},
+	{BIN: "622260", Bank: "交通银行", BankCode: "COMM", CardType: DebitCard, Length: 19},
+	{BIN: "622588", Bank: "招商银行", BankCode: "CMB", CardType: DebitCard, Length: 16},
+	{BIN: "622575", Bank: "招商银行", BankCode: "CMB", CardType: CreditCard, Length: 16},
+}
+
+//RegisterBIN 注册发卡行识别码,相同BIN覆盖原有数据
+func RegisterBIN(items ...CardBIN) {
+	binLock.Lock()
+	defer binLock.Unlock()
+	for _, item := range items {
+		replaced := false
+		for i, v := range bins {
+			if v.BIN == item.BIN {
+				bins[i] = item
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			bins = append(bins, item)
+		}
+	}
+	sort.SliceStable(bins, func(i, j int) bool {
+		return len(bins[i].BIN) > len(bins[j].BIN)
+	})
+}
+
+//LookupBIN 查询卡号所属发卡行,未知返回nil
+func LookupBIN(cardNo string) *CardBIN {
+	binLock.RLock()
+	defer binLock.RUnlock()
+	for _, v := range bins {
+		if len(cardNo) >= len(v.BIN) && cardNo[:len(v.BIN)] == v.BIN {
+			ret := v
+			return &ret
+		}
+	}
+	return nil
+}
+
+//Luhn 卡号Luhn校验
+func Luhn(cardNo string) bool {
+	if !isDigits(cardNo) {
+		return false
+	}
+	sum := 0
+	double := false
+	for i := len(cardNo) - 1; i >= 0; i-- {
+		d := int(cardNo[i] - '0')
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+	return sum%10 == 0
+}
+
+//BankCard 校验银行卡号,返回发卡行信息(BIN表中不存在时为nil)
+func BankCard(cardNo string) (*CardBIN, error) {
+	if len(cardNo) < 12 || len(cardNo) > 19 || !isDigits(cardNo) {
+		return nil, ErrCardFormat
+	} else if !Luhn(cardNo) {
+		return nil, ErrCardChecksum
+	}
+	bin := LookupBIN(cardNo)
+	if bin != nil && bin.Length > 0 && bin.Length != len(cardNo) {
+		return nil, ErrCardFormat
+	}
+	return bin, nil
+}
+
+//BankCardType 校验银行卡号及卡类型,BIN表中不存在的卡号不校验类型
+func BankCardType(cardNo, cardType string) (*CardBIN, error) {
+	bin, err := BankCard(cardNo)
+	if err != nil {
+		return nil, err
+	} else if bin != nil && cardType != "" && bin.CardType != cardType {
+		return bin, ErrCardType
+	}
+	return bin, nil
+}
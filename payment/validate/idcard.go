@@ -0,0 +1,69 @@
+package validate
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+var (
+	//ErrCertIDFormat 身份证号格式错误
+	ErrCertIDFormat = errors.New("身份证号必须是18位")
+	//ErrCertIDRegion 身份证号地区码错误
+	ErrCertIDRegion = errors.New("身份证号地区码错误")
+	//ErrCertIDBirthday 身份证号出生日期错误
+	ErrCertIDBirthday = errors.New("身份证号出生日期错误")
+	//ErrCertIDChecksum 身份证号校验位错误
+	ErrCertIDChecksum = errors.New("身份证号校验失败")
+)
+
+//省级行政区划代码
+var provinces = map[string]string{
+	"11": "北京", "12": "天津", "13": "河北", "14": "山西", "15": "内蒙古",
+	"21": "辽宁", "22": "吉林", "23": "黑龙江",
+	"31": "上海", "32": "江苏", "33": "浙江", "34": "安徽", "35": "福建", "36": "江西", "37": "山东",
+	"41": "河南", "42": "湖北", "43": "湖南", "44": "广东", "45": "广西", "46": "海南",
+	"50": "重庆", "51": "四川", "52": "贵州", "53": "云南", "54": "西藏",
+	"61": "陕西", "62": "甘肃", "63": "青海", "64": "宁夏", "65": "新疆",
+	"71": "台湾", "81": "香港", "82": "澳门",
+}
+
+//校验码权重
+var certIDWeights = []int{7, 9, 10, 5, 8, 4, 2, 1, 6, 3, 7, 9, 10, 5, 8, 4, 2}
+
+const certIDCheckCodes = "10X98765432"
+
+//CertIDInfo 身份证号信息
+type CertIDInfo struct {
+	Province string    //省份
+	Birthday time.Time //出生日期
+	Male     bool      //是否男性
+}
+
+//CertID 校验18位居民身份证号,包括地区码、出生日期和校验位
+func CertID(id string) (*CertIDInfo, error) {
+	id = strings.ToUpper(id)
+	if len(id) != 18 || !isDigits(id[:17]) || (id[17] != 'X' && (id[17] < '0' || id[17] > '9')) {
+		return nil, ErrCertIDFormat
+	}
+	province, ok := provinces[id[:2]]
+	if !ok || id[2:6] == "0000" {
+		return nil, ErrCertIDRegion
+	}
+	birthday, err := time.ParseInLocation("20060102", id[6:14], time.Local)
+	if err != nil || birthday.Year() < 1900 || birthday.After(time.Now()) {
+		return nil, ErrCertIDBirthday
+	}
+	sum := 0
+	for i, w := range certIDWeights {
+		sum += int(id[i]-'0') * w
+	}
+	if certIDCheckCodes[sum%11] != id[17] {
+		return nil, ErrCertIDChecksum
+	}
+	return &CertIDInfo{
+		Province: province,
+		Birthday: birthday,
+		Male:     int(id[16]-'0')%2 == 1,
+	}, nil
+}
@@ -0,0 +1,21 @@
+package validate
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+)
+
+//ErrMobile 手机号错误
+var ErrMobile = errors.New("手机号必须是11位大陆手机号")
+
+var regExpMobile = regexp.MustCompile(`^1[3-9]\d{9}$`)
+
+//Mobile 校验大陆手机号,允许+86/86前缀
+func Mobile(mobile string) error {
+	mobile = strings.TrimPrefix(strings.TrimPrefix(mobile, "+"), "86")
+	if len(mobile) != 11 || !regExpMobile.MatchString(mobile) {
+		return ErrMobile
+	}
+	return nil
+}
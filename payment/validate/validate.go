@@ -0,0 +1,84 @@
+package validate
+
+import (
+	"strings"
+
+	"github.com/kinwyb/golang/payment"
+)
+
+//银行卡、身份证号、手机号校验,在提现/支付请求发送到渠道前使用,减少因数据错误导致的失败和手续费
+
+//FieldError 字段校验错误
+type FieldError struct {
+	Field string //字段名称
+	Msg   string //错误描述
+}
+
+func (f *FieldError) Error() string {
+	return f.Field + ":" + f.Msg
+}
+
+//Errors 多个字段校验错误
+type Errors []*FieldError
+
+func (e Errors) Error() string {
+	msgs := make([]string, len(e))
+	for i, v := range e {
+		msgs[i] = v.Error()
+	}
+	return strings.Join(msgs, ";")
+}
+
+//Field 获取字段的校验错误,没有错误返回nil
+func (e Errors) Field(field string) *FieldError {
+	for _, v := range e {
+		if v.Field == field {
+			return v
+		}
+	}
+	return nil
+}
+
+//Add 添加字段错误,err为nil时忽略
+func (e *Errors) Add(field string, err error) {
+	if err != nil {
+		*e = append(*e, &FieldError{Field: field, Msg: err.Error()})
+	}
+}
+
+//Err 校验结果,没有错误时返回nil
+func (e Errors) Err() error {
+	if len(e) < 1 {
+		return nil
+	}
+	return e
+}
+
+//是否为纯数字
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+//BankWithdraw 校验银行卡提现信息
+//	个人账户校验收款银行卡号,身份证号不为空时校验身份证号;企业账户不校验
+func BankWithdraw(info *payment.WithdrawInfo) error {
+	if !info.People {
+		return nil
+	}
+	var errs Errors
+	_, err := BankCard(info.CardNo)
+	errs.Add("CardNo", err)
+	if info.CertID != "" {
+		_, err = CertID(info.CertID)
+		errs.Add("CertID", err)
+	}
+	return errs.Err()
+}
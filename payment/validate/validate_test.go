@@ -0,0 +1,70 @@
+package validate
+
+import (
+	"testing"
+
+	"github.com/kinwyb/golang/payment"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func Test_BankCard(t *testing.T) {
+	convey.Convey("银行卡校验", t, func() {
+		convey.So(Luhn("4111111111111111"), convey.ShouldBeTrue)
+		convey.So(Luhn("4111111111111112"), convey.ShouldBeFalse)
+		bin, err := BankCard("6222021234567890128")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(bin.BankCode, convey.ShouldEqual, "ICBC")
+		convey.So(bin.CardType, convey.ShouldEqual, DebitCard)
+		_, err = BankCard("6222021234567890127")
+		convey.So(err, convey.ShouldEqual, ErrCardChecksum)
+		_, err = BankCard("62220212345a")
+		convey.So(err, convey.ShouldEqual, ErrCardFormat)
+		bin, err = BankCard("4111111111111111")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(bin, convey.ShouldBeNil)
+		_, err = BankCardType("6225751234567893", DebitCard)
+		convey.So(err, convey.ShouldEqual, ErrCardType)
+		RegisterBIN(CardBIN{BIN: "41111111", Bank: "测试银行", CardType: CreditCard})
+		bin, err = BankCardType("4111111111111111", CreditCard)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(bin.Bank, convey.ShouldEqual, "测试银行")
+	})
+}
+
+func Test_CertID(t *testing.T) {
+	convey.Convey("身份证号校验", t, func() {
+		info, err := CertID("11010519491231002x")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(info.Province, convey.ShouldEqual, "北京")
+		convey.So(info.Birthday.Format("2006-01-02"), convey.ShouldEqual, "1949-12-31")
+		convey.So(info.Male, convey.ShouldBeFalse)
+		info, err = CertID("440307199001011232")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(info.Male, convey.ShouldBeTrue)
+		_, err = CertID("440307199001011233")
+		convey.So(err, convey.ShouldEqual, ErrCertIDChecksum)
+		_, err = CertID("990307199001011232")
+		convey.So(err, convey.ShouldEqual, ErrCertIDRegion)
+		_, err = CertID("440307199002301232")
+		convey.So(err, convey.ShouldEqual, ErrCertIDBirthday)
+		_, err = CertID("44030719900101123")
+		convey.So(err, convey.ShouldEqual, ErrCertIDFormat)
+	})
+	convey.Convey("手机号校验", t, func() {
+		convey.So(Mobile("13800138000"), convey.ShouldBeNil)
+		convey.So(Mobile("+8613800138000"), convey.ShouldBeNil)
+		convey.So(Mobile("12800138000"), convey.ShouldEqual, ErrMobile)
+		convey.So(Mobile("1380013800"), convey.ShouldEqual, ErrMobile)
+	})
+	convey.Convey("提现信息校验", t, func() {
+		info := &payment.WithdrawInfo{CardNo: "6222021234567890127", CertID: "440307199001011233", People: true}
+		err := BankWithdraw(info)
+		convey.So(err, convey.ShouldNotBeNil)
+		errs := err.(Errors)
+		convey.So(len(errs), convey.ShouldEqual, 2)
+		convey.So(errs.Field("CardNo").Msg, convey.ShouldEqual, ErrCardChecksum.Error())
+		convey.So(errs.Field("CertID"), convey.ShouldNotBeNil)
+		info.People = false
+		convey.So(BankWithdraw(info), convey.ShouldBeNil)
+	})
+}
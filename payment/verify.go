@@ -0,0 +1,60 @@
+package payment
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+//NotifyOrder 异步通知中解析出的订单信息,用于业务校验
+type NotifyOrder struct {
+	PayCode      string            //交易方式编码
+	No           string            //订单号
+	TradeNo      string            //交易单号
+	ThirdTradeNo string            //第三方交易流水号
+	Money        float64           //通知中的交易金额
+	AppID        string            //通知中的应用ID(支付宝app_id,微信appid)
+	MerchantID   string            //通知中的收款商户(支付宝seller_id,微信mch_id)
+	Navite       map[string]string //原始数据
+}
+
+//OrderVerifier 订单业务校验,支付方式在签名验证通过后调用
+//	返回错误时支付结果为失败,错误信息写入PayResult.ErrMsg
+//	应用需确认订单存在、金额一致、收款商户为自己
+type OrderVerifier interface {
+	VerifyOrder(order *NotifyOrder) error
+}
+
+//OrderVerifierFunc 函数形式的订单业务校验
+type OrderVerifierFunc func(order *NotifyOrder) error
+
+//VerifyOrder 订单业务校验
+func (f OrderVerifierFunc) VerifyOrder(order *NotifyOrder) error {
+	return f(order)
+}
+
+//VerifyMoney 校验通知金额与订单金额是否一致,按分比较
+func (o *NotifyOrder) VerifyMoney(money float64) error {
+	if math.Round(o.Money*100) != math.Round(money*100) {
+		return fmt.Errorf("交易金额不一致:通知金额%.2f,订单金额%.2f", o.Money, money)
+	}
+	return nil
+}
+
+//VerifyMerchant 校验通知中的收款商户,参数为空时不校验对应项
+func (o *NotifyOrder) VerifyMerchant(appID, merchantID string) error {
+	if appID != "" && o.AppID != appID {
+		return errors.New("应用ID不一致:" + o.AppID)
+	} else if merchantID != "" && o.MerchantID != merchantID {
+		return errors.New("收款商户不一致:" + o.MerchantID)
+	}
+	return nil
+}
+
+//VerifyNotifyOrder 调用订单业务校验,verifier为空时不校验
+func VerifyNotifyOrder(verifier OrderVerifier, order *NotifyOrder) error {
+	if verifier == nil {
+		return nil
+	}
+	return verifier.VerifyOrder(order)
+}
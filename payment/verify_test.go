@@ -0,0 +1,35 @@
+package payment
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func Test_NotifyOrder(t *testing.T) {
+	convey.Convey("异步通知订单校验", t, func() {
+		order := &NotifyOrder{
+			No:         "201710190001",
+			Money:      0.29,
+			AppID:      "app",
+			MerchantID: "mch",
+		}
+		convey.So(order.VerifyMoney(0.29), convey.ShouldBeNil)
+		convey.So(order.VerifyMoney(0.3), convey.ShouldNotBeNil)
+		convey.So(order.VerifyMerchant("app", "mch"), convey.ShouldBeNil)
+		convey.So(order.VerifyMerchant("", ""), convey.ShouldBeNil)
+		convey.So(order.VerifyMerchant("other", ""), convey.ShouldNotBeNil)
+		convey.So(order.VerifyMerchant("", "other"), convey.ShouldNotBeNil)
+		convey.So(VerifyNotifyOrder(nil, order), convey.ShouldBeNil)
+		verifier := OrderVerifierFunc(func(o *NotifyOrder) error {
+			if o.No != "201710190001" {
+				return errors.New("订单不存在")
+			}
+			return o.VerifyMoney(0.29)
+		})
+		convey.So(VerifyNotifyOrder(verifier, order), convey.ShouldBeNil)
+		order.Money = 0.01
+		convey.So(VerifyNotifyOrder(verifier, order), convey.ShouldNotBeNil)
+	})
+}
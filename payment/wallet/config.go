@@ -0,0 +1,79 @@
+package wallet
+
+import (
+	"strings"
+
+	"github.com/kinwyb/golang/gosql"
+	"github.com/kinwyb/golang/payment"
+)
+
+//Config 余额支付/提现配置信息
+//	支付请求PayRequest中：MemberID 必填 付款账户
+//						Ext      可空 结构为PayRequestExt
+//	提现请求WithdrawInfo中：CardNo 必填 收款账户
+type Config struct {
+	payment.Config
+	DB           gosql.SQL //数据库
+	AccountTable string    //账户表名称,默认:wallet_account
+	FlowTable    string    //资金流水表名称,默认:wallet_flow
+}
+
+//PayRequestExt 支付请求扩展信息
+type PayRequestExt struct {
+	Hold bool `description:"是否只冻结金额,冻结后需要调用PayConfirm完成扣款"`
+}
+
+//Validate 校验扩展信息
+func (p *PayRequestExt) Validate() error {
+	return nil
+}
+
+//Schema 数据表结构(MySQL),{account}和{flow}替换为配置中的表名
+const Schema = `CREATE TABLE IF NOT EXISTS {account} (
+  member_id VARCHAR(64) NOT NULL COMMENT '账户',
+  balance DECIMAL(18,2) NOT NULL DEFAULT 0 COMMENT '可用余额',
+  frozen DECIMAL(18,2) NOT NULL DEFAULT 0 COMMENT '冻结金额',
+  created DATETIME NOT NULL COMMENT '创建时间',
+  updated DATETIME NOT NULL COMMENT '更新时间',
+  PRIMARY KEY (member_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='余额账户';
+CREATE TABLE IF NOT EXISTS {flow} (
+  id BIGINT NOT NULL AUTO_INCREMENT,
+  trade_no VARCHAR(64) NOT NULL COMMENT '交易单号',
+  orig_no VARCHAR(64) NOT NULL DEFAULT '' COMMENT '原交易单号[退款时]',
+  member_id VARCHAR(64) NOT NULL COMMENT '账户',
+  type VARCHAR(16) NOT NULL COMMENT '类型 PAY:支付 REFUND:退款 WITHDRAW:转入',
+  amount DECIMAL(18,2) NOT NULL COMMENT '金额',
+  status VARCHAR(16) NOT NULL COMMENT '状态 SUCCESS:成功 DEALING:冻结中 FAIL:失败',
+  code VARCHAR(32) NOT NULL COMMENT '支付/提现方式编码',
+  remark VARCHAR(255) NOT NULL DEFAULT '' COMMENT '描述',
+  created DATETIME NOT NULL COMMENT '创建时间',
+  updated DATETIME NOT NULL COMMENT '更新时间',
+  PRIMARY KEY (id),
+  UNIQUE KEY uk_trade_type (trade_no, type)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='余额资金流水';`
+
+//CreateTable 创建数据表
+func CreateTable(c *Config) gosql.Error {
+	c.defaults()
+	sqls := strings.Replace(strings.Replace(Schema, "{account}", c.AccountTable, -1), "{flow}", c.FlowTable, -1)
+	for _, s := range strings.Split(sqls, ";") {
+		if strings.TrimSpace(s) == "" {
+			continue
+		}
+		if _, err := c.DB.Exec(s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//默认值
+func (c *Config) defaults() {
+	if c.AccountTable == "" {
+		c.AccountTable = "wallet_account"
+	}
+	if c.FlowTable == "" {
+		c.FlowTable = "wallet_flow"
+	}
+}
@@ -0,0 +1,41 @@
+package wallet
+
+import (
+	"github.com/kinwyb/golang/payment"
+
+	"github.com/kinwyb/golang/utils"
+)
+
+var lg utils.Logger
+
+//Driver 余额支付驱动
+func Driver(fun payment.RegDriverFun, logger utils.Logger) {
+	lg = logger
+	err := fun(&wallet{})
+	if err != nil {
+		log(utils.LogLevelError, "余额支付驱动注入......[失败]:%s", err.Error())
+	} else {
+		log(utils.LogLevelInfo, "余额支付驱动注入......[成功]")
+	}
+}
+
+//WithdrawDriver 余额提现(转入余额)驱动
+func WithdrawDriver(fun payment.RegWithdrawDriverFun, logger utils.Logger) {
+	lg = logger
+	err := fun(&withdraw{})
+	if err != nil {
+		log(utils.LogLevelError, "余额提现驱动注入......[失败]:%s", err.Error())
+	} else {
+		log(utils.LogLevelInfo, "余额提现驱动注入......[成功]")
+	}
+}
+
+//SetLogger 设置日志
+func SetLogger(log utils.Logger) {
+	lg = log
+}
+
+//日志输出
+func log(level utils.LoggerLevel, format string, args ...interface{}) {
+	utils.WriteLog(lg, level, format, args...)
+}
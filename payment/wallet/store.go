@@ -0,0 +1,179 @@
+package wallet
+
+import (
+	"database/sql"
+	"math"
+	"time"
+
+	"github.com/kinwyb/golang/gosql"
+	"github.com/kinwyb/golang/payment"
+)
+
+//资金流水类型
+const (
+	flowPay      = "PAY"      //支付
+	flowRefund   = "REFUND"   //退款
+	flowWithdraw = "WITHDRAW" //转入
+)
+
+var (
+	//ErrBalanceNotEnough 余额不足
+	ErrBalanceNotEnough = gosql.NewError(100, "账户余额不足")
+	//ErrTradeExists 交易单号重复
+	ErrTradeExists = gosql.NewError(101, "交易单号已存在")
+	//ErrTradeNotExists 交易不存在
+	ErrTradeNotExists = gosql.NewError(102, "交易不存在")
+	//ErrTradeStatus 交易状态不允许该操作
+	ErrTradeStatus = gosql.NewError(103, "交易状态异常")
+	//ErrRefundOverflow 退款金额超出可退金额
+	ErrRefundOverflow = gosql.NewError(104, "退款金额超出可退金额")
+	//ErrRefundConflict 退款单号已用于其他交易或其他金额的退款
+	ErrRefundConflict = gosql.NewError(105, "退款单号已存在且与本次退款不一致")
+)
+
+//Account 余额账户
+type Account struct {
+	MemberID string  //账户
+	Balance  float64 //可用余额
+	Frozen   float64 //冻结金额
+}
+
+//Flow 资金流水
+type Flow struct {
+	ID       int64          //流水编号
+	TradeNo  string         //交易单号
+	OrigNo   string         //原交易单号
+	MemberID string         //账户
+	Type     string         //类型
+	Amount   float64        //金额
+	Status   payment.Status //状态
+	Code     string         //支付/提现方式编码
+	Remark   string         //描述
+	Created  time.Time      //创建时间
+}
+
+//executor 数据库和事务的公共操作
+type executor interface {
+	Rows(sql string, args ...interface{}) ([]map[string]interface{}, gosql.Error)
+	Exec(sql string, args ...interface{}) (sql.Result, gosql.Error)
+}
+
+type store struct {
+	cfg *Config
+	now func() time.Time //时钟,使用支付/提现对象的PayInfo.Now
+}
+
+//GetAccount 查询账户余额,账户不存在时返回nil
+func GetAccount(c *Config, memberID string) (*Account, gosql.Error) {
+	c.defaults()
+	s := &store{cfg: c, now: time.Now}
+	return s.account(c.DB, memberID, false)
+}
+
+//查询账户,lock为true时锁定账户行(事务中使用)
+func (s *store) account(db executor, memberID string, lock bool) (*Account, gosql.Error) {
+	query := "SELECT member_id,balance,frozen FROM " + s.cfg.AccountTable + " WHERE member_id = ?"
+	if lock {
+		query += " FOR UPDATE"
+	}
+	rows, err := db.Rows(query, memberID)
+	if err != nil {
+		return nil, err
+	} else if len(rows) < 1 {
+		return nil, nil
+	}
+	return &Account{
+		MemberID: gosql.StringDefault(rows[0]["member_id"]),
+		Balance:  gosql.Float64Default(rows[0]["balance"]),
+		Frozen:   gosql.Float64Default(rows[0]["frozen"]),
+	}, nil
+}
+
+//锁定账户,账户不存在时创建
+func (s *store) lockOrCreateAccount(tx gosql.TxSQL, memberID string) (*Account, gosql.Error) {
+	now := s.now()
+	_, err := tx.Exec("INSERT INTO "+s.cfg.AccountTable+"(member_id,balance,frozen,created,updated) VALUES(?,0,0,?,?) "+
+		"ON DUPLICATE KEY UPDATE member_id = member_id", memberID, now, now)
+	if err != nil {
+		return nil, err
+	}
+	return s.account(tx, memberID, true)
+}
+
+//变更账户余额
+func (s *store) changeAccount(tx gosql.TxSQL, memberID string, balance, frozen float64) gosql.Error {
+	_, err := tx.Exec("UPDATE "+s.cfg.AccountTable+" SET balance = balance + ?,frozen = frozen + ?,updated = ? WHERE member_id = ?",
+		round(balance), round(frozen), s.now(), memberID)
+	return err
+}
+
+//查询流水,lock为true时锁定流水行(事务中使用)
+func (s *store) flow(db executor, tradeNo, tp string, lock bool) (*Flow, gosql.Error) {
+	query := "SELECT id,trade_no,orig_no,member_id,type,amount,status,code,remark,created FROM " +
+		s.cfg.FlowTable + " WHERE trade_no = ? AND type = ?"
+	if lock {
+		query += " FOR UPDATE"
+	}
+	rows, err := db.Rows(query, tradeNo, tp)
+	if err != nil {
+		return nil, err
+	} else if len(rows) < 1 {
+		return nil, nil
+	}
+	row := rows[0]
+	created, _ := time.ParseInLocation(timeFormat, gosql.StringDefault(row["created"]), time.Local)
+	return &Flow{
+		ID:       gosql.Int64Default(row["id"]),
+		TradeNo:  gosql.StringDefault(row["trade_no"]),
+		OrigNo:   gosql.StringDefault(row["orig_no"]),
+		MemberID: gosql.StringDefault(row["member_id"]),
+		Type:     gosql.StringDefault(row["type"]),
+		Amount:   gosql.Float64Default(row["amount"]),
+		Status:   payment.Status(gosql.StringDefault(row["status"])),
+		Code:     gosql.StringDefault(row["code"]),
+		Remark:   gosql.StringDefault(row["remark"]),
+		Created:  created,
+	}, nil
+}
+
+//新增流水
+func (s *store) insertFlow(tx gosql.TxSQL, f *Flow) gosql.Error {
+	now := s.now()
+	ret, err := tx.Exec("INSERT INTO "+s.cfg.FlowTable+"(trade_no,orig_no,member_id,type,amount,status,code,remark,created,updated) "+
+		"VALUES(?,?,?,?,?,?,?,?,?,?)", f.TradeNo, f.OrigNo, f.MemberID, f.Type, round(f.Amount), string(f.Status), f.Code, f.Remark, now, now)
+	if err != nil {
+		return err
+	}
+	f.ID, _ = ret.LastInsertId()
+	f.Created = now
+	return nil
+}
+
+//更新流水状态
+func (s *store) updateFlowStatus(tx gosql.TxSQL, f *Flow, status payment.Status) gosql.Error {
+	_, err := tx.Exec("UPDATE "+s.cfg.FlowTable+" SET status = ?,updated = ? WHERE id = ?", string(status), s.now(), f.ID)
+	if err == nil {
+		f.Status = status
+	}
+	return err
+}
+
+//已退款金额
+func (s *store) refunded(tx gosql.TxSQL, origNo string) (float64, gosql.Error) {
+	rows, err := tx.Rows("SELECT IFNULL(SUM(amount),0) AS amount FROM "+s.cfg.FlowTable+" WHERE orig_no = ? AND type = ? AND status = ?",
+		origNo, flowRefund, string(payment.SUCCESS))
+	if err != nil || len(rows) < 1 {
+		return 0, err
+	}
+	return gosql.Float64Default(rows[0]["amount"]), nil
+}
+
+//金额保留两位小数
+func round(money float64) float64 {
+	return math.Round(money*100) / 100
+}
+
+//金额转换成分,用于比较
+func cent(money float64) int64 {
+	return int64(math.Round(money * 100))
+}
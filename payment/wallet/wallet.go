@@ -0,0 +1,297 @@
+package wallet
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/kinwyb/golang/gosql"
+	"github.com/kinwyb/golang/payment"
+	"github.com/kinwyb/golang/utils"
+)
+
+//余额支付,支付在数据库事务中同步完成
+
+type wallet struct {
+	payment.PayInfo
+	config *Config
+	store  *store
+}
+
+//支付,返回交易单号
+//	Ext中Hold为true时只冻结金额,需调用PayConfirm完成扣款或Close释放冻结
+func (w *wallet) Pay(req *payment.PayRequest) (string, error) {
+	if !payment.IsCNY(req.Currency) {
+		return "", payment.ErrCurrencyNotSupport
+	}
+	if req.MemberID == "" {
+		return "", errors.New("付款账户[MemberID]不能为空")
+	} else if req.No == "" {
+		return "", errors.New("交易单号不能为空")
+	} else if cent(req.Money) <= 0 {
+		return "", errors.New("支付金额必须大于0")
+	}
+	ext := &PayRequestExt{}
+	if err := req.DecodeExt(ext); err != nil {
+		return "", err
+	}
+	gerr := w.config.DB.Transaction(func(tx gosql.TxSQL) gosql.Error {
+		f, err := w.store.flow(tx, req.No, flowPay, true)
+		if err != nil {
+			return err
+		} else if f != nil {
+			return ErrTradeExists
+		}
+		account, err := w.store.account(tx, req.MemberID, true)
+		if err != nil {
+			return err
+		} else if account == nil || cent(account.Balance) < cent(req.Money) {
+			return ErrBalanceNotEnough
+		}
+		f = &Flow{
+			TradeNo:  req.No,
+			MemberID: req.MemberID,
+			Type:     flowPay,
+			Amount:   req.Money,
+			Status:   payment.SUCCESS,
+			Code:     w.Code(),
+			Remark:   req.Desc,
+		}
+		frozen := 0.0
+		if ext.Hold {
+			f.Status = payment.DEALING
+			frozen = req.Money
+		}
+		if err = w.store.changeAccount(tx, req.MemberID, -req.Money, frozen); err != nil {
+			return err
+		}
+		return w.store.insertFlow(tx, f)
+	})
+	if gerr != nil {
+		w.Log(utils.LogLevelWarn, "余额支付[%s]失败:%s", req.No, gerr.Msg())
+		return "", errors.New(gerr.Msg())
+	}
+	return req.No, nil
+}
+
+//确认支付,完成冻结金额的扣款
+func (w *wallet) PayConfirm(req *payment.PayConfirmRequest) *payment.PayResult {
+	gerr := w.config.DB.Transaction(func(tx gosql.TxSQL) gosql.Error {
+		f, err := w.store.flow(tx, req.No, flowPay, true)
+		if err != nil {
+			return err
+		} else if f == nil {
+			return ErrTradeNotExists
+		} else if f.Status == payment.SUCCESS {
+			return nil
+		} else if f.Status != payment.DEALING {
+			return ErrTradeStatus
+		}
+		if err = w.store.changeAccount(tx, f.MemberID, 0, -f.Amount); err != nil {
+			return err
+		}
+		return w.store.updateFlowStatus(tx, f, payment.SUCCESS)
+	})
+	if gerr != nil {
+		return &payment.PayResult{
+			Succ:    false,
+			ErrMsg:  gerr.Msg(),
+			No:      req.No,
+			TradeNo: req.No,
+			PayCode: w.Code(),
+		}
+	}
+	return w.Query(req.No)
+}
+
+//Close 关闭冻结中的交易,冻结金额退回可用余额
+func (w *wallet) Close(tradeno string) error {
+	gerr := w.config.DB.Transaction(func(tx gosql.TxSQL) gosql.Error {
+		f, err := w.store.flow(tx, tradeno, flowPay, true)
+		if err != nil {
+			return err
+		} else if f == nil || f.Status == payment.FAIL {
+			return nil
+		} else if f.Status != payment.DEALING {
+			return ErrTradeStatus
+		}
+		if err = w.store.changeAccount(tx, f.MemberID, f.Amount, -f.Amount); err != nil {
+			return err
+		}
+		return w.store.updateFlowStatus(tx, f, payment.FAIL)
+	})
+	if gerr != nil {
+		return errors.New(gerr.Msg())
+	}
+	return nil
+}
+
+//异步结果通知处理,返回支付结果
+//	余额支付在Pay中同步完成,没有第三方回调,通知请求无法验证来源,一律返回失败
+//	支付结果以Pay返回为准,需要时调用Query查询资金流水
+func (w *wallet) Notify(params map[string]string) *payment.PayResult {
+	return &payment.PayResult{
+		Succ:    false,
+		ErrMsg:  "余额支付没有异步通知,请使用Query查询支付结果",
+		No:      params["trade_no"],
+		TradeNo: params["trade_no"],
+		PayCode: w.Code(),
+	}
+}
+
+//异步通知处理结果返回内容
+func (w *wallet) NotifyResult(payResult *payment.PayResult) string {
+	if payResult.Succ {
+		return "success"
+	}
+	return "fail"
+}
+
+//同步结果跳转处理,返回支付结果
+//	余额支付没有同步跳转,与Notify一样返回失败
+func (w *wallet) Result(params map[string]string) *payment.PayResult {
+	return w.Notify(params)
+}
+
+//Query 根据交易单号查询支付结果
+func (w *wallet) Query(tradeno string, tradeDate ...time.Time) *payment.PayResult {
+	ret := &payment.PayResult{
+		No:      tradeno,
+		TradeNo: tradeno,
+		PayCode: w.Code(),
+	}
+	f, err := w.store.flow(w.config.DB, tradeno, flowPay, false)
+	if err != nil {
+		ret.ErrMsg = err.Msg()
+		return ret
+	} else if f == nil {
+		ret.ErrMsg = ErrTradeNotExists.Msg()
+		return ret
+	}
+	ret.Money = f.Amount
+	ret.ThirdAccount = f.MemberID
+	ret.ThirdTradeNo = strconv.FormatInt(f.ID, 10)
+	ret.Navite = map[string]string{
+		"trade_no":  f.TradeNo,
+		"member_id": f.MemberID,
+		"status":    string(f.Status),
+		"created":   f.Created.Format(timeFormat),
+	}
+	if f.Status == payment.SUCCESS {
+		ret.Succ = true
+	} else {
+		ret.ErrMsg = "交易" + payment.StatusMsg(f.Status)
+	}
+	return w.FillPayFee(ret)
+}
+
+//Refund 退款,退款金额退回付款账户可用余额
+func (w *wallet) Refund(req *payment.RefundRequest) *payment.RefundResult {
+	ret := &payment.RefundResult{
+		Status:   payment.FAIL,
+		No:       req.No,
+		RefundNo: req.RefundNo,
+		Money:    req.Money,
+		PayCode:  w.Code(),
+	}
+	if req.RefundNo == "" || cent(req.Money) <= 0 {
+		ret.FailCode = "PARAMS_ERROR"
+		ret.FailMsg = "退款单号不能为空且退款金额必须大于0"
+		return ret
+	}
+	var refund *Flow
+	gerr := w.config.DB.Transaction(func(tx gosql.TxSQL) gosql.Error {
+		f, err := w.store.flow(tx, req.No, flowPay, true)
+		if err != nil {
+			return err
+		} else if f == nil {
+			return ErrTradeNotExists
+		} else if f.Status != payment.SUCCESS {
+			return ErrTradeStatus
+		}
+		refund, err = w.store.flow(tx, req.RefundNo, flowRefund, false)
+		if err != nil {
+			return err
+		} else if refund != nil { //重复退款请求直接返回原退款结果,退款单号被其他交易或金额使用时报错
+			if refund.OrigNo != req.No || cent(refund.Amount) != cent(req.Money) {
+				return ErrRefundConflict
+			}
+			return nil
+		}
+		refunded, err := w.store.refunded(tx, req.No)
+		if err != nil {
+			return err
+		} else if cent(refunded)+cent(req.Money) > cent(f.Amount) {
+			return ErrRefundOverflow
+		}
+		if _, err = w.store.lockOrCreateAccount(tx, f.MemberID); err != nil {
+			return err
+		}
+		if err = w.store.changeAccount(tx, f.MemberID, req.Money, 0); err != nil {
+			return err
+		}
+		refund = &Flow{
+			TradeNo:  req.RefundNo,
+			OrigNo:   req.No,
+			MemberID: f.MemberID,
+			Type:     flowRefund,
+			Amount:   req.Money,
+			Status:   payment.SUCCESS,
+			Code:     w.Code(),
+			Remark:   req.Reason,
+		}
+		return w.store.insertFlow(tx, refund)
+	})
+	if gerr != nil {
+		ret.FailCode = strconv.FormatInt(gerr.Code(), 10)
+		ret.FailMsg = gerr.Msg()
+		return ret
+	}
+	ret.Status = refund.Status
+	ret.Money = refund.Amount
+	ret.ThirdTradeNo = strconv.FormatInt(refund.ID, 10)
+	return ret
+}
+
+//GetPayment 生成一个支付对象
+func (w *wallet) GetPayment(cfg interface{}) payment.Payment {
+	var c *Config
+	ok := false
+	if c, ok = cfg.(*Config); !ok || c == nil {
+		log(utils.LogLevelWarn, "传递的配置信息不是一个有效的余额支付配置")
+		return nil
+	}
+	if c.Name == "" || c.Code == "" || c.DB == nil {
+		return nil
+	}
+	c.defaults()
+	obj := &wallet{
+		config: c,
+	}
+	obj.Init(obj.config.Code, obj.config.Name, obj.config.State)
+	obj.SetFee(obj.config.Fee)
+	obj.SetOptions(obj.config.Options, &lg)
+	obj.store = &store{cfg: c, now: obj.Now}
+	return obj
+}
+
+//Driver 驱动编码
+func (w *wallet) Driver() string {
+	return "wallet"
+}
+
+//NewExt 支付请求扩展信息
+func (w *wallet) NewExt() payment.PayExt {
+	return &PayRequestExt{}
+}
+
+//Capabilities 支付能力,Hold为true时需要PayConfirm完成扣款或Close关闭交易
+func (w *wallet) Capabilities() *payment.Capabilities {
+	return &payment.Capabilities{
+		Operations: []string{payment.OpPay, payment.OpPayConfirm, payment.OpClose},
+		TradeTypes: []string{payment.TradeWallet},
+		Required:   []string{"No", "Money", "MemberID"},
+		ExtFormat:  payment.ExtJSON,
+		Ext:        payment.ExtFields(&PayRequestExt{}),
+	}
+}
@@ -0,0 +1,211 @@
+package wallet
+
+import (
+	"database/sql"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kinwyb/golang/gosql"
+	"github.com/kinwyb/golang/payment"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+//内存模拟的余额数据库,只支持余额支付使用的SQL,事务失败时回滚
+type walletTestDB struct {
+	gosql.SQL
+	accounts map[string]map[string]interface{}
+	flows    []map[string]interface{}
+	failOn   string //执行包含该内容的SQL时返回错误
+}
+
+type walletTestTx struct {
+	gosql.TxSQL
+	db *walletTestDB
+}
+
+type walletTestResult int64
+
+func (r walletTestResult) LastInsertId() (int64, error) { return int64(r), nil }
+func (r walletTestResult) RowsAffected() (int64, error) { return 1, nil }
+
+func newWalletTestDB() *walletTestDB {
+	return &walletTestDB{accounts: map[string]map[string]interface{}{}}
+}
+
+func (d *walletTestDB) Transaction(t gosql.TransactionFunc) gosql.Error {
+	accounts := map[string]map[string]interface{}{}
+	for k, v := range d.accounts {
+		accounts[k] = copyRow(v)
+	}
+	flows := make([]map[string]interface{}, 0, len(d.flows))
+	for _, v := range d.flows {
+		flows = append(flows, copyRow(v))
+	}
+	if err := t(&walletTestTx{db: d}); err != nil {
+		d.accounts, d.flows = accounts, flows
+		return err
+	}
+	return nil
+}
+
+func (t *walletTestTx) Rows(query string, args ...interface{}) ([]map[string]interface{}, gosql.Error) {
+	return t.db.Rows(query, args...)
+}
+
+func (t *walletTestTx) Exec(query string, args ...interface{}) (sql.Result, gosql.Error) {
+	return t.db.Exec(query, args...)
+}
+
+func (d *walletTestDB) Rows(query string, args ...interface{}) ([]map[string]interface{}, gosql.Error) {
+	var ret []map[string]interface{}
+	switch {
+	case strings.Contains(query, "FROM wallet_account"):
+		if v, ok := d.accounts[args[0].(string)]; ok {
+			ret = append(ret, copyRow(v))
+		}
+	case strings.Contains(query, "SUM(amount)"):
+		sum := 0.0
+		for _, v := range d.flows {
+			if v["orig_no"] == args[0] && v["type"] == args[1] && v["status"] == args[2] {
+				sum += v["amount"].(float64)
+			}
+		}
+		ret = append(ret, map[string]interface{}{"amount": sum})
+	case strings.Contains(query, "FROM wallet_flow"):
+		for _, v := range d.flows {
+			if v["trade_no"] == args[0] && v["type"] == args[1] {
+				ret = append(ret, copyRow(v))
+			}
+		}
+	}
+	return ret, nil
+}
+
+func (d *walletTestDB) Exec(query string, args ...interface{}) (sql.Result, gosql.Error) {
+	if d.failOn != "" && strings.Contains(query, d.failOn) {
+		return nil, gosql.NewError(500, "数据库异常")
+	}
+	switch {
+	case strings.HasPrefix(query, "INSERT INTO wallet_account"):
+		if _, ok := d.accounts[args[0].(string)]; !ok {
+			d.accounts[args[0].(string)] = map[string]interface{}{"member_id": args[0], "balance": 0.0, "frozen": 0.0, "updated": args[2]}
+		}
+	case strings.HasPrefix(query, "UPDATE wallet_account"):
+		a := d.accounts[args[3].(string)]
+		a["balance"] = round(a["balance"].(float64) + args[0].(float64))
+		a["frozen"] = round(a["frozen"].(float64) + args[1].(float64))
+		a["updated"] = args[2]
+	case strings.HasPrefix(query, "INSERT INTO wallet_flow"):
+		id := int64(len(d.flows) + 1)
+		d.flows = append(d.flows, map[string]interface{}{
+			"id": id, "trade_no": args[0], "orig_no": args[1], "member_id": args[2], "type": args[3], "amount": args[4],
+			"status": args[5], "code": args[6], "remark": args[7], "created": args[8].(time.Time).Format(timeFormat),
+		})
+		return walletTestResult(id), nil
+	case strings.HasPrefix(query, "UPDATE wallet_flow"):
+		for _, v := range d.flows {
+			if v["id"] == args[2] {
+				v["status"] = args[0]
+			}
+		}
+	}
+	return walletTestResult(0), nil
+}
+
+func copyRow(r map[string]interface{}) map[string]interface{} {
+	ret := map[string]interface{}{}
+	for k, v := range r {
+		ret[k] = v
+	}
+	return ret
+}
+
+func (d *walletTestDB) balance(memberID string) float64 {
+	if a, ok := d.accounts[memberID]; ok {
+		return a["balance"].(float64)
+	}
+	return 0
+}
+
+func Test_Wallet(t *testing.T) {
+	db := newWalletTestDB()
+	now := time.Date(2017, 10, 19, 10, 0, 0, 0, time.Local)
+	registry := payment.NewRegistry()
+	Driver(registry.RegDriver, nil)
+	WithdrawDriver(registry.RegWithdrawDriver, nil)
+	cfg := &Config{DB: db}
+	cfg.Code = "wallet"
+	cfg.Name = "余额"
+	cfg.State = true
+	cfg.Options = &payment.Options{Clock: func() time.Time { return now }}
+	if _, err := registry.AddPayment("wallet", cfg); err != nil {
+		t.Fatal(err)
+	} else if _, err = registry.AddWithdraw("wallet", cfg); err != nil {
+		t.Fatal(err)
+	}
+	convey.Convey("余额充值", t, func() {
+		info := &payment.WithdrawInfo{TradeNo: "W001", CardNo: "m1", Money: 100}
+		ret := registry.Withdraw("wallet").Withdraw(info)
+		convey.So(ret.Status, convey.ShouldEqual, payment.SUCCESS)
+		convey.So(ret.PayTime, convey.ShouldEqual, now.Format(timeFormat))
+		ret = registry.Withdraw("wallet").Withdraw(info)
+		convey.So(ret.Status, convey.ShouldEqual, payment.SUCCESS)
+		convey.So(db.balance("m1"), convey.ShouldEqual, 100)
+		convey.So(db.accounts["m1"]["updated"], convey.ShouldResemble, now)
+	})
+	convey.Convey("余额支付", t, func() {
+		_, err := registry.Pay("wallet", &payment.PayRequest{No: "P001", Money: 200, MemberID: "m1"})
+		convey.So(err.Error(), convey.ShouldEqual, ErrBalanceNotEnough.Msg())
+		_, err = registry.Pay("wallet", &payment.PayRequest{No: "P001", Money: 30, MemberID: "m2"})
+		convey.So(err.Error(), convey.ShouldEqual, ErrBalanceNotEnough.Msg())
+		no, err := registry.Pay("wallet", &payment.PayRequest{No: "P001", Money: 30, MemberID: "m1"})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(no, convey.ShouldEqual, "P001")
+		_, err = registry.Pay("wallet", &payment.PayRequest{No: "P001", Money: 30, MemberID: "m1"})
+		convey.So(err.Error(), convey.ShouldEqual, ErrTradeExists.Msg())
+		convey.So(db.balance("m1"), convey.ShouldEqual, 70)
+		ret := registry.Query("wallet", "P001")
+		convey.So(ret.Succ, convey.ShouldBeTrue)
+		convey.So(ret.Money, convey.ShouldEqual, 30)
+		convey.So(ret.Navite["created"], convey.ShouldEqual, now.Format(timeFormat))
+		convey.So(registry.Notify("wallet", map[string]string{"trade_no": "P001"}).Succ, convey.ShouldBeFalse)
+	})
+	convey.Convey("退款", t, func() {
+		req := &payment.RefundRequest{No: "P001", RefundNo: "R001", Money: 10}
+		ret := registry.Refund("wallet", req)
+		convey.So(ret.Status, convey.ShouldEqual, payment.SUCCESS)
+		again := registry.Refund("wallet", req)
+		convey.So(again.Status, convey.ShouldEqual, payment.SUCCESS)
+		convey.So(again.ThirdTradeNo, convey.ShouldEqual, ret.ThirdTradeNo)
+		convey.So(db.balance("m1"), convey.ShouldEqual, 80)
+		ret = registry.Refund("wallet", &payment.RefundRequest{No: "P001", RefundNo: "R002", Money: 25})
+		convey.So(ret.Status, convey.ShouldEqual, payment.FAIL)
+		convey.So(ret.FailMsg, convey.ShouldEqual, ErrRefundOverflow.Msg())
+		ret = registry.Refund("wallet", &payment.RefundRequest{No: "P001", RefundNo: "R002", Money: 20})
+		convey.So(ret.Status, convey.ShouldEqual, payment.SUCCESS)
+		convey.So(db.balance("m1"), convey.ShouldEqual, 100)
+		ret = registry.Refund("wallet", &payment.RefundRequest{No: "P001", RefundNo: "R001", Money: 5})
+		convey.So(ret.Status, convey.ShouldEqual, payment.FAIL)
+		convey.So(ret.FailMsg, convey.ShouldEqual, ErrRefundConflict.Msg())
+		ret = registry.Refund("wallet", &payment.RefundRequest{No: "P404", RefundNo: "R003", Money: 1})
+		convey.So(ret.FailMsg, convey.ShouldEqual, ErrTradeNotExists.Msg())
+	})
+	convey.Convey("事务失败回滚", t, func() {
+		db.failOn = "INSERT INTO wallet_flow"
+		_, err := registry.Pay("wallet", &payment.PayRequest{No: "P002", Money: 20, MemberID: "m1"})
+		convey.So(err, convey.ShouldNotBeNil)
+		convey.So(db.balance("m1"), convey.ShouldEqual, 100)
+		ret := registry.Withdraw("wallet").Withdraw(&payment.WithdrawInfo{TradeNo: "W002", CardNo: "m1", Money: 5})
+		convey.So(ret.Status, convey.ShouldEqual, payment.FAIL)
+		convey.So(db.balance("m1"), convey.ShouldEqual, 100)
+		db.failOn = ""
+		convey.So(registry.Query("wallet", "P002").Succ, convey.ShouldBeFalse)
+		_, err = registry.Pay("wallet", &payment.PayRequest{No: "P002", Money: 20, MemberID: "m1", Ext: `{"Hold":true}`})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(db.accounts["m1"]["frozen"], convey.ShouldEqual, 20)
+		convey.So(registry.Close("wallet", "P002"), convey.ShouldBeNil)
+		convey.So(db.balance("m1"), convey.ShouldEqual, 100)
+		convey.So(db.accounts["m1"]["frozen"], convey.ShouldEqual, 0)
+	})
+}
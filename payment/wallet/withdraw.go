@@ -0,0 +1,140 @@
+package wallet
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/kinwyb/golang/gosql"
+	"github.com/kinwyb/golang/payment"
+	"github.com/kinwyb/golang/utils"
+)
+
+//余额提现,提现金额转入收款账户(WithdrawInfo.CardNo)的余额
+
+const timeFormat = "2006-01-02 15:04:05"
+
+type withdraw struct {
+	payment.PayInfo
+	config *Config
+	store  *store
+}
+
+//提现操作,成功返回资金流水号
+func (w *withdraw) Withdraw(info *payment.WithdrawInfo) *payment.WithdrawResult {
+	return w.FillWithdrawFee(w.withdraw(info), info)
+}
+
+//提现请求
+func (w *withdraw) withdraw(info *payment.WithdrawInfo) *payment.WithdrawResult {
+	if !payment.IsCNY(info.Currency) {
+		return payment.WithdrawCurrencyNotSupport
+	}
+	ret := &payment.WithdrawResult{
+		TradeNo:      info.TradeNo,
+		CardNo:       info.CardNo,
+		UserName:     info.UserName,
+		CertID:       info.CertID,
+		Money:        info.Money,
+		WithdrawCode: w.Code(),
+		WithdrawName: w.Name(),
+		Status:       payment.FAIL,
+	}
+	if info.TradeNo == "" || info.CardNo == "" || cent(info.Money) <= 0 {
+		ret.FailCode = "PARAMS_ERROR"
+		ret.FailMsg = "交易流水号、收款账户不能为空且提现金额必须大于0"
+		return ret
+	}
+	var f *Flow
+	gerr := w.config.DB.Transaction(func(tx gosql.TxSQL) gosql.Error {
+		var err gosql.Error
+		f, err = w.store.flow(tx, info.TradeNo, flowWithdraw, true)
+		if err != nil || f != nil { //重复提现请求直接返回原提现结果
+			return err
+		}
+		if _, err = w.store.lockOrCreateAccount(tx, info.CardNo); err != nil {
+			return err
+		}
+		if err = w.store.changeAccount(tx, info.CardNo, info.Money, 0); err != nil {
+			return err
+		}
+		f = &Flow{
+			TradeNo:  info.TradeNo,
+			MemberID: info.CardNo,
+			Type:     flowWithdraw,
+			Amount:   info.Money,
+			Status:   payment.SUCCESS,
+			Code:     w.Code(),
+			Remark:   info.Desc,
+		}
+		return w.store.insertFlow(tx, f)
+	})
+	if gerr != nil {
+		w.Log(utils.LogLevelWarn, "余额提现[%s]失败:%s", info.TradeNo, gerr.Msg())
+		ret.FailCode = strconv.FormatInt(gerr.Code(), 10)
+		ret.FailMsg = gerr.Msg()
+		return ret
+	}
+	ret.Status = f.Status
+	ret.Money = f.Amount
+	ret.CardNo = f.MemberID
+	ret.ThridFlowNo = strconv.FormatInt(f.ID, 10)
+	ret.PayTime = f.Created.Format(timeFormat)
+	return ret
+}
+
+//查询提现交易
+func (w *withdraw) QueryWithdraw(tradeno string, tradeDate ...time.Time) *payment.WithdrawQueryResult {
+	ret := &payment.WithdrawQueryResult{
+		Status:  payment.UNKNOW,
+		TradeNo: tradeno,
+	}
+	f, err := w.store.flow(w.config.DB, tradeno, flowWithdraw, false)
+	if err != nil {
+		ret.FailCode = strconv.FormatInt(err.Code(), 10)
+		ret.FailMsg = err.Msg()
+		return ret
+	} else if f == nil {
+		ret.Status = payment.FAIL
+		ret.FailCode = strconv.FormatInt(ErrTradeNotExists.Code(), 10)
+		ret.FailMsg = ErrTradeNotExists.Msg()
+		return ret
+	}
+	ret.Status = f.Status
+	ret.PayTime = f.Created.Format(timeFormat)
+	ret.ThridFlowNo = strconv.FormatInt(f.ID, 10)
+	return ret
+}
+
+//GetWithdraw 生成一个提现对象
+func (w *withdraw) GetWithdraw(cfg interface{}) payment.Withdraw {
+	var c *Config
+	ok := false
+	if c, ok = cfg.(*Config); !ok || c == nil {
+		log(utils.LogLevelWarn, "传递的配置信息不是一个有效的余额提现配置")
+		return nil
+	}
+	if c.Name == "" || c.Code == "" || c.DB == nil {
+		return nil
+	}
+	c.defaults()
+	obj := &withdraw{
+		config: c,
+	}
+	obj.Init(obj.config.Code, obj.config.Name, obj.config.State)
+	obj.SetFee(obj.config.Fee)
+	obj.SetOptions(obj.config.Options, &lg)
+	obj.store = &store{cfg: c, now: obj.Now}
+	return obj
+}
+
+//Driver 驱动编码
+func (w *withdraw) Driver() string {
+	return "wallet"
+}
+
+//Capabilities 提现能力,CardNo为收款账户
+func (w *withdraw) Capabilities() *payment.Capabilities {
+	return &payment.Capabilities{
+		Required: []string{"TradeNo", "CardNo", "Money"},
+	}
+}
@@ -0,0 +1,139 @@
+package webhook
+
+import (
+	"errors"
+	"sort"
+	"sync"
+	"time"
+)
+
+//DeliveryStatus 投递状态
+type DeliveryStatus string
+
+const (
+	DeliveryPending DeliveryStatus = "PENDING" //待投递/等待重试
+	DeliverySuccess DeliveryStatus = "SUCCESS" //投递成功
+	DeliveryDead    DeliveryStatus = "DEAD"    //超过最大重试次数,进入死信列表
+)
+
+//ErrDeliveryNotExists 投递记录不存在
+var ErrDeliveryNotExists = errors.New("投递记录不存在")
+
+//Delivery 一次事件投递,每个订阅事件的地址一条记录
+type Delivery struct {
+	ID        int64          //投递ID,接收方据此去重
+	Endpoint  string         //接收地址名称
+	EventType string         //事件类型
+	EventKey  string         //事件业务单号
+	Payload   string         //推送内容[json]
+	Status    DeliveryStatus //投递状态
+	Attempts  int            //已投递次数
+	NextTime  time.Time      //下次投递时间
+	LastError string         //最后一次投递错误
+	Created   time.Time      //创建时间
+	Updated   time.Time      //更新时间
+}
+
+//Outbox 待投递事件存储,事件先落库再投递,进程重启后继续投递
+type Outbox interface {
+	//Add 新增投递记录,成功后设置ID
+	Add(d *Delivery) error
+	//Due 查询NextTime不晚于now的待投递记录,按NextTime排序
+	Due(now time.Time, limit int) ([]*Delivery, error)
+	//Update 更新投递状态、次数、下次投递时间和错误信息
+	Update(d *Delivery) error
+	//Dead 查询死信列表,按更新时间倒序,limit<=0不限制数量
+	Dead(limit int) ([]*Delivery, error)
+	//Get 查询投递记录,不存在返回ErrDeliveryNotExists
+	Get(id int64) (*Delivery, error)
+}
+
+//MemoryOutbox 内存投递存储,只适用于单进程和测试
+type MemoryOutbox struct {
+	lock  sync.Mutex
+	seq   int64
+	items map[int64]*Delivery
+}
+
+//NewMemoryOutbox 创建内存投递存储
+func NewMemoryOutbox() *MemoryOutbox {
+	return &MemoryOutbox{items: map[int64]*Delivery{}}
+}
+
+//Add 新增投递记录
+func (m *MemoryOutbox) Add(d *Delivery) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.seq++
+	d.ID = m.seq
+	v := *d
+	m.items[d.ID] = &v
+	return nil
+}
+
+//Due 查询待投递记录
+func (m *MemoryOutbox) Due(now time.Time, limit int) ([]*Delivery, error) {
+	return m.list(func(d *Delivery) bool {
+		return d.Status == DeliveryPending && !d.NextTime.After(now)
+	}, func(a, b *Delivery) bool {
+		return a.NextTime.Before(b.NextTime)
+	}, limit), nil
+}
+
+//Update 更新投递记录
+func (m *MemoryOutbox) Update(d *Delivery) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.items[d.ID]; !ok {
+		return ErrDeliveryNotExists
+	}
+	v := *d
+	m.items[d.ID] = &v
+	return nil
+}
+
+//Dead 查询死信列表
+func (m *MemoryOutbox) Dead(limit int) ([]*Delivery, error) {
+	return m.list(func(d *Delivery) bool {
+		return d.Status == DeliveryDead
+	}, func(a, b *Delivery) bool {
+		return a.Updated.After(b.Updated)
+	}, limit), nil
+}
+
+//Get 查询投递记录
+func (m *MemoryOutbox) Get(id int64) (*Delivery, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	d, ok := m.items[id]
+	if !ok {
+		return nil, ErrDeliveryNotExists
+	}
+	v := *d
+	return &v, nil
+}
+
+//按条件查询,返回记录副本
+func (m *MemoryOutbox) list(match func(*Delivery) bool, less func(a, b *Delivery) bool, limit int) []*Delivery {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	ret := []*Delivery{}
+	for _, d := range m.items {
+		if match(d) {
+			v := *d
+			ret = append(ret, &v)
+		}
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		if less(ret[i], ret[j]) {
+			return true
+		} else if less(ret[j], ret[i]) {
+			return false
+		}
+		return ret[i].ID < ret[j].ID
+	})
+	if limit > 0 && len(ret) > limit {
+		ret = ret[:limit]
+	}
+	return ret
+}
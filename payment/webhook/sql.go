@@ -0,0 +1,149 @@
+package webhook
+
+import (
+	"strings"
+	"time"
+
+	"github.com/kinwyb/golang/gosql"
+)
+
+const timeFormat = "2006-01-02 15:04:05"
+
+//Schema 投递表结构(MySQL),{webhook}替换为表名
+const Schema = `CREATE TABLE IF NOT EXISTS {webhook} (
+  id BIGINT NOT NULL AUTO_INCREMENT,
+  endpoint VARCHAR(64) NOT NULL COMMENT '接收地址名称',
+  event_type VARCHAR(32) NOT NULL COMMENT '事件类型',
+  event_key VARCHAR(64) NOT NULL DEFAULT '' COMMENT '事件业务单号',
+  payload TEXT NOT NULL COMMENT '推送内容[json]',
+  status VARCHAR(16) NOT NULL COMMENT '状态 PENDING:待投递 SUCCESS:成功 DEAD:死信',
+  attempts INT NOT NULL DEFAULT 0 COMMENT '已投递次数',
+  next_time DATETIME NOT NULL COMMENT '下次投递时间',
+  last_error VARCHAR(255) NOT NULL DEFAULT '' COMMENT '最后一次投递错误',
+  created DATETIME NOT NULL COMMENT '创建时间',
+  updated DATETIME NOT NULL COMMENT '更新时间',
+  PRIMARY KEY (id),
+  KEY idx_due (status, next_time),
+  KEY idx_event (event_type, event_key)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='webhook投递';`
+
+const deliveryColumns = "id,endpoint,event_type,event_key,payload,status,attempts,next_time,last_error,created,updated"
+
+//SQLOutbox 数据库投递存储
+type SQLOutbox struct {
+	db    gosql.SQL
+	table string
+}
+
+//NewSQLOutbox 创建数据库投递存储,table为空时使用pay_webhook
+func NewSQLOutbox(db gosql.SQL, table string) *SQLOutbox {
+	if table == "" {
+		table = "pay_webhook"
+	}
+	return &SQLOutbox{db: db, table: table}
+}
+
+//CreateTable 创建投递表
+func (s *SQLOutbox) CreateTable() error {
+	if _, err := s.db.Exec(strings.Replace(Schema, "{webhook}", s.table, -1)); err != nil {
+		return err
+	}
+	return nil
+}
+
+//Add 新增投递记录
+func (s *SQLOutbox) Add(d *Delivery) error {
+	ret, err := s.db.Exec("INSERT INTO "+s.table+"(endpoint,event_type,event_key,payload,status,attempts,next_time,last_error,created,updated) "+
+		"VALUES(?,?,?,?,?,?,?,?,?,?)", d.Endpoint, d.EventType, d.EventKey, d.Payload, string(d.Status), d.Attempts,
+		d.NextTime, cut(d.LastError, 255), d.Created, d.Updated)
+	if err != nil {
+		return err
+	}
+	id, e := ret.LastInsertId()
+	if e != nil {
+		return gosql.NewError(1, "投递记录ID获取失败", e)
+	}
+	d.ID = id
+	return nil
+}
+
+//Due 查询待投递记录
+func (s *SQLOutbox) Due(now time.Time, limit int) ([]*Delivery, error) {
+	return s.list("SELECT "+deliveryColumns+" FROM "+s.table+" WHERE status = ? AND next_time <= ? ORDER BY next_time,id",
+		limit, string(DeliveryPending), now)
+}
+
+//Update 更新投递记录
+func (s *SQLOutbox) Update(d *Delivery) error {
+	ret, err := s.db.Exec("UPDATE "+s.table+" SET status = ?,attempts = ?,next_time = ?,last_error = ?,updated = ? WHERE id = ?",
+		string(d.Status), d.Attempts, d.NextTime, cut(d.LastError, 255), d.Updated, d.ID)
+	if err != nil {
+		return err
+	} else if n, e := ret.RowsAffected(); e == nil && n < 1 {
+		if _, e := s.Get(d.ID); e != nil { //MySQL数据未变化时影响行数为0
+			return e
+		}
+	}
+	return nil
+}
+
+//Dead 查询死信列表
+func (s *SQLOutbox) Dead(limit int) ([]*Delivery, error) {
+	return s.list("SELECT "+deliveryColumns+" FROM "+s.table+" WHERE status = ? ORDER BY updated DESC,id DESC",
+		limit, string(DeliveryDead))
+}
+
+//Get 查询投递记录
+func (s *SQLOutbox) Get(id int64) (*Delivery, error) {
+	ret, err := s.list("SELECT "+deliveryColumns+" FROM "+s.table+" WHERE id = ?", 0, id)
+	if err != nil {
+		return nil, err
+	} else if len(ret) < 1 {
+		return nil, ErrDeliveryNotExists
+	}
+	return ret[0], nil
+}
+
+//查询投递记录,limit<=0不限制数量
+func (s *SQLOutbox) list(query string, limit int, args ...interface{}) ([]*Delivery, error) {
+	if limit > 0 {
+		query += " LIMIT ?"
+		args = append(args, limit)
+	}
+	rows, err := s.db.Rows(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*Delivery, 0, len(rows))
+	for _, v := range rows {
+		ret = append(ret, &Delivery{
+			ID:        gosql.Int64Default(v["id"]),
+			Endpoint:  gosql.StringDefault(v["endpoint"]),
+			EventType: gosql.StringDefault(v["event_type"]),
+			EventKey:  gosql.StringDefault(v["event_key"]),
+			Payload:   gosql.StringDefault(v["payload"]),
+			Status:    DeliveryStatus(gosql.StringDefault(v["status"])),
+			Attempts:  gosql.IntDefault(v["attempts"]),
+			NextTime:  parseTime(v["next_time"]),
+			LastError: gosql.StringDefault(v["last_error"]),
+			Created:   parseTime(v["created"]),
+			Updated:   parseTime(v["updated"]),
+		})
+	}
+	return ret, nil
+}
+
+//数据库时间
+func parseTime(v interface{}) time.Time {
+	ret, _ := time.ParseInLocation(timeFormat, gosql.StringDefault(v), time.Local)
+	return ret
+}
+
+//截取字符串,避免超出字段长度
+func cut(s string, n int) string {
+	r := []rune(s)
+	if len(r) > n {
+		return string(r[:n])
+	}
+	return s
+}
@@ -0,0 +1,353 @@
+package webhook
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/kinwyb/golang/crypto"
+	"github.com/kinwyb/golang/payment"
+	"github.com/kinwyb/golang/utils"
+)
+
+//支付结果推送到下游服务
+//	1. PayResult/WithdrawResult 将事件按订阅地址写入Outbox,不直接发送
+//	2. Deliver/Run 发送到期的投递,失败按指数退避重试,超过最大次数进入死信列表
+//	3. DeadLetters/Replay 查询死信并手动重新投递
+//	投递至少一次,接收方需要按X-Webhook-ID去重,返回2xx表示接收成功
+
+//事件类型
+const (
+	EventPay      = "pay"      //支付结果
+	EventWithdraw = "withdraw" //提现结果
+)
+
+//推送请求头
+const (
+	HeaderEvent     = "X-Webhook-Event"     //事件类型
+	HeaderID        = "X-Webhook-ID"        //投递ID
+	HeaderTimestamp = "X-Webhook-Timestamp" //签名时间戳(秒)
+	HeaderSignature = "X-Webhook-Signature" //签名 hex(HMAC-SHA256(secret, timestamp + "." + body))
+)
+
+//Endpoint 接收地址
+type Endpoint struct {
+	Name    string        //名称,唯一
+	URL     string        //推送地址
+	Secret  string        //签名密钥
+	Events  []string      //订阅的事件类型,为空订阅全部事件
+	Timeout time.Duration //请求超时时间,0使用HTTP客户端设置
+}
+
+//订阅事件
+func (e *Endpoint) subscribe(event string) bool {
+	if len(e.Events) < 1 {
+		return true
+	}
+	for _, v := range e.Events {
+		if v == event {
+			return true
+		}
+	}
+	return false
+}
+
+//Event 推送内容
+type Event struct {
+	Type    string          `json:"type"`    //事件类型
+	Key     string          `json:"key"`     //事件业务单号
+	Created int64           `json:"created"` //事件时间(秒)
+	Data    json.RawMessage `json:"data"`    //事件数据
+}
+
+//Config 推送配置
+type Config struct {
+	Outbox      Outbox           //投递存储
+	Client      *http.Client     //HTTP客户端,默认http.DefaultClient
+	Logger      utils.Logger     //日志
+	MaxAttempts int              //最大投递次数,默认8
+	BaseDelay   time.Duration    //首次重试间隔,之后每次翻倍,默认30秒
+	MaxDelay    time.Duration    //最大重试间隔,默认6小时
+	BatchSize   int              //每次投递数量,默认100
+	Clock       func() time.Time //时钟,默认time.Now
+}
+
+//默认值
+func (c *Config) defaults() {
+	if c.Client == nil {
+		c.Client = http.DefaultClient
+	}
+	if c.MaxAttempts < 1 {
+		c.MaxAttempts = 8
+	}
+	if c.BaseDelay <= 0 {
+		c.BaseDelay = 30 * time.Second
+	}
+	if c.MaxDelay <= 0 {
+		c.MaxDelay = 6 * time.Hour
+	}
+	if c.BatchSize < 1 {
+		c.BatchSize = 100
+	}
+	if c.Clock == nil {
+		c.Clock = time.Now
+	}
+}
+
+//Dispatcher 推送调度
+type Dispatcher struct {
+	cfg       *Config
+	lock      sync.RWMutex
+	endpoints map[string]*Endpoint
+	deliver   sync.Mutex
+}
+
+//New 创建推送调度
+func New(c *Config) *Dispatcher {
+	c.defaults()
+	return &Dispatcher{cfg: c, endpoints: map[string]*Endpoint{}}
+}
+
+//AddEndpoint 添加接收地址,名称相同时替换
+func (d *Dispatcher) AddEndpoint(e *Endpoint) error {
+	if e == nil || e.Name == "" || e.URL == "" {
+		return errors.New("接收地址[Name/URL]不能为空")
+	} else if e.Secret == "" {
+		return errors.New("接收地址[Secret]不能为空")
+	}
+	d.lock.Lock()
+	d.endpoints[e.Name] = e
+	d.lock.Unlock()
+	return nil
+}
+
+//RemoveEndpoint 删除接收地址,已写入的投递记录在投递时标记为死信
+func (d *Dispatcher) RemoveEndpoint(name string) {
+	d.lock.Lock()
+	delete(d.endpoints, name)
+	d.lock.Unlock()
+}
+
+//PayResult 推送支付结果
+func (d *Dispatcher) PayResult(ret *payment.PayResult) error {
+	if ret == nil {
+		return errors.New("支付结果不能为空")
+	}
+	return d.Publish(EventPay, ret.No, ret)
+}
+
+//WithdrawResult 推送提现结果
+func (d *Dispatcher) WithdrawResult(ret *payment.WithdrawResult) error {
+	if ret == nil {
+		return errors.New("提现结果不能为空")
+	}
+	return d.Publish(EventWithdraw, ret.TradeNo, ret)
+}
+
+//Publish 发布事件,按订阅地址写入投递记录,等待Deliver发送
+func (d *Dispatcher) Publish(event string, key string, data interface{}) error {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("事件数据序列化失败:%s", err.Error())
+	}
+	now := d.cfg.Clock()
+	payload, err := json.Marshal(&Event{Type: event, Key: key, Created: now.Unix(), Data: raw})
+	if err != nil {
+		return fmt.Errorf("事件序列化失败:%s", err.Error())
+	}
+	d.lock.RLock()
+	defer d.lock.RUnlock()
+	for _, e := range d.endpoints {
+		if !e.subscribe(event) {
+			continue
+		}
+		err = d.cfg.Outbox.Add(&Delivery{
+			Endpoint:  e.Name,
+			EventType: event,
+			EventKey:  key,
+			Payload:   string(payload),
+			Status:    DeliveryPending,
+			NextTime:  now,
+			Created:   now,
+			Updated:   now,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//Deliver 发送到期的投递,返回成功数量,同一时间只有一个Deliver执行
+func (d *Dispatcher) Deliver() (int, error) {
+	d.deliver.Lock()
+	defer d.deliver.Unlock()
+	items, err := d.cfg.Outbox.Due(d.cfg.Clock(), d.cfg.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+	succ := 0
+	for _, v := range items {
+		d.lock.RLock()
+		e := d.endpoints[v.Endpoint]
+		d.lock.RUnlock()
+		if e == nil {
+			err = errors.New("接收地址不存在")
+			v.Attempts = d.cfg.MaxAttempts - 1 //直接进入死信
+		} else {
+			err = d.send(e, v)
+		}
+		if err == nil {
+			succ++
+		}
+		if e := d.result(v, err); e != nil {
+			return succ, e
+		}
+	}
+	return succ, nil
+}
+
+//Run 每隔interval发送一次到期的投递,stop关闭后退出
+func (d *Dispatcher) Run(interval time.Duration, stop <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if _, err := d.Deliver(); err != nil {
+			d.log(utils.LogLevelError, "webhook投递失败:%s", err.Error())
+		}
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+//DeadLetters 死信列表,limit<=0不限制数量
+func (d *Dispatcher) DeadLetters(limit int) ([]*Delivery, error) {
+	return d.cfg.Outbox.Dead(limit)
+}
+
+//Replay 重新投递死信,重置投递次数,下次Deliver时发送
+func (d *Dispatcher) Replay(id int64) error {
+	v, err := d.cfg.Outbox.Get(id)
+	if err != nil {
+		return err
+	} else if v.Status != DeliveryDead {
+		return errors.New("投递记录不是死信:" + string(v.Status))
+	}
+	now := d.cfg.Clock()
+	v.Status = DeliveryPending
+	v.Attempts = 0
+	v.NextTime = now
+	v.Updated = now
+	return d.cfg.Outbox.Update(v)
+}
+
+//Backoff 第attempts次投递失败后的重试间隔
+func (d *Dispatcher) Backoff(attempts int) time.Duration {
+	delay := d.cfg.BaseDelay
+	for i := 1; i < attempts && delay < d.cfg.MaxDelay; i++ {
+		delay *= 2
+	}
+	if delay > d.cfg.MaxDelay {
+		return d.cfg.MaxDelay
+	}
+	return delay
+}
+
+//发送投递
+func (d *Dispatcher) send(e *Endpoint, v *Delivery) error {
+	req, err := http.NewRequest("POST", e.URL, bytes.NewBufferString(v.Payload))
+	if err != nil {
+		return err
+	}
+	timestamp := strconv.FormatInt(d.cfg.Clock().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json;charset=utf-8")
+	req.Header.Set(HeaderEvent, v.EventType)
+	req.Header.Set(HeaderID, strconv.FormatInt(v.ID, 10))
+	req.Header.Set(HeaderTimestamp, timestamp)
+	req.Header.Set(HeaderSignature, Sign(e.Secret, timestamp, []byte(v.Payload)))
+	client := d.cfg.Client
+	if e.Timeout > 0 {
+		c := *client
+		c.Timeout = e.Timeout
+		client = &c
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	body, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 256))
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("HTTP %d:%s", resp.StatusCode, body)
+	}
+	return nil
+}
+
+//记录投递结果
+func (d *Dispatcher) result(v *Delivery, err error) error {
+	now := d.cfg.Clock()
+	v.Attempts++
+	v.Updated = now
+	if err == nil {
+		v.Status = DeliverySuccess
+		v.LastError = ""
+	} else {
+		v.LastError = err.Error()
+		if v.Attempts >= d.cfg.MaxAttempts {
+			v.Status = DeliveryDead
+			d.log(utils.LogLevelError, "webhook投递[%d]%s:%s 超过最大次数进入死信:%s", v.ID, v.Endpoint, v.EventKey, v.LastError)
+		} else {
+			v.NextTime = now.Add(d.Backoff(v.Attempts))
+			d.log(utils.LogLevelWarn, "webhook投递[%d]%s:%s 失败,第%d次:%s", v.ID, v.Endpoint, v.EventKey, v.Attempts, v.LastError)
+		}
+	}
+	return d.cfg.Outbox.Update(v)
+}
+
+//日志输出
+func (d *Dispatcher) log(level utils.LoggerLevel, format string, args ...interface{}) {
+	utils.WriteLog(d.cfg.Logger, level, format, args...)
+}
+
+//Sign 推送签名,hex(HMAC-SHA256(secret, timestamp + "." + body))
+func Sign(secret string, timestamp string, body []byte) string {
+	data := make([]byte, 0, len(timestamp)+1+len(body))
+	data = append(data, timestamp...)
+	data = append(data, '.')
+	data = append(data, body...)
+	return hex.EncodeToString(crypto.HmacSHA256(data, []byte(secret)))
+}
+
+//Verify 接收方验证推送签名,tolerance大于0时校验时间戳与当前时间的误差
+func Verify(secret string, header http.Header, body []byte, tolerance time.Duration) error {
+	timestamp := header.Get(HeaderTimestamp)
+	signature, err := hex.DecodeString(header.Get(HeaderSignature))
+	if err != nil || timestamp == "" {
+		return errors.New("推送签名缺失")
+	}
+	if tolerance > 0 {
+		ts, err := strconv.ParseInt(timestamp, 10, 64)
+		if err != nil {
+			return errors.New("推送时间戳错误")
+		} else if diff := time.Since(time.Unix(ts, 0)); diff > tolerance || diff < -tolerance {
+			return errors.New("推送时间戳超出允许范围")
+		}
+	}
+	expect, _ := hex.DecodeString(Sign(secret, timestamp, body))
+	if !hmac.Equal(signature, expect) {
+		return errors.New("推送签名验证失败")
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+package webhook
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kinwyb/golang/payment"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+type webhookTestServer struct {
+	lock   sync.Mutex
+	status int
+	bodies []string
+	errs   []error
+}
+
+func (s *webhookTestServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := ioutil.ReadAll(r.Body)
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.bodies = append(s.bodies, string(body))
+	s.errs = append(s.errs, Verify("secret", r.Header, body, time.Hour))
+	w.WriteHeader(s.status)
+}
+
+func Test_Webhook(t *testing.T) {
+	convey.Convey("签名", t, func() {
+		sign := Sign("secret", "1508378400", []byte(`{"a":1}`))
+		convey.So(len(sign), convey.ShouldEqual, 64)
+		header := http.Header{}
+		header.Set(HeaderTimestamp, "1508378400")
+		header.Set(HeaderSignature, sign)
+		convey.So(Verify("secret", header, []byte(`{"a":1}`), 0), convey.ShouldBeNil)
+		convey.So(Verify("other", header, []byte(`{"a":1}`), 0), convey.ShouldNotBeNil)
+		convey.So(Verify("secret", header, []byte(`{"a":2}`), 0), convey.ShouldNotBeNil)
+		convey.So(Verify("secret", header, []byte(`{"a":1}`), time.Minute), convey.ShouldNotBeNil)
+	})
+	convey.Convey("退避", t, func() {
+		d := New(&Config{Outbox: NewMemoryOutbox(), BaseDelay: time.Second, MaxDelay: 10 * time.Second})
+		convey.So(d.Backoff(1), convey.ShouldEqual, time.Second)
+		convey.So(d.Backoff(2), convey.ShouldEqual, 2*time.Second)
+		convey.So(d.Backoff(4), convey.ShouldEqual, 8*time.Second)
+		convey.So(d.Backoff(5), convey.ShouldEqual, 10*time.Second)
+		convey.So(d.Backoff(100), convey.ShouldEqual, 10*time.Second)
+	})
+	convey.Convey("投递", t, func() {
+		srv := &webhookTestServer{status: http.StatusInternalServerError}
+		ts := httptest.NewServer(srv)
+		defer ts.Close()
+		now := time.Now()
+		outbox := NewMemoryOutbox()
+		d := New(&Config{
+			Outbox:      outbox,
+			MaxAttempts: 3,
+			BaseDelay:   time.Minute,
+			Clock:       func() time.Time { return now },
+		})
+		convey.So(d.AddEndpoint(&Endpoint{Name: "order", URL: ts.URL}), convey.ShouldNotBeNil)
+		convey.So(d.AddEndpoint(&Endpoint{Name: "order", URL: ts.URL, Secret: "secret"}), convey.ShouldBeNil)
+		convey.So(d.AddEndpoint(&Endpoint{Name: "wallet", URL: ts.URL, Secret: "secret", Events: []string{EventWithdraw}}), convey.ShouldBeNil)
+		convey.So(d.PayResult(&payment.PayResult{Succ: true, No: "P001", Money: 10}), convey.ShouldBeNil)
+		convey.So(d.WithdrawResult(&payment.WithdrawResult{TradeNo: "W001", Status: payment.SUCCESS}), convey.ShouldBeNil)
+		due, _ := outbox.Due(now, 0)
+		convey.So(len(due), convey.ShouldEqual, 3)
+
+		n, err := d.Deliver()
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(n, convey.ShouldEqual, 0)
+		convey.So(len(srv.bodies), convey.ShouldEqual, 3)
+		for _, e := range srv.errs {
+			convey.So(e, convey.ShouldBeNil)
+		}
+		convey.So(srv.bodies[0], convey.ShouldContainSubstring, `"key":"P001"`)
+		due, _ = outbox.Due(now, 0)
+		convey.So(len(due), convey.ShouldEqual, 0)
+		v, _ := outbox.Get(1)
+		convey.So(v.Attempts, convey.ShouldEqual, 1)
+		convey.So(v.NextTime, convey.ShouldResemble, now.Add(time.Minute))
+		convey.So(v.LastError, convey.ShouldStartWith, "HTTP 500")
+
+		now = now.Add(time.Minute)
+		n, _ = d.Deliver()
+		convey.So(n, convey.ShouldEqual, 0)
+		now = now.Add(2 * time.Minute)
+		n, _ = d.Deliver()
+		convey.So(n, convey.ShouldEqual, 0)
+		dead, err := d.DeadLetters(0)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(len(dead), convey.ShouldEqual, 3)
+		convey.So(dead[0].Attempts, convey.ShouldEqual, 3)
+		convey.So(len(srv.bodies), convey.ShouldEqual, 9)
+
+		convey.So(d.Replay(99), convey.ShouldEqual, ErrDeliveryNotExists)
+		srv.status = http.StatusOK
+		convey.So(d.Replay(1), convey.ShouldBeNil)
+		convey.So(d.Replay(1), convey.ShouldNotBeNil)
+		n, err = d.Deliver()
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(n, convey.ShouldEqual, 1)
+		v, _ = outbox.Get(1)
+		convey.So(v.Status, convey.ShouldEqual, DeliverySuccess)
+		convey.So(v.LastError, convey.ShouldEqual, "")
+		dead, _ = d.DeadLetters(0)
+		convey.So(len(dead), convey.ShouldEqual, 2)
+
+		d.RemoveEndpoint("wallet")
+		for _, v := range dead {
+			if v.Endpoint == "wallet" {
+				convey.So(d.Replay(v.ID), convey.ShouldBeNil)
+			}
+		}
+		now = now.Add(time.Minute)
+		n, _ = d.Deliver()
+		convey.So(n, convey.ShouldEqual, 0)
+		dead, _ = d.DeadLetters(0)
+		convey.So(len(dead), convey.ShouldEqual, 2)
+		convey.So(dead[0].Endpoint, convey.ShouldEqual, "wallet")
+		convey.So(dead[0].LastError, convey.ShouldEqual, "接收地址不存在")
+	})
+}
@@ -1,14 +1,38 @@
 package wxpay
 
-import "github.com/kinwyb/golang/payment"
+import (
+	"errors"
+
+	"github.com/kinwyb/golang/payment"
+)
 
 //PayConfig 支付配置信息
 type PayConfig struct {
 	payment.Config
-	AppID     string //微信应用ID
-	MchID     string //微信商户ID
-	Key       string //微信交易密钥
-	NotifyURL string //交易结果通知地址
+	AppID         string   //微信应用ID
+	MchID         string   //微信商户ID
+	Key           string   //微信交易密钥
+	NotifyURL     string   //交易结果通知地址
+	Currencies    []string //支持的交易币种[跨境交易],为空只支持CNY
+	PlanID        string   //代扣模板ID[委托代扣]
+	SignNotifyURL string   //代扣签约/解约结果通知地址[委托代扣]
+	RawTradeNo    bool     //商户订单号(out_trade_no)直接使用交易单号,不增加时间前缀.调用方需保证每次发起支付的交易单号不重复,开启后可以根据交易单号查询和关闭订单
+	//OrderVerifier 订单业务校验,异步通知签名验证通过后调用
+	OrderVerifier payment.OrderVerifier `json:"-"`
+}
+
+//JSAPIExt 公众号/小程序支付扩展信息
+//	支付请求PayRequest中Ext填写OpenID时使用JSAPI支付,返回调起支付的参数(json)
+type JSAPIExt struct {
+	OpenID string `description:"用户在公众号/小程序下的openid[JSAPI支付必填]"`
+}
+
+//Validate 校验扩展信息
+func (j *JSAPIExt) Validate() error {
+	if len(j.OpenID) > 128 {
+		return errors.New("扩展信息[OpenID]长度不能超过128")
+	}
+	return nil
 }
 
 //WithdrawConfig 提现配置信息
@@ -2,13 +2,11 @@ package wxpay
 
 import (
 	"bytes"
-	"encoding/base64"
 	"encoding/xml"
 	"errors"
-	"image/png"
 	"io"
+	"io/ioutil"
 	"math/rand"
-	"os"
 	"strings"
 	"time"
 
@@ -17,8 +15,7 @@ import (
 	"crypto/md5"
 	"encoding/hex"
 
-	"github.com/boombuler/barcode"
-	"github.com/boombuler/barcode/qr"
+	"github.com/kinwyb/golang/payment/qrcode"
 )
 
 //公共函数
@@ -28,28 +25,21 @@ import (
 //@param size int  大小
 //@param filename int 保存文件名称[空时不保存文件]
 //@return string base64编码图片数据[如果保存到文件返回空]
+//Deprecated: 使用payment/qrcode包生成二维码
 func QRCode(content string, size int, filename ...string) (string, error) {
-	qrCode, _ := qr.Encode(content, qr.M, qr.Auto)
-	qrCode, _ = barcode.Scale(qrCode, size, size)
+	opt := &qrcode.Options{Size: size}
 	if filename != nil && len(filename) > 0 {
-		file, err := os.Create(filename[0])
+		data, err := qrcode.EncodePNG(content, opt)
 		if err != nil {
-			return "", errors.New("文件创建失败:" + err.Error())
+			return "", err
 		}
-		err = png.Encode(file, qrCode)
-		file.Close()
+		err = ioutil.WriteFile(filename[0], data, 0644)
 		if err != nil {
-			return "", errors.New("图像编码失败:" + err.Error())
+			return "", errors.New("文件创建失败:" + err.Error())
 		}
 		return "", nil
 	}
-	bt := &bytes.Buffer{}
-	err := png.Encode(bt, qrCode)
-	if err != nil {
-		return "", errors.New("图像编码失败:" + err.Error())
-	}
-	imgstr := base64.StdEncoding.EncodeToString(bt.Bytes())
-	return "data:image/png;base64," + imgstr, nil
+	return qrcode.EncodeDataURI(content, opt)
 }
 
 //解析XML
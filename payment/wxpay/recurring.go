@@ -0,0 +1,205 @@
+package wxpay
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/url"
+	"strconv"
+
+	"github.com/kinwyb/golang/payment"
+	"github.com/kinwyb/golang/utils"
+)
+
+//委托代扣(papay)
+//	1. Sign 签约,网页签约返回签约跳转地址,APP签约返回预签约ID(pre_entrustweb_id),签约/解约结果推送到SignNotifyURL
+//	2. Charge 申请扣款(pay/pappayapply),扣款结果与普通支付相同通过Notify异步通知
+//	3. QueryAgreement/Unsign 查询签约关系/申请解约,协议号为微信委托代扣协议ID(contract_id)
+
+const papayURL = "https://api.mch.weixin.qq.com/papay/"
+
+//Sign 委托代扣签约,IsApp为true时返回APP签约使用的预签约ID,否则返回公众号/网页签约跳转地址
+func (w *wxpay) Sign(req *payment.SignRequest) (string, error) {
+	if err := req.Validate(); err != nil {
+		return "", err
+	} else if w.config.PlanID == "" {
+		return "", errors.New("微信代扣模板ID[PlanID]未配置")
+	}
+	account := req.Desc
+	if account == "" {
+		account = req.MemberID
+	}
+	params := map[string]string{
+		"appid":                    w.config.AppID,
+		"mch_id":                   w.config.MchID,
+		"plan_id":                  w.config.PlanID,
+		"contract_code":            req.No,                                        //商户签约号
+		"request_serial":           strconv.FormatInt(w.Now().UnixNano()/1e6, 10), //请求序列号,商户侧唯一
+		"contract_display_account": account,                                       //用户账户展示名称
+		"notify_url":               w.config.SignNotifyURL,
+		"version":                  "1.0",
+		"timestamp":                strconv.FormatInt(w.Now().Unix(), 10),
+	}
+	if req.IsApp {
+		ret, err := w.papay(papayURL+"preentrustweb", params)
+		if err != nil {
+			return "", err
+		}
+		return ret["pre_entrustweb_id"], nil
+	}
+	sign(params, w.config.Key)
+	values := url.Values{}
+	for k, v := range params {
+		values.Set(k, v)
+	}
+	return papayURL + "entrustweb?" + values.Encode(), nil
+}
+
+//SignNotify 签约/解约异步通知处理,change_type为ADD表示签约,DELETE表示解约
+func (w *wxpay) SignNotify(params map[string]string) *payment.AgreementResult {
+	ret := &payment.AgreementResult{
+		PayCode: w.Code(),
+		Status:  payment.AgreementUnknow,
+	}
+	args, err := decodeXMLToMap([]byte(params["request_post_body"]))
+	if err != nil {
+		ret.ErrMsg = "微信签约通知数据解析失败"
+		return ret
+	}
+	ret.Navite = args
+	ret.No = args["contract_code"]
+	ret.AgreementNo = args["contract_id"]
+	ret.ThirdAccount = args["openid"]
+	if args["return_code"] != "SUCCESS" || args["result_code"] != "SUCCESS" {
+		ret.ErrMsg = "微信签约失败:" + args["return_msg"] + ":" + args["err_code_des"]
+		return ret
+	}
+	signSrc := args["sign"]
+	sign(args, w.config.Key)
+	if args["sign"] != signSrc {
+		ret.ErrMsg = "微信签约通知签名验证失败"
+		return ret
+	} else if args["mch_id"] != w.config.MchID {
+		ret.ErrMsg = "微信签约通知商户号不匹配"
+		return ret
+	}
+	ret.Succ = true
+	switch args["change_type"] {
+	case "ADD":
+		ret.Status = payment.AgreementNormal
+		ret.SignTime = args["operate_time"]
+		ret.InvalidTime = args["contract_expired_time"]
+	case "DELETE":
+		ret.Status = payment.AgreementStop
+		ret.InvalidTime = args["operate_time"]
+	}
+	return ret
+}
+
+//SignNotifyResult 签约/解约异步通知处理结果返回内容
+func (w *wxpay) SignNotifyResult(ret *payment.AgreementResult) string {
+	if ret != nil && ret.Succ {
+		return "<xml><return_code>SUCCESS</return_code><return_msg>OK</return_msg></xml>"
+	}
+	return "<xml><return_code>FAIL</return_code><return_msg>处理失败</return_msg></xml>"
+}
+
+//QueryAgreement 查询签约关系,agreementNo为空时使用模板ID和商户签约号查询
+func (w *wxpay) QueryAgreement(agreementNo string, no string) *payment.AgreementResult {
+	ret := &payment.AgreementResult{
+		PayCode:     w.Code(),
+		No:          no,
+		AgreementNo: agreementNo,
+		Status:      payment.AgreementUnknow,
+	}
+	params := map[string]string{
+		"appid":       w.config.AppID,
+		"mch_id":      w.config.MchID,
+		"contract_id": agreementNo,
+		"version":     "1.0",
+	}
+	if agreementNo == "" {
+		params["plan_id"] = w.config.PlanID
+		params["contract_code"] = no
+	}
+	args, err := w.papay(papayURL+"querycontract", params)
+	if err != nil {
+		ret.ErrMsg = err.Error()
+		return ret
+	}
+	ret.Succ = true
+	ret.Navite = args
+	ret.No = args["contract_code"]
+	ret.AgreementNo = args["contract_id"]
+	ret.ThirdAccount = args["openid"]
+	ret.SignTime = args["contract_signed_time"]
+	ret.InvalidTime = args["contract_expired_time"]
+	switch args["contract_state"] {
+	case "0":
+		ret.Status = payment.AgreementNormal
+	case "1":
+		ret.Status = payment.AgreementStop
+		ret.InvalidTime = args["contract_terminated_time"]
+	}
+	return ret
+}
+
+//Unsign 申请解约,remark为解约备注
+func (w *wxpay) Unsign(agreementNo string, remark string) error {
+	if agreementNo == "" {
+		return errors.New("协议号不能为空")
+	} else if remark == "" {
+		remark = "商户解约"
+	}
+	_, err := w.papay(papayURL+"deletecontract", map[string]string{
+		"appid":                       w.config.AppID,
+		"mch_id":                      w.config.MchID,
+		"contract_id":                 agreementNo,
+		"contract_termination_remark": remark,
+		"version":                     "1.0",
+	})
+	return err
+}
+
+//Charge 申请扣款,微信受理后返回nil,扣款结果通过Notify异步通知
+func (w *wxpay) Charge(req *payment.ChargeRequest) (*payment.PayResult, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	_, err := w.papay("https://api.mch.weixin.qq.com/pay/pappayapply", map[string]string{
+		"appid":            w.config.AppID,
+		"mch_id":           w.config.MchID,
+		"nonce_str":        w.Nonce(),
+		"body":             req.Desc,
+		"attach":           req.No, //与支付相同,异步通知中使用attach作为原始订单号
+		"out_trade_no":     req.No,
+		"total_fee":        strconv.FormatInt(payment.ToMinorUnit(req.Money, payment.CNY), 10),
+		"spbill_create_ip": req.IP,
+		"notify_url":       w.config.NotifyURL,
+		"trade_type":       "PAP",
+		"contract_id":      req.AgreementNo,
+	})
+	if err != nil {
+		if _, ok := err.(*payment.RequestFailed); ok { //请求已发送,扣款结果未知
+			w.Log(utils.LogLevelError, "微信扣款结果未知:%s", err.Error())
+			return nil, nil
+		}
+		return nil, err
+	}
+	return nil, nil
+}
+
+//委托代扣接口请求,返回验签后的结果
+func (w *wxpay) papay(apiURL string, params map[string]string) (map[string]string, error) {
+	sign(params, w.config.Key)
+	resp, err := w.HTTPClient().Post(apiURL, "application/xml;charset=utf-8", buildXML(params))
+	if err != nil {
+		return nil, payment.WrapRequestError(err, errors.New("微信请求失败:"+err.Error()))
+	}
+	data, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, &payment.RequestFailed{Err: errors.New("微信请求失败:" + err.Error())}
+	}
+	w.Log(utils.LogLevelInfo, "微信委托代扣请求结果:%s", data)
+	return w.decodeResp(data)
+}
@@ -0,0 +1,113 @@
+package wxpay
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kinwyb/golang/payment"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+//模拟微信网关,记录请求参数并返回预先录制的应答
+type recurringTestTransport struct {
+	reqs map[string]map[string]string
+	resp string
+	err  error
+}
+
+func (t *recurringTestTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	data, _ := ioutil.ReadAll(r.Body)
+	t.reqs[r.URL.Path], _ = decodeXMLToMap(data)
+	if t.err != nil {
+		return nil, t.err
+	}
+	return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader(t.resp)), Header: http.Header{}}, nil
+}
+
+//使用测试密钥签名的微信应答
+func recurringTestXML(key string, args map[string]string) string {
+	sign(args, key)
+	return buildXML(args).String()
+}
+
+func Test_Recurring(t *testing.T) {
+	transport := &recurringTestTransport{reqs: map[string]map[string]string{}}
+	cfg := &PayConfig{
+		AppID:     "wx2421b1c4370ec43b",
+		MchID:     "10000100",
+		Key:       "192006250b4c09247ec02edce69f6a2d",
+		NotifyURL: "https://example.com/notify/wxpay",
+		PlanID:    "12535",
+	}
+	cfg.Code = "wxpay"
+	cfg.Name = "微信支付"
+	cfg.State = true
+	cfg.Options = &payment.Options{
+		HTTPClient: &http.Client{Transport: transport},
+		Clock:      func() time.Time { return time.Date(2017, 10, 19, 10, 0, 0, 0, time.Local) },
+		Nonce:      func() string { return "5K8264ILTKCH16CQ2502SI8ZNMTM67VS" },
+	}
+	w := (&wxpay{}).GetPayment(cfg).(*wxpay)
+	convey.Convey("申请扣款", t, func() {
+		transport.resp = recurringTestXML(cfg.Key, map[string]string{
+			"return_code": "SUCCESS", "return_msg": "OK", "appid": cfg.AppID, "mch_id": cfg.MchID,
+			"nonce_str": "IITRi8Iabbblz1Jc", "result_code": "SUCCESS",
+		})
+		ret, err := w.Charge(&payment.ChargeRequest{No: "201710190001", AgreementNo: "Wx15463511252015071056489715", Desc: "会员续费", Money: 0.29, IP: "127.0.0.1"})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ret, convey.ShouldBeNil)
+		req := transport.reqs["/pay/pappayapply"]
+		convey.So(req["total_fee"], convey.ShouldEqual, "29")
+		convey.So(req["trade_type"], convey.ShouldEqual, "PAP")
+		convey.So(req["contract_id"], convey.ShouldEqual, "Wx15463511252015071056489715")
+		convey.So(req["attach"], convey.ShouldEqual, "201710190001")
+		_, err = w.Charge(&payment.ChargeRequest{No: "201710190001", AgreementNo: "Wx1", Money: 19.99})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(transport.reqs["/pay/pappayapply"]["total_fee"], convey.ShouldEqual, "1999")
+
+		transport.resp = recurringTestXML(cfg.Key, map[string]string{
+			"return_code": "SUCCESS", "result_code": "FAIL", "err_code": "CONTRACT_NOT_EXIST", "err_code_des": "签约协议不存在",
+		})
+		_, err = w.Charge(&payment.ChargeRequest{No: "201710190002", AgreementNo: "Wx1", Money: 1})
+		convey.So(err.Error(), convey.ShouldEqual, "微信请求失败:签约协议不存在")
+		transport.resp = strings.Replace(recurringTestXML(cfg.Key, map[string]string{"return_code": "SUCCESS", "result_code": "SUCCESS"}), "SUCCESS</result_code>", "SUCCESS</result_code><attach>x</attach>", 1)
+		_, err = w.Charge(&payment.ChargeRequest{No: "201710190002", AgreementNo: "Wx1", Money: 1})
+		convey.So(err.Error(), convey.ShouldEqual, "微信签名验证失败")
+		transport.err = errors.New("timeout")
+		ret, err = w.Charge(&payment.ChargeRequest{No: "201710190002", AgreementNo: "Wx1", Money: 1})
+		convey.So(ret, convey.ShouldBeNil)
+		convey.So(err, convey.ShouldBeNil)
+		transport.err = nil
+	})
+	convey.Convey("签约通知", t, func() {
+		body := recurringTestXML(cfg.Key, map[string]string{
+			"return_code": "SUCCESS", "result_code": "SUCCESS", "mch_id": cfg.MchID, "contract_code": "S001",
+			"plan_id": cfg.PlanID, "openid": "onqOjjmM1tad-3ROpncN-yUfa6uI", "change_type": "ADD",
+			"operate_time": "2017-10-19 10:00:00", "contract_id": "Wx15463511252015071056489715",
+			"contract_expired_time": "2018-10-19 10:00:00", "request_serial": "1508378400000",
+		})
+		ret := w.SignNotify(map[string]string{"request_post_body": body})
+		convey.So(ret.Succ, convey.ShouldBeTrue)
+		convey.So(ret.Status, convey.ShouldEqual, payment.AgreementNormal)
+		convey.So(ret.No, convey.ShouldEqual, "S001")
+		convey.So(ret.AgreementNo, convey.ShouldEqual, "Wx15463511252015071056489715")
+		convey.So(w.SignNotifyResult(ret), convey.ShouldContainSubstring, "SUCCESS")
+
+		ret = w.SignNotify(map[string]string{"request_post_body": strings.Replace(body, "<change_type>ADD", "<change_type>DELETE", 1)})
+		convey.So(ret.Succ, convey.ShouldBeFalse)
+		convey.So(ret.ErrMsg, convey.ShouldEqual, "微信签约通知签名验证失败")
+		convey.So(w.SignNotifyResult(ret), convey.ShouldContainSubstring, "FAIL")
+		ret = w.SignNotify(map[string]string{"request_post_body": recurringTestXML("other", map[string]string{
+			"return_code": "SUCCESS", "result_code": "SUCCESS", "mch_id": cfg.MchID, "change_type": "ADD",
+		})})
+		convey.So(ret.ErrMsg, convey.ShouldEqual, "微信签约通知签名验证失败")
+		ret = w.SignNotify(map[string]string{"request_post_body": recurringTestXML(cfg.Key, map[string]string{
+			"return_code": "SUCCESS", "result_code": "SUCCESS", "mch_id": "10000101", "change_type": "ADD",
+		})})
+		convey.So(ret.ErrMsg, convey.ShouldEqual, "微信签约通知商户号不匹配")
+	})
+}
@@ -61,15 +61,25 @@ func (w *wxwithdraw) GetWithdraw(cfg interface{}) payment.Withdraw {
 		},
 	}
 	obj.Init(obj.config.Code, obj.config.Name, obj.config.State)
+	obj.SetFee(obj.config.Fee)
+	obj.SetOptions(obj.config.Options, &lg)
 	return obj
 }
 
 //提现操作,成功返回第三方交易流水,失败返回错误
 func (w *wxwithdraw) Withdraw(info *payment.WithdrawInfo) *payment.WithdrawResult {
+	return w.FillWithdrawFee(w.withdraw(info), info)
+}
+
+//提现请求
+func (w *wxwithdraw) withdraw(info *payment.WithdrawInfo) *payment.WithdrawResult {
+	if !payment.IsCNY(info.Currency) {
+		return payment.WithdrawCurrencyNotSupport
+	}
 	params := map[string]string{
 		"mch_appid":        w.config.AppID,
 		"mchid":            w.config.MchID,
-		"nonce_str":        nonceStr(),
+		"nonce_str":        w.Nonce(),
 		"partner_trade_no": info.TradeNo,
 		"check_name":       "FORCE_CHECK",
 		"openid":           info.CardNo,
@@ -94,20 +104,20 @@ func (w *wxwithdraw) Withdraw(info *payment.WithdrawInfo) *payment.WithdrawResul
 				UserName:     info.UserName,
 				WithdrawCode: w.Code(),
 				WithdrawName: w.Name(),
-				PayTime:      time.Now().Format("2006-01-02 15:04:05"),
+				PayTime:      w.Now().Format("2006-01-02 15:04:05"),
 				Status:       payment.SUCCESS,
 			}
 		} else if result["err_code"] == "SYSTEMERROR" { //请求结果提示业务繁忙的,调用查询接口确认一下业务是否真实失败
 			return w.withdrawCheckResult(info)
 		}
-		log(utils.LogLevelError, "微信提现失败:%s", result["err_code_des"])
+		w.Log(utils.LogLevelError, "微信提现失败:%s", result["err_code_des"])
 		return &payment.WithdrawResult{
 			Status:   payment.FAIL,
 			FailCode: result["err_code"],
 			FailMsg:  result["err_code_des"],
 		}
 	}
-	log(utils.LogLevelError, "微信提现失败:%s", result["return_msg"])
+	w.Log(utils.LogLevelError, "微信提现失败:%s", result["return_msg"])
 	return &payment.WithdrawResult{
 		Status:   payment.FAIL,
 		FailCode: result["return_code"],
@@ -128,7 +138,7 @@ func (w *wxwithdraw) withdrawCheckResult(info *payment.WithdrawInfo) *payment.Wi
 			UserName:     info.UserName,
 			WithdrawCode: w.Code(),
 			WithdrawName: w.Name(),
-			PayTime:      time.Now().Format("2006-01-02 15:04:05"),
+			PayTime:      w.Now().Format("2006-01-02 15:04:05"),
 			Status:       payment.SUCCESS,
 		}
 	} else if res.Status == payment.DEALING {
@@ -140,11 +150,11 @@ func (w *wxwithdraw) withdrawCheckResult(info *payment.WithdrawInfo) *payment.Wi
 			UserName:     info.UserName,
 			WithdrawCode: w.Code(),
 			WithdrawName: w.Name(),
-			PayTime:      time.Now().Format("2006-01-02 15:04:05"),
+			PayTime:      w.Now().Format("2006-01-02 15:04:05"),
 			Status:       payment.DEALING,
 		}
 	}
-	log(utils.LogLevelError, "微信提现失败[%s]:%s", res.FailCode, res.FailMsg)
+	w.Log(utils.LogLevelError, "微信提现失败[%s]:%s", res.FailCode, res.FailMsg)
 	return &payment.WithdrawResult{
 		Status:   payment.FAIL,
 		FailCode: res.FailCode,
@@ -158,29 +168,32 @@ func (w *wxwithdraw) withdrawCheckResult(info *payment.WithdrawInfo) *payment.Wi
 func (w *wxwithdraw) request(params map[string]string, apiURL string) (map[string]string, *payment.WithdrawResult) {
 	sign(params, w.config.Key)
 	xmlstr := buildXML(params)
-	log(utils.LogLevelInfo, "微信地址:%s", apiURL)
-	log(utils.LogLevelInfo, "微信请求:%s", xmlstr)
+	w.Log(utils.LogLevelInfo, "微信地址:%s", apiURL)
+	w.Log(utils.LogLevelInfo, "微信请求:%s", xmlstr)
 	request, err := http.NewRequest("POST", apiURL, strings.NewReader(xmlstr.String()))
 	if err != nil {
-		log(utils.LogLevelError, "微信提现请求创建失败:%s", err.Error())
+		w.Log(utils.LogLevelError, "微信提现请求创建失败:%s", err.Error())
 		return nil, payment.WithdrawRequestFail
 	}
-	client := &http.Client{Transport: w.transport}
+	client := w.HTTPClient(&http.Client{Transport: w.transport})
 	response, err := client.Do(request)
 	if err != nil {
-		log(utils.LogLevelError, "微信提现请求失败:%s", err.Error())
+		w.Log(utils.LogLevelError, "微信提现请求失败:%s", err.Error())
+		if payment.IsDialError(err) {
+			return nil, payment.WithdrawRequestNotSent
+		}
 		return nil, payment.WithdrawRequestFail
 	}
 	responsedata, err := ioutil.ReadAll(response.Body)
 	response.Body.Close()
 	if err != nil {
-		log(utils.LogLevelError, "微信提现请求结果读取失败:%s", err.Error())
+		w.Log(utils.LogLevelError, "微信提现请求结果读取失败:%s", err.Error())
 		return nil, payment.WithdrawResponseReadFail
 	}
-	log(utils.LogLevelInfo, "微信提现结果:%s", responsedata)
+	w.Log(utils.LogLevelInfo, "微信提现结果:%s", responsedata)
 	result, err := decodeXMLToMap(responsedata)
 	if err != nil {
-		log(utils.LogLevelError, "微信提现请求结果解析失败:%s", err.Error())
+		w.Log(utils.LogLevelError, "微信提现请求结果解析失败:%s", err.Error())
 		return nil, payment.WithdrawResponseUnserializeFail
 	}
 	return result, nil
@@ -191,7 +204,7 @@ func (w *wxwithdraw) QueryWithdraw(tradeno string, tradeDate ...time.Time) *paym
 	params := map[string]string{
 		"appid":            w.config.AppID,
 		"mch_id":           w.config.MchID,
-		"nonce_str":        nonceStr(),
+		"nonce_str":        w.Nonce(),
 		"partner_trade_no": tradeno,
 	}
 	result, err := w.request(params, "https://api.mch.weixin.qq.com/mmpaymkttransfers/gettransferinfo")
@@ -219,3 +232,10 @@ func (w *wxwithdraw) QueryWithdraw(tradeno string, tradeDate ...time.Time) *paym
 	}
 	return ret
 }
+
+//Capabilities 提现能力,CardNo为收款用户openid
+func (w *wxwithdraw) Capabilities() *payment.Capabilities {
+	return &payment.Capabilities{
+		Required: []string{"TradeNo", "UserName", "CardNo", "Money", "IP"},
+	}
+}
@@ -29,7 +29,7 @@ func Test_withdraw(t *testing.T) {
 	}
 	cfg.CertKey, err = ioutil.ReadAll(c)
 	if err != nil {
-		t.Error("证书读取失败:%s", err.Error())
+		t.Errorf("证书读取失败:%s", err.Error())
 		return
 	}
 	ww := &wxwithdraw{}
@@ -42,9 +42,9 @@ func Test_withdraw(t *testing.T) {
 		Desc:     "提现测试",
 		IP:       "127.0.0.1",
 	}
-	result, e := w.Withdraw(winfo)
-	if e != nil {
-		t.Errorf("提现失败:%s", e.Error())
+	result := w.Withdraw(winfo)
+	if result.Status == payment.FAIL {
+		t.Errorf("提现失败:%s", result.FailMsg)
 		return
 	}
 	t.Logf("提现单号:%s", result.ThridFlowNo)
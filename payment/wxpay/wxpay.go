@@ -1,20 +1,23 @@
 package wxpay
 
 import (
+	"encoding/json"
 	"errors"
-	"time"
 
 	"github.com/kinwyb/golang/payment"
+	"github.com/kinwyb/golang/payment/qrcode"
 
 	"github.com/kinwyb/golang/utils"
 
-	"net/http"
-
 	"io/ioutil"
 
 	"strconv"
+	"time"
 )
 
+//订单接口地址
+const orderURL = "https://api.mch.weixin.qq.com/pay/"
+
 type wxpay struct {
 	payment.PayInfo
 	config *PayConfig
@@ -23,32 +26,104 @@ type wxpay struct {
 
 //支付,返回支付代码
 func (w *wxpay) Pay(req *payment.PayRequest) (string, error) {
+	if !payment.SupportCurrency(w, req.Currency) {
+		return "", errors.New("不支持的交易币种:" + req.Currency)
+	}
+	ext := &JSAPIExt{}
+	if err := req.DecodeExt(ext); err != nil {
+		return "", err
+	} else if ext.OpenID != "" && req.IsApp {
+		return "", errors.New("APP支付不支持JSAPI支付")
+	}
 	params := map[string]string{
-		"appid":        w.config.AppID,                              //微信分配的公众账号ID
-		"mch_id":       w.config.MchID,                              //微信支付分配的商户号
-		"nonce_str":    nonceStr(),                                  //随机字符串
-		"body":         req.Desc,                                    //商品名称
-		"attach":       req.No,                                      //由于统一订单号无法重复发起支付所以订单号只能存放在附加字段,交易单号重新生成
-		"total_fee":    strconv.FormatInt(int64(req.Money*100), 10), //交易金额,单位分
+		"appid":        w.config.AppID,                                                     //微信分配的公众账号ID
+		"mch_id":       w.config.MchID,                                                     //微信支付分配的商户号
+		"nonce_str":    w.Nonce(),                                                          //随机字符串
+		"body":         req.Desc,                                                           //商品名称
+		"attach":       req.No,                                                             //由于统一订单号无法重复发起支付所以订单号只能存放在附加字段,交易单号重新生成
+		"total_fee":    strconv.FormatInt(payment.ToMinorUnit(req.Money, payment.CNY), 10), //交易金额,单位分
 		"notify_url":   w.config.NotifyURL,
 		"trade_type":   "NATIVE",
 		"product_id":   "0",
-		"out_trade_no": time.Now().Format("150405") + req.No,
+		"out_trade_no": w.Now().Format("150405") + req.No,
+	}
+	if w.config.RawTradeNo {
+		params["out_trade_no"] = req.No
+	}
+	if !payment.IsCNY(req.Currency) { //跨境交易,total_fee为标价币种最小单位金额
+		params["fee_type"] = payment.Currency(req.Currency)
+		params["total_fee"] = strconv.FormatInt(payment.ToMinorUnit(req.Money, req.Currency), 10)
+	}
+	if ext.OpenID != "" { //公众号/小程序支付
+		params["trade_type"] = "JSAPI"
+		params["openid"] = ext.OpenID
+		delete(params, "product_id")
 	}
 	sign(params, w.config.Key)
 	xmlBuf := buildXML(params)
-	resp, err := http.Post(w.apiURL, "application/xml;charset=utf-8", xmlBuf)
+	resp, err := w.HTTPClient().Post(w.apiURL, "application/xml;charset=utf-8", xmlBuf)
 	if err != nil {
-		return "", errors.New("微信请求失败:" + err.Error())
+		return "", payment.WrapRequestError(err, errors.New("微信请求失败:"+err.Error()))
 	}
 	data, err := ioutil.ReadAll(resp.Body)
 	resp.Body.Close()
 	if err != nil {
-		return "", errors.New("微信请求失败:" + err.Error())
+		return "", &payment.RequestFailed{Err: errors.New("微信请求失败:" + err.Error())}
 	} else if req.IsApp {
 		return string(data), nil //app支付无需处理直接返回结果
 	}
-	return w.decodeResp(data)
+	wxRes, err := w.decodeResp(data)
+	if err != nil {
+		return "", err
+	} else if ext.OpenID != "" {
+		return w.jsapiParams(wxRes["prepay_id"])
+	}
+	return wxRes["code_url"], nil
+}
+
+//JSAPI调起支付参数,json格式
+func (w *wxpay) jsapiParams(prepayID string) (string, error) {
+	params := map[string]string{
+		"appId":     w.config.AppID,
+		"timeStamp": strconv.FormatInt(w.Now().Unix(), 10),
+		"nonceStr":  w.Nonce(),
+		"package":   "prepay_id=" + prepayID,
+		"signType":  "MD5",
+	}
+	sign(params, w.config.Key)
+	params["paySign"] = params["sign"]
+	delete(params, "sign")
+	data, err := json.Marshal(params)
+	if err != nil {
+		return "", errors.New("支付参数序列化失败:" + err.Error())
+	}
+	return string(data), nil
+}
+
+//QRCodePay 扫码支付(NATIVE),返回二维码内容,opt不为空时同时返回生成的二维码图片
+func (w *wxpay) QRCodePay(req *payment.PayRequest, opt *qrcode.Options) (*payment.QRCodeResult, error) {
+	if req.IsApp {
+		return nil, errors.New("APP支付不支持扫码支付")
+	}
+	ext := &JSAPIExt{}
+	if err := req.DecodeExt(ext); err != nil {
+		return nil, err
+	} else if ext.OpenID != "" {
+		return nil, errors.New("JSAPI支付不支持扫码支付")
+	}
+	code, err := w.Pay(req)
+	if err != nil {
+		return nil, err
+	}
+	ret := &payment.QRCodeResult{Code: code}
+	if opt != nil {
+		ret.Image, err = qrcode.Render(code, opt)
+		if err != nil {
+			w.Log(utils.LogLevelError, "二维码创建失败:%s", err.Error())
+			return ret, errors.New("二维码创建失败")
+		}
+	}
+	return ret, nil
 }
 
 //异步结果通知处理,返回支付结果
@@ -80,15 +155,134 @@ func (w *wxpay) Notify(params map[string]string) *payment.PayResult {
 		ret.ErrMsg = "微信支付签名验证失败"
 		return ret
 	}
-	money, err := strconv.ParseFloat(args["total_fee"], 64)
+	if err = setMoney(ret, args); err != nil {
+		ret.Succ = false
+		ret.ErrMsg = "交易金额异常:" + err.Error()
+		return ret
+	}
+	order := &payment.NotifyOrder{
+		PayCode:      ret.PayCode,
+		No:           ret.No,
+		TradeNo:      ret.TradeNo,
+		ThirdTradeNo: ret.ThirdTradeNo,
+		Money:        ret.Money,
+		AppID:        args["appid"],
+		MerchantID:   args["mch_id"],
+		Navite:       args,
+	}
+	err = order.VerifyMerchant(w.config.AppID, w.config.MchID)
+	if err == nil {
+		err = payment.VerifyNotifyOrder(w.config.OrderVerifier, order)
+	}
 	if err != nil {
 		ret.Succ = false
+		ret.ErrMsg = "微信支付订单校验失败:" + err.Error()
+		return ret
+	}
+	ret.Succ = true
+	return w.FillPayFee(ret)
+}
+
+//交易金额及跨境交易结算信息
+func setMoney(ret *payment.PayResult, args map[string]string) error {
+	money, err := strconv.ParseFloat(args["total_fee"], 64)
+	if err != nil {
+		return err
+	}
+	ret.Currency = payment.Currency(args["fee_type"])
+	ret.Money = payment.FromMinorUnit(int64(money), ret.Currency)
+	if args["cash_fee_type"] != "" && args["cash_fee_type"] != args["fee_type"] { //跨境交易用户实际支付币种及金额
+		ret.SettleCurrency = args["cash_fee_type"]
+		cashFee, _ := strconv.ParseInt(args["cash_fee"], 10, 64)
+		ret.SettleMoney = payment.FromMinorUnit(cashFee, ret.SettleCurrency)
+		rate, _ := strconv.ParseFloat(args["rate"], 64)
+		ret.ExchangeRate = rate / 1e8 //微信汇率为1标价币种兑换人民币的汇率乘以10^8
+	}
+	return nil
+}
+
+//Query 查询订单(orderquery),tradeno为商户订单号(out_trade_no)
+//	请求失败、结果验证失败时返回结果的Navite为空,表示支付状态未知
+//	未开启RawTradeNo时商户订单号包含时间前缀,订单不存在不能说明未支付,同样返回Navite为空的结果
+func (w *wxpay) Query(tradeno string, tradeDate ...time.Time) *payment.PayResult {
+	ret := &payment.PayResult{
+		PayCode: w.Code(),
+		TradeNo: tradeno,
+	}
+	args, err := w.order("orderquery", tradeno)
+	if err != nil {
+		ret.ErrMsg = err.Error()
+		return ret
+	} else if args["result_code"] != "SUCCESS" {
+		ret.ErrMsg = "微信查询订单失败:[" + args["err_code"] + "]" + args["err_code_des"]
+		if args["err_code"] == "ORDERNOTEXIST" && w.config.RawTradeNo {
+			ret.Navite = args
+		}
+		return ret
+	}
+	ret.Navite = args
+	ret.No = args["attach"]
+	ret.ThirdAccount = args["openid"]
+	ret.ThirdTradeNo = args["transaction_id"]
+	if err = setMoney(ret, args); err != nil {
 		ret.ErrMsg = "交易金额异常:" + err.Error()
+		ret.Navite = nil
+		return ret
+	} else if args["trade_state"] != "SUCCESS" {
+		ret.ErrMsg = "微信订单未支付:[" + args["trade_state"] + "]" + args["trade_state_desc"]
 		return ret
 	}
 	ret.Succ = true
-	ret.Money = money / 100
-	return ret
+	return w.FillPayFee(ret)
+}
+
+//Close 关闭订单(closeorder),tradeno为商户订单号(out_trade_no),订单已关闭时视为关闭成功
+//	开启RawTradeNo时订单不存在(用户未下单)也视为关闭成功
+func (w *wxpay) Close(tradeno string) error {
+	args, err := w.order("closeorder", tradeno)
+	if err != nil {
+		return err
+	}
+	switch {
+	case args["result_code"] == "SUCCESS", args["err_code"] == "ORDERCLOSED":
+		return nil
+	case args["err_code"] == "ORDERNOTEXIST" && w.config.RawTradeNo:
+		return nil
+	}
+	return errors.New("微信关闭订单失败:[" + args["err_code"] + "]" + args["err_code_des"])
+}
+
+//订单查询/关闭接口请求,返回验签后的结果,业务结果(result_code)由调用方处理
+func (w *wxpay) order(api string, tradeno string) (map[string]string, error) {
+	params := map[string]string{
+		"appid":        w.config.AppID,
+		"mch_id":       w.config.MchID,
+		"out_trade_no": tradeno,
+		"nonce_str":    w.Nonce(),
+	}
+	sign(params, w.config.Key)
+	resp, err := w.HTTPClient().Post(orderURL+api, "application/xml;charset=utf-8", buildXML(params))
+	if err != nil {
+		return nil, payment.WrapRequestError(err, errors.New("微信请求失败:"+err.Error()))
+	}
+	data, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, &payment.RequestFailed{Err: errors.New("微信请求失败:" + err.Error())}
+	}
+	w.Log(utils.LogLevelInfo, "微信%s请求结果:%s", api, data)
+	args, err := decodeXMLToMap(data)
+	if err != nil {
+		return nil, errors.New("微信请求结果解析失败:" + err.Error())
+	} else if args["return_code"] != "SUCCESS" {
+		return nil, errors.New("微信通讯失败:" + args["return_msg"])
+	}
+	signSrc := args["sign"]
+	sign(args, w.config.Key)
+	if args["sign"] != signSrc {
+		return nil, errors.New("微信签名验证失败")
+	}
+	return args, nil
 }
 
 //异步通知处理结果返回内容
@@ -104,6 +298,11 @@ func (w *wxpay) Result(params map[string]string) *payment.PayResult { //微信
 	return nil
 }
 
+//Currencies 支持的交易币种
+func (w *wxpay) Currencies() []string {
+	return append([]string{payment.CNY}, w.config.Currencies...)
+}
+
 //GetPayment 生成一个支付对象
 func (w *wxpay) GetPayment(cfg interface{}) payment.Payment {
 	var c *PayConfig
@@ -120,6 +319,8 @@ func (w *wxpay) GetPayment(cfg interface{}) payment.Payment {
 		config: c,
 	}
 	obj.Init(obj.config.Code, obj.config.Name, obj.config.State)
+	obj.SetFee(obj.config.Fee)
+	obj.SetOptions(obj.config.Options, &lg)
 	return obj
 }
 
@@ -128,32 +329,50 @@ func (w *wxpay) Driver() string {
 }
 
 //解析请求返回结果
-func (w *wxpay) decodeResp(data []byte) (string, error) {
+func (w *wxpay) decodeResp(data []byte) (map[string]string, error) {
 	wxRes, err := decodeXMLToMap(data)
 	if err != nil {
-		return "", errors.New("微信请求结果解析失败:" + err.Error())
+		return nil, errors.New("微信请求结果解析失败:" + err.Error())
 	}
 	signSrc := wxRes["sign"]
 	if wxRes["return_code"] != "SUCCESS" {
-		return "", errors.New("微信通讯失败:" + wxRes["return_msg"])
+		return nil, errors.New("微信通讯失败:" + wxRes["return_msg"])
 	} else if wxRes["result_code"] != "SUCCESS" {
-		return "", errors.New("微信请求失败:" + wxRes["err_code_des"])
+		return nil, errors.New("微信请求失败:" + wxRes["err_code_des"])
 	}
 	sign(wxRes, w.config.Key)
 	if wxRes["sign"] != signSrc {
-		return "", errors.New("微信签名验证失败")
+		return nil, errors.New("微信签名验证失败")
 	}
-	return wxRes["code_url"], nil
-	//编码成二维码图片返回
-	//img, err := QRCode(wxRes["code_url"], 150)
-	//if err != nil {
-	//	log(utils.LogLevelError, "二维码创建失败:%s", err.Error())
-	//	return wxRes["code_url"], nil
-	//}
-	//return img, nil
+	return wxRes, nil
 }
 
 //无需确认支付
 func (w *wxpay) PayConfirm(req *payment.PayConfirmRequest) *payment.PayResult {
 	return payment.NoPayConfirmResult
 }
+
+//NewExt 支付请求扩展信息
+func (w *wxpay) NewExt() payment.PayExt {
+	return &JSAPIExt{}
+}
+
+//Capabilities 支付能力,没有同步跳转结果,IsApp为true时返回原始应答内容,Ext填写OpenID时使用JSAPI支付
+func (w *wxpay) Capabilities() *payment.Capabilities {
+	return &payment.Capabilities{
+		Operations: []string{payment.OpPay, payment.OpNotify},
+		TradeTypes: []string{payment.TradeQRCode, payment.TradeApp, payment.TradeJSAPI},
+		Required:   []string{"No", "Desc", "Money"},
+		ExtFormat:  payment.ExtJSON,
+		Ext:        payment.ExtFields(&JSAPIExt{}),
+		MaxTradeNo: w.maxTradeNo(),
+	}
+}
+
+//交易单号最大长度,out_trade_no最长32位,未设置RawTradeNo时前面会增加6位时间
+func (w *wxpay) maxTradeNo() int {
+	if w.config != nil && w.config.RawTradeNo {
+		return 32
+	}
+	return 26
+}